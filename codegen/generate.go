@@ -24,6 +24,7 @@ import (
 	"github.com/solo-io/skv2/pkg/crdutils"
 	soloapi_codegen "github.com/solo-io/solo-apis/codegen"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 func main() {
@@ -83,7 +84,8 @@ var (
 
 	snapshotApiGroups = map[string][]model.Group{
 		"":                                 groups.AllGeneratedGroups,
-		"github.com/solo-io/external-apis": externalapis.Groups,
+		"github.com/solo-io/external-apis": withoutGroupVersion(externalapis.Groups, groups.IstioSecurityGroup.GroupVersion, groups.IstioNetworkingGroup.GroupVersion, groups.K8sBatchGroup.GroupVersion),
+		"github.com/solo-io/gloo-mesh":     {groups.IstioSecurityGroup, groups.IstioNetworkingGroup, groups.K8sBatchGroup, groups.K8sDiscoveryGroup, groups.ArgoRolloutsGroup},
 		"github.com/solo-io/skv2":          {skv1alpha1.Group},
 		"github.com/solo-io/solo-apis":     soloapi_codegen.RateLimiterGroups(),
 	}
@@ -191,11 +193,32 @@ func makeGlooMeshCommand(chartOnly bool) codegen.Command {
 		AppName:           appName,
 		AnyVendorConfig:   anyvendorImports,
 		ManifestRoot:      glooMeshManifestRoot,
+		Groups:            []model.Group{groups.IstioSecurityGroup, groups.IstioNetworkingGroup, groups.K8sBatchGroup, groups.K8sDiscoveryGroup, groups.ArgoRolloutsGroup},
 		TopLevelTemplates: project.TopLevelTemplates(),
 		Chart:             helm.Chart,
 	}
 }
 
+// remove the given GroupVersions from the imported groups, used for groups which are generated locally instead
+func withoutGroupVersion(importedGroups []model.Group, groupVersions ...schema.GroupVersion) []model.Group {
+	var filtered []model.Group
+	for _, group := range importedGroups {
+		if !containsGroupVersion(groupVersions, group.GroupVersion) {
+			filtered = append(filtered, group)
+		}
+	}
+	return filtered
+}
+
+func containsGroupVersion(groupVersions []schema.GroupVersion, groupVersion schema.GroupVersion) bool {
+	for _, gv := range groupVersions {
+		if gv == groupVersion {
+			return true
+		}
+	}
+	return false
+}
+
 func makeGlooMeshCrdsCommand() codegen.Command {
 	return codegen.Command{
 		AppName:         appName,
@@ -8,9 +8,14 @@ package groups
 
 import (
 	"github.com/solo-io/gloo-mesh/codegen/constants"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 	gmversion "github.com/solo-io/gloo-mesh/pkg/common/version"
 	"github.com/solo-io/skv2/codegen/model"
 	"github.com/solo-io/skv2/contrib"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	istiosecurityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	batchv1 "k8s.io/api/batch/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -92,6 +97,94 @@ var AllGeneratedGroups = append(
 	XdsAgentGroup,
 )
 
+// Istio security types, generated locally because the external-apis group does not include RequestAuthentication.
+// This group replaces the external-apis security.istio.io group in snapshots.
+var IstioSecurityGroup = model.Group{
+	GroupVersion: istiosecurityv1beta1.SchemeGroupVersion,
+	Module:       "istio.io/client-go",
+	Resources: []model.Resource{
+		{Kind: "AuthorizationPolicy"},
+		{Kind: "PeerAuthentication"},
+		{Kind: "RequestAuthentication"},
+	},
+	CustomTypesImportPath: "istio.io/client-go/pkg/apis/security/v1beta1",
+	ApiRoot:               "pkg/api/external/istio",
+	RenderClients:         true,
+	RenderController:      true,
+	MockgenDirective:      true,
+	CustomTemplates:       contrib.AllGroupCustomTemplates,
+}
+
+// Istio networking types, generated locally because the external-apis group does not include WorkloadGroup.
+// This group replaces the external-apis networking.istio.io group in snapshots.
+var IstioNetworkingGroup = model.Group{
+	GroupVersion: istionetworkingv1alpha3.SchemeGroupVersion,
+	Module:       "istio.io/client-go",
+	Resources: []model.Resource{
+		{Kind: "DestinationRule"},
+		{Kind: "EnvoyFilter"},
+		{Kind: "Gateway"},
+		{Kind: "ServiceEntry"},
+		{Kind: "WorkloadEntry"},
+		{Kind: "WorkloadGroup"},
+		{Kind: "VirtualService"},
+		{Kind: "Sidecar"},
+	},
+	CustomTypesImportPath: "istio.io/client-go/pkg/apis/networking/v1alpha3",
+	ApiRoot:               "pkg/api/external/istio",
+	RenderClients:         true,
+	RenderController:      true,
+	MockgenDirective:      true,
+	CustomTemplates:       contrib.AllGroupCustomTemplates,
+}
+
+// Kubernetes batch types, generated locally because the external-apis group does not include CronJob.
+// This group replaces the external-apis batch group in snapshots.
+var K8sBatchGroup = model.Group{
+	GroupVersion: batchv1.SchemeGroupVersion,
+	Module:       "k8s.io/api",
+	Resources: []model.Resource{
+		{Kind: "Job"},
+		{Kind: "CronJob"},
+	},
+	CustomTypesImportPath: "k8s.io/api/batch/v1",
+	ApiRoot:               "pkg/api/external/k8s",
+	RenderClients:         true,
+	RenderController:      true,
+	MockgenDirective:      true,
+	CustomTemplates:       contrib.AllGroupCustomTemplates,
+}
+
+// Kubernetes EndpointSlices, generated locally because the external-apis groups do not include discovery.k8s.io.
+var K8sDiscoveryGroup = model.Group{
+	GroupVersion: discoveryv1.SchemeGroupVersion,
+	Module:       "k8s.io/api",
+	Resources: []model.Resource{
+		{Kind: "EndpointSlice"},
+	},
+	CustomTypesImportPath: "k8s.io/api/discovery/v1",
+	ApiRoot:               "pkg/api/external/k8s",
+	RenderClients:         true,
+	RenderController:      true,
+	MockgenDirective:      true,
+	CustomTemplates:       contrib.AllGroupCustomTemplates,
+}
+
+// Argo Rollouts types, used to discover workloads whose pods are controlled by Rollouts.
+var ArgoRolloutsGroup = model.Group{
+	GroupVersion: argorolloutsv1alpha1.SchemeGroupVersion,
+	Module:       glooMeshModule,
+	Resources: []model.Resource{
+		{Kind: "Rollout"},
+	},
+	CustomTypesImportPath: "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1",
+	ApiRoot:               "pkg/api/external/argoproj",
+	RenderClients:         true,
+	RenderController:      true,
+	MockgenDirective:      true,
+	CustomTemplates:       contrib.AllGroupCustomTemplates,
+}
+
 type ResourceToGenerate struct {
 	Kind       string
 	ShortNames []string
@@ -214,6 +214,29 @@ func certAgentOperator() model.Operator {
 		APIGroups: []string{""},
 		Resources: []string{"pods"},
 	})
+	// ability to perform rolling restarts of pod controllers
+	rbacPolicies = append(rbacPolicies,
+		rbacv1.PolicyRule{
+			Verbs:     []string{"get", "patch"},
+			APIGroups: []string{"apps"},
+			Resources: []string{"deployments", "statefulsets", "daemonsets"},
+		},
+		rbacv1.PolicyRule{
+			Verbs:     []string{"get"},
+			APIGroups: []string{"apps"},
+			Resources: []string{"replicasets"},
+		},
+		rbacv1.PolicyRule{
+			Verbs:     []string{"list"},
+			APIGroups: []string{"policy"},
+			Resources: []string{"poddisruptionbudgets"},
+		},
+		rbacv1.PolicyRule{
+			Verbs:     []string{"get", "patch"},
+			APIGroups: []string{"argoproj.io"},
+			Resources: []string{"rollouts"},
+		},
+	)
 	return model.Operator{
 		Name: "cert-agent",
 		Deployment: model.Deployment{
@@ -3,8 +3,12 @@ package io
 import (
 	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
 	"github.com/solo-io/gloo-mesh/codegen/constants"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -24,9 +28,27 @@ var (
 			"DaemonSet",
 			"StatefulSet",
 		},
+		batchv1.SchemeGroupVersion: {
+			"Job",
+			"CronJob",
+		},
+		// EndpointSlices are preferred over Endpoints, which truncate large services and carry no topology hints.
+		discoveryv1.SchemeGroupVersion: {
+			"EndpointSlice",
+		},
+		// Argo Rollouts control pods like Deployments, e.g. for canary updates.
+		argorolloutsv1alpha1.SchemeGroupVersion: {
+			"Rollout",
+		},
 		appmeshv1beta2.GroupVersion: {
 			"Mesh",
 		},
+		// Istio resources describing workloads and services outside of Kubernetes, e.g. virtual machines.
+		istionetworkingv1alpha3.SchemeGroupVersion: {
+			"ServiceEntry",
+			"WorkloadEntry",
+			"WorkloadGroup",
+		},
 		// Need to watch IssuedCertificates to propagate the status onto the Mesh.
 		schema.GroupVersion{
 			Group:   "certificates." + constants.GlooMeshApiGroupSuffix,
@@ -79,6 +79,7 @@ var (
 			},
 			istiosecurityv1beta1.SchemeGroupVersion: {
 				"AuthorizationPolicy",
+				"RequestAuthentication",
 			},
 			schema.GroupVersion{
 				Group:   "certificates." + constants.GlooMeshApiGroupSuffix,
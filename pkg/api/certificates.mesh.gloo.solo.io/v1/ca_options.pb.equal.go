@@ -62,6 +62,10 @@ func (m *CommonCertOptions) Equal(that interface{}) bool {
 		return false
 	}
 
+	if m.GetKeyAlgorithm() != target.GetKeyAlgorithm() {
+		return false
+	}
+
 	return true
 }
 
@@ -166,6 +170,21 @@ func (m *CertificateRotationVerificationMethod) Equal(that interface{}) bool {
 			}
 		}
 
+	case *CertificateRotationVerificationMethod_Automatic:
+		if _, ok := target.Method.(*CertificateRotationVerificationMethod_Automatic); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetAutomatic()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetAutomatic()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetAutomatic(), target.GetAutomatic()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.Method != target.Method {
@@ -222,3 +241,162 @@ func (m *CertificateRotationCondition) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *CertificateRotationVerificationStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CertificateRotationVerificationStatus)
+	if !ok {
+		that2, ok := that.(CertificateRotationVerificationStatus)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetRotationState() != target.GetRotationState() {
+		return false
+	}
+
+	if strings.Compare(m.GetTimestamp(), target.GetTimestamp()) != 0 {
+		return false
+	}
+
+	if m.GetVerified() != target.GetVerified() {
+		return false
+	}
+
+	if len(m.GetPendingNamespaces()) != len(target.GetPendingNamespaces()) {
+		return false
+	}
+	for idx, v := range m.GetPendingNamespaces() {
+
+		if strings.Compare(v, target.GetPendingNamespaces()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetPendingWorkloads()) != len(target.GetPendingWorkloads()) {
+		return false
+	}
+	for idx, v := range m.GetPendingWorkloads() {
+
+		if strings.Compare(v, target.GetPendingWorkloads()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetErrors()) != len(target.GetErrors()) {
+		return false
+	}
+	for idx, v := range m.GetErrors() {
+
+		if strings.Compare(v, target.GetErrors()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *IssuedCertificateInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*IssuedCertificateInfo)
+	if !ok {
+		that2, ok := that.(IssuedCertificateInfo)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetSerialNumber(), target.GetSerialNumber()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNotBefore(), target.GetNotBefore()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNotAfter(), target.GetNotAfter()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetIssuer(), target.GetIssuer()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetSubject(), target.GetSubject()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetSha256Fingerprint(), target.GetSha256Fingerprint()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRootSha256Fingerprint(), target.GetRootSha256Fingerprint()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetRenewalTime(), target.GetRenewalTime()) != 0 {
+		return false
+	}
+
+	return true
+}
+
+// Equal function
+func (m *CertificateRotationVerificationMethod_AutomaticVerification) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*CertificateRotationVerificationMethod_AutomaticVerification)
+	if !ok {
+		that2, ok := that.(CertificateRotationVerificationMethod_AutomaticVerification)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTimeout(), target.GetTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -11,6 +11,7 @@ import (
 	sync "sync"
 
 	proto "github.com/golang/protobuf/proto"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -179,6 +180,65 @@ func (CertificateRotationStrategy) EnumDescriptor() ([]byte, []int) {
 	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescGZIP(), []int{1}
 }
 
+// Private key algorithms supported for generated certificates.
+// Certificates can be signed by a certificate which uses a different key algorithm,
+// e.g. an `ECDSA_P256` intermediate certificate can be signed by an `RSA` root certificate.
+type CommonCertOptions_KeyAlgorithm int32
+
+const (
+	// RSA with the key size specified by `rsa_key_size_bytes`.
+	CommonCertOptions_RSA CommonCertOptions_KeyAlgorithm = 0
+	// ECDSA using the NIST P-256 curve.
+	CommonCertOptions_ECDSA_P256 CommonCertOptions_KeyAlgorithm = 1
+	// ECDSA using the NIST P-384 curve.
+	CommonCertOptions_ECDSA_P384 CommonCertOptions_KeyAlgorithm = 2
+	// Ed25519. Only supported for root certificates, as Istio does not accept Ed25519 keys for its intermediate CA.
+	CommonCertOptions_ED25519 CommonCertOptions_KeyAlgorithm = 3
+)
+
+// Enum value maps for CommonCertOptions_KeyAlgorithm.
+var (
+	CommonCertOptions_KeyAlgorithm_name = map[int32]string{
+		0: "RSA",
+		1: "ECDSA_P256",
+		2: "ECDSA_P384",
+		3: "ED25519",
+	}
+	CommonCertOptions_KeyAlgorithm_value = map[string]int32{
+		"RSA":        0,
+		"ECDSA_P256": 1,
+		"ECDSA_P384": 2,
+		"ED25519":    3,
+	}
+)
+
+func (x CommonCertOptions_KeyAlgorithm) Enum() *CommonCertOptions_KeyAlgorithm {
+	p := new(CommonCertOptions_KeyAlgorithm)
+	*p = x
+	return p
+}
+
+func (x CommonCertOptions_KeyAlgorithm) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommonCertOptions_KeyAlgorithm) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes[2].Descriptor()
+}
+
+func (CommonCertOptions_KeyAlgorithm) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes[2]
+}
+
+func (x CommonCertOptions_KeyAlgorithm) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommonCertOptions_KeyAlgorithm.Descriptor instead.
+func (CommonCertOptions_KeyAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // Configuration for generating a self-signed root certificate.
 // Uses the X.509 format, RFC5280.
 type CommonCertOptions struct {
@@ -189,12 +249,15 @@ type CommonCertOptions struct {
 	// Number of days before root cert expires. Defaults to 365.
 	TtlDays uint32 `protobuf:"varint,1,opt,name=ttl_days,json=ttlDays,proto3" json:"ttl_days,omitempty"`
 	// Size in bytes of the root cert's private key. Defaults to 4096.
+	// Only applies to the `RSA` key algorithm.
 	RsaKeySizeBytes uint32 `protobuf:"varint,2,opt,name=rsa_key_size_bytes,json=rsaKeySizeBytes,proto3" json:"rsa_key_size_bytes,omitempty"`
 	// Root cert organization name. Defaults to "gloo-mesh".
 	OrgName string `protobuf:"bytes,3,opt,name=org_name,json=orgName,proto3" json:"org_name,omitempty"`
 	// The ratio of cert lifetime to refresh a cert. For example, at 0.10 and 1 hour TTL,
 	// we would refresh 6 minutes before expiration
 	SecretRotationGracePeriodRatio float32 `protobuf:"fixed32,4,opt,name=secret_rotation_grace_period_ratio,json=secretRotationGracePeriodRatio,proto3" json:"secret_rotation_grace_period_ratio,omitempty"`
+	// The algorithm used to generate the cert's private key. Defaults to `RSA`.
+	KeyAlgorithm CommonCertOptions_KeyAlgorithm `protobuf:"varint,5,opt,name=key_algorithm,json=keyAlgorithm,proto3,enum=certificates.mesh.gloo.solo.io.CommonCertOptions_KeyAlgorithm" json:"key_algorithm,omitempty"`
 }
 
 func (x *CommonCertOptions) Reset() {
@@ -257,6 +320,13 @@ func (x *CommonCertOptions) GetSecretRotationGracePeriodRatio() float32 {
 	return 0
 }
 
+func (x *CommonCertOptions) GetKeyAlgorithm() CommonCertOptions_KeyAlgorithm {
+	if x != nil {
+		return x.KeyAlgorithm
+	}
+	return CommonCertOptions_RSA
+}
+
 // Specify parameters for configuring the root certificate authority for a VirtualMesh.
 type IntermediateCertificateAuthority struct {
 	state         protoimpl.MessageState
@@ -335,6 +405,7 @@ type CertificateRotationVerificationMethod struct {
 	// Types that are assignable to Method:
 	//	*CertificateRotationVerificationMethod_None
 	//	*CertificateRotationVerificationMethod_Manual
+	//	*CertificateRotationVerificationMethod_Automatic
 	Method isCertificateRotationVerificationMethod_Method `protobuf_oneof:"method"`
 }
 
@@ -391,6 +462,13 @@ func (x *CertificateRotationVerificationMethod) GetManual() *empty.Empty {
 	return nil
 }
 
+func (x *CertificateRotationVerificationMethod) GetAutomatic() *CertificateRotationVerificationMethod_AutomaticVerification {
+	if x, ok := x.GetMethod().(*CertificateRotationVerificationMethod_Automatic); ok {
+		return x.Automatic
+	}
+	return nil
+}
+
 type isCertificateRotationVerificationMethod_Method interface {
 	isCertificateRotationVerificationMethod_Method()
 }
@@ -407,11 +485,25 @@ type CertificateRotationVerificationMethod_Manual struct {
 	Manual *empty.Empty `protobuf:"bytes,2,opt,name=manual,proto3,oneof"`
 }
 
+type CertificateRotationVerificationMethod_Automatic struct {
+	// Verification is performed automatically. At each rotation step, the Gloo Mesh agent verifies that the root
+	// certificate bundle has been distributed to the namespaces of the Mesh's proxies, and that all proxies of the Mesh's revision
+	// have been (re)started since the certificate for the step was issued.
+	// The rotation is verified once the workloads of all Meshes in the VirtualMesh have been verified,
+	// and is rolled back if verification fails or does not complete within the timeout.
+	// Gloo Mesh starts a rotation when the VirtualMesh's shared trust differs from its deployed shared trust, and advances to the next step once each step is verified.
+	// NOTE: Istio proxies which are not restarted (e.g. when `auto_restart_pods` is disabled) will cause the rotation to be rolled back.
+	Automatic *CertificateRotationVerificationMethod_AutomaticVerification `protobuf:"bytes,3,opt,name=automatic,proto3,oneof"`
+}
+
 func (*CertificateRotationVerificationMethod_None) isCertificateRotationVerificationMethod_Method() {}
 
 func (*CertificateRotationVerificationMethod_Manual) isCertificateRotationVerificationMethod_Method() {
 }
 
+func (*CertificateRotationVerificationMethod_Automatic) isCertificateRotationVerificationMethod_Method() {
+}
+
 // CertificateRotationCondition represents a timesptamped snapshot of the certificate
 // rotation workflow. This is used to keep track of the steps which have been completed
 // thus far.
@@ -490,6 +582,263 @@ func (x *CertificateRotationCondition) GetErrors() []string {
 	return nil
 }
 
+// The result of the automatic verification of a certificate rotation step for a Mesh, performed by the Gloo Mesh agent.
+type CertificateRotationVerificationStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The rotation state which was verified.
+	RotationState CertificateRotationState `protobuf:"varint,1,opt,name=rotation_state,json=rotationState,proto3,enum=certificates.mesh.gloo.solo.io.CertificateRotationState" json:"rotation_state,omitempty"`
+	// The RFC 3339 formatted time at which the verification was last performed.
+	Timestamp string `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// True if the workloads of the Mesh have picked up the certificates issued for the rotation state.
+	Verified bool `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Namespaces whose Istio root certificate ConfigMap does not yet contain the issued root certificate bundle.
+	PendingNamespaces []string `protobuf:"bytes,4,rep,name=pending_namespaces,json=pendingNamespaces,proto3" json:"pending_namespaces,omitempty"`
+	// Istio proxies which have not yet picked up the issued certificates, in the format `name.namespace`.
+	PendingWorkloads []string `protobuf:"bytes,5,rep,name=pending_workloads,json=pendingWorkloads,proto3" json:"pending_workloads,omitempty"`
+	// Any errors which prevented the rotation step from being verified.
+	Errors []string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CertificateRotationVerificationStatus) Reset() {
+	*x = CertificateRotationVerificationStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateRotationVerificationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRotationVerificationStatus) ProtoMessage() {}
+
+func (x *CertificateRotationVerificationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRotationVerificationStatus.ProtoReflect.Descriptor instead.
+func (*CertificateRotationVerificationStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CertificateRotationVerificationStatus) GetRotationState() CertificateRotationState {
+	if x != nil {
+		return x.RotationState
+	}
+	return CertificateRotationState_NOT_ROTATING
+}
+
+func (x *CertificateRotationVerificationStatus) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *CertificateRotationVerificationStatus) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *CertificateRotationVerificationStatus) GetPendingNamespaces() []string {
+	if x != nil {
+		return x.PendingNamespaces
+	}
+	return nil
+}
+
+func (x *CertificateRotationVerificationStatus) GetPendingWorkloads() []string {
+	if x != nil {
+		return x.PendingWorkloads
+	}
+	return nil
+}
+
+func (x *CertificateRotationVerificationStatus) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Details of a certificate issued to a Mesh, recorded by the Gloo Mesh agent once the certificate has been issued.
+type IssuedCertificateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The serial number of the certificate, hex encoded.
+	SerialNumber string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// The RFC 3339 formatted time from which the certificate is valid.
+	NotBefore string `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// The RFC 3339 formatted time at which the certificate expires.
+	NotAfter string `protobuf:"bytes,3,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// The distinguished name of the certificate's issuer.
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The distinguished name of the certificate's subject.
+	Subject string `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`
+	// The hex encoded SHA-256 fingerprint of the certificate.
+	Sha256Fingerprint string `protobuf:"bytes,6,opt,name=sha256_fingerprint,json=sha256Fingerprint,proto3" json:"sha256_fingerprint,omitempty"`
+	// The hex encoded SHA-256 fingerprint of the root certificate which the certificate chains to.
+	RootSha256Fingerprint string `protobuf:"bytes,7,opt,name=root_sha256_fingerprint,json=rootSha256Fingerprint,proto3" json:"root_sha256_fingerprint,omitempty"`
+	// The RFC 3339 formatted time at which the certificate will be renewed by the Gloo Mesh agent,
+	// determined by the certificate's `secret_rotation_grace_period_ratio`.
+	RenewalTime string `protobuf:"bytes,8,opt,name=renewal_time,json=renewalTime,proto3" json:"renewal_time,omitempty"`
+}
+
+func (x *IssuedCertificateInfo) Reset() {
+	*x = IssuedCertificateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IssuedCertificateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedCertificateInfo) ProtoMessage() {}
+
+func (x *IssuedCertificateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedCertificateInfo.ProtoReflect.Descriptor instead.
+func (*IssuedCertificateInfo) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *IssuedCertificateInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetSha256Fingerprint() string {
+	if x != nil {
+		return x.Sha256Fingerprint
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetRootSha256Fingerprint() string {
+	if x != nil {
+		return x.RootSha256Fingerprint
+	}
+	return ""
+}
+
+func (x *IssuedCertificateInfo) GetRenewalTime() string {
+	if x != nil {
+		return x.RenewalTime
+	}
+	return ""
+}
+
+// Options for automatically verifying certificate rotation steps.
+type CertificateRotationVerificationMethod_AutomaticVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum amount of time to wait for the workloads of all Meshes to be verified at each rotation step,
+	// after which the rotation is rolled back. Defaults to 10 minutes.
+	Timeout *duration.Duration `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *CertificateRotationVerificationMethod_AutomaticVerification) Reset() {
+	*x = CertificateRotationVerificationMethod_AutomaticVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertificateRotationVerificationMethod_AutomaticVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateRotationVerificationMethod_AutomaticVerification) ProtoMessage() {}
+
+func (x *CertificateRotationVerificationMethod_AutomaticVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateRotationVerificationMethod_AutomaticVerification.ProtoReflect.Descriptor instead.
+func (*CertificateRotationVerificationMethod_AutomaticVerification) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *CertificateRotationVerificationMethod_AutomaticVerification) GetTimeout() *duration.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDesc = []byte{
@@ -500,13 +849,15 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDe
 	0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
 	0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
 	0x2e, 0x69, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
 	0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65,
 	0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x61, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc2, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
 	0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
 	0x74, 0x6c, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x74,
 	0x74, 0x6c, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x73, 0x61, 0x5f, 0x6b, 0x65,
@@ -518,58 +869,120 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDe
 	0x6e, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x72,
 	0x61, 0x74, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x1e, 0x73, 0x65, 0x63, 0x72,
 	0x65, 0x74, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0x70, 0x0a, 0x20, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3f,
-	0x0a, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56,
-	0x61, 0x75, 0x6c, 0x74, 0x43, 0x41, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x42,
-	0x0b, 0x0a, 0x09, 0x63, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x91, 0x01, 0x0a,
-	0x25, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04,
-	0x6e, 0x6f, 0x6e, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06,
-	0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x22, 0xbe, 0x01, 0x0a, 0x1c, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x4e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38,
-	0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x2a, 0xd4, 0x01, 0x0a, 0x18, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10,
-	0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
-	0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x10,
-	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x44, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45, 0x57, 0x5f,
-	0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x52, 0x4f, 0x50, 0x41, 0x47,
-	0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d,
-	0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x45, 0x4c, 0x45,
-	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x04, 0x12,
-	0x0d, 0x0a, 0x09, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x0c,
-	0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c,
-	0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x07, 0x12, 0x0c,
-	0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06,
-	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x37, 0x0a, 0x1b, 0x43, 0x65, 0x72, 0x74,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x63, 0x0a, 0x0d, 0x6b, 0x65,
+	0x79, 0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x3e, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22,
+	0x44, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x07, 0x0a, 0x03, 0x52, 0x53, 0x41, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x43, 0x44, 0x53,
+	0x41, 0x5f, 0x50, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x43, 0x44, 0x53,
+	0x41, 0x5f, 0x50, 0x33, 0x38, 0x34, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x44, 0x32, 0x35,
+	0x35, 0x31, 0x39, 0x10, 0x03, 0x22, 0x70, 0x0a, 0x20, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x43,
+	0x41, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x61,
+	0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xdc, 0x02, 0x0a, 0x25, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12,
+	0x30, 0x0a, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x6e, 0x75, 0x61,
+	0x6c, 0x12, 0x7b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x5b, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x41, 0x75, 0x74, 0x6f,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x1a, 0x4c,
+	0x0a, 0x15, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x08, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0xbe, 0x01, 0x0a, 0x1c, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x25, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x5f, 0x0a, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x38, 0x2e, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0d, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x22, 0xb4, 0x02, 0x0a, 0x15, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2d, 0x0a,
+	0x12, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x5f, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x17,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x5f, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72,
+	0x6f, 0x6f, 0x74, 0x53, 0x68, 0x61, 0x32, 0x35, 0x36, 0x46, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65,
+	0x77, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0xd4, 0x01, 0x0a, 0x18, 0x43, 0x65, 0x72, 0x74,
 	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x4d, 0x55, 0x4c, 0x54, 0x49,
-	0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
-	0x01, 0x42, 0x4c, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
-	0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x4f, 0x54, 0x41,
+	0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x44, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c,
+	0x50, 0x52, 0x4f, 0x50, 0x41, 0x47, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4e, 0x45, 0x57, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x15,
+	0x0a, 0x11, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x4c, 0x44, 0x5f, 0x52,
+	0x4f, 0x4f, 0x54, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x49,
+	0x4e, 0x47, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
+	0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x37,
+	0x0a, 0x1b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x5f, 0x52, 0x4f, 0x4f, 0x54, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x42, 0x4c, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -584,28 +997,37 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawD
 	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_goTypes = []interface{}{
-	(CertificateRotationState)(0),                 // 0: certificates.mesh.gloo.solo.io.CertificateRotationState
-	(CertificateRotationStrategy)(0),              // 1: certificates.mesh.gloo.solo.io.CertificateRotationStrategy
-	(*CommonCertOptions)(nil),                     // 2: certificates.mesh.gloo.solo.io.CommonCertOptions
-	(*IntermediateCertificateAuthority)(nil),      // 3: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
-	(*CertificateRotationVerificationMethod)(nil), // 4: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod
-	(*CertificateRotationCondition)(nil),          // 5: certificates.mesh.gloo.solo.io.CertificateRotationCondition
-	(*VaultCA)(nil),                               // 6: certificates.mesh.gloo.solo.io.VaultCA
-	(*empty.Empty)(nil),                           // 7: google.protobuf.Empty
+	(CertificateRotationState)(0),                                       // 0: certificates.mesh.gloo.solo.io.CertificateRotationState
+	(CertificateRotationStrategy)(0),                                    // 1: certificates.mesh.gloo.solo.io.CertificateRotationStrategy
+	(CommonCertOptions_KeyAlgorithm)(0),                                 // 2: certificates.mesh.gloo.solo.io.CommonCertOptions.KeyAlgorithm
+	(*CommonCertOptions)(nil),                                           // 3: certificates.mesh.gloo.solo.io.CommonCertOptions
+	(*IntermediateCertificateAuthority)(nil),                            // 4: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	(*CertificateRotationVerificationMethod)(nil),                       // 5: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod
+	(*CertificateRotationCondition)(nil),                                // 6: certificates.mesh.gloo.solo.io.CertificateRotationCondition
+	(*CertificateRotationVerificationStatus)(nil),                       // 7: certificates.mesh.gloo.solo.io.CertificateRotationVerificationStatus
+	(*IssuedCertificateInfo)(nil),                                       // 8: certificates.mesh.gloo.solo.io.IssuedCertificateInfo
+	(*CertificateRotationVerificationMethod_AutomaticVerification)(nil), // 9: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.AutomaticVerification
+	(*VaultCA)(nil),                                                     // 10: certificates.mesh.gloo.solo.io.VaultCA
+	(*empty.Empty)(nil),                                                 // 11: google.protobuf.Empty
+	(*duration.Duration)(nil),                                           // 12: google.protobuf.Duration
 }
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_depIdxs = []int32{
-	6, // 0: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority.vault:type_name -> certificates.mesh.gloo.solo.io.VaultCA
-	7, // 1: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.none:type_name -> google.protobuf.Empty
-	7, // 2: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.manual:type_name -> google.protobuf.Empty
-	0, // 3: certificates.mesh.gloo.solo.io.CertificateRotationCondition.state:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationState
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: certificates.mesh.gloo.solo.io.CommonCertOptions.key_algorithm:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions.KeyAlgorithm
+	10, // 1: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority.vault:type_name -> certificates.mesh.gloo.solo.io.VaultCA
+	11, // 2: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.none:type_name -> google.protobuf.Empty
+	11, // 3: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.manual:type_name -> google.protobuf.Empty
+	9,  // 4: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.automatic:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.AutomaticVerification
+	0,  // 5: certificates.mesh.gloo.solo.io.CertificateRotationCondition.state:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationState
+	0,  // 6: certificates.mesh.gloo.solo.io.CertificateRotationVerificationStatus.rotation_state:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationState
+	12, // 7: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod.AutomaticVerification.timeout:type_name -> google.protobuf.Duration
+	8,  // [8:8] is the sub-list for method output_type
+	8,  // [8:8] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_init() }
@@ -663,6 +1085,42 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_init
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateRotationVerificationStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IssuedCertificateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertificateRotationVerificationMethod_AutomaticVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*IntermediateCertificateAuthority_Vault)(nil),
@@ -670,14 +1128,15 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_init
 	file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*CertificateRotationVerificationMethod_None)(nil),
 		(*CertificateRotationVerificationMethod_Manual)(nil),
+		(*CertificateRotationVerificationMethod_Automatic)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_certificates_v1_ca_options_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
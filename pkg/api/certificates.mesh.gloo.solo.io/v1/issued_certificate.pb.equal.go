@@ -105,6 +105,17 @@ func (m *IssuedCertificateSpec) Equal(that interface{}) bool {
 		return false
 	}
 
+	if len(m.GetTrustedRootCertificates()) != len(target.GetTrustedRootCertificates()) {
+		return false
+	}
+	for idx, v := range m.GetTrustedRootCertificates() {
+
+		if bytes.Compare(v, target.GetTrustedRootCertificates()[idx]) != 0 {
+			return false
+		}
+
+	}
+
 	switch m.CertificateAuthority.(type) {
 
 	case *IssuedCertificateSpec_GlooMeshCa:
@@ -232,6 +243,26 @@ func (m *IssuedCertificateStatus) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetIssuedCertificateInfo()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIssuedCertificateInfo()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIssuedCertificateInfo(), target.GetIssuedCertificateInfo()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRotationVerification()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRotationVerification()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRotationVerification(), target.GetRotationVerification()) {
+			return false
+		}
+	}
+
 	switch m.AppliedCertificateAuthority.(type) {
 
 	case *IssuedCertificateStatus_AppliedGlooMeshCa:
@@ -63,6 +63,14 @@ func (m *PodBounceDirectiveSpec) Equal(that interface{}) bool {
 
 	}
 
+	if m.GetRestartStrategy() != target.GetRestartStrategy() {
+		return false
+	}
+
+	if m.GetMaxConcurrentRestartsPerNamespace() != target.GetMaxConcurrentRestartsPerNamespace() {
+		return false
+	}
+
 	return true
 }
 
@@ -104,6 +112,23 @@ func (m *PodBounceDirectiveStatus) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetControllerRestarts()) != len(target.GetControllerRestarts()) {
+		return false
+	}
+	for idx, v := range m.GetControllerRestarts() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetControllerRestarts()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetControllerRestarts()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -246,3 +271,63 @@ func (m *PodBounceDirectiveStatus_BouncedPodSet) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *PodBounceDirectiveStatus_ControllerRestart) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*PodBounceDirectiveStatus_ControllerRestart)
+	if !ok {
+		that2, ok := that.(PodBounceDirectiveStatus_ControllerRestart)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetKind(), target.GetKind()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetController()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetController()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetController(), target.GetController()) {
+			return false
+		}
+	}
+
+	if m.GetSelectorIndex() != target.GetSelectorIndex() {
+		return false
+	}
+
+	if m.GetState() != target.GetState() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRestartedAt()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRestartedAt()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRestartedAt(), target.GetRestartedAt()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetMessage(), target.GetMessage()) != 0 {
+		return false
+	}
+
+	return true
+}
@@ -12,7 +12,7 @@ import (
 
 	proto "github.com/golang/protobuf/proto"
 	_ "github.com/golang/protobuf/ptypes/duration"
-	_ "github.com/golang/protobuf/ptypes/timestamp"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "github.com/solo-io/protoc-gen-ext/extproto"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -30,6 +30,118 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Strategies for restarting the selected pods.
+type PodBounceDirectiveSpec_RestartStrategy int32
+
+const (
+	// Restart the Deployments, StatefulSets, DaemonSets and Argo Rollouts which control the selected pods by updating
+	// the restart annotation of their pod template (equivalent to `kubectl rollout restart`),
+	// so that pods are replaced according to the controller's update strategy.
+	// Argo Rollouts are restarted by setting their `spec.restartAt`.
+	// Controllers whose rollout may take pods out of service before their replacements are available are only restarted once the PodDisruptionBudgets which select their pods allow disruptions, and are skipped if they do not within 10 minutes.
+	// Pods which are not controlled by a Deployment, StatefulSet, DaemonSet or Argo Rollout, and pods of
+	// controllers using the `OnDelete` update strategy, are deleted instead.
+	PodBounceDirectiveSpec_ROLLING_RESTART PodBounceDirectiveSpec_RestartStrategy = 0
+	// Delete the selected pods.
+	PodBounceDirectiveSpec_DELETE_PODS PodBounceDirectiveSpec_RestartStrategy = 1
+)
+
+// Enum value maps for PodBounceDirectiveSpec_RestartStrategy.
+var (
+	PodBounceDirectiveSpec_RestartStrategy_name = map[int32]string{
+		0: "ROLLING_RESTART",
+		1: "DELETE_PODS",
+	}
+	PodBounceDirectiveSpec_RestartStrategy_value = map[string]int32{
+		"ROLLING_RESTART": 0,
+		"DELETE_PODS":     1,
+	}
+)
+
+func (x PodBounceDirectiveSpec_RestartStrategy) Enum() *PodBounceDirectiveSpec_RestartStrategy {
+	p := new(PodBounceDirectiveSpec_RestartStrategy)
+	*p = x
+	return p
+}
+
+func (x PodBounceDirectiveSpec_RestartStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PodBounceDirectiveSpec_RestartStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_enumTypes[0].Descriptor()
+}
+
+func (PodBounceDirectiveSpec_RestartStrategy) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_enumTypes[0]
+}
+
+func (x PodBounceDirectiveSpec_RestartStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PodBounceDirectiveSpec_RestartStrategy.Descriptor instead.
+func (PodBounceDirectiveSpec_RestartStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// Possible states of a controller restart.
+type PodBounceDirectiveStatus_ControllerRestart_State int32
+
+const (
+	// The restart is waiting for other controllers in the namespace to finish restarting.
+	PodBounceDirectiveStatus_ControllerRestart_WAITING PodBounceDirectiveStatus_ControllerRestart_State = 0
+	// The restart is waiting for a PodDisruptionBudget selecting the controller's pods to allow disruptions. The restart is skipped if the PodDisruptionBudget does not allow disruptions within 10 minutes.
+	PodBounceDirectiveStatus_ControllerRestart_BLOCKED_BY_DISRUPTION_BUDGET PodBounceDirectiveStatus_ControllerRestart_State = 1
+	// The controller has been restarted, and is rolling out replacement pods.
+	PodBounceDirectiveStatus_ControllerRestart_RESTARTING PodBounceDirectiveStatus_ControllerRestart_State = 2
+	// The controller has finished rolling out replacement pods.
+	PodBounceDirectiveStatus_ControllerRestart_FINISHED PodBounceDirectiveStatus_ControllerRestart_State = 3
+)
+
+// Enum value maps for PodBounceDirectiveStatus_ControllerRestart_State.
+var (
+	PodBounceDirectiveStatus_ControllerRestart_State_name = map[int32]string{
+		0: "WAITING",
+		1: "BLOCKED_BY_DISRUPTION_BUDGET",
+		2: "RESTARTING",
+		3: "FINISHED",
+	}
+	PodBounceDirectiveStatus_ControllerRestart_State_value = map[string]int32{
+		"WAITING":                      0,
+		"BLOCKED_BY_DISRUPTION_BUDGET": 1,
+		"RESTARTING":                   2,
+		"FINISHED":                     3,
+	}
+)
+
+func (x PodBounceDirectiveStatus_ControllerRestart_State) Enum() *PodBounceDirectiveStatus_ControllerRestart_State {
+	p := new(PodBounceDirectiveStatus_ControllerRestart_State)
+	*p = x
+	return p
+}
+
+func (x PodBounceDirectiveStatus_ControllerRestart_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PodBounceDirectiveStatus_ControllerRestart_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_enumTypes[1].Descriptor()
+}
+
+func (PodBounceDirectiveStatus_ControllerRestart_State) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_enumTypes[1]
+}
+
+func (x PodBounceDirectiveStatus_ControllerRestart_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PodBounceDirectiveStatus_ControllerRestart_State.Descriptor instead.
+func (PodBounceDirectiveStatus_ControllerRestart_State) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDescGZIP(), []int{1, 1, 0}
+}
+
 //
 //When certificates are issued, Istio-controlled pods need to be bounced (restarted) to ensure they pick up the
 //new certificates due to [this issue](https://github.com/istio/istio/issues/22993).
@@ -45,6 +157,11 @@ type PodBounceDirectiveSpec struct {
 	// This will include the control plane pods as well as any Pods
 	// which share a data plane with the target mesh.
 	PodsToBounce []*PodBounceDirectiveSpec_PodSelector `protobuf:"bytes,6,rep,name=pods_to_bounce,json=podsToBounce,proto3" json:"pods_to_bounce,omitempty"`
+	// The strategy used to restart the selected pods. Defaults to `ROLLING_RESTART`.
+	RestartStrategy PodBounceDirectiveSpec_RestartStrategy `protobuf:"varint,7,opt,name=restart_strategy,json=restartStrategy,proto3,enum=certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec_RestartStrategy" json:"restart_strategy,omitempty"`
+	// The maximum number of controllers which are restarted concurrently in each namespace
+	// when using the `ROLLING_RESTART` strategy. Defaults to 1.
+	MaxConcurrentRestartsPerNamespace uint32 `protobuf:"varint,8,opt,name=max_concurrent_restarts_per_namespace,json=maxConcurrentRestartsPerNamespace,proto3" json:"max_concurrent_restarts_per_namespace,omitempty"`
 }
 
 func (x *PodBounceDirectiveSpec) Reset() {
@@ -86,6 +203,20 @@ func (x *PodBounceDirectiveSpec) GetPodsToBounce() []*PodBounceDirectiveSpec_Pod
 	return nil
 }
 
+func (x *PodBounceDirectiveSpec) GetRestartStrategy() PodBounceDirectiveSpec_RestartStrategy {
+	if x != nil {
+		return x.RestartStrategy
+	}
+	return PodBounceDirectiveSpec_ROLLING_RESTART
+}
+
+func (x *PodBounceDirectiveSpec) GetMaxConcurrentRestartsPerNamespace() uint32 {
+	if x != nil {
+		return x.MaxConcurrentRestartsPerNamespace
+	}
+	return 0
+}
+
 //
 //PodBounceDirectiveStatus reports the status for stateful Pod bounces (when bouncing pods requires waiting for readiness).
 type PodBounceDirectiveStatus struct {
@@ -98,6 +229,8 @@ type PodBounceDirectiveStatus struct {
 	// This will include the control plane pods as well as any Pods
 	// which share a data plane with the target mesh.
 	PodsBounced []*PodBounceDirectiveStatus_BouncedPodSet `protobuf:"bytes,4,rep,name=pods_bounced,json=podsBounced,proto3" json:"pods_bounced,omitempty"`
+	// The progress of the rolling restart of each controller, when using the `ROLLING_RESTART` strategy.
+	ControllerRestarts []*PodBounceDirectiveStatus_ControllerRestart `protobuf:"bytes,5,rep,name=controller_restarts,json=controllerRestarts,proto3" json:"controller_restarts,omitempty"`
 }
 
 func (x *PodBounceDirectiveStatus) Reset() {
@@ -139,6 +272,13 @@ func (x *PodBounceDirectiveStatus) GetPodsBounced() []*PodBounceDirectiveStatus_
 	return nil
 }
 
+func (x *PodBounceDirectiveStatus) GetControllerRestarts() []*PodBounceDirectiveStatus_ControllerRestart {
+	if x != nil {
+		return x.ControllerRestarts
+	}
+	return nil
+}
+
 // pods that will be restarted.
 type PodBounceDirectiveSpec_PodSelector struct {
 	state         protoimpl.MessageState
@@ -340,6 +480,101 @@ func (x *PodBounceDirectiveStatus_BouncedPodSet) GetBouncedPods() []string {
 	return nil
 }
 
+// The rolling restart of a controller (Deployment, StatefulSet, DaemonSet or Argo Rollout),
+// or the deletion of the selected pods of a controller which does not support rolling restarts.
+type PodBounceDirectiveStatus_ControllerRestart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of the controller, or `Pod` for pods without a controller.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Reference to the controller.
+	Controller *v1.ObjectRef `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// The index of the selector in `PodBounceDirectiveSpec.pods_to_bounce` which selected the controller's pods.
+	SelectorIndex uint32 `protobuf:"varint,3,opt,name=selector_index,json=selectorIndex,proto3" json:"selector_index,omitempty"`
+	// The state of the restart.
+	State PodBounceDirectiveStatus_ControllerRestart_State `protobuf:"varint,4,opt,name=state,proto3,enum=certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus_ControllerRestart_State" json:"state,omitempty"`
+	// The time at which the controller was restarted, or at which the restart was first blocked by a PodDisruptionBudget.
+	RestartedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=restarted_at,json=restartedAt,proto3" json:"restarted_at,omitempty"`
+	// A human readable message describing the state of the restart.
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) Reset() {
+	*x = PodBounceDirectiveStatus_ControllerRestart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodBounceDirectiveStatus_ControllerRestart) ProtoMessage() {}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodBounceDirectiveStatus_ControllerRestart.ProtoReflect.Descriptor instead.
+func (*PodBounceDirectiveStatus_ControllerRestart) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) GetController() *v1.ObjectRef {
+	if x != nil {
+		return x.Controller
+	}
+	return nil
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) GetSelectorIndex() uint32 {
+	if x != nil {
+		return x.SelectorIndex
+	}
+	return 0
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) GetState() PodBounceDirectiveStatus_ControllerRestart_State {
+	if x != nil {
+		return x.State
+	}
+	return PodBounceDirectiveStatus_ControllerRestart_WAITING
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) GetRestartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.RestartedAt
+	}
+	return nil
+}
+
+func (x *PodBounceDirectiveStatus_ControllerRestart) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 var File_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDesc = []byte{
@@ -358,7 +593,7 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_p
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
 	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12,
 	0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xcd, 0x05, 0x0a, 0x16, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x74, 0x6f, 0x22, 0xcb, 0x07, 0x0a, 0x16, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
 	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x68, 0x0a,
 	0x0e, 0x70, 0x6f, 0x64, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18,
 	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
@@ -366,61 +601,111 @@ var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_p
 	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
 	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
 	0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x73, 0x54,
-	0x6f, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x1a, 0xc8, 0x04, 0x0a, 0x0b, 0x50, 0x6f, 0x64, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
-	0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2a, 0x0a,
-	0x11, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f,
-	0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x75, 0x0a, 0x0e, 0x72, 0x6f, 0x6f,
-	0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x4f, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x6f, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x71, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x46, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
 	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
 	0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x53, 0x79,
-	0x6e, 0x63, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63,
-	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd4, 0x01, 0x0a, 0x0c,
-	0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x3b, 0x0a, 0x0a,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x09,
-	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0c,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x52, 0x65, 0x66, 0x12, 0x24, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x4b,
-	0x65, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x18, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x69, 0x0a, 0x0c, 0x70, 0x6f, 0x64, 0x73, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x50, 0x0a, 0x25, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x21, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x50, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x1a, 0xc8, 0x04, 0x0a,
+	0x0b, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x66, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4e, 0x2e, 0x63, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x2e, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x72,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x77,
+	0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x75,
+	0x0a, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63,
+	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
+	0x6f, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x43,
+	0x65, 0x72, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x0c, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x53, 0x79, 0x6e, 0x63, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0xd4, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x3b, 0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76,
+	0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x66, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x42, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x72, 0x65, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76,
+	0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x61, 0x70, 0x52, 0x65,
+	0x66, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6d, 0x61, 0x70, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x22, 0x37, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x4f,
+	0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x4f, 0x44, 0x53, 0x10, 0x01,
+	0x22, 0xdc, 0x05, 0x0a, 0x18, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x69, 0x0a,
+	0x0c, 0x70, 0x6f, 0x64, 0x73, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x42, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x70, 0x6f, 0x64,
+	0x73, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x7b, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
 	0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
 	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
 	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
-	0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x70,
-	0x6f, 0x64, 0x73, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x1a, 0x32, 0x0a, 0x0d, 0x42, 0x6f,
-	0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62,
-	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x73, 0x42, 0x4c,
-	0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x73, 0x1a, 0x32, 0x0a, 0x0d, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64,
+	0x50, 0x6f, 0x64, 0x53, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x64, 0x5f, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f,
+	0x75, 0x6e, 0x63, 0x65, 0x64, 0x50, 0x6f, 0x64, 0x73, 0x1a, 0xa3, 0x03, 0x0a, 0x11, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x66, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x50, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x50, 0x6f, 0x64, 0x42, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x54, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12,
+	0x20, 0x0a, 0x1c, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x44, 0x49,
+	0x53, 0x52, 0x55, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03, 0x42,
+	0x4c, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -435,28 +720,38 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_
 	return file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_goTypes = []interface{}{
-	(*PodBounceDirectiveSpec)(nil),             // 0: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec
-	(*PodBounceDirectiveStatus)(nil),           // 1: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus
-	(*PodBounceDirectiveSpec_PodSelector)(nil), // 2: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector
-	nil, // 3: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.LabelsEntry
-	(*PodBounceDirectiveSpec_PodSelector_RootCertSync)(nil), // 4: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync
-	(*PodBounceDirectiveStatus_BouncedPodSet)(nil),          // 5: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet
-	(*v1.ObjectRef)(nil), // 6: core.skv2.solo.io.ObjectRef
+	(PodBounceDirectiveSpec_RestartStrategy)(0),           // 0: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.RestartStrategy
+	(PodBounceDirectiveStatus_ControllerRestart_State)(0), // 1: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart.State
+	(*PodBounceDirectiveSpec)(nil),                        // 2: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec
+	(*PodBounceDirectiveStatus)(nil),                      // 3: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus
+	(*PodBounceDirectiveSpec_PodSelector)(nil),            // 4: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector
+	nil, // 5: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.LabelsEntry
+	(*PodBounceDirectiveSpec_PodSelector_RootCertSync)(nil), // 6: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync
+	(*PodBounceDirectiveStatus_BouncedPodSet)(nil),          // 7: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet
+	(*PodBounceDirectiveStatus_ControllerRestart)(nil),      // 8: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart
+	(*v1.ObjectRef)(nil),        // 9: core.skv2.solo.io.ObjectRef
+	(*timestamp.Timestamp)(nil), // 10: google.protobuf.Timestamp
 }
 var file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_depIdxs = []int32{
-	2, // 0: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.pods_to_bounce:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector
-	5, // 1: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.pods_bounced:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet
-	3, // 2: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.labels:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.LabelsEntry
-	4, // 3: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.root_cert_sync:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync
-	6, // 4: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync.secret_ref:type_name -> core.skv2.solo.io.ObjectRef
-	6, // 5: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync.config_map_ref:type_name -> core.skv2.solo.io.ObjectRef
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	4,  // 0: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.pods_to_bounce:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector
+	0,  // 1: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.restart_strategy:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.RestartStrategy
+	7,  // 2: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.pods_bounced:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.BouncedPodSet
+	8,  // 3: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.controller_restarts:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart
+	5,  // 4: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.labels:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.LabelsEntry
+	6,  // 5: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.root_cert_sync:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync
+	9,  // 6: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync.secret_ref:type_name -> core.skv2.solo.io.ObjectRef
+	9,  // 7: certificates.mesh.gloo.solo.io.PodBounceDirectiveSpec.PodSelector.RootCertSync.config_map_ref:type_name -> core.skv2.solo.io.ObjectRef
+	9,  // 8: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart.controller:type_name -> core.skv2.solo.io.ObjectRef
+	1,  // 9: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart.state:type_name -> certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart.State
+	10, // 10: certificates.mesh.gloo.solo.io.PodBounceDirectiveStatus.ControllerRestart.restarted_at:type_name -> google.protobuf.Timestamp
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_init() }
@@ -525,19 +820,32 @@ func file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodBounceDirectiveStatus_ControllerRestart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_goTypes,
 		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_enumTypes,
 		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto_msgTypes,
 	}.Build()
 	File_github_com_solo_io_gloo_mesh_api_certificates_v1_pod_bounce_directive_proto = out.File
@@ -33,13 +33,15 @@ type VaultCA struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// `ca_path` is the mount path of the Vault PKI backend's `sign` endpoint, e.g:
-	// "my_pki_mount/sign/my-role-name".
+	// `ca_path` is the path of a Vault PKI backend endpoint which returns the root certificate of the CA, e.g:
+	// "my_pki_mount/cert/ca".
+	// If unspecified, the last certificate of the CA chain returned when signing the certificate request is used as the root certificate.
 	CaPath string `protobuf:"bytes,1,opt,name=ca_path,json=caPath,proto3" json:"ca_path,omitempty"`
-	// `csr_path` is the mount path of the Vault PKI backend's `generate` endpoint, e.g:
-	// "my_pki_mount/intermediate/generate/exported".
-	// "exported" is necessary here as istio needs access to the private key
-	// See vault docs here: https://www.vaultproject.io/api-docs/secret/pki#parameters-4
+	// `csr_path` is the path of the Vault PKI backend endpoint which signs the certificate signing request
+	// generated by the Gloo Mesh cert agent for the intermediate CA, e.g:
+	// "my_pki_mount/root/sign-intermediate".
+	// The private key of the intermediate CA never leaves the cluster of the cert agent.
+	// See vault docs here: https://www.vaultproject.io/api-docs/secret/pki#sign-intermediate
 	CsrPath string `protobuf:"bytes,2,opt,name=csr_path,json=csrPath,proto3" json:"csr_path,omitempty"`
 	// Server is the connection address for the Vault server, e.g: "https://vault.example.com:8200".
 	Server string `protobuf:"bytes,3,opt,name=server,proto3" json:"server,omitempty"`
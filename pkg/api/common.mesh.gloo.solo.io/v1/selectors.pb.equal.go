@@ -66,6 +66,16 @@ func (m *DestinationSelector) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetExternalServiceMatcher()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetExternalServiceMatcher()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetExternalServiceMatcher(), target.GetExternalServiceMatcher()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -300,6 +310,63 @@ func (m *DestinationSelector_KubeServiceRefs) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *DestinationSelector_ExternalServiceMatcher) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*DestinationSelector_ExternalServiceMatcher)
+	if !ok {
+		that2, ok := that.(DestinationSelector_ExternalServiceMatcher)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetHosts()) != len(target.GetHosts()) {
+		return false
+	}
+	for idx, v := range m.GetHosts() {
+
+		if strings.Compare(v, target.GetHosts()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNamespaces()) != len(target.GetNamespaces()) {
+		return false
+	}
+	for idx, v := range m.GetNamespaces() {
+
+		if strings.Compare(v, target.GetNamespaces()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetClusters()) != len(target.GetClusters()) {
+		return false
+	}
+	for idx, v := range m.GetClusters() {
+
+		if strings.Compare(v, target.GetClusters()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *WorkloadSelector_KubeWorkloadMatcher) Equal(that interface{}) bool {
 	if that == nil {
@@ -17,6 +17,13 @@
 // * ReplicaSets
 // * DaemonSets
 // * StatefulSets
+// * ServiceEntries
+// * WorkloadEntries
+// * WorkloadGroups
+// * Jobs
+// * CronJobs
+// * EndpointSlices
+// * Rollouts
 // for a given cluster or set of clusters.
 //
 // Input Reconcilers can be be constructed from either a single Manager (watch events in a single cluster)
@@ -49,6 +56,18 @@ import (
 
 	apps_v1_controllers "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/controller"
 	apps_v1 "k8s.io/api/apps/v1"
+
+	networking_istio_io_v1alpha3_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/controller"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	batch_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/controller"
+	batch_v1 "k8s.io/api/batch/v1"
+
+	discovery_k8s_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/controller"
+	discovery_k8s_io_v1 "k8s.io/api/discovery/v1"
+
+	argoproj_io_v1alpha1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/controller"
+	argoproj_io_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 )
 
 // the multiClusterAgentReconciler reconciles events for input resources across clusters
@@ -71,6 +90,17 @@ type multiClusterAgentReconciler interface {
 	apps_v1_controllers.MulticlusterReplicaSetReconciler
 	apps_v1_controllers.MulticlusterDaemonSetReconciler
 	apps_v1_controllers.MulticlusterStatefulSetReconciler
+
+	networking_istio_io_v1alpha3_controllers.MulticlusterServiceEntryReconciler
+	networking_istio_io_v1alpha3_controllers.MulticlusterWorkloadEntryReconciler
+	networking_istio_io_v1alpha3_controllers.MulticlusterWorkloadGroupReconciler
+
+	batch_v1_controllers.MulticlusterJobReconciler
+	batch_v1_controllers.MulticlusterCronJobReconciler
+
+	discovery_k8s_io_v1_controllers.MulticlusterEndpointSliceReconciler
+
+	argoproj_io_v1alpha1_controllers.MulticlusterRolloutReconciler
 }
 
 var _ multiClusterAgentReconciler = &multiClusterAgentReconcilerImpl{}
@@ -112,6 +142,24 @@ type AgentReconcileOptions struct {
 	DaemonSets reconcile.Options
 	// Options for reconciling StatefulSets
 	StatefulSets reconcile.Options
+
+	// Options for reconciling ServiceEntries
+	ServiceEntries reconcile.Options
+	// Options for reconciling WorkloadEntries
+	WorkloadEntries reconcile.Options
+	// Options for reconciling WorkloadGroups
+	WorkloadGroups reconcile.Options
+
+	// Options for reconciling Jobs
+	Jobs reconcile.Options
+	// Options for reconciling CronJobs
+	CronJobs reconcile.Options
+
+	// Options for reconciling EndpointSlices
+	EndpointSlices reconcile.Options
+
+	// Options for reconciling Rollouts
+	Rollouts reconcile.Options
 }
 
 // register the reconcile func with the cluster watcher
@@ -164,6 +212,20 @@ func RegisterMultiClusterAgentReconciler(
 	apps_v1_controllers.NewMulticlusterDaemonSetReconcileLoop("DaemonSet", clusters, options.DaemonSets).AddMulticlusterDaemonSetReconciler(ctx, r, predicates...)
 
 	apps_v1_controllers.NewMulticlusterStatefulSetReconcileLoop("StatefulSet", clusters, options.StatefulSets).AddMulticlusterStatefulSetReconciler(ctx, r, predicates...)
+
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterServiceEntryReconcileLoop("ServiceEntry", clusters, options.ServiceEntries).AddMulticlusterServiceEntryReconciler(ctx, r, predicates...)
+
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterWorkloadEntryReconcileLoop("WorkloadEntry", clusters, options.WorkloadEntries).AddMulticlusterWorkloadEntryReconciler(ctx, r, predicates...)
+
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterWorkloadGroupReconcileLoop("WorkloadGroup", clusters, options.WorkloadGroups).AddMulticlusterWorkloadGroupReconciler(ctx, r, predicates...)
+
+	batch_v1_controllers.NewMulticlusterJobReconcileLoop("Job", clusters, options.Jobs).AddMulticlusterJobReconciler(ctx, r, predicates...)
+
+	batch_v1_controllers.NewMulticlusterCronJobReconcileLoop("CronJob", clusters, options.CronJobs).AddMulticlusterCronJobReconciler(ctx, r, predicates...)
+
+	discovery_k8s_io_v1_controllers.NewMulticlusterEndpointSliceReconcileLoop("EndpointSlice", clusters, options.EndpointSlices).AddMulticlusterEndpointSliceReconciler(ctx, r, predicates...)
+
+	argoproj_io_v1alpha1_controllers.NewMulticlusterRolloutReconcileLoop("Rollout", clusters, options.Rollouts).AddMulticlusterRolloutReconciler(ctx, r, predicates...)
 	return r.base
 }
 
@@ -362,6 +424,111 @@ func (r *multiClusterAgentReconcilerImpl) ReconcileStatefulSetDeletion(clusterNa
 	return err
 }
 
+func (r *multiClusterAgentReconcilerImpl) ReconcileServiceEntry(clusterName string, obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileServiceEntryDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileWorkloadEntry(clusterName string, obj *networking_istio_io_v1alpha3.WorkloadEntry) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileWorkloadEntryDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileWorkloadGroup(clusterName string, obj *networking_istio_io_v1alpha3.WorkloadGroup) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileWorkloadGroupDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileJob(clusterName string, obj *batch_v1.Job) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileJobDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileCronJob(clusterName string, obj *batch_v1.CronJob) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileCronJobDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileEndpointSlice(clusterName string, obj *discovery_k8s_io_v1.EndpointSlice) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileEndpointSliceDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileRollout(clusterName string, obj *argoproj_io_v1alpha1.Rollout) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *multiClusterAgentReconcilerImpl) ReconcileRolloutDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
 // the singleClusterAgentReconciler reconciles events for input resources across clusters
 // this private interface is used to ensure that the generated struct implements the intended functions
 type singleClusterAgentReconciler interface {
@@ -382,6 +549,17 @@ type singleClusterAgentReconciler interface {
 	apps_v1_controllers.ReplicaSetReconciler
 	apps_v1_controllers.DaemonSetReconciler
 	apps_v1_controllers.StatefulSetReconciler
+
+	networking_istio_io_v1alpha3_controllers.ServiceEntryReconciler
+	networking_istio_io_v1alpha3_controllers.WorkloadEntryReconciler
+	networking_istio_io_v1alpha3_controllers.WorkloadGroupReconciler
+
+	batch_v1_controllers.JobReconciler
+	batch_v1_controllers.CronJobReconciler
+
+	discovery_k8s_io_v1_controllers.EndpointSliceReconciler
+
+	argoproj_io_v1alpha1_controllers.RolloutReconciler
 }
 
 var _ singleClusterAgentReconciler = &singleClusterAgentReconcilerImpl{}
@@ -459,6 +637,31 @@ func RegisterSingleClusterAgentReconciler(
 		return nil, err
 	}
 
+	if err := networking_istio_io_v1alpha3_controllers.NewServiceEntryReconcileLoop("ServiceEntry", mgr, options).RunServiceEntryReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+	if err := networking_istio_io_v1alpha3_controllers.NewWorkloadEntryReconcileLoop("WorkloadEntry", mgr, options).RunWorkloadEntryReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+	if err := networking_istio_io_v1alpha3_controllers.NewWorkloadGroupReconcileLoop("WorkloadGroup", mgr, options).RunWorkloadGroupReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+
+	if err := batch_v1_controllers.NewJobReconcileLoop("Job", mgr, options).RunJobReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+	if err := batch_v1_controllers.NewCronJobReconcileLoop("CronJob", mgr, options).RunCronJobReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+
+	if err := discovery_k8s_io_v1_controllers.NewEndpointSliceReconcileLoop("EndpointSlice", mgr, options).RunEndpointSliceReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+
+	if err := argoproj_io_v1alpha1_controllers.NewRolloutReconcileLoop("Rollout", mgr, options).RunRolloutReconciler(ctx, r, predicates...); err != nil {
+		return nil, err
+	}
+
 	return r.base, nil
 }
 
@@ -630,3 +833,94 @@ func (r *singleClusterAgentReconcilerImpl) ReconcileStatefulSetDeletion(obj reco
 	_, err := r.base.ReconcileLocalGeneric(ref)
 	return err
 }
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileServiceEntry(obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileServiceEntryDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileWorkloadEntry(obj *networking_istio_io_v1alpha3.WorkloadEntry) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileWorkloadEntryDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileWorkloadGroup(obj *networking_istio_io_v1alpha3.WorkloadGroup) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileWorkloadGroupDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileJob(obj *batch_v1.Job) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileJobDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileCronJob(obj *batch_v1.CronJob) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileCronJobDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileEndpointSlice(obj *discovery_k8s_io_v1.EndpointSlice) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileEndpointSliceDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileRollout(obj *argoproj_io_v1alpha1.Rollout) (reconcile.Result, error) {
+	return r.base.ReconcileLocalGeneric(obj)
+}
+
+func (r *singleClusterAgentReconcilerImpl) ReconcileRolloutDeletion(obj reconcile.Request) error {
+	ref := &sk_core_v1.ObjectRef{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+	_, err := r.base.ReconcileLocalGeneric(ref)
+	return err
+}
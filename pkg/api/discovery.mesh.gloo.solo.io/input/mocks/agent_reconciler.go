@@ -10,10 +10,14 @@ import (
 	v1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 	v10 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	reconcile "github.com/solo-io/skv2/pkg/reconcile"
+	v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	v11 "k8s.io/api/apps/v1"
-	v12 "k8s.io/api/core/v1"
+	v12 "k8s.io/api/batch/v1"
+	v13 "k8s.io/api/core/v1"
+	v14 "k8s.io/api/discovery/v1"
 )
 
 // MockmultiClusterAgentReconciler is a mock of multiClusterAgentReconciler interface.
@@ -40,7 +44,7 @@ func (m *MockmultiClusterAgentReconciler) EXPECT() *MockmultiClusterAgentReconci
 }
 
 // ReconcileConfigMap mocks base method.
-func (m *MockmultiClusterAgentReconciler) ReconcileConfigMap(clusterName string, obj *v12.ConfigMap) (reconcile.Result, error) {
+func (m *MockmultiClusterAgentReconciler) ReconcileConfigMap(clusterName string, obj *v13.ConfigMap) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileConfigMap", clusterName, obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -54,6 +58,21 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileConfigMap(cluste
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileConfigMap", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileConfigMap), clusterName, obj)
 }
 
+// ReconcileCronJob mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileCronJob(clusterName string, obj *v12.CronJob) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileCronJob", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileCronJob indicates an expected call of ReconcileCronJob.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileCronJob(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileCronJob", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileCronJob), clusterName, obj)
+}
+
 // ReconcileDaemonSet mocks base method.
 func (m *MockmultiClusterAgentReconciler) ReconcileDaemonSet(clusterName string, obj *v11.DaemonSet) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -84,8 +103,23 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileDeployment(clust
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDeployment", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileDeployment), clusterName, obj)
 }
 
+// ReconcileEndpointSlice mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileEndpointSlice(clusterName string, obj *v14.EndpointSlice) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEndpointSlice", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEndpointSlice indicates an expected call of ReconcileEndpointSlice.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileEndpointSlice(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEndpointSlice", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileEndpointSlice), clusterName, obj)
+}
+
 // ReconcileEndpoints mocks base method.
-func (m *MockmultiClusterAgentReconciler) ReconcileEndpoints(clusterName string, obj *v12.Endpoints) (reconcile.Result, error) {
+func (m *MockmultiClusterAgentReconciler) ReconcileEndpoints(clusterName string, obj *v13.Endpoints) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileEndpoints", clusterName, obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -114,6 +148,21 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileIssuedCertificat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileIssuedCertificate", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileIssuedCertificate), clusterName, obj)
 }
 
+// ReconcileJob mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileJob(clusterName string, obj *v12.Job) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileJob", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileJob indicates an expected call of ReconcileJob.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileJob(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileJob", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileJob), clusterName, obj)
+}
+
 // ReconcileMesh mocks base method.
 func (m *MockmultiClusterAgentReconciler) ReconcileMesh(clusterName string, obj *v1beta2.Mesh) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -130,7 +179,7 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileMesh(clusterName
 }
 
 // ReconcileNamespace mocks base method.
-func (m *MockmultiClusterAgentReconciler) ReconcileNamespace(clusterName string, obj *v12.Namespace) (reconcile.Result, error) {
+func (m *MockmultiClusterAgentReconciler) ReconcileNamespace(clusterName string, obj *v13.Namespace) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileNamespace", clusterName, obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -145,7 +194,7 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileNamespace(cluste
 }
 
 // ReconcileNode mocks base method.
-func (m *MockmultiClusterAgentReconciler) ReconcileNode(clusterName string, obj *v12.Node) (reconcile.Result, error) {
+func (m *MockmultiClusterAgentReconciler) ReconcileNode(clusterName string, obj *v13.Node) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileNode", clusterName, obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -160,7 +209,7 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileNode(clusterName
 }
 
 // ReconcilePod mocks base method.
-func (m *MockmultiClusterAgentReconciler) ReconcilePod(clusterName string, obj *v12.Pod) (reconcile.Result, error) {
+func (m *MockmultiClusterAgentReconciler) ReconcilePod(clusterName string, obj *v13.Pod) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcilePod", clusterName, obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -189,8 +238,23 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileReplicaSet(clust
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileReplicaSet", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileReplicaSet), clusterName, obj)
 }
 
+// ReconcileRollout mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileRollout(clusterName string, obj *v1alpha1.Rollout) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRollout", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileRollout indicates an expected call of ReconcileRollout.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileRollout(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRollout", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileRollout), clusterName, obj)
+}
+
 // ReconcileService mocks base method.
-func (m *MockmultiClusterAgentReconciler) ReconcileService(clusterName string, obj *v12.Service) (reconcile.Result, error) {
+func (m *MockmultiClusterAgentReconciler) ReconcileService(clusterName string, obj *v13.Service) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileService", clusterName, obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -204,6 +268,21 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileService(clusterN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileService", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileService), clusterName, obj)
 }
 
+// ReconcileServiceEntry mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileServiceEntry(clusterName string, obj *v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileServiceEntry", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileServiceEntry indicates an expected call of ReconcileServiceEntry.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileServiceEntry(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileServiceEntry", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileServiceEntry), clusterName, obj)
+}
+
 // ReconcileSettings mocks base method.
 func (m *MockmultiClusterAgentReconciler) ReconcileSettings(clusterName string, obj *v10.Settings) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -234,6 +313,36 @@ func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileStatefulSet(clus
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileStatefulSet", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileStatefulSet), clusterName, obj)
 }
 
+// ReconcileWorkloadEntry mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileWorkloadEntry(clusterName string, obj *v1alpha3.WorkloadEntry) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWorkloadEntry", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileWorkloadEntry indicates an expected call of ReconcileWorkloadEntry.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileWorkloadEntry(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkloadEntry", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileWorkloadEntry), clusterName, obj)
+}
+
+// ReconcileWorkloadGroup mocks base method.
+func (m *MockmultiClusterAgentReconciler) ReconcileWorkloadGroup(clusterName string, obj *v1alpha3.WorkloadGroup) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWorkloadGroup", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileWorkloadGroup indicates an expected call of ReconcileWorkloadGroup.
+func (mr *MockmultiClusterAgentReconcilerMockRecorder) ReconcileWorkloadGroup(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkloadGroup", reflect.TypeOf((*MockmultiClusterAgentReconciler)(nil).ReconcileWorkloadGroup), clusterName, obj)
+}
+
 // MocksingleClusterAgentReconciler is a mock of singleClusterAgentReconciler interface.
 type MocksingleClusterAgentReconciler struct {
 	ctrl     *gomock.Controller
@@ -258,7 +367,7 @@ func (m *MocksingleClusterAgentReconciler) EXPECT() *MocksingleClusterAgentRecon
 }
 
 // ReconcileConfigMap mocks base method.
-func (m *MocksingleClusterAgentReconciler) ReconcileConfigMap(obj *v12.ConfigMap) (reconcile.Result, error) {
+func (m *MocksingleClusterAgentReconciler) ReconcileConfigMap(obj *v13.ConfigMap) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileConfigMap", obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -272,6 +381,21 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileConfigMap(obj i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileConfigMap", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileConfigMap), obj)
 }
 
+// ReconcileCronJob mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileCronJob(obj *v12.CronJob) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileCronJob", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileCronJob indicates an expected call of ReconcileCronJob.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileCronJob(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileCronJob", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileCronJob), obj)
+}
+
 // ReconcileDaemonSet mocks base method.
 func (m *MocksingleClusterAgentReconciler) ReconcileDaemonSet(obj *v11.DaemonSet) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -302,8 +426,23 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileDeployment(obj
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDeployment", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileDeployment), obj)
 }
 
+// ReconcileEndpointSlice mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileEndpointSlice(obj *v14.EndpointSlice) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEndpointSlice", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEndpointSlice indicates an expected call of ReconcileEndpointSlice.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileEndpointSlice(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEndpointSlice", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileEndpointSlice), obj)
+}
+
 // ReconcileEndpoints mocks base method.
-func (m *MocksingleClusterAgentReconciler) ReconcileEndpoints(obj *v12.Endpoints) (reconcile.Result, error) {
+func (m *MocksingleClusterAgentReconciler) ReconcileEndpoints(obj *v13.Endpoints) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileEndpoints", obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -332,6 +471,21 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileIssuedCertifica
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileIssuedCertificate", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileIssuedCertificate), obj)
 }
 
+// ReconcileJob mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileJob(obj *v12.Job) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileJob", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileJob indicates an expected call of ReconcileJob.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileJob(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileJob", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileJob), obj)
+}
+
 // ReconcileMesh mocks base method.
 func (m *MocksingleClusterAgentReconciler) ReconcileMesh(obj *v1beta2.Mesh) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -348,7 +502,7 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileMesh(obj interf
 }
 
 // ReconcileNamespace mocks base method.
-func (m *MocksingleClusterAgentReconciler) ReconcileNamespace(obj *v12.Namespace) (reconcile.Result, error) {
+func (m *MocksingleClusterAgentReconciler) ReconcileNamespace(obj *v13.Namespace) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileNamespace", obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -363,7 +517,7 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileNamespace(obj i
 }
 
 // ReconcileNode mocks base method.
-func (m *MocksingleClusterAgentReconciler) ReconcileNode(obj *v12.Node) (reconcile.Result, error) {
+func (m *MocksingleClusterAgentReconciler) ReconcileNode(obj *v13.Node) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileNode", obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -378,7 +532,7 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileNode(obj interf
 }
 
 // ReconcilePod mocks base method.
-func (m *MocksingleClusterAgentReconciler) ReconcilePod(obj *v12.Pod) (reconcile.Result, error) {
+func (m *MocksingleClusterAgentReconciler) ReconcilePod(obj *v13.Pod) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcilePod", obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -407,8 +561,23 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileReplicaSet(obj
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileReplicaSet", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileReplicaSet), obj)
 }
 
+// ReconcileRollout mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileRollout(obj *v1alpha1.Rollout) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRollout", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileRollout indicates an expected call of ReconcileRollout.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileRollout(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRollout", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileRollout), obj)
+}
+
 // ReconcileService mocks base method.
-func (m *MocksingleClusterAgentReconciler) ReconcileService(obj *v12.Service) (reconcile.Result, error) {
+func (m *MocksingleClusterAgentReconciler) ReconcileService(obj *v13.Service) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReconcileService", obj)
 	ret0, _ := ret[0].(reconcile.Result)
@@ -422,6 +591,21 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileService(obj int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileService", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileService), obj)
 }
 
+// ReconcileServiceEntry mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileServiceEntry(obj *v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileServiceEntry", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileServiceEntry indicates an expected call of ReconcileServiceEntry.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileServiceEntry(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileServiceEntry", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileServiceEntry), obj)
+}
+
 // ReconcileSettings mocks base method.
 func (m *MocksingleClusterAgentReconciler) ReconcileSettings(obj *v10.Settings) (reconcile.Result, error) {
 	m.ctrl.T.Helper()
@@ -451,3 +635,33 @@ func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileStatefulSet(obj
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileStatefulSet", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileStatefulSet), obj)
 }
+
+// ReconcileWorkloadEntry mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileWorkloadEntry(obj *v1alpha3.WorkloadEntry) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWorkloadEntry", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileWorkloadEntry indicates an expected call of ReconcileWorkloadEntry.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileWorkloadEntry(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkloadEntry", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileWorkloadEntry), obj)
+}
+
+// ReconcileWorkloadGroup mocks base method.
+func (m *MocksingleClusterAgentReconciler) ReconcileWorkloadGroup(obj *v1alpha3.WorkloadGroup) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWorkloadGroup", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileWorkloadGroup indicates an expected call of ReconcileWorkloadGroup.
+func (mr *MocksingleClusterAgentReconcilerMockRecorder) ReconcileWorkloadGroup(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkloadGroup", reflect.TypeOf((*MocksingleClusterAgentReconciler)(nil).ReconcileWorkloadGroup), obj)
+}
@@ -14,6 +14,10 @@ import (
 	v1sets0 "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1sets1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
 	input "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1alpha1sets "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/sets"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	v1sets2 "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
+	v1sets3 "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
 	multicluster "github.com/solo-io/skv2/pkg/multicluster"
 	resource "github.com/solo-io/skv2/pkg/resource"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -71,6 +75,20 @@ func (mr *MockDiscoveryInputSnapshotMockRecorder) ConfigMaps() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigMaps", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).ConfigMaps))
 }
 
+// CronJobs mocks base method.
+func (m *MockDiscoveryInputSnapshot) CronJobs() v1sets2.CronJobSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CronJobs")
+	ret0, _ := ret[0].(v1sets2.CronJobSet)
+	return ret0
+}
+
+// CronJobs indicates an expected call of CronJobs.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) CronJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CronJobs", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).CronJobs))
+}
+
 // DaemonSets mocks base method.
 func (m *MockDiscoveryInputSnapshot) DaemonSets() v1sets.DaemonSetSet {
 	m.ctrl.T.Helper()
@@ -99,6 +117,20 @@ func (mr *MockDiscoveryInputSnapshotMockRecorder) Deployments() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deployments", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).Deployments))
 }
 
+// EndpointSlices mocks base method.
+func (m *MockDiscoveryInputSnapshot) EndpointSlices() v1sets3.EndpointSliceSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndpointSlices")
+	ret0, _ := ret[0].(v1sets3.EndpointSliceSet)
+	return ret0
+}
+
+// EndpointSlices indicates an expected call of EndpointSlices.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) EndpointSlices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointSlices", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).EndpointSlices))
+}
+
 // Endpoints mocks base method.
 func (m *MockDiscoveryInputSnapshot) Endpoints() v1sets0.EndpointsSet {
 	m.ctrl.T.Helper()
@@ -153,6 +185,20 @@ func (mr *MockDiscoveryInputSnapshotMockRecorder) IssuedCertificates() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssuedCertificates", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).IssuedCertificates))
 }
 
+// Jobs mocks base method.
+func (m *MockDiscoveryInputSnapshot) Jobs() v1sets2.JobSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Jobs")
+	ret0, _ := ret[0].(v1sets2.JobSet)
+	return ret0
+}
+
+// Jobs indicates an expected call of Jobs.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) Jobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Jobs", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).Jobs))
+}
+
 // MarshalJSON mocks base method.
 func (m *MockDiscoveryInputSnapshot) MarshalJSON() ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +284,34 @@ func (mr *MockDiscoveryInputSnapshotMockRecorder) ReplicaSets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicaSets", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).ReplicaSets))
 }
 
+// Rollouts mocks base method.
+func (m *MockDiscoveryInputSnapshot) Rollouts() v1alpha1sets.RolloutSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rollouts")
+	ret0, _ := ret[0].(v1alpha1sets.RolloutSet)
+	return ret0
+}
+
+// Rollouts indicates an expected call of Rollouts.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) Rollouts() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollouts", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).Rollouts))
+}
+
+// ServiceEntries mocks base method.
+func (m *MockDiscoveryInputSnapshot) ServiceEntries() v1alpha3sets.ServiceEntrySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ServiceEntries")
+	ret0, _ := ret[0].(v1alpha3sets.ServiceEntrySet)
+	return ret0
+}
+
+// ServiceEntries indicates an expected call of ServiceEntries.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) ServiceEntries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ServiceEntries", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).ServiceEntries))
+}
+
 // Services mocks base method.
 func (m *MockDiscoveryInputSnapshot) Services() v1sets0.ServiceSet {
 	m.ctrl.T.Helper()
@@ -294,6 +368,34 @@ func (mr *MockDiscoveryInputSnapshotMockRecorder) SyncStatusesMultiCluster(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatusesMultiCluster", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).SyncStatusesMultiCluster), ctx, mcClient, opts)
 }
 
+// WorkloadEntries mocks base method.
+func (m *MockDiscoveryInputSnapshot) WorkloadEntries() v1alpha3sets.WorkloadEntrySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadEntries")
+	ret0, _ := ret[0].(v1alpha3sets.WorkloadEntrySet)
+	return ret0
+}
+
+// WorkloadEntries indicates an expected call of WorkloadEntries.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) WorkloadEntries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadEntries", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).WorkloadEntries))
+}
+
+// WorkloadGroups mocks base method.
+func (m *MockDiscoveryInputSnapshot) WorkloadGroups() v1alpha3sets.WorkloadGroupSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WorkloadGroups")
+	ret0, _ := ret[0].(v1alpha3sets.WorkloadGroupSet)
+	return ret0
+}
+
+// WorkloadGroups indicates an expected call of WorkloadGroups.
+func (mr *MockDiscoveryInputSnapshotMockRecorder) WorkloadGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WorkloadGroups", reflect.TypeOf((*MockDiscoveryInputSnapshot)(nil).WorkloadGroups))
+}
+
 // MockDiscoveryInputBuilder is a mock of DiscoveryInputBuilder interface.
 type MockDiscoveryInputBuilder struct {
 	ctrl     *gomock.Controller
@@ -17,10 +17,18 @@ import (
 	v1_controllers "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/controller"
 	certificates_mesh_gloo_solo_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	certificates_mesh_gloo_solo_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/controller"
+	argoproj_io_v1alpha1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/controller"
+	argoproj_io_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
+	networking_istio_io_v1alpha3_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/controller"
+	batch_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/controller"
+	discovery_k8s_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/controller"
 	settings_mesh_gloo_solo_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	settings_mesh_gloo_solo_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1/controller"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	apps_v1 "k8s.io/api/apps/v1"
+	batch_v1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	discovery_k8s_io_v1 "k8s.io/api/discovery/v1"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 )
@@ -39,6 +47,13 @@ import (
 // * ReplicaSets
 // * DaemonSets
 // * StatefulSets
+// * ServiceEntries
+// * WorkloadEntries
+// * WorkloadGroups
+// * Jobs
+// * CronJobs
+// * EndpointSlices
+// * Rollouts
 // from a remote cluster.
 // * Settings
 // from the local cluster.
@@ -62,7 +77,7 @@ func RegisterInputReconciler(
 	singleClusterReconcileFunc input.SingleClusterReconcileFunc,
 	options ReconcileOptions,
 ) (input.InputReconciler, error) {
-	// [certificates.mesh.gloo.solo.io/v1 appmesh.k8s.aws/v1beta2 v1 apps/v1] false 4
+	// [certificates.mesh.gloo.solo.io/v1 appmesh.k8s.aws/v1beta2 v1 apps/v1 networking.istio.io/v1alpha3 batch/v1 discovery.k8s.io/v1 argoproj.io/v1alpha1] false 8
 	// [settings.mesh.gloo.solo.io/v1]
 
 	base := input.NewInputReconciler(
@@ -102,6 +117,24 @@ func RegisterInputReconciler(
 	// initialize StatefulSets reconcile loop for remote clusters
 	apps_v1_controllers.NewMulticlusterStatefulSetReconcileLoop("StatefulSet", clusters, options.Remote.StatefulSets).AddMulticlusterStatefulSetReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 
+	// initialize ServiceEntries reconcile loop for remote clusters
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterServiceEntryReconcileLoop("ServiceEntry", clusters, options.Remote.ServiceEntries).AddMulticlusterServiceEntryReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+	// initialize WorkloadEntries reconcile loop for remote clusters
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterWorkloadEntryReconcileLoop("WorkloadEntry", clusters, options.Remote.WorkloadEntries).AddMulticlusterWorkloadEntryReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+	// initialize WorkloadGroups reconcile loop for remote clusters
+	networking_istio_io_v1alpha3_controllers.NewMulticlusterWorkloadGroupReconcileLoop("WorkloadGroup", clusters, options.Remote.WorkloadGroups).AddMulticlusterWorkloadGroupReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+
+	// initialize Jobs reconcile loop for remote clusters
+	batch_v1_controllers.NewMulticlusterJobReconcileLoop("Job", clusters, options.Remote.Jobs).AddMulticlusterJobReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+	// initialize CronJobs reconcile loop for remote clusters
+	batch_v1_controllers.NewMulticlusterCronJobReconcileLoop("CronJob", clusters, options.Remote.CronJobs).AddMulticlusterCronJobReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+
+	// initialize EndpointSlices reconcile loop for remote clusters
+	discovery_k8s_io_v1_controllers.NewMulticlusterEndpointSliceReconcileLoop("EndpointSlice", clusters, options.Remote.EndpointSlices).AddMulticlusterEndpointSliceReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+
+	// initialize Rollouts reconcile loop for remote clusters
+	argoproj_io_v1alpha1_controllers.NewMulticlusterRolloutReconcileLoop("Rollout", clusters, options.Remote.Rollouts).AddMulticlusterRolloutReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+
 	// initialize Settings reconcile loop for local cluster
 	if err := settings_mesh_gloo_solo_io_v1_controllers.NewSettingsReconcileLoop("Settings", mgr, options.Local.Settings).RunSettingsReconciler(ctx, &localInputReconciler{base: base}, options.Local.Predicates...); err != nil {
 		return nil, err
@@ -141,6 +174,24 @@ type RemoteReconcileOptions struct {
 	// Options for reconciling StatefulSets
 	StatefulSets reconcile.Options
 
+	// Options for reconciling ServiceEntries
+	ServiceEntries reconcile.Options
+	// Options for reconciling WorkloadEntries
+	WorkloadEntries reconcile.Options
+	// Options for reconciling WorkloadGroups
+	WorkloadGroups reconcile.Options
+
+	// Options for reconciling Jobs
+	Jobs reconcile.Options
+	// Options for reconciling CronJobs
+	CronJobs reconcile.Options
+
+	// Options for reconciling EndpointSlices
+	EndpointSlices reconcile.Options
+
+	// Options for reconciling Rollouts
+	Rollouts reconcile.Options
+
 	// optional predicates for filtering remote events
 	Predicates []predicate.Predicate
 }
@@ -329,6 +380,111 @@ func (r *remoteInputReconciler) ReconcileStatefulSetDeletion(clusterName string,
 	return err
 }
 
+func (r *remoteInputReconciler) ReconcileServiceEntry(clusterName string, obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileServiceEntryDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileWorkloadEntry(clusterName string, obj *networking_istio_io_v1alpha3.WorkloadEntry) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileWorkloadEntryDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileWorkloadGroup(clusterName string, obj *networking_istio_io_v1alpha3.WorkloadGroup) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileWorkloadGroupDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileJob(clusterName string, obj *batch_v1.Job) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileJobDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileCronJob(clusterName string, obj *batch_v1.CronJob) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileCronJobDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileEndpointSlice(clusterName string, obj *discovery_k8s_io_v1.EndpointSlice) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileEndpointSliceDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileRollout(clusterName string, obj *argoproj_io_v1alpha1.Rollout) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileRolloutDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
 // Options for reconciling a snapshot in remote clusters
 type LocalReconcileOptions struct {
 
@@ -15,6 +15,13 @@
 // * ReplicaSets
 // * DaemonSets
 // * StatefulSets
+// * ServiceEntries
+// * WorkloadEntries
+// * WorkloadGroups
+// * Jobs
+// * CronJobs
+// * EndpointSlices
+// * Rollouts
 // read from a given cluster or set of clusters, across all namespaces.
 //
 // A snapshot can be constructed from either a single Manager (for a single cluster)
@@ -59,6 +66,22 @@ import (
 	apps_v1 "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1"
 	apps_v1_sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
 	apps_v1_types "k8s.io/api/apps/v1"
+
+	networking_istio_io_v1alpha3 "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3"
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3_types "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	batch_v1 "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1"
+	batch_v1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
+	batch_v1_types "k8s.io/api/batch/v1"
+
+	discovery_k8s_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1"
+	discovery_k8s_io_v1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
+	discovery_k8s_io_v1_types "k8s.io/api/discovery/v1"
+
+	argoproj_io_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1"
+	argoproj_io_v1alpha1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/sets"
+	argoproj_io_v1alpha1_types "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 )
 
 // SnapshotGVKs is a list of the GVKs included in this snapshot
@@ -123,6 +146,41 @@ var DiscoveryInputSnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1",
 		Kind:    "StatefulSet",
 	},
+	schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1alpha3",
+		Kind:    "ServiceEntry",
+	},
+	schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1alpha3",
+		Kind:    "WorkloadEntry",
+	},
+	schema.GroupVersionKind{
+		Group:   "networking.istio.io",
+		Version: "v1alpha3",
+		Kind:    "WorkloadGroup",
+	},
+	schema.GroupVersionKind{
+		Group:   "batch",
+		Version: "v1",
+		Kind:    "Job",
+	},
+	schema.GroupVersionKind{
+		Group:   "batch",
+		Version: "v1",
+		Kind:    "CronJob",
+	},
+	schema.GroupVersionKind{
+		Group:   "discovery.k8s.io",
+		Version: "v1",
+		Kind:    "EndpointSlice",
+	},
+	schema.GroupVersionKind{
+		Group:   "argoproj.io",
+		Version: "v1alpha1",
+		Kind:    "Rollout",
+	},
 }
 
 // the snapshot of input resources consumed by translation
@@ -155,6 +213,24 @@ type DiscoveryInputSnapshot interface {
 	DaemonSets() apps_v1_sets.DaemonSetSet
 	// return the set of input StatefulSets
 	StatefulSets() apps_v1_sets.StatefulSetSet
+
+	// return the set of input ServiceEntries
+	ServiceEntries() networking_istio_io_v1alpha3_sets.ServiceEntrySet
+	// return the set of input WorkloadEntries
+	WorkloadEntries() networking_istio_io_v1alpha3_sets.WorkloadEntrySet
+	// return the set of input WorkloadGroups
+	WorkloadGroups() networking_istio_io_v1alpha3_sets.WorkloadGroupSet
+
+	// return the set of input Jobs
+	Jobs() batch_v1_sets.JobSet
+	// return the set of input CronJobs
+	CronJobs() batch_v1_sets.CronJobSet
+
+	// return the set of input EndpointSlices
+	EndpointSlices() discovery_k8s_io_v1_sets.EndpointSliceSet
+
+	// return the set of input Rollouts
+	Rollouts() argoproj_io_v1alpha1_sets.RolloutSet
 	// update the status of all input objects which support
 	// the Status subresource (across multiple clusters)
 	SyncStatusesMultiCluster(ctx context.Context, mcClient multicluster.Client, opts DiscoveryInputSyncStatusOptions) error
@@ -204,6 +280,24 @@ type DiscoveryInputSyncStatusOptions struct {
 	DaemonSet bool
 	// sync status of StatefulSet objects
 	StatefulSet bool
+
+	// sync status of ServiceEntry objects
+	ServiceEntry bool
+	// sync status of WorkloadEntry objects
+	WorkloadEntry bool
+	// sync status of WorkloadGroup objects
+	WorkloadGroup bool
+
+	// sync status of Job objects
+	Job bool
+	// sync status of CronJob objects
+	CronJob bool
+
+	// sync status of EndpointSlice objects
+	EndpointSlice bool
+
+	// sync status of Rollout objects
+	Rollout bool
 }
 
 type snapshotDiscoveryInput struct {
@@ -224,6 +318,17 @@ type snapshotDiscoveryInput struct {
 	replicaSets  apps_v1_sets.ReplicaSetSet
 	daemonSets   apps_v1_sets.DaemonSetSet
 	statefulSets apps_v1_sets.StatefulSetSet
+
+	serviceEntries  networking_istio_io_v1alpha3_sets.ServiceEntrySet
+	workloadEntries networking_istio_io_v1alpha3_sets.WorkloadEntrySet
+	workloadGroups  networking_istio_io_v1alpha3_sets.WorkloadGroupSet
+
+	jobs     batch_v1_sets.JobSet
+	cronJobs batch_v1_sets.CronJobSet
+
+	endpointSlices discovery_k8s_io_v1_sets.EndpointSliceSet
+
+	rollouts argoproj_io_v1alpha1_sets.RolloutSet
 }
 
 func NewDiscoveryInputSnapshot(
@@ -245,6 +350,17 @@ func NewDiscoveryInputSnapshot(
 	daemonSets apps_v1_sets.DaemonSetSet,
 	statefulSets apps_v1_sets.StatefulSetSet,
 
+	serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet,
+	workloadEntries networking_istio_io_v1alpha3_sets.WorkloadEntrySet,
+	workloadGroups networking_istio_io_v1alpha3_sets.WorkloadGroupSet,
+
+	jobs batch_v1_sets.JobSet,
+	cronJobs batch_v1_sets.CronJobSet,
+
+	endpointSlices discovery_k8s_io_v1_sets.EndpointSliceSet,
+
+	rollouts argoproj_io_v1alpha1_sets.RolloutSet,
+
 ) DiscoveryInputSnapshot {
 	return &snapshotDiscoveryInput{
 		name: name,
@@ -261,6 +377,13 @@ func NewDiscoveryInputSnapshot(
 		replicaSets:        replicaSets,
 		daemonSets:         daemonSets,
 		statefulSets:       statefulSets,
+		serviceEntries:     serviceEntries,
+		workloadEntries:    workloadEntries,
+		workloadGroups:     workloadGroups,
+		jobs:               jobs,
+		cronJobs:           cronJobs,
+		endpointSlices:     endpointSlices,
+		rollouts:           rollouts,
 	}
 }
 
@@ -285,6 +408,17 @@ func NewDiscoveryInputSnapshotFromGeneric(
 	daemonSetSet := apps_v1_sets.NewDaemonSetSet()
 	statefulSetSet := apps_v1_sets.NewStatefulSetSet()
 
+	serviceEntrySet := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+	workloadEntrySet := networking_istio_io_v1alpha3_sets.NewWorkloadEntrySet()
+	workloadGroupSet := networking_istio_io_v1alpha3_sets.NewWorkloadGroupSet()
+
+	jobSet := batch_v1_sets.NewJobSet()
+	cronJobSet := batch_v1_sets.NewCronJobSet()
+
+	endpointSliceSet := discovery_k8s_io_v1_sets.NewEndpointSliceSet()
+
+	rolloutSet := argoproj_io_v1alpha1_sets.NewRolloutSet()
+
 	for _, snapshot := range genericSnapshot {
 
 		issuedCertificates := snapshot[schema.GroupVersionKind{
@@ -399,6 +533,73 @@ func NewDiscoveryInputSnapshotFromGeneric(
 			statefulSetSet.Insert(statefulSet.(*apps_v1_types.StatefulSet))
 		}
 
+		serviceEntries := snapshot[schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
+		}]
+
+		for _, serviceEntry := range serviceEntries {
+			serviceEntrySet.Insert(serviceEntry.(*networking_istio_io_v1alpha3_types.ServiceEntry))
+		}
+		workloadEntries := snapshot[schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadEntry",
+		}]
+
+		for _, workloadEntry := range workloadEntries {
+			workloadEntrySet.Insert(workloadEntry.(*networking_istio_io_v1alpha3_types.WorkloadEntry))
+		}
+		workloadGroups := snapshot[schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadGroup",
+		}]
+
+		for _, workloadGroup := range workloadGroups {
+			workloadGroupSet.Insert(workloadGroup.(*networking_istio_io_v1alpha3_types.WorkloadGroup))
+		}
+
+		jobs := snapshot[schema.GroupVersionKind{
+			Group:   "batch",
+			Version: "v1",
+			Kind:    "Job",
+		}]
+
+		for _, job := range jobs {
+			jobSet.Insert(job.(*batch_v1_types.Job))
+		}
+		cronJobs := snapshot[schema.GroupVersionKind{
+			Group:   "batch",
+			Version: "v1",
+			Kind:    "CronJob",
+		}]
+
+		for _, cronJob := range cronJobs {
+			cronJobSet.Insert(cronJob.(*batch_v1_types.CronJob))
+		}
+
+		endpointSlices := snapshot[schema.GroupVersionKind{
+			Group:   "discovery.k8s.io",
+			Version: "v1",
+			Kind:    "EndpointSlice",
+		}]
+
+		for _, endpointSlice := range endpointSlices {
+			endpointSliceSet.Insert(endpointSlice.(*discovery_k8s_io_v1_types.EndpointSlice))
+		}
+
+		rollouts := snapshot[schema.GroupVersionKind{
+			Group:   "argoproj.io",
+			Version: "v1alpha1",
+			Kind:    "Rollout",
+		}]
+
+		for _, rollout := range rollouts {
+			rolloutSet.Insert(rollout.(*argoproj_io_v1alpha1_types.Rollout))
+		}
+
 	}
 	return NewDiscoveryInputSnapshot(
 		name,
@@ -414,6 +615,13 @@ func NewDiscoveryInputSnapshotFromGeneric(
 		replicaSetSet,
 		daemonSetSet,
 		statefulSetSet,
+		serviceEntrySet,
+		workloadEntrySet,
+		workloadGroupSet,
+		jobSet,
+		cronJobSet,
+		endpointSliceSet,
+		rolloutSet,
 	)
 }
 
@@ -465,6 +673,34 @@ func (s *snapshotDiscoveryInput) StatefulSets() apps_v1_sets.StatefulSetSet {
 	return s.statefulSets
 }
 
+func (s *snapshotDiscoveryInput) ServiceEntries() networking_istio_io_v1alpha3_sets.ServiceEntrySet {
+	return s.serviceEntries
+}
+
+func (s *snapshotDiscoveryInput) WorkloadEntries() networking_istio_io_v1alpha3_sets.WorkloadEntrySet {
+	return s.workloadEntries
+}
+
+func (s *snapshotDiscoveryInput) WorkloadGroups() networking_istio_io_v1alpha3_sets.WorkloadGroupSet {
+	return s.workloadGroups
+}
+
+func (s *snapshotDiscoveryInput) Jobs() batch_v1_sets.JobSet {
+	return s.jobs
+}
+
+func (s *snapshotDiscoveryInput) CronJobs() batch_v1_sets.CronJobSet {
+	return s.cronJobs
+}
+
+func (s *snapshotDiscoveryInput) EndpointSlices() discovery_k8s_io_v1_sets.EndpointSliceSet {
+	return s.endpointSlices
+}
+
+func (s *snapshotDiscoveryInput) Rollouts() argoproj_io_v1alpha1_sets.RolloutSet {
+	return s.rollouts
+}
+
 func (s *snapshotDiscoveryInput) SyncStatusesMultiCluster(ctx context.Context, mcClient multicluster.Client, opts DiscoveryInputSyncStatusOptions) error {
 	var errs error
 
@@ -588,6 +824,59 @@ func (s *snapshotDiscoveryInput) MarshalJSON() ([]byte, error) {
 		statefulSetSet.Insert(obj.(*apps_v1_types.StatefulSet))
 	}
 	snapshotMap["statefulSets"] = statefulSetSet.List()
+
+	serviceEntrySet := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+	for _, obj := range s.serviceEntries.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		serviceEntrySet.Insert(obj.(*networking_istio_io_v1alpha3_types.ServiceEntry))
+	}
+	snapshotMap["serviceEntries"] = serviceEntrySet.List()
+	workloadEntrySet := networking_istio_io_v1alpha3_sets.NewWorkloadEntrySet()
+	for _, obj := range s.workloadEntries.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		workloadEntrySet.Insert(obj.(*networking_istio_io_v1alpha3_types.WorkloadEntry))
+	}
+	snapshotMap["workloadEntries"] = workloadEntrySet.List()
+	workloadGroupSet := networking_istio_io_v1alpha3_sets.NewWorkloadGroupSet()
+	for _, obj := range s.workloadGroups.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		workloadGroupSet.Insert(obj.(*networking_istio_io_v1alpha3_types.WorkloadGroup))
+	}
+	snapshotMap["workloadGroups"] = workloadGroupSet.List()
+
+	jobSet := batch_v1_sets.NewJobSet()
+	for _, obj := range s.jobs.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		jobSet.Insert(obj.(*batch_v1_types.Job))
+	}
+	snapshotMap["jobs"] = jobSet.List()
+	cronJobSet := batch_v1_sets.NewCronJobSet()
+	for _, obj := range s.cronJobs.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		cronJobSet.Insert(obj.(*batch_v1_types.CronJob))
+	}
+	snapshotMap["cronJobs"] = cronJobSet.List()
+
+	endpointSliceSet := discovery_k8s_io_v1_sets.NewEndpointSliceSet()
+	for _, obj := range s.endpointSlices.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		endpointSliceSet.Insert(obj.(*discovery_k8s_io_v1_types.EndpointSlice))
+	}
+	snapshotMap["endpointSlices"] = endpointSliceSet.List()
+
+	rolloutSet := argoproj_io_v1alpha1_sets.NewRolloutSet()
+	for _, obj := range s.rollouts.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		rolloutSet.Insert(obj.(*argoproj_io_v1alpha1_types.Rollout))
+	}
+	snapshotMap["rollouts"] = rolloutSet.List()
 	return json.Marshal(snapshotMap)
 }
 
@@ -607,6 +896,13 @@ func (s *snapshotDiscoveryInput) Clone() DiscoveryInputSnapshot {
 		replicaSets:        s.replicaSets.Clone(),
 		daemonSets:         s.daemonSets.Clone(),
 		statefulSets:       s.statefulSets.Clone(),
+		serviceEntries:     s.serviceEntries.Clone(),
+		workloadEntries:    s.workloadEntries.Clone(),
+		workloadGroups:     s.workloadGroups.Clone(),
+		jobs:               s.jobs.Clone(),
+		cronJobs:           s.cronJobs.Clone(),
+		endpointSlices:     s.endpointSlices.Clone(),
+		rollouts:           s.rollouts.Clone(),
 	}
 }
 
@@ -733,6 +1029,73 @@ func (s *snapshotDiscoveryInput) ForEachObject(handleObject func(cluster string,
 		}
 		handleObject(cluster, gvk, obj)
 	}
+
+	for _, obj := range s.serviceEntries.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+	for _, obj := range s.workloadEntries.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadEntry",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+	for _, obj := range s.workloadGroups.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadGroup",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+
+	for _, obj := range s.jobs.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "batch",
+			Version: "v1",
+			Kind:    "Job",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+	for _, obj := range s.cronJobs.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "batch",
+			Version: "v1",
+			Kind:    "CronJob",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+
+	for _, obj := range s.endpointSlices.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "discovery.k8s.io",
+			Version: "v1",
+			Kind:    "EndpointSlice",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+
+	for _, obj := range s.rollouts.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "argoproj.io",
+			Version: "v1alpha1",
+			Kind:    "Rollout",
+		}
+		handleObject(cluster, gvk, obj)
+	}
 }
 
 // builds the input snapshot from API Clients.
@@ -770,6 +1133,24 @@ type DiscoveryInputBuildOptions struct {
 	DaemonSets ResourceDiscoveryInputBuildOptions
 	// List options for composing a snapshot from StatefulSets
 	StatefulSets ResourceDiscoveryInputBuildOptions
+
+	// List options for composing a snapshot from ServiceEntries
+	ServiceEntries ResourceDiscoveryInputBuildOptions
+	// List options for composing a snapshot from WorkloadEntries
+	WorkloadEntries ResourceDiscoveryInputBuildOptions
+	// List options for composing a snapshot from WorkloadGroups
+	WorkloadGroups ResourceDiscoveryInputBuildOptions
+
+	// List options for composing a snapshot from Jobs
+	Jobs ResourceDiscoveryInputBuildOptions
+	// List options for composing a snapshot from CronJobs
+	CronJobs ResourceDiscoveryInputBuildOptions
+
+	// List options for composing a snapshot from EndpointSlices
+	EndpointSlices ResourceDiscoveryInputBuildOptions
+
+	// List options for composing a snapshot from Rollouts
+	Rollouts ResourceDiscoveryInputBuildOptions
 }
 
 // Options for reading resources of a given type
@@ -817,6 +1198,17 @@ func (b *multiClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, n
 	daemonSets := apps_v1_sets.NewDaemonSetSet()
 	statefulSets := apps_v1_sets.NewStatefulSetSet()
 
+	serviceEntries := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+	workloadEntries := networking_istio_io_v1alpha3_sets.NewWorkloadEntrySet()
+	workloadGroups := networking_istio_io_v1alpha3_sets.NewWorkloadGroupSet()
+
+	jobs := batch_v1_sets.NewJobSet()
+	cronJobs := batch_v1_sets.NewCronJobSet()
+
+	endpointSlices := discovery_k8s_io_v1_sets.NewEndpointSliceSet()
+
+	rollouts := argoproj_io_v1alpha1_sets.NewRolloutSet()
+
 	var errs error
 
 	for _, cluster := range b.clusters.ListClusters() {
@@ -857,6 +1249,27 @@ func (b *multiClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, n
 		if err := b.insertStatefulSetsFromCluster(ctx, cluster, statefulSets, opts.StatefulSets); err != nil {
 			errs = multierror.Append(errs, err)
 		}
+		if err := b.insertServiceEntriesFromCluster(ctx, cluster, serviceEntries, opts.ServiceEntries); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertWorkloadEntriesFromCluster(ctx, cluster, workloadEntries, opts.WorkloadEntries); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertWorkloadGroupsFromCluster(ctx, cluster, workloadGroups, opts.WorkloadGroups); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertJobsFromCluster(ctx, cluster, jobs, opts.Jobs); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertCronJobsFromCluster(ctx, cluster, cronJobs, opts.CronJobs); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertEndpointSlicesFromCluster(ctx, cluster, endpointSlices, opts.EndpointSlices); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertRolloutsFromCluster(ctx, cluster, rollouts, opts.Rollouts); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 
 	}
 
@@ -875,6 +1288,13 @@ func (b *multiClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, n
 		replicaSets,
 		daemonSets,
 		statefulSets,
+		serviceEntries,
+		workloadEntries,
+		workloadGroups,
+		jobs,
+		cronJobs,
+		endpointSlices,
+		rollouts,
 	)
 
 	return outputSnap, errs
@@ -1388,52 +1808,361 @@ func (b *multiClusterDiscoveryInputBuilder) insertStatefulSetsFromCluster(ctx co
 	return nil
 }
 
-// build a snapshot from resources in a single cluster
-type singleClusterDiscoveryInputBuilder struct {
-	mgr         manager.Manager
-	clusterName string
-}
+func (b *multiClusterDiscoveryInputBuilder) insertServiceEntriesFromCluster(ctx context.Context, cluster string, serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet, opts ResourceDiscoveryInputBuildOptions) error {
+	serviceEntryClient, err := networking_istio_io_v1alpha3.NewMulticlusterServiceEntryClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
 
-// Produces snapshots of resources read from the manager for the given cluster
-func NewSingleClusterDiscoveryInputBuilder(
-	mgr manager.Manager,
-) DiscoveryInputBuilder {
-	return NewSingleClusterDiscoveryInputBuilderWithClusterName(mgr, "")
-}
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
 
-// Produces snapshots of resources read from the manager for the given cluster.
-// Snapshot resources will be marked with the given ClusterName.
-func NewSingleClusterDiscoveryInputBuilderWithClusterName(
-	mgr manager.Manager,
-	clusterName string,
-) DiscoveryInputBuilder {
-	return &singleClusterDiscoveryInputBuilder{
-		mgr:         mgr,
-		clusterName: clusterName,
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
 	}
-}
 
-func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name string, opts DiscoveryInputBuildOptions) (DiscoveryInputSnapshot, error) {
+	serviceEntryList, err := serviceEntryClient.ListServiceEntry(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
 
-	issuedCertificates := certificates_mesh_gloo_solo_io_v1_sets.NewIssuedCertificateSet()
+	for _, item := range serviceEntryList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		serviceEntries.Insert(item)
+	}
 
-	meshes := appmesh_k8s_aws_v1beta2_sets.NewMeshSet()
+	return nil
+}
+func (b *multiClusterDiscoveryInputBuilder) insertWorkloadEntriesFromCluster(ctx context.Context, cluster string, workloadEntries networking_istio_io_v1alpha3_sets.WorkloadEntrySet, opts ResourceDiscoveryInputBuildOptions) error {
+	workloadEntryClient, err := networking_istio_io_v1alpha3.NewMulticlusterWorkloadEntryClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
 
-	configMaps := v1_sets.NewConfigMapSet()
-	services := v1_sets.NewServiceSet()
-	pods := v1_sets.NewPodSet()
-	endpoints := v1_sets.NewEndpointsSet()
-	namespaces := v1_sets.NewNamespaceSet()
-	nodes := v1_sets.NewNodeSet()
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
 
-	deployments := apps_v1_sets.NewDeploymentSet()
-	replicaSets := apps_v1_sets.NewReplicaSetSet()
-	daemonSets := apps_v1_sets.NewDaemonSetSet()
-	statefulSets := apps_v1_sets.NewStatefulSetSet()
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadEntry",
+		}
 
-	var errs error
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
 
-	if err := b.insertIssuedCertificates(ctx, issuedCertificates, opts.IssuedCertificates); err != nil {
+	workloadEntryList, err := workloadEntryClient.ListWorkloadEntry(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range workloadEntryList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		workloadEntries.Insert(item)
+	}
+
+	return nil
+}
+func (b *multiClusterDiscoveryInputBuilder) insertWorkloadGroupsFromCluster(ctx context.Context, cluster string, workloadGroups networking_istio_io_v1alpha3_sets.WorkloadGroupSet, opts ResourceDiscoveryInputBuildOptions) error {
+	workloadGroupClient, err := networking_istio_io_v1alpha3.NewMulticlusterWorkloadGroupClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadGroup",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	workloadGroupList, err := workloadGroupClient.ListWorkloadGroup(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range workloadGroupList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		workloadGroups.Insert(item)
+	}
+
+	return nil
+}
+
+func (b *multiClusterDiscoveryInputBuilder) insertJobsFromCluster(ctx context.Context, cluster string, jobs batch_v1_sets.JobSet, opts ResourceDiscoveryInputBuildOptions) error {
+	jobClient, err := batch_v1.NewMulticlusterJobClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "batch",
+			Version: "v1",
+			Kind:    "Job",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	jobList, err := jobClient.ListJob(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range jobList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		jobs.Insert(item)
+	}
+
+	return nil
+}
+func (b *multiClusterDiscoveryInputBuilder) insertCronJobsFromCluster(ctx context.Context, cluster string, cronJobs batch_v1_sets.CronJobSet, opts ResourceDiscoveryInputBuildOptions) error {
+	cronJobClient, err := batch_v1.NewMulticlusterCronJobClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "batch",
+			Version: "v1",
+			Kind:    "CronJob",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	cronJobList, err := cronJobClient.ListCronJob(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range cronJobList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		cronJobs.Insert(item)
+	}
+
+	return nil
+}
+
+func (b *multiClusterDiscoveryInputBuilder) insertEndpointSlicesFromCluster(ctx context.Context, cluster string, endpointSlices discovery_k8s_io_v1_sets.EndpointSliceSet, opts ResourceDiscoveryInputBuildOptions) error {
+	endpointSliceClient, err := discovery_k8s_io_v1.NewMulticlusterEndpointSliceClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "discovery.k8s.io",
+			Version: "v1",
+			Kind:    "EndpointSlice",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	endpointSliceList, err := endpointSliceClient.ListEndpointSlice(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range endpointSliceList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		endpointSlices.Insert(item)
+	}
+
+	return nil
+}
+
+func (b *multiClusterDiscoveryInputBuilder) insertRolloutsFromCluster(ctx context.Context, cluster string, rollouts argoproj_io_v1alpha1_sets.RolloutSet, opts ResourceDiscoveryInputBuildOptions) error {
+	rolloutClient, err := argoproj_io_v1alpha1.NewMulticlusterRolloutClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "argoproj.io",
+			Version: "v1alpha1",
+			Kind:    "Rollout",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	rolloutList, err := rolloutClient.ListRollout(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range rolloutList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		rollouts.Insert(item)
+	}
+
+	return nil
+}
+
+// build a snapshot from resources in a single cluster
+type singleClusterDiscoveryInputBuilder struct {
+	mgr         manager.Manager
+	clusterName string
+}
+
+// Produces snapshots of resources read from the manager for the given cluster
+func NewSingleClusterDiscoveryInputBuilder(
+	mgr manager.Manager,
+) DiscoveryInputBuilder {
+	return NewSingleClusterDiscoveryInputBuilderWithClusterName(mgr, "")
+}
+
+// Produces snapshots of resources read from the manager for the given cluster.
+// Snapshot resources will be marked with the given ClusterName.
+func NewSingleClusterDiscoveryInputBuilderWithClusterName(
+	mgr manager.Manager,
+	clusterName string,
+) DiscoveryInputBuilder {
+	return &singleClusterDiscoveryInputBuilder{
+		mgr:         mgr,
+		clusterName: clusterName,
+	}
+}
+
+func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name string, opts DiscoveryInputBuildOptions) (DiscoveryInputSnapshot, error) {
+
+	issuedCertificates := certificates_mesh_gloo_solo_io_v1_sets.NewIssuedCertificateSet()
+
+	meshes := appmesh_k8s_aws_v1beta2_sets.NewMeshSet()
+
+	configMaps := v1_sets.NewConfigMapSet()
+	services := v1_sets.NewServiceSet()
+	pods := v1_sets.NewPodSet()
+	endpoints := v1_sets.NewEndpointsSet()
+	namespaces := v1_sets.NewNamespaceSet()
+	nodes := v1_sets.NewNodeSet()
+
+	deployments := apps_v1_sets.NewDeploymentSet()
+	replicaSets := apps_v1_sets.NewReplicaSetSet()
+	daemonSets := apps_v1_sets.NewDaemonSetSet()
+	statefulSets := apps_v1_sets.NewStatefulSetSet()
+
+	serviceEntries := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+	workloadEntries := networking_istio_io_v1alpha3_sets.NewWorkloadEntrySet()
+	workloadGroups := networking_istio_io_v1alpha3_sets.NewWorkloadGroupSet()
+
+	jobs := batch_v1_sets.NewJobSet()
+	cronJobs := batch_v1_sets.NewCronJobSet()
+
+	endpointSlices := discovery_k8s_io_v1_sets.NewEndpointSliceSet()
+
+	rollouts := argoproj_io_v1alpha1_sets.NewRolloutSet()
+
+	var errs error
+
+	if err := b.insertIssuedCertificates(ctx, issuedCertificates, opts.IssuedCertificates); err != nil {
 		errs = multierror.Append(errs, err)
 	}
 	if err := b.insertMeshes(ctx, meshes, opts.Meshes); err != nil {
@@ -1469,6 +2198,27 @@ func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context,
 	if err := b.insertStatefulSets(ctx, statefulSets, opts.StatefulSets); err != nil {
 		errs = multierror.Append(errs, err)
 	}
+	if err := b.insertServiceEntries(ctx, serviceEntries, opts.ServiceEntries); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertWorkloadEntries(ctx, workloadEntries, opts.WorkloadEntries); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertWorkloadGroups(ctx, workloadGroups, opts.WorkloadGroups); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertJobs(ctx, jobs, opts.Jobs); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertCronJobs(ctx, cronJobs, opts.CronJobs); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertEndpointSlices(ctx, endpointSlices, opts.EndpointSlices); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertRollouts(ctx, rollouts, opts.Rollouts); err != nil {
+		errs = multierror.Append(errs, err)
+	}
 
 	outputSnap := NewDiscoveryInputSnapshot(
 		name,
@@ -1485,6 +2235,13 @@ func (b *singleClusterDiscoveryInputBuilder) BuildSnapshot(ctx context.Context,
 		replicaSets,
 		daemonSets,
 		statefulSets,
+		serviceEntries,
+		workloadEntries,
+		workloadGroups,
+		jobs,
+		cronJobs,
+		endpointSlices,
+		rollouts,
 	)
 
 	return outputSnap, errs
@@ -1544,27 +2301,259 @@ func (b *singleClusterDiscoveryInputBuilder) insertMeshes(ctx context.Context, m
 		}
 	}
 
-	meshList, err := appmesh_k8s_aws_v1beta2.NewMeshClient(b.mgr.GetClient()).ListMesh(ctx, opts.ListOptions...)
+	meshList, err := appmesh_k8s_aws_v1beta2.NewMeshClient(b.mgr.GetClient()).ListMesh(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range meshList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		meshes.Insert(item)
+	}
+
+	return nil
+}
+
+func (b *singleClusterDiscoveryInputBuilder) insertConfigMaps(ctx context.Context, configMaps v1_sets.ConfigMapSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "ConfigMap",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	configMapList, err := v1.NewConfigMapClient(b.mgr.GetClient()).ListConfigMap(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range configMapList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		configMaps.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterDiscoveryInputBuilder) insertServices(ctx context.Context, services v1_sets.ServiceSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Service",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	serviceList, err := v1.NewServiceClient(b.mgr.GetClient()).ListService(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range serviceList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		services.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterDiscoveryInputBuilder) insertPods(ctx context.Context, pods v1_sets.PodSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Pod",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	podList, err := v1.NewPodClient(b.mgr.GetClient()).ListPod(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range podList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		pods.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterDiscoveryInputBuilder) insertEndpoints(ctx context.Context, endpoints v1_sets.EndpointsSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Endpoints",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	endpointsList, err := v1.NewEndpointsClient(b.mgr.GetClient()).ListEndpoints(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range endpointsList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		endpoints.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterDiscoveryInputBuilder) insertNamespaces(ctx context.Context, namespaces v1_sets.NamespaceSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Namespace",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	namespaceList, err := v1.NewNamespaceClient(b.mgr.GetClient()).ListNamespace(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range namespaceList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		namespaces.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterDiscoveryInputBuilder) insertNodes(ctx context.Context, nodes v1_sets.NodeSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "",
+			Version: "v1",
+			Kind:    "Node",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	nodeList, err := v1.NewNodeClient(b.mgr.GetClient()).ListNode(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range nodeList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		nodes.Insert(item)
+	}
+
+	return nil
+}
+
+func (b *singleClusterDiscoveryInputBuilder) insertDeployments(ctx context.Context, deployments apps_v1_sets.DeploymentSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "apps",
+			Version: "v1",
+			Kind:    "Deployment",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	deploymentList, err := apps_v1.NewDeploymentClient(b.mgr.GetClient()).ListDeployment(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range meshList.Items {
+	for _, item := range deploymentList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		meshes.Insert(item)
+		deployments.Insert(item)
 	}
 
 	return nil
 }
-
-func (b *singleClusterDiscoveryInputBuilder) insertConfigMaps(ctx context.Context, configMaps v1_sets.ConfigMapSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertReplicaSets(ctx context.Context, replicaSets apps_v1_sets.ReplicaSetSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "",
+			Group:   "apps",
 			Version: "v1",
-			Kind:    "ConfigMap",
+			Kind:    "ReplicaSet",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1578,26 +2567,26 @@ func (b *singleClusterDiscoveryInputBuilder) insertConfigMaps(ctx context.Contex
 		}
 	}
 
-	configMapList, err := v1.NewConfigMapClient(b.mgr.GetClient()).ListConfigMap(ctx, opts.ListOptions...)
+	replicaSetList, err := apps_v1.NewReplicaSetClient(b.mgr.GetClient()).ListReplicaSet(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range configMapList.Items {
+	for _, item := range replicaSetList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		configMaps.Insert(item)
+		replicaSets.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertServices(ctx context.Context, services v1_sets.ServiceSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertDaemonSets(ctx context.Context, daemonSets apps_v1_sets.DaemonSetSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "",
+			Group:   "apps",
 			Version: "v1",
-			Kind:    "Service",
+			Kind:    "DaemonSet",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1611,26 +2600,26 @@ func (b *singleClusterDiscoveryInputBuilder) insertServices(ctx context.Context,
 		}
 	}
 
-	serviceList, err := v1.NewServiceClient(b.mgr.GetClient()).ListService(ctx, opts.ListOptions...)
+	daemonSetList, err := apps_v1.NewDaemonSetClient(b.mgr.GetClient()).ListDaemonSet(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range serviceList.Items {
+	for _, item := range daemonSetList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		services.Insert(item)
+		daemonSets.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertPods(ctx context.Context, pods v1_sets.PodSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertStatefulSets(ctx context.Context, statefulSets apps_v1_sets.StatefulSetSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "",
+			Group:   "apps",
 			Version: "v1",
-			Kind:    "Pod",
+			Kind:    "StatefulSet",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1644,26 +2633,27 @@ func (b *singleClusterDiscoveryInputBuilder) insertPods(ctx context.Context, pod
 		}
 	}
 
-	podList, err := v1.NewPodClient(b.mgr.GetClient()).ListPod(ctx, opts.ListOptions...)
+	statefulSetList, err := apps_v1.NewStatefulSetClient(b.mgr.GetClient()).ListStatefulSet(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range podList.Items {
+	for _, item := range statefulSetList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		pods.Insert(item)
+		statefulSets.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertEndpoints(ctx context.Context, endpoints v1_sets.EndpointsSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+func (b *singleClusterDiscoveryInputBuilder) insertServiceEntries(ctx context.Context, serviceEntries networking_istio_io_v1alpha3_sets.ServiceEntrySet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "",
-			Version: "v1",
-			Kind:    "Endpoints",
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "ServiceEntry",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1677,26 +2667,26 @@ func (b *singleClusterDiscoveryInputBuilder) insertEndpoints(ctx context.Context
 		}
 	}
 
-	endpointsList, err := v1.NewEndpointsClient(b.mgr.GetClient()).ListEndpoints(ctx, opts.ListOptions...)
+	serviceEntryList, err := networking_istio_io_v1alpha3.NewServiceEntryClient(b.mgr.GetClient()).ListServiceEntry(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range endpointsList.Items {
+	for _, item := range serviceEntryList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		endpoints.Insert(item)
+		serviceEntries.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertNamespaces(ctx context.Context, namespaces v1_sets.NamespaceSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertWorkloadEntries(ctx context.Context, workloadEntries networking_istio_io_v1alpha3_sets.WorkloadEntrySet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "",
-			Version: "v1",
-			Kind:    "Namespace",
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadEntry",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1710,26 +2700,26 @@ func (b *singleClusterDiscoveryInputBuilder) insertNamespaces(ctx context.Contex
 		}
 	}
 
-	namespaceList, err := v1.NewNamespaceClient(b.mgr.GetClient()).ListNamespace(ctx, opts.ListOptions...)
+	workloadEntryList, err := networking_istio_io_v1alpha3.NewWorkloadEntryClient(b.mgr.GetClient()).ListWorkloadEntry(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range namespaceList.Items {
+	for _, item := range workloadEntryList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		namespaces.Insert(item)
+		workloadEntries.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertNodes(ctx context.Context, nodes v1_sets.NodeSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertWorkloadGroups(ctx context.Context, workloadGroups networking_istio_io_v1alpha3_sets.WorkloadGroupSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "",
-			Version: "v1",
-			Kind:    "Node",
+			Group:   "networking.istio.io",
+			Version: "v1alpha3",
+			Kind:    "WorkloadGroup",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1743,27 +2733,27 @@ func (b *singleClusterDiscoveryInputBuilder) insertNodes(ctx context.Context, no
 		}
 	}
 
-	nodeList, err := v1.NewNodeClient(b.mgr.GetClient()).ListNode(ctx, opts.ListOptions...)
+	workloadGroupList, err := networking_istio_io_v1alpha3.NewWorkloadGroupClient(b.mgr.GetClient()).ListWorkloadGroup(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range nodeList.Items {
+	for _, item := range workloadGroupList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		nodes.Insert(item)
+		workloadGroups.Insert(item)
 	}
 
 	return nil
 }
 
-func (b *singleClusterDiscoveryInputBuilder) insertDeployments(ctx context.Context, deployments apps_v1_sets.DeploymentSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertJobs(ctx context.Context, jobs batch_v1_sets.JobSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "apps",
+			Group:   "batch",
 			Version: "v1",
-			Kind:    "Deployment",
+			Kind:    "Job",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1777,26 +2767,26 @@ func (b *singleClusterDiscoveryInputBuilder) insertDeployments(ctx context.Conte
 		}
 	}
 
-	deploymentList, err := apps_v1.NewDeploymentClient(b.mgr.GetClient()).ListDeployment(ctx, opts.ListOptions...)
+	jobList, err := batch_v1.NewJobClient(b.mgr.GetClient()).ListJob(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range deploymentList.Items {
+	for _, item := range jobList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		deployments.Insert(item)
+		jobs.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertReplicaSets(ctx context.Context, replicaSets apps_v1_sets.ReplicaSetSet, opts ResourceDiscoveryInputBuildOptions) error {
+func (b *singleClusterDiscoveryInputBuilder) insertCronJobs(ctx context.Context, cronJobs batch_v1_sets.CronJobSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "apps",
+			Group:   "batch",
 			Version: "v1",
-			Kind:    "ReplicaSet",
+			Kind:    "CronJob",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1810,26 +2800,27 @@ func (b *singleClusterDiscoveryInputBuilder) insertReplicaSets(ctx context.Conte
 		}
 	}
 
-	replicaSetList, err := apps_v1.NewReplicaSetClient(b.mgr.GetClient()).ListReplicaSet(ctx, opts.ListOptions...)
+	cronJobList, err := batch_v1.NewCronJobClient(b.mgr.GetClient()).ListCronJob(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range replicaSetList.Items {
+	for _, item := range cronJobList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		replicaSets.Insert(item)
+		cronJobs.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertDaemonSets(ctx context.Context, daemonSets apps_v1_sets.DaemonSetSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+func (b *singleClusterDiscoveryInputBuilder) insertEndpointSlices(ctx context.Context, endpointSlices discovery_k8s_io_v1_sets.EndpointSliceSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "apps",
+			Group:   "discovery.k8s.io",
 			Version: "v1",
-			Kind:    "DaemonSet",
+			Kind:    "EndpointSlice",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1843,26 +2834,27 @@ func (b *singleClusterDiscoveryInputBuilder) insertDaemonSets(ctx context.Contex
 		}
 	}
 
-	daemonSetList, err := apps_v1.NewDaemonSetClient(b.mgr.GetClient()).ListDaemonSet(ctx, opts.ListOptions...)
+	endpointSliceList, err := discovery_k8s_io_v1.NewEndpointSliceClient(b.mgr.GetClient()).ListEndpointSlice(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range daemonSetList.Items {
+	for _, item := range endpointSliceList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		daemonSets.Insert(item)
+		endpointSlices.Insert(item)
 	}
 
 	return nil
 }
-func (b *singleClusterDiscoveryInputBuilder) insertStatefulSets(ctx context.Context, statefulSets apps_v1_sets.StatefulSetSet, opts ResourceDiscoveryInputBuildOptions) error {
+
+func (b *singleClusterDiscoveryInputBuilder) insertRollouts(ctx context.Context, rollouts argoproj_io_v1alpha1_sets.RolloutSet, opts ResourceDiscoveryInputBuildOptions) error {
 
 	if opts.Verifier != nil {
 		gvk := schema.GroupVersionKind{
-			Group:   "apps",
-			Version: "v1",
-			Kind:    "StatefulSet",
+			Group:   "argoproj.io",
+			Version: "v1alpha1",
+			Kind:    "Rollout",
 		}
 
 		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
@@ -1876,15 +2868,15 @@ func (b *singleClusterDiscoveryInputBuilder) insertStatefulSets(ctx context.Cont
 		}
 	}
 
-	statefulSetList, err := apps_v1.NewStatefulSetClient(b.mgr.GetClient()).ListStatefulSet(ctx, opts.ListOptions...)
+	rolloutList, err := argoproj_io_v1alpha1.NewRolloutClient(b.mgr.GetClient()).ListRollout(ctx, opts.ListOptions...)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range statefulSetList.Items {
+	for _, item := range rolloutList.Items {
 		item := item.DeepCopy() // pike + own the item.
 		item.ClusterName = b.clusterName
-		statefulSets.Insert(item)
+		rollouts.Insert(item)
 	}
 
 	return nil
@@ -1926,6 +2918,17 @@ func (i *inMemoryDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name
 	daemonSets := apps_v1_sets.NewDaemonSetSet()
 	statefulSets := apps_v1_sets.NewStatefulSetSet()
 
+	serviceEntries := networking_istio_io_v1alpha3_sets.NewServiceEntrySet()
+	workloadEntries := networking_istio_io_v1alpha3_sets.NewWorkloadEntrySet()
+	workloadGroups := networking_istio_io_v1alpha3_sets.NewWorkloadGroupSet()
+
+	jobs := batch_v1_sets.NewJobSet()
+	cronJobs := batch_v1_sets.NewCronJobSet()
+
+	endpointSlices := discovery_k8s_io_v1_sets.NewEndpointSliceSet()
+
+	rollouts := argoproj_io_v1alpha1_sets.NewRolloutSet()
+
 	genericSnap.ForEachObject(func(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) {
 		switch obj := obj.(type) {
 		// insert IssuedCertificates
@@ -1964,6 +2967,27 @@ func (i *inMemoryDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name
 		// insert StatefulSets
 		case *apps_v1_types.StatefulSet:
 			i.insertStatefulSet(ctx, obj, statefulSets, opts)
+		// insert ServiceEntries
+		case *networking_istio_io_v1alpha3_types.ServiceEntry:
+			i.insertServiceEntry(ctx, obj, serviceEntries, opts)
+		// insert WorkloadEntries
+		case *networking_istio_io_v1alpha3_types.WorkloadEntry:
+			i.insertWorkloadEntry(ctx, obj, workloadEntries, opts)
+		// insert WorkloadGroups
+		case *networking_istio_io_v1alpha3_types.WorkloadGroup:
+			i.insertWorkloadGroup(ctx, obj, workloadGroups, opts)
+		// insert Jobs
+		case *batch_v1_types.Job:
+			i.insertJob(ctx, obj, jobs, opts)
+		// insert CronJobs
+		case *batch_v1_types.CronJob:
+			i.insertCronJob(ctx, obj, cronJobs, opts)
+		// insert EndpointSlices
+		case *discovery_k8s_io_v1_types.EndpointSlice:
+			i.insertEndpointSlice(ctx, obj, endpointSlices, opts)
+		// insert Rollouts
+		case *argoproj_io_v1alpha1_types.Rollout:
+			i.insertRollout(ctx, obj, rollouts, opts)
 		}
 	})
 
@@ -1982,6 +3006,13 @@ func (i *inMemoryDiscoveryInputBuilder) BuildSnapshot(ctx context.Context, name
 		replicaSets,
 		daemonSets,
 		statefulSets,
+		serviceEntries,
+		workloadEntries,
+		workloadGroups,
+		jobs,
+		cronJobs,
+		endpointSlices,
+		rollouts,
 	), nil
 }
 
@@ -2336,3 +3367,210 @@ func (i *inMemoryDiscoveryInputBuilder) insertStatefulSet(
 		statefulSetSet.Insert(statefulSet)
 	}
 }
+
+func (i *inMemoryDiscoveryInputBuilder) insertServiceEntry(
+	ctx context.Context,
+	serviceEntry *networking_istio_io_v1alpha3_types.ServiceEntry,
+	serviceEntrySet networking_istio_io_v1alpha3_sets.ServiceEntrySet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.ServiceEntries.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = serviceEntry.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(serviceEntry.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		serviceEntrySet.Insert(serviceEntry)
+	}
+}
+func (i *inMemoryDiscoveryInputBuilder) insertWorkloadEntry(
+	ctx context.Context,
+	workloadEntry *networking_istio_io_v1alpha3_types.WorkloadEntry,
+	workloadEntrySet networking_istio_io_v1alpha3_sets.WorkloadEntrySet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.WorkloadEntries.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = workloadEntry.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(workloadEntry.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		workloadEntrySet.Insert(workloadEntry)
+	}
+}
+func (i *inMemoryDiscoveryInputBuilder) insertWorkloadGroup(
+	ctx context.Context,
+	workloadGroup *networking_istio_io_v1alpha3_types.WorkloadGroup,
+	workloadGroupSet networking_istio_io_v1alpha3_sets.WorkloadGroupSet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.WorkloadGroups.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = workloadGroup.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(workloadGroup.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		workloadGroupSet.Insert(workloadGroup)
+	}
+}
+
+func (i *inMemoryDiscoveryInputBuilder) insertJob(
+	ctx context.Context,
+	job *batch_v1_types.Job,
+	jobSet batch_v1_sets.JobSet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.Jobs.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = job.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(job.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		jobSet.Insert(job)
+	}
+}
+func (i *inMemoryDiscoveryInputBuilder) insertCronJob(
+	ctx context.Context,
+	cronJob *batch_v1_types.CronJob,
+	cronJobSet batch_v1_sets.CronJobSet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.CronJobs.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = cronJob.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(cronJob.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		cronJobSet.Insert(cronJob)
+	}
+}
+
+func (i *inMemoryDiscoveryInputBuilder) insertEndpointSlice(
+	ctx context.Context,
+	endpointSlice *discovery_k8s_io_v1_types.EndpointSlice,
+	endpointSliceSet discovery_k8s_io_v1_sets.EndpointSliceSet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.EndpointSlices.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = endpointSlice.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(endpointSlice.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		endpointSliceSet.Insert(endpointSlice)
+	}
+}
+
+func (i *inMemoryDiscoveryInputBuilder) insertRollout(
+	ctx context.Context,
+	rollout *argoproj_io_v1alpha1_types.Rollout,
+	rolloutSet argoproj_io_v1alpha1_sets.RolloutSet,
+	buildOpts DiscoveryInputBuildOptions,
+) {
+
+	opts := buildOpts.Rollouts.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = rollout.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(rollout.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		rolloutSet.Insert(rollout)
+	}
+}
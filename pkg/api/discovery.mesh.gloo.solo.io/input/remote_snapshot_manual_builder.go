@@ -17,6 +17,18 @@ import (
 
 	apps_v1_sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
 	apps_v1 "k8s.io/api/apps/v1"
+
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
+	batch_v1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
+	batch_v1 "k8s.io/api/batch/v1"
+
+	discovery_k8s_io_v1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
+	discovery_k8s_io_v1 "k8s.io/api/discovery/v1"
+
+	argoproj_io_v1alpha1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/sets"
+	argoproj_io_v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 )
 
 type InputDiscoveryInputSnapshotManualBuilder struct {
@@ -37,6 +49,17 @@ type InputDiscoveryInputSnapshotManualBuilder struct {
 	replicaSets  apps_v1_sets.ReplicaSetSet
 	daemonSets   apps_v1_sets.DaemonSetSet
 	statefulSets apps_v1_sets.StatefulSetSet
+
+	serviceEntries  networking_istio_io_v1alpha3_sets.ServiceEntrySet
+	workloadEntries networking_istio_io_v1alpha3_sets.WorkloadEntrySet
+	workloadGroups  networking_istio_io_v1alpha3_sets.WorkloadGroupSet
+
+	jobs     batch_v1_sets.JobSet
+	cronJobs batch_v1_sets.CronJobSet
+
+	endpointSlices discovery_k8s_io_v1_sets.EndpointSliceSet
+
+	rollouts argoproj_io_v1alpha1_sets.RolloutSet
 }
 
 func NewInputDiscoveryInputSnapshotManualBuilder(name string) *InputDiscoveryInputSnapshotManualBuilder {
@@ -58,6 +81,17 @@ func NewInputDiscoveryInputSnapshotManualBuilder(name string) *InputDiscoveryInp
 		replicaSets:  apps_v1_sets.NewReplicaSetSet(),
 		daemonSets:   apps_v1_sets.NewDaemonSetSet(),
 		statefulSets: apps_v1_sets.NewStatefulSetSet(),
+
+		serviceEntries:  networking_istio_io_v1alpha3_sets.NewServiceEntrySet(),
+		workloadEntries: networking_istio_io_v1alpha3_sets.NewWorkloadEntrySet(),
+		workloadGroups:  networking_istio_io_v1alpha3_sets.NewWorkloadGroupSet(),
+
+		jobs:     batch_v1_sets.NewJobSet(),
+		cronJobs: batch_v1_sets.NewCronJobSet(),
+
+		endpointSlices: discovery_k8s_io_v1_sets.NewEndpointSliceSet(),
+
+		rollouts: argoproj_io_v1alpha1_sets.NewRolloutSet(),
 	}
 }
 
@@ -80,6 +114,17 @@ func (i *InputDiscoveryInputSnapshotManualBuilder) Build() DiscoveryInputSnapsho
 		i.replicaSets,
 		i.daemonSets,
 		i.statefulSets,
+
+		i.serviceEntries,
+		i.workloadEntries,
+		i.workloadGroups,
+
+		i.jobs,
+		i.cronJobs,
+
+		i.endpointSlices,
+
+		i.rollouts,
 	)
 }
 func (i *InputDiscoveryInputSnapshotManualBuilder) AddIssuedCertificates(issuedCertificates []*certificates_mesh_gloo_solo_io_v1.IssuedCertificate) *InputDiscoveryInputSnapshotManualBuilder {
@@ -130,3 +175,31 @@ func (i *InputDiscoveryInputSnapshotManualBuilder) AddStatefulSets(statefulSets
 	i.statefulSets.Insert(statefulSets...)
 	return i
 }
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddServiceEntries(serviceEntries []*networking_istio_io_v1alpha3.ServiceEntry) *InputDiscoveryInputSnapshotManualBuilder {
+	i.serviceEntries.Insert(serviceEntries...)
+	return i
+}
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddWorkloadEntries(workloadEntries []*networking_istio_io_v1alpha3.WorkloadEntry) *InputDiscoveryInputSnapshotManualBuilder {
+	i.workloadEntries.Insert(workloadEntries...)
+	return i
+}
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddWorkloadGroups(workloadGroups []*networking_istio_io_v1alpha3.WorkloadGroup) *InputDiscoveryInputSnapshotManualBuilder {
+	i.workloadGroups.Insert(workloadGroups...)
+	return i
+}
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddJobs(jobs []*batch_v1.Job) *InputDiscoveryInputSnapshotManualBuilder {
+	i.jobs.Insert(jobs...)
+	return i
+}
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddCronJobs(cronJobs []*batch_v1.CronJob) *InputDiscoveryInputSnapshotManualBuilder {
+	i.cronJobs.Insert(cronJobs...)
+	return i
+}
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddEndpointSlices(endpointSlices []*discovery_k8s_io_v1.EndpointSlice) *InputDiscoveryInputSnapshotManualBuilder {
+	i.endpointSlices.Insert(endpointSlices...)
+	return i
+}
+func (i *InputDiscoveryInputSnapshotManualBuilder) AddRollouts(rollouts []*argoproj_io_v1alpha1.Rollout) *InputDiscoveryInputSnapshotManualBuilder {
+	i.rollouts.Insert(rollouts...)
+	return i
+}
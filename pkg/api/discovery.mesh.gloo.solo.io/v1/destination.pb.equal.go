@@ -452,6 +452,27 @@ func (m *DestinationSpec_ExternalService) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRef(), target.GetRef()) {
+			return false
+		}
+	}
+
+	if len(m.GetWorkloadSelectorLabels()) != len(target.GetWorkloadSelectorLabels()) {
+		return false
+	}
+	for k, v := range m.GetWorkloadSelectorLabels() {
+
+		if strings.Compare(v, target.GetWorkloadSelectorLabels()[k]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
 
@@ -667,6 +688,10 @@ func (m *DestinationSpec_KubeService_EndpointsSubset) Equal(that interface{}) bo
 
 	}
 
+	if m.GetAddressFamily() != target.GetAddressFamily() {
+		return false
+	}
+
 	return true
 }
 
@@ -756,6 +781,17 @@ func (m *DestinationSpec_KubeService_EndpointsSubset_Endpoint) Equal(that interf
 		}
 	}
 
+	if len(m.GetZoneHints()) != len(target.GetZoneHints()) {
+		return false
+	}
+	for idx, v := range m.GetZoneHints() {
+
+		if strings.Compare(v, target.GetZoneHints()[idx]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
 
@@ -89,6 +89,55 @@ func (DestinationSpec_KubeService_ServiceType) EnumDescriptor() ([]byte, []int)
 	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+// Describes the IP address family of a set of endpoints.
+type DestinationSpec_KubeService_EndpointsSubset_AddressFamily int32
+
+const (
+	// IPv4 addresses.
+	DestinationSpec_KubeService_EndpointsSubset_IPV4 DestinationSpec_KubeService_EndpointsSubset_AddressFamily = 0
+	// IPv6 addresses.
+	DestinationSpec_KubeService_EndpointsSubset_IPV6 DestinationSpec_KubeService_EndpointsSubset_AddressFamily = 1
+)
+
+// Enum value maps for DestinationSpec_KubeService_EndpointsSubset_AddressFamily.
+var (
+	DestinationSpec_KubeService_EndpointsSubset_AddressFamily_name = map[int32]string{
+		0: "IPV4",
+		1: "IPV6",
+	}
+	DestinationSpec_KubeService_EndpointsSubset_AddressFamily_value = map[string]int32{
+		"IPV4": 0,
+		"IPV6": 1,
+	}
+)
+
+func (x DestinationSpec_KubeService_EndpointsSubset_AddressFamily) Enum() *DestinationSpec_KubeService_EndpointsSubset_AddressFamily {
+	p := new(DestinationSpec_KubeService_EndpointsSubset_AddressFamily)
+	*p = x
+	return p
+}
+
+func (x DestinationSpec_KubeService_EndpointsSubset_AddressFamily) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DestinationSpec_KubeService_EndpointsSubset_AddressFamily) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_enumTypes[1].Descriptor()
+}
+
+func (DestinationSpec_KubeService_EndpointsSubset_AddressFamily) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_enumTypes[1]
+}
+
+func (x DestinationSpec_KubeService_EndpointsSubset_AddressFamily) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DestinationSpec_KubeService_EndpointsSubset_AddressFamily.Descriptor instead.
+func (DestinationSpec_KubeService_EndpointsSubset_AddressFamily) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescGZIP(), []int{0, 0, 6, 0}
+}
+
 // The Destination is an abstraction for any entity capable of receiving networking requests.
 type DestinationSpec struct {
 	state         protoimpl.MessageState
@@ -493,7 +542,13 @@ type DestinationSpec_ExternalService struct {
 	Ports []*DestinationSpec_ExternalService_ServicePort `protobuf:"bytes,4,rep,name=ports,proto3" json:"ports,omitempty"`
 	// List of endpoints, to which any requests to this Destionation
 	// will be load balanced across.
+	// For ServiceEntries located outside the mesh (`MESH_EXTERNAL`), endpoints are read from the ServiceEntry's `endpoints`.
 	Endpoints []*DestinationSpec_ExternalService_ExternalEndpoint `protobuf:"bytes,5,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	// Resource reference to the Istio ServiceEntry from which this Destination was discovered, if any.
+	Ref *v1.ClusterObjectRef `protobuf:"bytes,6,opt,name=ref,proto3" json:"ref,omitempty"`
+	// Selectors for the virtual machine Workloads backing this Destination, read from the ServiceEntry's `workloadSelector`.
+	// Endpoints are derived from the instances of the selected Workloads.
+	WorkloadSelectorLabels map[string]string `protobuf:"bytes,7,rep,name=workload_selector_labels,json=workloadSelectorLabels,proto3" json:"workload_selector_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *DestinationSpec_ExternalService) Reset() {
@@ -563,6 +618,20 @@ func (x *DestinationSpec_ExternalService) GetEndpoints() []*DestinationSpec_Exte
 	return nil
 }
 
+func (x *DestinationSpec_ExternalService) GetRef() *v1.ClusterObjectRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *DestinationSpec_ExternalService) GetWorkloadSelectorLabels() map[string]string {
+	if x != nil {
+		return x.WorkloadSelectorLabels
+	}
+	return nil
+}
+
 // Describes the address data for Kubernetes Services exposed to external traffic (i.e. for non ClusterIP type Services).
 type DestinationSpec_KubeService_ExternalAddress struct {
 	state         protoimpl.MessageState
@@ -839,6 +908,8 @@ type DestinationSpec_KubeService_EndpointsSubset struct {
 
 	Endpoints []*DestinationSpec_KubeService_EndpointsSubset_Endpoint `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
 	Ports     []*DestinationSpec_KubeService_EndpointPort             `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	// The IP address family of the endpoints in this subset.
+	AddressFamily DestinationSpec_KubeService_EndpointsSubset_AddressFamily `protobuf:"varint,3,opt,name=address_family,json=addressFamily,proto3,enum=discovery.mesh.gloo.solo.io.DestinationSpec_KubeService_EndpointsSubset_AddressFamily" json:"address_family,omitempty"`
 }
 
 func (x *DestinationSpec_KubeService_EndpointsSubset) Reset() {
@@ -887,6 +958,13 @@ func (x *DestinationSpec_KubeService_EndpointsSubset) GetPorts() []*DestinationS
 	return nil
 }
 
+func (x *DestinationSpec_KubeService_EndpointsSubset) GetAddressFamily() DestinationSpec_KubeService_EndpointsSubset_AddressFamily {
+	if x != nil {
+		return x.AddressFamily
+	}
+	return DestinationSpec_KubeService_EndpointsSubset_IPV4
+}
+
 // Describes the endpoints's ports. See [here](https://kubernetes.io/docs/reference/kubernetes-api/service-resources/endpoints-v1/) for more information.
 type DestinationSpec_KubeService_EndpointPort struct {
 	state         protoimpl.MessageState
@@ -974,6 +1052,8 @@ type DestinationSpec_KubeService_EndpointsSubset_Endpoint struct {
 	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// The zone and sub-zone (if controlled by Istio) of the endpoint.
 	SubLocality *DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality `protobuf:"bytes,3,opt,name=sub_locality,json=subLocality,proto3" json:"sub_locality,omitempty"`
+	// The zones this endpoint should serve traffic for, as hinted by [topology aware routing](https://kubernetes.io/docs/concepts/services-networking/topology-aware-hints/).
+	ZoneHints []string `protobuf:"bytes,4,rep,name=zone_hints,json=zoneHints,proto3" json:"zone_hints,omitempty"`
 }
 
 func (x *DestinationSpec_KubeService_EndpointsSubset_Endpoint) Reset() {
@@ -1029,6 +1109,13 @@ func (x *DestinationSpec_KubeService_EndpointsSubset_Endpoint) GetSubLocality()
 	return nil
 }
 
+func (x *DestinationSpec_KubeService_EndpointsSubset_Endpoint) GetZoneHints() []string {
+	if x != nil {
+		return x.ZoneHints
+	}
+	return nil
+}
+
 // A subdivision of a region representing a set of physically colocated compute resources.
 type DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality struct {
 	state         protoimpl.MessageState
@@ -1103,7 +1190,7 @@ type DestinationSpec_ExternalService_ExternalEndpoint struct {
 func (x *DestinationSpec_ExternalService_ExternalEndpoint) Reset() {
 	*x = DestinationSpec_ExternalService_ExternalEndpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[16]
+		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1116,7 +1203,7 @@ func (x *DestinationSpec_ExternalService_ExternalEndpoint) String() string {
 func (*DestinationSpec_ExternalService_ExternalEndpoint) ProtoMessage() {}
 
 func (x *DestinationSpec_ExternalService_ExternalEndpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[16]
+	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1216,7 @@ func (x *DestinationSpec_ExternalService_ExternalEndpoint) ProtoReflect() protor
 
 // Deprecated: Use DestinationSpec_ExternalService_ExternalEndpoint.ProtoReflect.Descriptor instead.
 func (*DestinationSpec_ExternalService_ExternalEndpoint) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescGZIP(), []int{0, 1, 0}
+	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescGZIP(), []int{0, 1, 1}
 }
 
 func (x *DestinationSpec_ExternalService_ExternalEndpoint) GetAddress() string {
@@ -1164,7 +1251,7 @@ type DestinationSpec_ExternalService_ServicePort struct {
 func (x *DestinationSpec_ExternalService_ServicePort) Reset() {
 	*x = DestinationSpec_ExternalService_ServicePort{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[17]
+		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1177,7 +1264,7 @@ func (x *DestinationSpec_ExternalService_ServicePort) String() string {
 func (*DestinationSpec_ExternalService_ServicePort) ProtoMessage() {}
 
 func (x *DestinationSpec_ExternalService_ServicePort) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[17]
+	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1277,7 @@ func (x *DestinationSpec_ExternalService_ServicePort) ProtoReflect() protoreflec
 
 // Deprecated: Use DestinationSpec_ExternalService_ServicePort.ProtoReflect.Descriptor instead.
 func (*DestinationSpec_ExternalService_ServicePort) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescGZIP(), []int{0, 1, 1}
+	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescGZIP(), []int{0, 1, 2}
 }
 
 func (x *DestinationSpec_ExternalService_ServicePort) GetNumber() uint32 {
@@ -1233,7 +1320,7 @@ type DestinationStatus_AppliedAccessPolicy struct {
 func (x *DestinationStatus_AppliedAccessPolicy) Reset() {
 	*x = DestinationStatus_AppliedAccessPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[19]
+		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1246,7 +1333,7 @@ func (x *DestinationStatus_AppliedAccessPolicy) String() string {
 func (*DestinationStatus_AppliedAccessPolicy) ProtoMessage() {}
 
 func (x *DestinationStatus_AppliedAccessPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[19]
+	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1398,7 @@ type DestinationStatus_AppliedFederation struct {
 func (x *DestinationStatus_AppliedFederation) Reset() {
 	*x = DestinationStatus_AppliedFederation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[20]
+		mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1324,7 +1411,7 @@ func (x *DestinationStatus_AppliedFederation) String() string {
 func (*DestinationStatus_AppliedFederation) ProtoMessage() {}
 
 func (x *DestinationStatus_AppliedFederation) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[20]
+	mi := &file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1409,7 +1496,7 @@ var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDesc
 	0x64, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x63, 0x75,
 	0x65, 0x2f, 0x63, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0xa3, 0x19, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0xfd, 0x1c, 0x0a, 0x0f, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
 	0x70, 0x65, 0x63, 0x12, 0x5d, 0x0a, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x64, 0x69, 0x73, 0x63,
 	0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
@@ -1426,7 +1513,7 @@ var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDesc
 	0x04, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
 	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x1a,
-	0xb2, 0x12, 0x0a, 0x0b, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0xf5, 0x13, 0x0a, 0x0b, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
 	0x35, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
 	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
 	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
@@ -1520,7 +1607,7 @@ var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDesc
 	0x6f, 0x64, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
 	0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x20, 0x0a, 0x06, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74,
 	0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xfe, 0x04, 0x0a, 0x0f, 0x45, 0x6e, 0x64,
+	0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xc1, 0x06, 0x0a, 0x0f, 0x45, 0x6e, 0x64,
 	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x12, 0x6f, 0x0a, 0x09,
 	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x51, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68,
@@ -1534,172 +1621,201 @@ var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDesc
 	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
 	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50,
-	0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x9c, 0x03, 0x0a, 0x08, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x75, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x5d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x70, 0x65, 0x63, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74,
-	0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x80, 0x01,
-	0x0a, 0x0c, 0x73, 0x75, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x5d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70,
-	0x65, 0x63, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x45,
-	0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x69, 0x74, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79,
-	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0b, 0x53,
-	0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x73, 0x75, 0x62, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x73, 0x75, 0x62, 0x5a, 0x6f, 0x6e, 0x65, 0x1a, 0x75, 0x0a, 0x0c, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x21, 0x0a,
-	0x0c, 0x61, 0x70, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x22, 0x52, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x50, 0x10, 0x00, 0x12,
-	0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x01, 0x12, 0x11,
-	0x0a, 0x0d, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x52, 0x10,
-	0x02, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x4e, 0x41,
-	0x4d, 0x45, 0x10, 0x03, 0x1a, 0xd6, 0x04, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x68, 0x6f, 0x73,
-	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x12, 0x5e, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x48, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x12, 0x6b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e,
+	0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x7d, 0x0a, 0x0e, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x56, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x1a, 0xbb, 0x03, 0x0a, 0x08, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x75, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x5d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x70, 0x65, 0x63, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x80, 0x01, 0x0a,
+	0x0c, 0x73, 0x75, 0x62, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x5d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
 	0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65,
-	0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0xd6, 0x01,
-	0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x05,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x58, 0x2e, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x38, 0x0a, 0x0a,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x06, 0x0a,
-	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa5, 0x08, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x18,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f,
-	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x65, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x42, 0x05, 0xea, 0x42, 0x02, 0x10, 0x01, 0x52, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x64, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
-	0x73, 0x12, 0x7a, 0x0a, 0x17, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x42, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d,
+	0x63, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x2e, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x53, 0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x7a, 0x6f, 0x6e, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x39,
+	0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0b, 0x53, 0x75, 0x62,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x75, 0x62, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x34,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x50, 0x56, 0x36, 0x10, 0x01, 0x1a, 0x75, 0x0a, 0x0c,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x22, 0x52, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x49, 0x50,
+	0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43,
+	0x45, 0x52, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x03, 0x1a, 0xed, 0x06, 0x0a, 0x0f, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x5e, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x48, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x46, 0x71, 0x64, 0x6e, 0x12, 0x6f, 0x0a, 0x12,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x65, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a,
-	0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74,
-	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x75,
-	0x62, 0x73, 0x65, 0x74, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53,
-	0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x1a, 0xb9, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e,
-	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x2e,
-	0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x1a, 0xc8, 0x02, 0x0a, 0x11, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x46, 0x65,
-	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x48,
-	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x66, 0x65, 0x64, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x66, 0x52, 0x11, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x4d,
-	0x65, 0x73, 0x68, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x6e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6c, 0x61,
-	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x46, 0x0a, 0x10, 0x76, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x52, 0x65, 0x66,
-	0x12, 0x4b, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x69, 0x6f, 0x2e, 0x54, 0x43, 0x50, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x52,
-	0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x22, 0xfb, 0x01,
-	0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74,
-	0x73, 0x12, 0x4a, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x6b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x35, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
 	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x10, 0x74, 0x72, 0x61,
-	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x12, 0x2e, 0x0a,
-	0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a,
-	0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74,
-	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x68, 0x69, 0x66, 0x74, 0x42, 0x4d, 0x5a, 0x43, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69,
-	0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f,
-	0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0xb8, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x92, 0x01, 0x0a, 0x18, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x58, 0x2e, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x49, 0x0a, 0x1b,
+	0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xd6, 0x01, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x6e, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x58, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x70, 0x65, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x55, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0xa5, 0x08, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x18, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x05, 0xea, 0x42,
+	0x02, 0x10, 0x01, 0x52, 0x16, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x17, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x15, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x46, 0x71, 0x64, 0x6e, 0x12, 0x6f, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x5f, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x52,
+	0x0f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73,
+	0x1a, 0xb9, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2e, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76,
+	0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x1a, 0xc8, 0x02, 0x0a,
+	0x11, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x4c, 0x0a, 0x13, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74,
+	0x6f, 0x5f, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x11, 0x66, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x6f, 0x4d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x46, 0x0a, 0x10, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x6d, 0x65,
+	0x73, 0x68, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x0e, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x52, 0x65, 0x66, 0x12, 0x4b, 0x0a, 0x0d, 0x74, 0x63,
+	0x70, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x43, 0x50,
+	0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65,
+	0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x74,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x10, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x66, 0x12, 0x2e, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x42, 0x4d, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x64, 0x69,
+	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xb8, 0xf5, 0x04, 0x01,
+	0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1714,74 +1830,79 @@ func file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDes
 	return file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_goTypes = []interface{}{
-	(DestinationSpec_KubeService_ServiceType)(0), // 0: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ServiceType
-	(*DestinationSpec)(nil),                      // 1: discovery.mesh.gloo.solo.io.DestinationSpec
-	(*DestinationStatus)(nil),                    // 2: discovery.mesh.gloo.solo.io.DestinationStatus
-	(*RequiredSubsets)(nil),                      // 3: discovery.mesh.gloo.solo.io.RequiredSubsets
-	(*DestinationSpec_KubeService)(nil),          // 4: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService
-	(*DestinationSpec_ExternalService)(nil),      // 5: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService
-	nil,                                          // 6: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.WorkloadSelectorLabelsEntry
-	nil,                                          // 7: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.LabelsEntry
-	nil,                                          // 8: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.SubsetsEntry
-	(*DestinationSpec_KubeService_ExternalAddress)(nil),          // 9: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ExternalAddress
-	(*DestinationSpec_KubeService_KubeServicePort)(nil),          // 10: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.KubeServicePort
-	(*DestinationSpec_KubeService_Subset)(nil),                   // 11: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.Subset
-	(*DestinationSpec_KubeService_EndpointsSubset)(nil),          // 12: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset
-	(*DestinationSpec_KubeService_EndpointPort)(nil),             // 13: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointPort
-	(*DestinationSpec_KubeService_EndpointsSubset_Endpoint)(nil), // 14: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint
-	nil, // 15: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.LabelsEntry
-	(*DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality)(nil), // 16: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.SubLocality
-	(*DestinationSpec_ExternalService_ExternalEndpoint)(nil),                 // 17: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint
-	(*DestinationSpec_ExternalService_ServicePort)(nil),                      // 18: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ServicePort
-	nil, // 19: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint.PortsEntry
-	(*DestinationStatus_AppliedAccessPolicy)(nil),         // 20: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy
-	(*DestinationStatus_AppliedFederation)(nil),           // 21: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation
-	(*v1.ObjectRef)(nil),                                  // 22: core.skv2.solo.io.ObjectRef
-	(*v11.AppliedTrafficPolicy)(nil),                      // 23: networking.mesh.gloo.solo.io.AppliedTrafficPolicy
-	(*v11.TrafficPolicySpec_Policy_MultiDestination)(nil), // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
-	(*v1.ClusterObjectRef)(nil),                           // 25: core.skv2.solo.io.ClusterObjectRef
-	(*v11.AccessPolicySpec)(nil),                          // 26: networking.mesh.gloo.solo.io.AccessPolicySpec
-	(*v12.TCPKeepalive)(nil),                              // 27: common.mesh.gloo.solo.io.TCPKeepalive
+	(DestinationSpec_KubeService_ServiceType)(0),                   // 0: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ServiceType
+	(DestinationSpec_KubeService_EndpointsSubset_AddressFamily)(0), // 1: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.AddressFamily
+	(*DestinationSpec)(nil),                                        // 2: discovery.mesh.gloo.solo.io.DestinationSpec
+	(*DestinationStatus)(nil),                                      // 3: discovery.mesh.gloo.solo.io.DestinationStatus
+	(*RequiredSubsets)(nil),                                        // 4: discovery.mesh.gloo.solo.io.RequiredSubsets
+	(*DestinationSpec_KubeService)(nil),                            // 5: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService
+	(*DestinationSpec_ExternalService)(nil),                        // 6: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService
+	nil,                                                            // 7: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.WorkloadSelectorLabelsEntry
+	nil,                                                            // 8: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.LabelsEntry
+	nil,                                                            // 9: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.SubsetsEntry
+	(*DestinationSpec_KubeService_ExternalAddress)(nil),          // 10: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ExternalAddress
+	(*DestinationSpec_KubeService_KubeServicePort)(nil),          // 11: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.KubeServicePort
+	(*DestinationSpec_KubeService_Subset)(nil),                   // 12: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.Subset
+	(*DestinationSpec_KubeService_EndpointsSubset)(nil),          // 13: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset
+	(*DestinationSpec_KubeService_EndpointPort)(nil),             // 14: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointPort
+	(*DestinationSpec_KubeService_EndpointsSubset_Endpoint)(nil), // 15: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint
+	nil, // 16: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.LabelsEntry
+	(*DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality)(nil), // 17: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.SubLocality
+	nil, // 18: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.WorkloadSelectorLabelsEntry
+	(*DestinationSpec_ExternalService_ExternalEndpoint)(nil), // 19: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint
+	(*DestinationSpec_ExternalService_ServicePort)(nil),      // 20: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ServicePort
+	nil, // 21: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint.PortsEntry
+	(*DestinationStatus_AppliedAccessPolicy)(nil),         // 22: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy
+	(*DestinationStatus_AppliedFederation)(nil),           // 23: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation
+	(*v1.ObjectRef)(nil),                                  // 24: core.skv2.solo.io.ObjectRef
+	(*v11.AppliedTrafficPolicy)(nil),                      // 25: networking.mesh.gloo.solo.io.AppliedTrafficPolicy
+	(*v11.TrafficPolicySpec_Policy_MultiDestination)(nil), // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
+	(*v1.ClusterObjectRef)(nil),                           // 27: core.skv2.solo.io.ClusterObjectRef
+	(*v11.AccessPolicySpec)(nil),                          // 28: networking.mesh.gloo.solo.io.AccessPolicySpec
+	(*v12.TCPKeepalive)(nil),                              // 29: common.mesh.gloo.solo.io.TCPKeepalive
 }
 var file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_depIdxs = []int32{
-	4,  // 0: discovery.mesh.gloo.solo.io.DestinationSpec.kube_service:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService
-	5,  // 1: discovery.mesh.gloo.solo.io.DestinationSpec.external_service:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService
-	22, // 2: discovery.mesh.gloo.solo.io.DestinationSpec.mesh:type_name -> core.skv2.solo.io.ObjectRef
-	23, // 3: discovery.mesh.gloo.solo.io.DestinationStatus.applied_traffic_policies:type_name -> networking.mesh.gloo.solo.io.AppliedTrafficPolicy
-	20, // 4: discovery.mesh.gloo.solo.io.DestinationStatus.applied_access_policies:type_name -> discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy
-	21, // 5: discovery.mesh.gloo.solo.io.DestinationStatus.applied_federation:type_name -> discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation
-	3,  // 6: discovery.mesh.gloo.solo.io.DestinationStatus.required_subsets:type_name -> discovery.mesh.gloo.solo.io.RequiredSubsets
-	22, // 7: discovery.mesh.gloo.solo.io.RequiredSubsets.traffic_policy_ref:type_name -> core.skv2.solo.io.ObjectRef
-	24, // 8: discovery.mesh.gloo.solo.io.RequiredSubsets.traffic_shift:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
-	25, // 9: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ref:type_name -> core.skv2.solo.io.ClusterObjectRef
-	6,  // 10: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.workload_selector_labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.WorkloadSelectorLabelsEntry
-	7,  // 11: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.LabelsEntry
-	10, // 12: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.KubeServicePort
-	8,  // 13: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.subsets:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.SubsetsEntry
-	12, // 14: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.endpoint_subsets:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset
-	9,  // 15: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.external_addresses:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ExternalAddress
+	5,  // 0: discovery.mesh.gloo.solo.io.DestinationSpec.kube_service:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService
+	6,  // 1: discovery.mesh.gloo.solo.io.DestinationSpec.external_service:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService
+	24, // 2: discovery.mesh.gloo.solo.io.DestinationSpec.mesh:type_name -> core.skv2.solo.io.ObjectRef
+	25, // 3: discovery.mesh.gloo.solo.io.DestinationStatus.applied_traffic_policies:type_name -> networking.mesh.gloo.solo.io.AppliedTrafficPolicy
+	22, // 4: discovery.mesh.gloo.solo.io.DestinationStatus.applied_access_policies:type_name -> discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy
+	23, // 5: discovery.mesh.gloo.solo.io.DestinationStatus.applied_federation:type_name -> discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation
+	4,  // 6: discovery.mesh.gloo.solo.io.DestinationStatus.required_subsets:type_name -> discovery.mesh.gloo.solo.io.RequiredSubsets
+	24, // 7: discovery.mesh.gloo.solo.io.RequiredSubsets.traffic_policy_ref:type_name -> core.skv2.solo.io.ObjectRef
+	26, // 8: discovery.mesh.gloo.solo.io.RequiredSubsets.traffic_shift:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
+	27, // 9: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ref:type_name -> core.skv2.solo.io.ClusterObjectRef
+	7,  // 10: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.workload_selector_labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.WorkloadSelectorLabelsEntry
+	8,  // 11: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.LabelsEntry
+	11, // 12: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.KubeServicePort
+	9,  // 13: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.subsets:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.SubsetsEntry
+	13, // 14: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.endpoint_subsets:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset
+	10, // 15: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.external_addresses:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ExternalAddress
 	0,  // 16: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.service_type:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.ServiceType
-	18, // 17: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ServicePort
-	17, // 18: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.endpoints:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint
-	11, // 19: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.SubsetsEntry.value:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.Subset
-	14, // 20: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.endpoints:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint
-	13, // 21: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointPort
-	15, // 22: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.LabelsEntry
-	16, // 23: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.sub_locality:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.SubLocality
-	19, // 24: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint.PortsEntry
-	22, // 25: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy.ref:type_name -> core.skv2.solo.io.ObjectRef
-	26, // 26: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy.spec:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec
-	22, // 27: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation.federated_to_meshes:type_name -> core.skv2.solo.io.ObjectRef
-	22, // 28: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation.virtual_mesh_ref:type_name -> core.skv2.solo.io.ObjectRef
-	27, // 29: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation.tcp_keepalive:type_name -> common.mesh.gloo.solo.io.TCPKeepalive
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	20, // 17: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ServicePort
+	19, // 18: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.endpoints:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint
+	27, // 19: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ref:type_name -> core.skv2.solo.io.ClusterObjectRef
+	18, // 20: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.workload_selector_labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.WorkloadSelectorLabelsEntry
+	12, // 21: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.SubsetsEntry.value:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.Subset
+	15, // 22: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.endpoints:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint
+	14, // 23: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointPort
+	1,  // 24: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.address_family:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.AddressFamily
+	16, // 25: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.labels:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.LabelsEntry
+	17, // 26: discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.sub_locality:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.KubeService.EndpointsSubset.Endpoint.SubLocality
+	21, // 27: discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint.ports:type_name -> discovery.mesh.gloo.solo.io.DestinationSpec.ExternalService.ExternalEndpoint.PortsEntry
+	24, // 28: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy.ref:type_name -> core.skv2.solo.io.ObjectRef
+	28, // 29: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedAccessPolicy.spec:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec
+	24, // 30: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation.federated_to_meshes:type_name -> core.skv2.solo.io.ObjectRef
+	24, // 31: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation.virtual_mesh_ref:type_name -> core.skv2.solo.io.ObjectRef
+	29, // 32: discovery.mesh.gloo.solo.io.DestinationStatus.AppliedFederation.tcp_keepalive:type_name -> common.mesh.gloo.solo.io.TCPKeepalive
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_init() }
@@ -1934,7 +2055,7 @@ func file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_init()
 				return nil
 			}
 		}
-		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DestinationSpec_ExternalService_ExternalEndpoint); i {
 			case 0:
 				return &v.state
@@ -1946,7 +2067,7 @@ func file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_init()
 				return nil
 			}
 		}
-		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DestinationSpec_ExternalService_ServicePort); i {
 			case 0:
 				return &v.state
@@ -1958,7 +2079,7 @@ func file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_init()
 				return nil
 			}
 		}
-		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DestinationStatus_AppliedAccessPolicy); i {
 			case 0:
 				return &v.state
@@ -1970,7 +2091,7 @@ func file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_init()
 				return nil
 			}
 		}
-		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DestinationStatus_AppliedFederation); i {
 			case 0:
 				return &v.state
@@ -2000,8 +2121,8 @@ func file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_init()
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_discovery_v1_destination_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      2,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -570,6 +570,49 @@ func (m *DestinationSpec_ExternalService) Hash(hasher hash.Hash64) (uint64, erro
 
 	}
 
+	if h, ok := interface{}(m.GetRef()).(safe_hasher.SafeHasher); ok {
+		if _, err = hasher.Write([]byte("Ref")); err != nil {
+			return 0, err
+		}
+		if _, err = h.Hash(hasher); err != nil {
+			return 0, err
+		}
+	} else {
+		if fieldValue, err := hashstructure.Hash(m.GetRef(), nil); err != nil {
+			return 0, err
+		} else {
+			if _, err = hasher.Write([]byte("Ref")); err != nil {
+				return 0, err
+			}
+			if err := binary.Write(hasher, binary.LittleEndian, fieldValue); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	{
+		var result uint64
+		innerHash := fnv.New64()
+		for k, v := range m.GetWorkloadSelectorLabels() {
+			innerHash.Reset()
+
+			if _, err = innerHash.Write([]byte(v)); err != nil {
+				return 0, err
+			}
+
+			if _, err = innerHash.Write([]byte(k)); err != nil {
+				return 0, err
+			}
+
+			result = result ^ innerHash.Sum64()
+		}
+		err = binary.Write(hasher, binary.LittleEndian, result)
+		if err != nil {
+			return 0, err
+		}
+
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -745,6 +788,11 @@ func (m *DestinationSpec_KubeService_EndpointsSubset) Hash(hasher hash.Hash64) (
 
 	}
 
+	err = binary.Write(hasher, binary.LittleEndian, m.GetAddressFamily())
+	if err != nil {
+		return 0, err
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -841,6 +889,14 @@ func (m *DestinationSpec_KubeService_EndpointsSubset_Endpoint) Hash(hasher hash.
 		}
 	}
 
+	for _, v := range m.GetZoneHints() {
+
+		if _, err = hasher.Write([]byte(v)); err != nil {
+			return 0, err
+		}
+
+	}
+
 	return hasher.Sum64(), nil
 }
 
@@ -336,6 +336,21 @@ func (m *MeshSpec_Istio) Equal(that interface{}) bool {
 		return false
 	}
 
+	if strings.Compare(m.GetRevision(), target.GetRevision()) != 0 {
+		return false
+	}
+
+	if len(m.GetRevisionTags()) != len(target.GetRevisionTags()) {
+		return false
+	}
+	for idx, v := range m.GetRevisionTags() {
+
+		if strings.Compare(v, target.GetRevisionTags()[idx]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
 
@@ -425,6 +440,14 @@ func (m *MeshSpec_LinkerdMesh) Equal(that interface{}) bool {
 		return false
 	}
 
+	if strings.Compare(m.GetTrustDomain(), target.GetTrustDomain()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetTrustAnchorsPem(), target.GetTrustAnchorsPem()) != 0 {
+		return false
+	}
+
 	return true
 }
 
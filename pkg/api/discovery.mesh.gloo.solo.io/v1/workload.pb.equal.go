@@ -83,6 +83,21 @@ func (m *WorkloadSpec) Equal(that interface{}) bool {
 			}
 		}
 
+	case *WorkloadSpec_VirtualMachine:
+		if _, ok := target.Type.(*WorkloadSpec_VirtualMachine); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetVirtualMachine()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetVirtualMachine()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetVirtualMachine(), target.GetVirtualMachine()) {
+				return false
+			}
+		}
+
 	default:
 		// m is nil but target is not nil
 		if m.Type != target.Type {
@@ -214,6 +229,80 @@ func (m *WorkloadSpec_KubernetesWorkload) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *WorkloadSpec_VirtualMachineWorkload) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*WorkloadSpec_VirtualMachineWorkload)
+	if !ok {
+		that2, ok := that.(WorkloadSpec_VirtualMachineWorkload)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRef(), target.GetRef()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetKind(), target.GetKind()) != 0 {
+		return false
+	}
+
+	if len(m.GetLabels()) != len(target.GetLabels()) {
+		return false
+	}
+	for k, v := range m.GetLabels() {
+
+		if strings.Compare(v, target.GetLabels()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	if strings.Compare(m.GetServiceAccountName(), target.GetServiceAccountName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetNetwork(), target.GetNetwork()) != 0 {
+		return false
+	}
+
+	if len(m.GetInstances()) != len(target.GetInstances()) {
+		return false
+	}
+	for idx, v := range m.GetInstances() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetInstances()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetInstances()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *WorkloadSpec_AppMesh) Equal(that interface{}) bool {
 	if that == nil {
@@ -259,6 +348,59 @@ func (m *WorkloadSpec_AppMesh) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *WorkloadSpec_VirtualMachineWorkload_Instance) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*WorkloadSpec_VirtualMachineWorkload_Instance)
+	if !ok {
+		that2, ok := that.(WorkloadSpec_VirtualMachineWorkload_Instance)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetWorkloadEntry()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetWorkloadEntry()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetWorkloadEntry(), target.GetWorkloadEntry()) {
+			return false
+		}
+	}
+
+	if strings.Compare(m.GetAddress(), target.GetAddress()) != 0 {
+		return false
+	}
+
+	if len(m.GetPorts()) != len(target.GetPorts()) {
+		return false
+	}
+	for k, v := range m.GetPorts() {
+
+		if v != target.GetPorts()[k] {
+			return false
+		}
+
+	}
+
+	if strings.Compare(m.GetLocality(), target.GetLocality()) != 0 {
+		return false
+	}
+
+	return true
+}
+
 // Equal function
 func (m *WorkloadSpec_AppMesh_ContainerPort) Equal(that interface{}) bool {
 	if that == nil {
@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./reconcilers.go
+
+// Package mock_controller is a generated GoMock package.
+package mock_controller
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	controller "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/controller"
+	v1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
+	reconcile "github.com/solo-io/skv2/pkg/reconcile"
+	predicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// MockRolloutReconciler is a mock of RolloutReconciler interface.
+type MockRolloutReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolloutReconcilerMockRecorder
+}
+
+// MockRolloutReconcilerMockRecorder is the mock recorder for MockRolloutReconciler.
+type MockRolloutReconcilerMockRecorder struct {
+	mock *MockRolloutReconciler
+}
+
+// NewMockRolloutReconciler creates a new mock instance.
+func NewMockRolloutReconciler(ctrl *gomock.Controller) *MockRolloutReconciler {
+	mock := &MockRolloutReconciler{ctrl: ctrl}
+	mock.recorder = &MockRolloutReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolloutReconciler) EXPECT() *MockRolloutReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileRollout mocks base method.
+func (m *MockRolloutReconciler) ReconcileRollout(obj *v1alpha1.Rollout) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRollout", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileRollout indicates an expected call of ReconcileRollout.
+func (mr *MockRolloutReconcilerMockRecorder) ReconcileRollout(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRollout", reflect.TypeOf((*MockRolloutReconciler)(nil).ReconcileRollout), obj)
+}
+
+// MockRolloutDeletionReconciler is a mock of RolloutDeletionReconciler interface.
+type MockRolloutDeletionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolloutDeletionReconcilerMockRecorder
+}
+
+// MockRolloutDeletionReconcilerMockRecorder is the mock recorder for MockRolloutDeletionReconciler.
+type MockRolloutDeletionReconcilerMockRecorder struct {
+	mock *MockRolloutDeletionReconciler
+}
+
+// NewMockRolloutDeletionReconciler creates a new mock instance.
+func NewMockRolloutDeletionReconciler(ctrl *gomock.Controller) *MockRolloutDeletionReconciler {
+	mock := &MockRolloutDeletionReconciler{ctrl: ctrl}
+	mock.recorder = &MockRolloutDeletionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolloutDeletionReconciler) EXPECT() *MockRolloutDeletionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileRolloutDeletion mocks base method.
+func (m *MockRolloutDeletionReconciler) ReconcileRolloutDeletion(req reconcile.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRolloutDeletion", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileRolloutDeletion indicates an expected call of ReconcileRolloutDeletion.
+func (mr *MockRolloutDeletionReconcilerMockRecorder) ReconcileRolloutDeletion(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRolloutDeletion", reflect.TypeOf((*MockRolloutDeletionReconciler)(nil).ReconcileRolloutDeletion), req)
+}
+
+// MockRolloutFinalizer is a mock of RolloutFinalizer interface.
+type MockRolloutFinalizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolloutFinalizerMockRecorder
+}
+
+// MockRolloutFinalizerMockRecorder is the mock recorder for MockRolloutFinalizer.
+type MockRolloutFinalizerMockRecorder struct {
+	mock *MockRolloutFinalizer
+}
+
+// NewMockRolloutFinalizer creates a new mock instance.
+func NewMockRolloutFinalizer(ctrl *gomock.Controller) *MockRolloutFinalizer {
+	mock := &MockRolloutFinalizer{ctrl: ctrl}
+	mock.recorder = &MockRolloutFinalizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolloutFinalizer) EXPECT() *MockRolloutFinalizerMockRecorder {
+	return m.recorder
+}
+
+// FinalizeRollout mocks base method.
+func (m *MockRolloutFinalizer) FinalizeRollout(obj *v1alpha1.Rollout) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizeRollout", obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinalizeRollout indicates an expected call of FinalizeRollout.
+func (mr *MockRolloutFinalizerMockRecorder) FinalizeRollout(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizeRollout", reflect.TypeOf((*MockRolloutFinalizer)(nil).FinalizeRollout), obj)
+}
+
+// ReconcileRollout mocks base method.
+func (m *MockRolloutFinalizer) ReconcileRollout(obj *v1alpha1.Rollout) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileRollout", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileRollout indicates an expected call of ReconcileRollout.
+func (mr *MockRolloutFinalizerMockRecorder) ReconcileRollout(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileRollout", reflect.TypeOf((*MockRolloutFinalizer)(nil).ReconcileRollout), obj)
+}
+
+// RolloutFinalizerName mocks base method.
+func (m *MockRolloutFinalizer) RolloutFinalizerName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RolloutFinalizerName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// RolloutFinalizerName indicates an expected call of RolloutFinalizerName.
+func (mr *MockRolloutFinalizerMockRecorder) RolloutFinalizerName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolloutFinalizerName", reflect.TypeOf((*MockRolloutFinalizer)(nil).RolloutFinalizerName))
+}
+
+// MockRolloutReconcileLoop is a mock of RolloutReconcileLoop interface.
+type MockRolloutReconcileLoop struct {
+	ctrl     *gomock.Controller
+	recorder *MockRolloutReconcileLoopMockRecorder
+}
+
+// MockRolloutReconcileLoopMockRecorder is the mock recorder for MockRolloutReconcileLoop.
+type MockRolloutReconcileLoopMockRecorder struct {
+	mock *MockRolloutReconcileLoop
+}
+
+// NewMockRolloutReconcileLoop creates a new mock instance.
+func NewMockRolloutReconcileLoop(ctrl *gomock.Controller) *MockRolloutReconcileLoop {
+	mock := &MockRolloutReconcileLoop{ctrl: ctrl}
+	mock.recorder = &MockRolloutReconcileLoopMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRolloutReconcileLoop) EXPECT() *MockRolloutReconcileLoopMockRecorder {
+	return m.recorder
+}
+
+// RunRolloutReconciler mocks base method.
+func (m *MockRolloutReconcileLoop) RunRolloutReconciler(ctx context.Context, rec controller.RolloutReconciler, predicates ...predicate.Predicate) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, rec}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunRolloutReconciler", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunRolloutReconciler indicates an expected call of RunRolloutReconciler.
+func (mr *MockRolloutReconcileLoopMockRecorder) RunRolloutReconciler(ctx, rec interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, rec}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRolloutReconciler", reflect.TypeOf((*MockRolloutReconcileLoop)(nil).RunRolloutReconciler), varargs...)
+}
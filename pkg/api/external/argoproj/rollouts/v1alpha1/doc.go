@@ -0,0 +1,7 @@
+// Package v1alpha1 contains the subset of the Argo Rollouts argoproj.io/v1alpha1 API read by Gloo Mesh.
+// The types mirror github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1, whose module
+// cannot be imported alongside the Kubernetes versions Gloo Mesh depends on.
+// Fields which are not declared here are ignored when decoding Rollouts.
+// +k8s:deepcopy-gen=package
+// +groupName=argoproj.io
+package v1alpha1
@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Rollout is a replacement for a Deployment which supports progressive delivery, e.g. canary and blue-green updates.
+// Like a Deployment, a Rollout creates its pods through ReplicaSets.
+type Rollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RolloutSpec   `json:"spec"`
+	Status RolloutStatus `json:"status,omitempty"`
+}
+
+// RolloutSpec is the spec for a Rollout resource
+type RolloutSpec struct {
+	// Number of desired pods.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods. Existing ReplicaSets whose pods are
+	// selected by this will be the ones affected by this rollout.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Template describes the pods that will be created.
+	Template corev1.PodTemplateSpec `json:"template"`
+	// RestartAt indicates when all the pods of a Rollout should be restarted
+	RestartAt *metav1.Time `json:"restartAt,omitempty"`
+}
+
+// RolloutStatus is the status for a Rollout resource
+type RolloutStatus struct {
+	// Total number of non-terminated pods targeted by this rollout (their labels match the selector).
+	Replicas int32 `json:"replicas,omitempty"`
+	// Total number of non-terminated pods targeted by this rollout that have the desired template spec.
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// Total number of ready pods targeted by this rollout.
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Total number of available pods (ready for at least minReadySeconds) targeted by this rollout.
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// RestartedAt indicates last time a Rollout was restarted
+	RestartedAt *metav1.Time `json:"restartedAt,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RolloutList is a list of Rollout resources
+type RolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Rollout `json:"items"`
+}
@@ -0,0 +1,246 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./multicluster_reconcilers.go
+
+// Package mock_controller is a generated GoMock package.
+package mock_controller
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	controller "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/controller"
+	reconcile "github.com/solo-io/skv2/pkg/reconcile"
+	v1 "k8s.io/api/batch/v1"
+	predicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// MockMulticlusterJobReconciler is a mock of MulticlusterJobReconciler interface.
+type MockMulticlusterJobReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterJobReconcilerMockRecorder
+}
+
+// MockMulticlusterJobReconcilerMockRecorder is the mock recorder for MockMulticlusterJobReconciler.
+type MockMulticlusterJobReconcilerMockRecorder struct {
+	mock *MockMulticlusterJobReconciler
+}
+
+// NewMockMulticlusterJobReconciler creates a new mock instance.
+func NewMockMulticlusterJobReconciler(ctrl *gomock.Controller) *MockMulticlusterJobReconciler {
+	mock := &MockMulticlusterJobReconciler{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterJobReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterJobReconciler) EXPECT() *MockMulticlusterJobReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileJob mocks base method.
+func (m *MockMulticlusterJobReconciler) ReconcileJob(clusterName string, obj *v1.Job) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileJob", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileJob indicates an expected call of ReconcileJob.
+func (mr *MockMulticlusterJobReconcilerMockRecorder) ReconcileJob(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileJob", reflect.TypeOf((*MockMulticlusterJobReconciler)(nil).ReconcileJob), clusterName, obj)
+}
+
+// MockMulticlusterJobDeletionReconciler is a mock of MulticlusterJobDeletionReconciler interface.
+type MockMulticlusterJobDeletionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterJobDeletionReconcilerMockRecorder
+}
+
+// MockMulticlusterJobDeletionReconcilerMockRecorder is the mock recorder for MockMulticlusterJobDeletionReconciler.
+type MockMulticlusterJobDeletionReconcilerMockRecorder struct {
+	mock *MockMulticlusterJobDeletionReconciler
+}
+
+// NewMockMulticlusterJobDeletionReconciler creates a new mock instance.
+func NewMockMulticlusterJobDeletionReconciler(ctrl *gomock.Controller) *MockMulticlusterJobDeletionReconciler {
+	mock := &MockMulticlusterJobDeletionReconciler{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterJobDeletionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterJobDeletionReconciler) EXPECT() *MockMulticlusterJobDeletionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileJobDeletion mocks base method.
+func (m *MockMulticlusterJobDeletionReconciler) ReconcileJobDeletion(clusterName string, req reconcile.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileJobDeletion", clusterName, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileJobDeletion indicates an expected call of ReconcileJobDeletion.
+func (mr *MockMulticlusterJobDeletionReconcilerMockRecorder) ReconcileJobDeletion(clusterName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileJobDeletion", reflect.TypeOf((*MockMulticlusterJobDeletionReconciler)(nil).ReconcileJobDeletion), clusterName, req)
+}
+
+// MockMulticlusterJobReconcileLoop is a mock of MulticlusterJobReconcileLoop interface.
+type MockMulticlusterJobReconcileLoop struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterJobReconcileLoopMockRecorder
+}
+
+// MockMulticlusterJobReconcileLoopMockRecorder is the mock recorder for MockMulticlusterJobReconcileLoop.
+type MockMulticlusterJobReconcileLoopMockRecorder struct {
+	mock *MockMulticlusterJobReconcileLoop
+}
+
+// NewMockMulticlusterJobReconcileLoop creates a new mock instance.
+func NewMockMulticlusterJobReconcileLoop(ctrl *gomock.Controller) *MockMulticlusterJobReconcileLoop {
+	mock := &MockMulticlusterJobReconcileLoop{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterJobReconcileLoopMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterJobReconcileLoop) EXPECT() *MockMulticlusterJobReconcileLoopMockRecorder {
+	return m.recorder
+}
+
+// AddMulticlusterJobReconciler mocks base method.
+func (m *MockMulticlusterJobReconcileLoop) AddMulticlusterJobReconciler(ctx context.Context, rec controller.MulticlusterJobReconciler, predicates ...predicate.Predicate) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, rec}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddMulticlusterJobReconciler", varargs...)
+}
+
+// AddMulticlusterJobReconciler indicates an expected call of AddMulticlusterJobReconciler.
+func (mr *MockMulticlusterJobReconcileLoopMockRecorder) AddMulticlusterJobReconciler(ctx, rec interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, rec}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMulticlusterJobReconciler", reflect.TypeOf((*MockMulticlusterJobReconcileLoop)(nil).AddMulticlusterJobReconciler), varargs...)
+}
+
+// MockMulticlusterCronJobReconciler is a mock of MulticlusterCronJobReconciler interface.
+type MockMulticlusterCronJobReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterCronJobReconcilerMockRecorder
+}
+
+// MockMulticlusterCronJobReconcilerMockRecorder is the mock recorder for MockMulticlusterCronJobReconciler.
+type MockMulticlusterCronJobReconcilerMockRecorder struct {
+	mock *MockMulticlusterCronJobReconciler
+}
+
+// NewMockMulticlusterCronJobReconciler creates a new mock instance.
+func NewMockMulticlusterCronJobReconciler(ctrl *gomock.Controller) *MockMulticlusterCronJobReconciler {
+	mock := &MockMulticlusterCronJobReconciler{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterCronJobReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterCronJobReconciler) EXPECT() *MockMulticlusterCronJobReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileCronJob mocks base method.
+func (m *MockMulticlusterCronJobReconciler) ReconcileCronJob(clusterName string, obj *v1.CronJob) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileCronJob", clusterName, obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileCronJob indicates an expected call of ReconcileCronJob.
+func (mr *MockMulticlusterCronJobReconcilerMockRecorder) ReconcileCronJob(clusterName, obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileCronJob", reflect.TypeOf((*MockMulticlusterCronJobReconciler)(nil).ReconcileCronJob), clusterName, obj)
+}
+
+// MockMulticlusterCronJobDeletionReconciler is a mock of MulticlusterCronJobDeletionReconciler interface.
+type MockMulticlusterCronJobDeletionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterCronJobDeletionReconcilerMockRecorder
+}
+
+// MockMulticlusterCronJobDeletionReconcilerMockRecorder is the mock recorder for MockMulticlusterCronJobDeletionReconciler.
+type MockMulticlusterCronJobDeletionReconcilerMockRecorder struct {
+	mock *MockMulticlusterCronJobDeletionReconciler
+}
+
+// NewMockMulticlusterCronJobDeletionReconciler creates a new mock instance.
+func NewMockMulticlusterCronJobDeletionReconciler(ctrl *gomock.Controller) *MockMulticlusterCronJobDeletionReconciler {
+	mock := &MockMulticlusterCronJobDeletionReconciler{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterCronJobDeletionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterCronJobDeletionReconciler) EXPECT() *MockMulticlusterCronJobDeletionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileCronJobDeletion mocks base method.
+func (m *MockMulticlusterCronJobDeletionReconciler) ReconcileCronJobDeletion(clusterName string, req reconcile.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileCronJobDeletion", clusterName, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileCronJobDeletion indicates an expected call of ReconcileCronJobDeletion.
+func (mr *MockMulticlusterCronJobDeletionReconcilerMockRecorder) ReconcileCronJobDeletion(clusterName, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileCronJobDeletion", reflect.TypeOf((*MockMulticlusterCronJobDeletionReconciler)(nil).ReconcileCronJobDeletion), clusterName, req)
+}
+
+// MockMulticlusterCronJobReconcileLoop is a mock of MulticlusterCronJobReconcileLoop interface.
+type MockMulticlusterCronJobReconcileLoop struct {
+	ctrl     *gomock.Controller
+	recorder *MockMulticlusterCronJobReconcileLoopMockRecorder
+}
+
+// MockMulticlusterCronJobReconcileLoopMockRecorder is the mock recorder for MockMulticlusterCronJobReconcileLoop.
+type MockMulticlusterCronJobReconcileLoopMockRecorder struct {
+	mock *MockMulticlusterCronJobReconcileLoop
+}
+
+// NewMockMulticlusterCronJobReconcileLoop creates a new mock instance.
+func NewMockMulticlusterCronJobReconcileLoop(ctrl *gomock.Controller) *MockMulticlusterCronJobReconcileLoop {
+	mock := &MockMulticlusterCronJobReconcileLoop{ctrl: ctrl}
+	mock.recorder = &MockMulticlusterCronJobReconcileLoopMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMulticlusterCronJobReconcileLoop) EXPECT() *MockMulticlusterCronJobReconcileLoopMockRecorder {
+	return m.recorder
+}
+
+// AddMulticlusterCronJobReconciler mocks base method.
+func (m *MockMulticlusterCronJobReconcileLoop) AddMulticlusterCronJobReconciler(ctx context.Context, rec controller.MulticlusterCronJobReconciler, predicates ...predicate.Predicate) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, rec}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddMulticlusterCronJobReconciler", varargs...)
+}
+
+// AddMulticlusterCronJobReconciler indicates an expected call of AddMulticlusterCronJobReconciler.
+func (mr *MockMulticlusterCronJobReconcileLoopMockRecorder) AddMulticlusterCronJobReconciler(ctx, rec interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, rec}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMulticlusterCronJobReconciler", reflect.TypeOf((*MockMulticlusterCronJobReconcileLoop)(nil).AddMulticlusterCronJobReconciler), varargs...)
+}
@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./reconcilers.go
+
+// Package mock_controller is a generated GoMock package.
+package mock_controller
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	controller "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/controller"
+	reconcile "github.com/solo-io/skv2/pkg/reconcile"
+	v1 "k8s.io/api/discovery/v1"
+	predicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// MockEndpointSliceReconciler is a mock of EndpointSliceReconciler interface.
+type MockEndpointSliceReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointSliceReconcilerMockRecorder
+}
+
+// MockEndpointSliceReconcilerMockRecorder is the mock recorder for MockEndpointSliceReconciler.
+type MockEndpointSliceReconcilerMockRecorder struct {
+	mock *MockEndpointSliceReconciler
+}
+
+// NewMockEndpointSliceReconciler creates a new mock instance.
+func NewMockEndpointSliceReconciler(ctrl *gomock.Controller) *MockEndpointSliceReconciler {
+	mock := &MockEndpointSliceReconciler{ctrl: ctrl}
+	mock.recorder = &MockEndpointSliceReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointSliceReconciler) EXPECT() *MockEndpointSliceReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileEndpointSlice mocks base method.
+func (m *MockEndpointSliceReconciler) ReconcileEndpointSlice(obj *v1.EndpointSlice) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEndpointSlice", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEndpointSlice indicates an expected call of ReconcileEndpointSlice.
+func (mr *MockEndpointSliceReconcilerMockRecorder) ReconcileEndpointSlice(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEndpointSlice", reflect.TypeOf((*MockEndpointSliceReconciler)(nil).ReconcileEndpointSlice), obj)
+}
+
+// MockEndpointSliceDeletionReconciler is a mock of EndpointSliceDeletionReconciler interface.
+type MockEndpointSliceDeletionReconciler struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointSliceDeletionReconcilerMockRecorder
+}
+
+// MockEndpointSliceDeletionReconcilerMockRecorder is the mock recorder for MockEndpointSliceDeletionReconciler.
+type MockEndpointSliceDeletionReconcilerMockRecorder struct {
+	mock *MockEndpointSliceDeletionReconciler
+}
+
+// NewMockEndpointSliceDeletionReconciler creates a new mock instance.
+func NewMockEndpointSliceDeletionReconciler(ctrl *gomock.Controller) *MockEndpointSliceDeletionReconciler {
+	mock := &MockEndpointSliceDeletionReconciler{ctrl: ctrl}
+	mock.recorder = &MockEndpointSliceDeletionReconcilerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointSliceDeletionReconciler) EXPECT() *MockEndpointSliceDeletionReconcilerMockRecorder {
+	return m.recorder
+}
+
+// ReconcileEndpointSliceDeletion mocks base method.
+func (m *MockEndpointSliceDeletionReconciler) ReconcileEndpointSliceDeletion(req reconcile.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEndpointSliceDeletion", req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileEndpointSliceDeletion indicates an expected call of ReconcileEndpointSliceDeletion.
+func (mr *MockEndpointSliceDeletionReconcilerMockRecorder) ReconcileEndpointSliceDeletion(req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEndpointSliceDeletion", reflect.TypeOf((*MockEndpointSliceDeletionReconciler)(nil).ReconcileEndpointSliceDeletion), req)
+}
+
+// MockEndpointSliceFinalizer is a mock of EndpointSliceFinalizer interface.
+type MockEndpointSliceFinalizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointSliceFinalizerMockRecorder
+}
+
+// MockEndpointSliceFinalizerMockRecorder is the mock recorder for MockEndpointSliceFinalizer.
+type MockEndpointSliceFinalizerMockRecorder struct {
+	mock *MockEndpointSliceFinalizer
+}
+
+// NewMockEndpointSliceFinalizer creates a new mock instance.
+func NewMockEndpointSliceFinalizer(ctrl *gomock.Controller) *MockEndpointSliceFinalizer {
+	mock := &MockEndpointSliceFinalizer{ctrl: ctrl}
+	mock.recorder = &MockEndpointSliceFinalizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointSliceFinalizer) EXPECT() *MockEndpointSliceFinalizerMockRecorder {
+	return m.recorder
+}
+
+// EndpointSliceFinalizerName mocks base method.
+func (m *MockEndpointSliceFinalizer) EndpointSliceFinalizerName() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndpointSliceFinalizerName")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// EndpointSliceFinalizerName indicates an expected call of EndpointSliceFinalizerName.
+func (mr *MockEndpointSliceFinalizerMockRecorder) EndpointSliceFinalizerName() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndpointSliceFinalizerName", reflect.TypeOf((*MockEndpointSliceFinalizer)(nil).EndpointSliceFinalizerName))
+}
+
+// FinalizeEndpointSlice mocks base method.
+func (m *MockEndpointSliceFinalizer) FinalizeEndpointSlice(obj *v1.EndpointSlice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalizeEndpointSlice", obj)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinalizeEndpointSlice indicates an expected call of FinalizeEndpointSlice.
+func (mr *MockEndpointSliceFinalizerMockRecorder) FinalizeEndpointSlice(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalizeEndpointSlice", reflect.TypeOf((*MockEndpointSliceFinalizer)(nil).FinalizeEndpointSlice), obj)
+}
+
+// ReconcileEndpointSlice mocks base method.
+func (m *MockEndpointSliceFinalizer) ReconcileEndpointSlice(obj *v1.EndpointSlice) (reconcile.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileEndpointSlice", obj)
+	ret0, _ := ret[0].(reconcile.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileEndpointSlice indicates an expected call of ReconcileEndpointSlice.
+func (mr *MockEndpointSliceFinalizerMockRecorder) ReconcileEndpointSlice(obj interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileEndpointSlice", reflect.TypeOf((*MockEndpointSliceFinalizer)(nil).ReconcileEndpointSlice), obj)
+}
+
+// MockEndpointSliceReconcileLoop is a mock of EndpointSliceReconcileLoop interface.
+type MockEndpointSliceReconcileLoop struct {
+	ctrl     *gomock.Controller
+	recorder *MockEndpointSliceReconcileLoopMockRecorder
+}
+
+// MockEndpointSliceReconcileLoopMockRecorder is the mock recorder for MockEndpointSliceReconcileLoop.
+type MockEndpointSliceReconcileLoopMockRecorder struct {
+	mock *MockEndpointSliceReconcileLoop
+}
+
+// NewMockEndpointSliceReconcileLoop creates a new mock instance.
+func NewMockEndpointSliceReconcileLoop(ctrl *gomock.Controller) *MockEndpointSliceReconcileLoop {
+	mock := &MockEndpointSliceReconcileLoop{ctrl: ctrl}
+	mock.recorder = &MockEndpointSliceReconcileLoopMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEndpointSliceReconcileLoop) EXPECT() *MockEndpointSliceReconcileLoopMockRecorder {
+	return m.recorder
+}
+
+// RunEndpointSliceReconciler mocks base method.
+func (m *MockEndpointSliceReconcileLoop) RunEndpointSliceReconciler(ctx context.Context, rec controller.EndpointSliceReconciler, predicates ...predicate.Predicate) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, rec}
+	for _, a := range predicates {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunEndpointSliceReconciler", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunEndpointSliceReconciler indicates an expected call of RunEndpointSliceReconciler.
+func (mr *MockEndpointSliceReconcileLoopMockRecorder) RunEndpointSliceReconciler(ctx, rec interface{}, predicates ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, rec}, predicates...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunEndpointSliceReconciler", reflect.TypeOf((*MockEndpointSliceReconcileLoop)(nil).RunEndpointSliceReconciler), varargs...)
+}
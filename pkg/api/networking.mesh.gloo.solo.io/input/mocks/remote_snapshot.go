@@ -9,9 +9,9 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
-	v1beta1sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	v1beta1sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/security.istio.io/v1beta1/sets"
 	input "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	v1beta1sets0 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 	multicluster "github.com/solo-io/skv2/pkg/multicluster"
@@ -197,6 +197,20 @@ func (mr *MockRemoteSnapshotMockRecorder) RateLimitConfigs() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RateLimitConfigs", reflect.TypeOf((*MockRemoteSnapshot)(nil).RateLimitConfigs))
 }
 
+// RequestAuthentications mocks base method.
+func (m *MockRemoteSnapshot) RequestAuthentications() v1beta1sets.RequestAuthenticationSet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAuthentications")
+	ret0, _ := ret[0].(v1beta1sets.RequestAuthenticationSet)
+	return ret0
+}
+
+// RequestAuthentications indicates an expected call of RequestAuthentications.
+func (mr *MockRemoteSnapshotMockRecorder) RequestAuthentications() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAuthentications", reflect.TypeOf((*MockRemoteSnapshot)(nil).RequestAuthentications))
+}
+
 // ServiceEntries mocks base method.
 func (m *MockRemoteSnapshot) ServiceEntries() v1alpha3sets.ServiceEntrySet {
 	m.ctrl.T.Helper()
@@ -11,13 +11,13 @@ import (
 	"github.com/solo-io/skv2/pkg/multicluster"
 	"github.com/solo-io/skv2/pkg/reconcile"
 
-	networking_istio_io_v1alpha3_controllers "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/controller"
-	security_istio_io_v1beta1_controllers "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/controller"
 	v1_controllers "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/controller"
 	certificates_mesh_gloo_solo_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	certificates_mesh_gloo_solo_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/controller"
 	discovery_mesh_gloo_solo_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discovery_mesh_gloo_solo_io_v1_controllers "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/controller"
+	networking_istio_io_v1alpha3_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/controller"
+	security_istio_io_v1beta1_controllers "github.com/solo-io/gloo-mesh/pkg/api/external/istio/security.istio.io/v1beta1/controller"
 	networking_enterprise_mesh_gloo_solo_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
 	networking_enterprise_mesh_gloo_solo_io_v1beta1_controllers "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1/controller"
 	networking_mesh_gloo_solo_io_v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
@@ -44,13 +44,14 @@ import (
 // * IssuedCertificates
 // * PodBounceDirectives
 // * XdsConfigs
+// * AuthorizationPolicies
+// * RequestAuthentications
 // * DestinationRules
 // * EnvoyFilters
 // * Gateways
 // * ServiceEntries
 // * VirtualServices
 // * Sidecars
-// * AuthorizationPolicies
 // * RateLimitConfigs
 // from a remote cluster.
 // * WasmDeployments
@@ -93,7 +94,7 @@ func RegisterInputReconciler(
 	singleClusterReconcileFunc input.SingleClusterReconcileFunc,
 	options ReconcileOptions,
 ) (input.InputReconciler, error) {
-	// [certificates.mesh.gloo.solo.io/v1 xds.agent.enterprise.mesh.gloo.solo.io/v1beta1 networking.istio.io/v1alpha3 security.istio.io/v1beta1 ratelimit.solo.io/v1alpha1] false 5
+	// [certificates.mesh.gloo.solo.io/v1 xds.agent.enterprise.mesh.gloo.solo.io/v1beta1 security.istio.io/v1beta1 networking.istio.io/v1alpha3 ratelimit.solo.io/v1alpha1] false 5
 	// [networking.enterprise.mesh.gloo.solo.io/v1beta1 networking.mesh.gloo.solo.io/v1 settings.mesh.gloo.solo.io/v1 discovery.mesh.gloo.solo.io/v1 observability.enterprise.mesh.gloo.solo.io/v1 v1 multicluster.solo.io/v1alpha1]
 
 	base := input.NewInputReconciler(
@@ -113,6 +114,11 @@ func RegisterInputReconciler(
 	// initialize XdsConfigs reconcile loop for remote clusters
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_controllers.NewMulticlusterXdsConfigReconcileLoop("XdsConfig", clusters, options.Remote.XdsConfigs).AddMulticlusterXdsConfigReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 
+	// initialize AuthorizationPolicies reconcile loop for remote clusters
+	security_istio_io_v1beta1_controllers.NewMulticlusterAuthorizationPolicyReconcileLoop("AuthorizationPolicy", clusters, options.Remote.AuthorizationPolicies).AddMulticlusterAuthorizationPolicyReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+	// initialize RequestAuthentications reconcile loop for remote clusters
+	security_istio_io_v1beta1_controllers.NewMulticlusterRequestAuthenticationReconcileLoop("RequestAuthentication", clusters, options.Remote.RequestAuthentications).AddMulticlusterRequestAuthenticationReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
+
 	// initialize DestinationRules reconcile loop for remote clusters
 	networking_istio_io_v1alpha3_controllers.NewMulticlusterDestinationRuleReconcileLoop("DestinationRule", clusters, options.Remote.DestinationRules).AddMulticlusterDestinationRuleReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 	// initialize EnvoyFilters reconcile loop for remote clusters
@@ -126,9 +132,6 @@ func RegisterInputReconciler(
 	// initialize Sidecars reconcile loop for remote clusters
 	networking_istio_io_v1alpha3_controllers.NewMulticlusterSidecarReconcileLoop("Sidecar", clusters, options.Remote.Sidecars).AddMulticlusterSidecarReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 
-	// initialize AuthorizationPolicies reconcile loop for remote clusters
-	security_istio_io_v1beta1_controllers.NewMulticlusterAuthorizationPolicyReconcileLoop("AuthorizationPolicy", clusters, options.Remote.AuthorizationPolicies).AddMulticlusterAuthorizationPolicyReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
-
 	// initialize RateLimitConfigs reconcile loop for remote clusters
 	ratelimit_solo_io_v1alpha1_controllers.NewMulticlusterRateLimitConfigReconcileLoop("RateLimitConfig", clusters, options.Remote.RateLimitConfigs).AddMulticlusterRateLimitConfigReconciler(ctx, &remoteInputReconciler{base: base}, options.Remote.Predicates...)
 
@@ -229,6 +232,11 @@ type RemoteReconcileOptions struct {
 	// Options for reconciling XdsConfigs
 	XdsConfigs reconcile.Options
 
+	// Options for reconciling AuthorizationPolicies
+	AuthorizationPolicies reconcile.Options
+	// Options for reconciling RequestAuthentications
+	RequestAuthentications reconcile.Options
+
 	// Options for reconciling DestinationRules
 	DestinationRules reconcile.Options
 	// Options for reconciling EnvoyFilters
@@ -242,9 +250,6 @@ type RemoteReconcileOptions struct {
 	// Options for reconciling Sidecars
 	Sidecars reconcile.Options
 
-	// Options for reconciling AuthorizationPolicies
-	AuthorizationPolicies reconcile.Options
-
 	// Options for reconciling RateLimitConfigs
 	RateLimitConfigs reconcile.Options
 
@@ -301,12 +306,12 @@ func (r *remoteInputReconciler) ReconcileXdsConfigDeletion(clusterName string, o
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileDestinationRule(clusterName string, obj *networking_istio_io_v1alpha3.DestinationRule) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileAuthorizationPolicy(clusterName string, obj *security_istio_io_v1beta1.AuthorizationPolicy) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileDestinationRuleDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileAuthorizationPolicyDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -316,12 +321,12 @@ func (r *remoteInputReconciler) ReconcileDestinationRuleDeletion(clusterName str
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileEnvoyFilter(clusterName string, obj *networking_istio_io_v1alpha3.EnvoyFilter) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileRequestAuthentication(clusterName string, obj *security_istio_io_v1beta1.RequestAuthentication) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileEnvoyFilterDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileRequestAuthenticationDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -331,12 +336,12 @@ func (r *remoteInputReconciler) ReconcileEnvoyFilterDeletion(clusterName string,
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileGateway(clusterName string, obj *networking_istio_io_v1alpha3.Gateway) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileDestinationRule(clusterName string, obj *networking_istio_io_v1alpha3.DestinationRule) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileGatewayDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileDestinationRuleDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -346,12 +351,12 @@ func (r *remoteInputReconciler) ReconcileGatewayDeletion(clusterName string, obj
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileServiceEntry(clusterName string, obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileEnvoyFilter(clusterName string, obj *networking_istio_io_v1alpha3.EnvoyFilter) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileServiceEntryDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileEnvoyFilterDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -361,12 +366,12 @@ func (r *remoteInputReconciler) ReconcileServiceEntryDeletion(clusterName string
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileVirtualService(clusterName string, obj *networking_istio_io_v1alpha3.VirtualService) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileGateway(clusterName string, obj *networking_istio_io_v1alpha3.Gateway) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileVirtualServiceDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileGatewayDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -376,12 +381,12 @@ func (r *remoteInputReconciler) ReconcileVirtualServiceDeletion(clusterName stri
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileSidecar(clusterName string, obj *networking_istio_io_v1alpha3.Sidecar) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileServiceEntry(clusterName string, obj *networking_istio_io_v1alpha3.ServiceEntry) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileSidecarDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileServiceEntryDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -391,12 +396,27 @@ func (r *remoteInputReconciler) ReconcileSidecarDeletion(clusterName string, obj
 	return err
 }
 
-func (r *remoteInputReconciler) ReconcileAuthorizationPolicy(clusterName string, obj *security_istio_io_v1beta1.AuthorizationPolicy) (reconcile.Result, error) {
+func (r *remoteInputReconciler) ReconcileVirtualService(clusterName string, obj *networking_istio_io_v1alpha3.VirtualService) (reconcile.Result, error) {
 	obj.ClusterName = clusterName
 	return r.base.ReconcileRemoteGeneric(obj)
 }
 
-func (r *remoteInputReconciler) ReconcileAuthorizationPolicyDeletion(clusterName string, obj reconcile.Request) error {
+func (r *remoteInputReconciler) ReconcileVirtualServiceDeletion(clusterName string, obj reconcile.Request) error {
+	ref := &sk_core_v1.ClusterObjectRef{
+		Name:        obj.Name,
+		Namespace:   obj.Namespace,
+		ClusterName: clusterName,
+	}
+	_, err := r.base.ReconcileRemoteGeneric(ref)
+	return err
+}
+
+func (r *remoteInputReconciler) ReconcileSidecar(clusterName string, obj *networking_istio_io_v1alpha3.Sidecar) (reconcile.Result, error) {
+	obj.ClusterName = clusterName
+	return r.base.ReconcileRemoteGeneric(obj)
+}
+
+func (r *remoteInputReconciler) ReconcileSidecarDeletion(clusterName string, obj reconcile.Request) error {
 	ref := &sk_core_v1.ClusterObjectRef{
 		Name:        obj.Name,
 		Namespace:   obj.Namespace,
@@ -6,13 +6,14 @@
 // * IssuedCertificates
 // * PodBounceDirectives
 // * XdsConfigs
+// * AuthorizationPolicies
+// * RequestAuthentications
 // * DestinationRules
 // * EnvoyFilters
 // * Gateways
 // * ServiceEntries
 // * VirtualServices
 // * Sidecars
-// * AuthorizationPolicies
 // * RateLimitConfigs
 // read from a given cluster or set of clusters, across all namespaces.
 //
@@ -51,14 +52,14 @@ import (
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_types "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 
-	networking_istio_io_v1alpha3 "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3"
-	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
-	networking_istio_io_v1alpha3_types "istio.io/client-go/pkg/apis/networking/v1alpha3"
-
-	security_istio_io_v1beta1 "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1"
-	security_istio_io_v1beta1_sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
+	security_istio_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/external/istio/security.istio.io/v1beta1"
+	security_istio_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/security.istio.io/v1beta1/sets"
 	security_istio_io_v1beta1_types "istio.io/client-go/pkg/apis/security/v1beta1"
 
+	networking_istio_io_v1alpha3 "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3"
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3_types "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
 	ratelimit_solo_io_v1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	ratelimit_solo_io_v1alpha1_types "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	ratelimit_solo_io_v1alpha1_sets "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1/sets"
@@ -81,6 +82,16 @@ var RemoteSnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1beta1",
 		Kind:    "XdsConfig",
 	},
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "AuthorizationPolicy",
+	},
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "RequestAuthentication",
+	},
 	schema.GroupVersionKind{
 		Group:   "networking.istio.io",
 		Version: "v1alpha3",
@@ -111,11 +122,6 @@ var RemoteSnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1alpha3",
 		Kind:    "Sidecar",
 	},
-	schema.GroupVersionKind{
-		Group:   "security.istio.io",
-		Version: "v1beta1",
-		Kind:    "AuthorizationPolicy",
-	},
 	schema.GroupVersionKind{
 		Group:   "ratelimit.solo.io",
 		Version: "v1alpha1",
@@ -134,6 +140,11 @@ type RemoteSnapshot interface {
 	// return the set of input XdsConfigs
 	XdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	// return the set of input AuthorizationPolicies
+	AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	// return the set of input RequestAuthentications
+	RequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	// return the set of input DestinationRules
 	DestinationRules() networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	// return the set of input EnvoyFilters
@@ -147,9 +158,6 @@ type RemoteSnapshot interface {
 	// return the set of input Sidecars
 	Sidecars() networking_istio_io_v1alpha3_sets.SidecarSet
 
-	// return the set of input AuthorizationPolicies
-	AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
 	// return the set of input RateLimitConfigs
 	RateLimitConfigs() ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet
 	// update the status of all input objects which support
@@ -182,6 +190,11 @@ type RemoteSyncStatusOptions struct {
 	// sync status of XdsConfig objects
 	XdsConfig bool
 
+	// sync status of AuthorizationPolicy objects
+	AuthorizationPolicy bool
+	// sync status of RequestAuthentication objects
+	RequestAuthentication bool
+
 	// sync status of DestinationRule objects
 	DestinationRule bool
 	// sync status of EnvoyFilter objects
@@ -195,9 +208,6 @@ type RemoteSyncStatusOptions struct {
 	// sync status of Sidecar objects
 	Sidecar bool
 
-	// sync status of AuthorizationPolicy objects
-	AuthorizationPolicy bool
-
 	// sync status of RateLimitConfig objects
 	RateLimitConfig bool
 }
@@ -210,6 +220,9 @@ type snapshotRemote struct {
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	authorizationPolicies  security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	envoyFilters     networking_istio_io_v1alpha3_sets.EnvoyFilterSet
 	gateways         networking_istio_io_v1alpha3_sets.GatewaySet
@@ -217,8 +230,6 @@ type snapshotRemote struct {
 	virtualServices  networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	sidecars         networking_istio_io_v1alpha3_sets.SidecarSet
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
 	rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet
 }
 
@@ -230,6 +241,9 @@ func NewRemoteSnapshot(
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet,
 
+	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet,
 	envoyFilters networking_istio_io_v1alpha3_sets.EnvoyFilterSet,
 	gateways networking_istio_io_v1alpha3_sets.GatewaySet,
@@ -237,25 +251,24 @@ func NewRemoteSnapshot(
 	virtualServices networking_istio_io_v1alpha3_sets.VirtualServiceSet,
 	sidecars networking_istio_io_v1alpha3_sets.SidecarSet,
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
-
 	rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet,
 
 ) RemoteSnapshot {
 	return &snapshotRemote{
 		name: name,
 
-		issuedCertificates:    issuedCertificates,
-		podBounceDirectives:   podBounceDirectives,
-		xdsConfigs:            xdsConfigs,
-		destinationRules:      destinationRules,
-		envoyFilters:          envoyFilters,
-		gateways:              gateways,
-		serviceEntries:        serviceEntries,
-		virtualServices:       virtualServices,
-		sidecars:              sidecars,
-		authorizationPolicies: authorizationPolicies,
-		rateLimitConfigs:      rateLimitConfigs,
+		issuedCertificates:     issuedCertificates,
+		podBounceDirectives:    podBounceDirectives,
+		xdsConfigs:             xdsConfigs,
+		authorizationPolicies:  authorizationPolicies,
+		requestAuthentications: requestAuthentications,
+		destinationRules:       destinationRules,
+		envoyFilters:           envoyFilters,
+		gateways:               gateways,
+		serviceEntries:         serviceEntries,
+		virtualServices:        virtualServices,
+		sidecars:               sidecars,
+		rateLimitConfigs:       rateLimitConfigs,
 	}
 }
 
@@ -269,6 +282,9 @@ func NewRemoteSnapshotFromGeneric(
 
 	xdsConfigSet := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthenticationSet := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRuleSet := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilterSet := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gatewaySet := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -276,8 +292,6 @@ func NewRemoteSnapshotFromGeneric(
 	virtualServiceSet := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecarSet := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	rateLimitConfigSet := ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet()
 
 	for _, snapshot := range genericSnapshot {
@@ -311,6 +325,25 @@ func NewRemoteSnapshotFromGeneric(
 			xdsConfigSet.Insert(xdsConfig.(*xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_types.XdsConfig))
 		}
 
+		authorizationPolicies := snapshot[schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}]
+
+		for _, authorizationPolicy := range authorizationPolicies {
+			authorizationPolicySet.Insert(authorizationPolicy.(*security_istio_io_v1beta1_types.AuthorizationPolicy))
+		}
+		requestAuthentications := snapshot[schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}]
+
+		for _, requestAuthentication := range requestAuthentications {
+			requestAuthenticationSet.Insert(requestAuthentication.(*security_istio_io_v1beta1_types.RequestAuthentication))
+		}
+
 		destinationRules := snapshot[schema.GroupVersionKind{
 			Group:   "networking.istio.io",
 			Version: "v1alpha3",
@@ -366,16 +399,6 @@ func NewRemoteSnapshotFromGeneric(
 			sidecarSet.Insert(sidecar.(*networking_istio_io_v1alpha3_types.Sidecar))
 		}
 
-		authorizationPolicies := snapshot[schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}]
-
-		for _, authorizationPolicy := range authorizationPolicies {
-			authorizationPolicySet.Insert(authorizationPolicy.(*security_istio_io_v1beta1_types.AuthorizationPolicy))
-		}
-
 		rateLimitConfigs := snapshot[schema.GroupVersionKind{
 			Group:   "ratelimit.solo.io",
 			Version: "v1alpha1",
@@ -392,13 +415,14 @@ func NewRemoteSnapshotFromGeneric(
 		issuedCertificateSet,
 		podBounceDirectiveSet,
 		xdsConfigSet,
+		authorizationPolicySet,
+		requestAuthenticationSet,
 		destinationRuleSet,
 		envoyFilterSet,
 		gatewaySet,
 		serviceEntrySet,
 		virtualServiceSet,
 		sidecarSet,
-		authorizationPolicySet,
 		rateLimitConfigSet,
 	)
 }
@@ -415,6 +439,14 @@ func (s *snapshotRemote) XdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1b
 	return s.xdsConfigs
 }
 
+func (s *snapshotRemote) AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
+	return s.authorizationPolicies
+}
+
+func (s *snapshotRemote) RequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet {
+	return s.requestAuthentications
+}
+
 func (s *snapshotRemote) DestinationRules() networking_istio_io_v1alpha3_sets.DestinationRuleSet {
 	return s.destinationRules
 }
@@ -439,10 +471,6 @@ func (s *snapshotRemote) Sidecars() networking_istio_io_v1alpha3_sets.SidecarSet
 	return s.sidecars
 }
 
-func (s *snapshotRemote) AuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
-	return s.authorizationPolicies
-}
-
 func (s *snapshotRemote) RateLimitConfigs() ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet {
 	return s.rateLimitConfigs
 }
@@ -565,6 +593,21 @@ func (s *snapshotRemote) MarshalJSON() ([]byte, error) {
 	}
 	snapshotMap["xdsConfigs"] = xdsConfigSet.List()
 
+	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	for _, obj := range s.authorizationPolicies.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		authorizationPolicySet.Insert(obj.(*security_istio_io_v1beta1_types.AuthorizationPolicy))
+	}
+	snapshotMap["authorizationPolicies"] = authorizationPolicySet.List()
+	requestAuthenticationSet := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+	for _, obj := range s.requestAuthentications.UnsortedList() {
+		// redact secret data from the snapshot
+		obj := snapshotutils.RedactSecretData(obj)
+		requestAuthenticationSet.Insert(obj.(*security_istio_io_v1beta1_types.RequestAuthentication))
+	}
+	snapshotMap["requestAuthentications"] = requestAuthenticationSet.List()
+
 	destinationRuleSet := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	for _, obj := range s.destinationRules.UnsortedList() {
 		// redact secret data from the snapshot
@@ -608,14 +651,6 @@ func (s *snapshotRemote) MarshalJSON() ([]byte, error) {
 	}
 	snapshotMap["sidecars"] = sidecarSet.List()
 
-	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-	for _, obj := range s.authorizationPolicies.UnsortedList() {
-		// redact secret data from the snapshot
-		obj := snapshotutils.RedactSecretData(obj)
-		authorizationPolicySet.Insert(obj.(*security_istio_io_v1beta1_types.AuthorizationPolicy))
-	}
-	snapshotMap["authorizationPolicies"] = authorizationPolicySet.List()
-
 	rateLimitConfigSet := ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet()
 	for _, obj := range s.rateLimitConfigs.UnsortedList() {
 		// redact secret data from the snapshot
@@ -630,17 +665,18 @@ func (s *snapshotRemote) Clone() RemoteSnapshot {
 	return &snapshotRemote{
 		name: s.name,
 
-		issuedCertificates:    s.issuedCertificates.Clone(),
-		podBounceDirectives:   s.podBounceDirectives.Clone(),
-		xdsConfigs:            s.xdsConfigs.Clone(),
-		destinationRules:      s.destinationRules.Clone(),
-		envoyFilters:          s.envoyFilters.Clone(),
-		gateways:              s.gateways.Clone(),
-		serviceEntries:        s.serviceEntries.Clone(),
-		virtualServices:       s.virtualServices.Clone(),
-		sidecars:              s.sidecars.Clone(),
-		authorizationPolicies: s.authorizationPolicies.Clone(),
-		rateLimitConfigs:      s.rateLimitConfigs.Clone(),
+		issuedCertificates:     s.issuedCertificates.Clone(),
+		podBounceDirectives:    s.podBounceDirectives.Clone(),
+		xdsConfigs:             s.xdsConfigs.Clone(),
+		authorizationPolicies:  s.authorizationPolicies.Clone(),
+		requestAuthentications: s.requestAuthentications.Clone(),
+		destinationRules:       s.destinationRules.Clone(),
+		envoyFilters:           s.envoyFilters.Clone(),
+		gateways:               s.gateways.Clone(),
+		serviceEntries:         s.serviceEntries.Clone(),
+		virtualServices:        s.virtualServices.Clone(),
+		sidecars:               s.sidecars.Clone(),
+		rateLimitConfigs:       s.rateLimitConfigs.Clone(),
 	}
 }
 
@@ -685,6 +721,25 @@ func (s *snapshotRemote) ForEachObject(handleObject func(cluster string, gvk sch
 		handleObject(cluster, gvk, obj)
 	}
 
+	for _, obj := range s.authorizationPolicies.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+	for _, obj := range s.requestAuthentications.List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+
 	for _, obj := range s.destinationRules.List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
@@ -740,16 +795,6 @@ func (s *snapshotRemote) ForEachObject(handleObject func(cluster string, gvk sch
 		handleObject(cluster, gvk, obj)
 	}
 
-	for _, obj := range s.authorizationPolicies.List() {
-		cluster := obj.GetClusterName()
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-		handleObject(cluster, gvk, obj)
-	}
-
 	for _, obj := range s.rateLimitConfigs.List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
@@ -777,6 +822,11 @@ type RemoteBuildOptions struct {
 	// List options for composing a snapshot from XdsConfigs
 	XdsConfigs ResourceRemoteBuildOptions
 
+	// List options for composing a snapshot from AuthorizationPolicies
+	AuthorizationPolicies ResourceRemoteBuildOptions
+	// List options for composing a snapshot from RequestAuthentications
+	RequestAuthentications ResourceRemoteBuildOptions
+
 	// List options for composing a snapshot from DestinationRules
 	DestinationRules ResourceRemoteBuildOptions
 	// List options for composing a snapshot from EnvoyFilters
@@ -790,9 +840,6 @@ type RemoteBuildOptions struct {
 	// List options for composing a snapshot from Sidecars
 	Sidecars ResourceRemoteBuildOptions
 
-	// List options for composing a snapshot from AuthorizationPolicies
-	AuthorizationPolicies ResourceRemoteBuildOptions
-
 	// List options for composing a snapshot from RateLimitConfigs
 	RateLimitConfigs ResourceRemoteBuildOptions
 }
@@ -831,6 +878,9 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 
 	xdsConfigs := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthentications := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRules := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilters := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gateways := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -838,8 +888,6 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 	virtualServices := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecars := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	rateLimitConfigs := ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet()
 
 	var errs error
@@ -855,6 +903,12 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 		if err := b.insertXdsConfigsFromCluster(ctx, cluster, xdsConfigs, opts.XdsConfigs); err != nil {
 			errs = multierror.Append(errs, err)
 		}
+		if err := b.insertAuthorizationPoliciesFromCluster(ctx, cluster, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if err := b.insertRequestAuthenticationsFromCluster(ctx, cluster, requestAuthentications, opts.RequestAuthentications); err != nil {
+			errs = multierror.Append(errs, err)
+		}
 		if err := b.insertDestinationRulesFromCluster(ctx, cluster, destinationRules, opts.DestinationRules); err != nil {
 			errs = multierror.Append(errs, err)
 		}
@@ -873,9 +927,6 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 		if err := b.insertSidecarsFromCluster(ctx, cluster, sidecars, opts.Sidecars); err != nil {
 			errs = multierror.Append(errs, err)
 		}
-		if err := b.insertAuthorizationPoliciesFromCluster(ctx, cluster, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
-			errs = multierror.Append(errs, err)
-		}
 		if err := b.insertRateLimitConfigsFromCluster(ctx, cluster, rateLimitConfigs, opts.RateLimitConfigs); err != nil {
 			errs = multierror.Append(errs, err)
 		}
@@ -888,13 +939,14 @@ func (b *multiClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name stri
 		issuedCertificates,
 		podBounceDirectives,
 		xdsConfigs,
+		authorizationPolicies,
+		requestAuthentications,
 		destinationRules,
 		envoyFilters,
 		gateways,
 		serviceEntries,
 		virtualServices,
 		sidecars,
-		authorizationPolicies,
 		rateLimitConfigs,
 	)
 
@@ -1029,6 +1081,91 @@ func (b *multiClusterRemoteBuilder) insertXdsConfigsFromCluster(ctx context.Cont
 	return nil
 }
 
+func (b *multiClusterRemoteBuilder) insertAuthorizationPoliciesFromCluster(ctx context.Context, cluster string, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
+	authorizationPolicyClient, err := security_istio_io_v1beta1.NewMulticlusterAuthorizationPolicyClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	authorizationPolicyList, err := authorizationPolicyClient.ListAuthorizationPolicy(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range authorizationPolicyList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		authorizationPolicies.Insert(item)
+	}
+
+	return nil
+}
+func (b *multiClusterRemoteBuilder) insertRequestAuthenticationsFromCluster(ctx context.Context, cluster string, requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet, opts ResourceRemoteBuildOptions) error {
+	requestAuthenticationClient, err := security_istio_io_v1beta1.NewMulticlusterRequestAuthenticationClient(b.client).Cluster(cluster)
+	if err != nil {
+		return err
+	}
+
+	if opts.Verifier != nil {
+		mgr, err := b.clusters.Cluster(cluster)
+		if err != nil {
+			return err
+		}
+
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			cluster,
+			mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	requestAuthenticationList, err := requestAuthenticationClient.ListRequestAuthentication(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range requestAuthenticationList.Items {
+		item := item.DeepCopy()    // pike + own
+		item.ClusterName = cluster // set cluster for in-memory processing
+		requestAuthentications.Insert(item)
+	}
+
+	return nil
+}
+
 func (b *multiClusterRemoteBuilder) insertDestinationRulesFromCluster(ctx context.Context, cluster string, destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet, opts ResourceRemoteBuildOptions) error {
 	destinationRuleClient, err := networking_istio_io_v1alpha3.NewMulticlusterDestinationRuleClient(b.client).Cluster(cluster)
 	if err != nil {
@@ -1282,49 +1419,6 @@ func (b *multiClusterRemoteBuilder) insertSidecarsFromCluster(ctx context.Contex
 	return nil
 }
 
-func (b *multiClusterRemoteBuilder) insertAuthorizationPoliciesFromCluster(ctx context.Context, cluster string, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
-	authorizationPolicyClient, err := security_istio_io_v1beta1.NewMulticlusterAuthorizationPolicyClient(b.client).Cluster(cluster)
-	if err != nil {
-		return err
-	}
-
-	if opts.Verifier != nil {
-		mgr, err := b.clusters.Cluster(cluster)
-		if err != nil {
-			return err
-		}
-
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-
-		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
-			cluster,
-			mgr.GetConfig(),
-			gvk,
-		); err != nil {
-			return err
-		} else if !resourceRegistered {
-			return nil
-		}
-	}
-
-	authorizationPolicyList, err := authorizationPolicyClient.ListAuthorizationPolicy(ctx, opts.ListOptions...)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range authorizationPolicyList.Items {
-		item := item.DeepCopy()    // pike + own
-		item.ClusterName = cluster // set cluster for in-memory processing
-		authorizationPolicies.Insert(item)
-	}
-
-	return nil
-}
-
 func (b *multiClusterRemoteBuilder) insertRateLimitConfigsFromCluster(ctx context.Context, cluster string, rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet, opts ResourceRemoteBuildOptions) error {
 	rateLimitConfigClient, err := ratelimit_solo_io_v1alpha1.NewMulticlusterRateLimitConfigClient(b.client).Cluster(cluster)
 	if err != nil {
@@ -1400,6 +1494,9 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 
 	xdsConfigs := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthentications := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRules := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilters := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gateways := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -1407,8 +1504,6 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 	virtualServices := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecars := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	rateLimitConfigs := ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet()
 
 	var errs error
@@ -1422,6 +1517,12 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 	if err := b.insertXdsConfigs(ctx, xdsConfigs, opts.XdsConfigs); err != nil {
 		errs = multierror.Append(errs, err)
 	}
+	if err := b.insertAuthorizationPolicies(ctx, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+	if err := b.insertRequestAuthentications(ctx, requestAuthentications, opts.RequestAuthentications); err != nil {
+		errs = multierror.Append(errs, err)
+	}
 	if err := b.insertDestinationRules(ctx, destinationRules, opts.DestinationRules); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1440,9 +1541,6 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 	if err := b.insertSidecars(ctx, sidecars, opts.Sidecars); err != nil {
 		errs = multierror.Append(errs, err)
 	}
-	if err := b.insertAuthorizationPolicies(ctx, authorizationPolicies, opts.AuthorizationPolicies); err != nil {
-		errs = multierror.Append(errs, err)
-	}
 	if err := b.insertRateLimitConfigs(ctx, rateLimitConfigs, opts.RateLimitConfigs); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1453,13 +1551,14 @@ func (b *singleClusterRemoteBuilder) BuildSnapshot(ctx context.Context, name str
 		issuedCertificates,
 		podBounceDirectives,
 		xdsConfigs,
+		authorizationPolicies,
+		requestAuthentications,
 		destinationRules,
 		envoyFilters,
 		gateways,
 		serviceEntries,
 		virtualServices,
 		sidecars,
-		authorizationPolicies,
 		rateLimitConfigs,
 	)
 
@@ -1567,6 +1666,73 @@ func (b *singleClusterRemoteBuilder) insertXdsConfigs(ctx context.Context, xdsCo
 	return nil
 }
 
+func (b *singleClusterRemoteBuilder) insertAuthorizationPolicies(ctx context.Context, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	authorizationPolicyList, err := security_istio_io_v1beta1.NewAuthorizationPolicyClient(b.mgr.GetClient()).ListAuthorizationPolicy(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range authorizationPolicyList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		authorizationPolicies.Insert(item)
+	}
+
+	return nil
+}
+func (b *singleClusterRemoteBuilder) insertRequestAuthentications(ctx context.Context, requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet, opts ResourceRemoteBuildOptions) error {
+
+	if opts.Verifier != nil {
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+
+		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
+			"", // verify in the local cluster
+			b.mgr.GetConfig(),
+			gvk,
+		); err != nil {
+			return err
+		} else if !resourceRegistered {
+			return nil
+		}
+	}
+
+	requestAuthenticationList, err := security_istio_io_v1beta1.NewRequestAuthenticationClient(b.mgr.GetClient()).ListRequestAuthentication(ctx, opts.ListOptions...)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range requestAuthenticationList.Items {
+		item := item.DeepCopy() // pike + own the item.
+		item.ClusterName = b.clusterName
+		requestAuthentications.Insert(item)
+	}
+
+	return nil
+}
+
 func (b *singleClusterRemoteBuilder) insertDestinationRules(ctx context.Context, destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet, opts ResourceRemoteBuildOptions) error {
 
 	if opts.Verifier != nil {
@@ -1766,40 +1932,6 @@ func (b *singleClusterRemoteBuilder) insertSidecars(ctx context.Context, sidecar
 	return nil
 }
 
-func (b *singleClusterRemoteBuilder) insertAuthorizationPolicies(ctx context.Context, authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet, opts ResourceRemoteBuildOptions) error {
-
-	if opts.Verifier != nil {
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-
-		if resourceRegistered, err := opts.Verifier.VerifyServerResource(
-			"", // verify in the local cluster
-			b.mgr.GetConfig(),
-			gvk,
-		); err != nil {
-			return err
-		} else if !resourceRegistered {
-			return nil
-		}
-	}
-
-	authorizationPolicyList, err := security_istio_io_v1beta1.NewAuthorizationPolicyClient(b.mgr.GetClient()).ListAuthorizationPolicy(ctx, opts.ListOptions...)
-	if err != nil {
-		return err
-	}
-
-	for _, item := range authorizationPolicyList.Items {
-		item := item.DeepCopy() // pike + own the item.
-		item.ClusterName = b.clusterName
-		authorizationPolicies.Insert(item)
-	}
-
-	return nil
-}
-
 func (b *singleClusterRemoteBuilder) insertRateLimitConfigs(ctx context.Context, rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet, opts ResourceRemoteBuildOptions) error {
 
 	if opts.Verifier != nil {
@@ -1859,6 +1991,9 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 
 	xdsConfigs := xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet()
 
+	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	requestAuthentications := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+
 	destinationRules := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	envoyFilters := networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet()
 	gateways := networking_istio_io_v1alpha3_sets.NewGatewaySet()
@@ -1866,8 +2001,6 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 	virtualServices := networking_istio_io_v1alpha3_sets.NewVirtualServiceSet()
 	sidecars := networking_istio_io_v1alpha3_sets.NewSidecarSet()
 
-	authorizationPolicies := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-
 	rateLimitConfigs := ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet()
 
 	genericSnap.ForEachObject(func(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) {
@@ -1881,6 +2014,12 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 		// insert XdsConfigs
 		case *xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_types.XdsConfig:
 			i.insertXdsConfig(ctx, obj, xdsConfigs, opts)
+		// insert AuthorizationPolicies
+		case *security_istio_io_v1beta1_types.AuthorizationPolicy:
+			i.insertAuthorizationPolicy(ctx, obj, authorizationPolicies, opts)
+		// insert RequestAuthentications
+		case *security_istio_io_v1beta1_types.RequestAuthentication:
+			i.insertRequestAuthentication(ctx, obj, requestAuthentications, opts)
 		// insert DestinationRules
 		case *networking_istio_io_v1alpha3_types.DestinationRule:
 			i.insertDestinationRule(ctx, obj, destinationRules, opts)
@@ -1899,9 +2038,6 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 		// insert Sidecars
 		case *networking_istio_io_v1alpha3_types.Sidecar:
 			i.insertSidecar(ctx, obj, sidecars, opts)
-		// insert AuthorizationPolicies
-		case *security_istio_io_v1beta1_types.AuthorizationPolicy:
-			i.insertAuthorizationPolicy(ctx, obj, authorizationPolicies, opts)
 		// insert RateLimitConfigs
 		case *ratelimit_solo_io_v1alpha1_types.RateLimitConfig:
 			i.insertRateLimitConfig(ctx, obj, rateLimitConfigs, opts)
@@ -1914,13 +2050,14 @@ func (i *inMemoryRemoteBuilder) BuildSnapshot(ctx context.Context, name string,
 		issuedCertificates,
 		podBounceDirectives,
 		xdsConfigs,
+		authorizationPolicies,
+		requestAuthentications,
 		destinationRules,
 		envoyFilters,
 		gateways,
 		serviceEntries,
 		virtualServices,
 		sidecars,
-		authorizationPolicies,
 		rateLimitConfigs,
 	), nil
 }
@@ -2014,6 +2151,65 @@ func (i *inMemoryRemoteBuilder) insertXdsConfig(
 	}
 }
 
+func (i *inMemoryRemoteBuilder) insertAuthorizationPolicy(
+	ctx context.Context,
+	authorizationPolicy *security_istio_io_v1beta1_types.AuthorizationPolicy,
+	authorizationPolicySet security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	buildOpts RemoteBuildOptions,
+) {
+
+	opts := buildOpts.AuthorizationPolicies.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = authorizationPolicy.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(authorizationPolicy.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		authorizationPolicySet.Insert(authorizationPolicy)
+	}
+}
+func (i *inMemoryRemoteBuilder) insertRequestAuthentication(
+	ctx context.Context,
+	requestAuthentication *security_istio_io_v1beta1_types.RequestAuthentication,
+	requestAuthenticationSet security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+	buildOpts RemoteBuildOptions,
+) {
+
+	opts := buildOpts.RequestAuthentications.ListOptions
+
+	listOpts := &client.ListOptions{}
+	for _, opt := range opts {
+		opt.ApplyToList(listOpts)
+	}
+
+	filteredOut := false
+	if listOpts.Namespace != "" {
+		filteredOut = requestAuthentication.Namespace != listOpts.Namespace
+	}
+	if listOpts.LabelSelector != nil {
+		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(requestAuthentication.Labels))
+	}
+	if listOpts.FieldSelector != nil {
+		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
+	}
+
+	if !filteredOut {
+		requestAuthenticationSet.Insert(requestAuthentication)
+	}
+}
+
 func (i *inMemoryRemoteBuilder) insertDestinationRule(
 	ctx context.Context,
 	destinationRule *networking_istio_io_v1alpha3_types.DestinationRule,
@@ -2189,36 +2385,6 @@ func (i *inMemoryRemoteBuilder) insertSidecar(
 	}
 }
 
-func (i *inMemoryRemoteBuilder) insertAuthorizationPolicy(
-	ctx context.Context,
-	authorizationPolicy *security_istio_io_v1beta1_types.AuthorizationPolicy,
-	authorizationPolicySet security_istio_io_v1beta1_sets.AuthorizationPolicySet,
-	buildOpts RemoteBuildOptions,
-) {
-
-	opts := buildOpts.AuthorizationPolicies.ListOptions
-
-	listOpts := &client.ListOptions{}
-	for _, opt := range opts {
-		opt.ApplyToList(listOpts)
-	}
-
-	filteredOut := false
-	if listOpts.Namespace != "" {
-		filteredOut = authorizationPolicy.Namespace != listOpts.Namespace
-	}
-	if listOpts.LabelSelector != nil {
-		filteredOut = !listOpts.LabelSelector.Matches(labels.Set(authorizationPolicy.Labels))
-	}
-	if listOpts.FieldSelector != nil {
-		contextutils.LoggerFrom(ctx).DPanicf("field selector is not implemented for in-memory remote snapshot")
-	}
-
-	if !filteredOut {
-		authorizationPolicySet.Insert(authorizationPolicy)
-	}
-}
-
 func (i *inMemoryRemoteBuilder) insertRateLimitConfig(
 	ctx context.Context,
 	rateLimitConfig *ratelimit_solo_io_v1alpha1_types.RateLimitConfig,
@@ -12,12 +12,12 @@ import (
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 
-	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
-	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
-
-	security_istio_io_v1beta1_sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
+	security_istio_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/security.istio.io/v1beta1/sets"
 	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
 
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
 	ratelimit_solo_io_v1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	ratelimit_solo_io_v1alpha1_sets "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1/sets"
 )
@@ -30,6 +30,9 @@ type InputRemoteSnapshotManualBuilder struct {
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	authorizationPolicies  security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	envoyFilters     networking_istio_io_v1alpha3_sets.EnvoyFilterSet
 	gateways         networking_istio_io_v1alpha3_sets.GatewaySet
@@ -37,8 +40,6 @@ type InputRemoteSnapshotManualBuilder struct {
 	virtualServices  networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	sidecars         networking_istio_io_v1alpha3_sets.SidecarSet
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
 	rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet
 }
 
@@ -51,6 +52,9 @@ func NewInputRemoteSnapshotManualBuilder(name string) *InputRemoteSnapshotManual
 
 		xdsConfigs: xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet(),
 
+		authorizationPolicies:  security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
+		requestAuthentications: security_istio_io_v1beta1_sets.NewRequestAuthenticationSet(),
+
 		destinationRules: networking_istio_io_v1alpha3_sets.NewDestinationRuleSet(),
 		envoyFilters:     networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet(),
 		gateways:         networking_istio_io_v1alpha3_sets.NewGatewaySet(),
@@ -58,8 +62,6 @@ func NewInputRemoteSnapshotManualBuilder(name string) *InputRemoteSnapshotManual
 		virtualServices:  networking_istio_io_v1alpha3_sets.NewVirtualServiceSet(),
 		sidecars:         networking_istio_io_v1alpha3_sets.NewSidecarSet(),
 
-		authorizationPolicies: security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
-
 		rateLimitConfigs: ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet(),
 	}
 }
@@ -73,6 +75,9 @@ func (i *InputRemoteSnapshotManualBuilder) Build() RemoteSnapshot {
 
 		i.xdsConfigs,
 
+		i.authorizationPolicies,
+		i.requestAuthentications,
+
 		i.destinationRules,
 		i.envoyFilters,
 		i.gateways,
@@ -80,8 +85,6 @@ func (i *InputRemoteSnapshotManualBuilder) Build() RemoteSnapshot {
 		i.virtualServices,
 		i.sidecars,
 
-		i.authorizationPolicies,
-
 		i.rateLimitConfigs,
 	)
 }
@@ -97,6 +100,14 @@ func (i *InputRemoteSnapshotManualBuilder) AddXdsConfigs(xdsConfigs []*xds_agent
 	i.xdsConfigs.Insert(xdsConfigs...)
 	return i
 }
+func (i *InputRemoteSnapshotManualBuilder) AddAuthorizationPolicies(authorizationPolicies []*security_istio_io_v1beta1.AuthorizationPolicy) *InputRemoteSnapshotManualBuilder {
+	i.authorizationPolicies.Insert(authorizationPolicies...)
+	return i
+}
+func (i *InputRemoteSnapshotManualBuilder) AddRequestAuthentications(requestAuthentications []*security_istio_io_v1beta1.RequestAuthentication) *InputRemoteSnapshotManualBuilder {
+	i.requestAuthentications.Insert(requestAuthentications...)
+	return i
+}
 func (i *InputRemoteSnapshotManualBuilder) AddDestinationRules(destinationRules []*networking_istio_io_v1alpha3.DestinationRule) *InputRemoteSnapshotManualBuilder {
 	i.destinationRules.Insert(destinationRules...)
 	return i
@@ -121,10 +132,6 @@ func (i *InputRemoteSnapshotManualBuilder) AddSidecars(sidecars []*networking_is
 	i.sidecars.Insert(sidecars...)
 	return i
 }
-func (i *InputRemoteSnapshotManualBuilder) AddAuthorizationPolicies(authorizationPolicies []*security_istio_io_v1beta1.AuthorizationPolicy) *InputRemoteSnapshotManualBuilder {
-	i.authorizationPolicies.Insert(authorizationPolicies...)
-	return i
-}
 func (i *InputRemoteSnapshotManualBuilder) AddRateLimitConfigs(rateLimitConfigs []*ratelimit_solo_io_v1alpha1.RateLimitConfig) *InputRemoteSnapshotManualBuilder {
 	i.rateLimitConfigs.Insert(rateLimitConfigs...)
 	return i
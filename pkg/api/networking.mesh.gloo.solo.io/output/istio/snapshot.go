@@ -28,12 +28,12 @@ import (
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1 "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1"
 	xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/xds.agent.enterprise.mesh.gloo.solo.io/v1beta1/sets"
 
-	networking_istio_io_v1alpha3_sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
-	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
-
-	security_istio_io_v1beta1_sets "github.com/solo-io/external-apis/pkg/api/istio/security.istio.io/v1beta1/sets"
+	security_istio_io_v1beta1_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/security.istio.io/v1beta1/sets"
 	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
 
+	networking_istio_io_v1alpha3_sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	networking_istio_io_v1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+
 	ratelimit_solo_io_v1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
 	ratelimit_solo_io_v1alpha1_sets "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1/sets"
 )
@@ -61,6 +61,16 @@ var SnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1beta1",
 		Kind:    "XdsConfig",
 	},
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "AuthorizationPolicy",
+	},
+	schema.GroupVersionKind{
+		Group:   "security.istio.io",
+		Version: "v1beta1",
+		Kind:    "RequestAuthentication",
+	},
 	schema.GroupVersionKind{
 		Group:   "networking.istio.io",
 		Version: "v1alpha3",
@@ -91,11 +101,6 @@ var SnapshotGVKs = []schema.GroupVersionKind{
 		Version: "v1alpha3",
 		Kind:    "Sidecar",
 	},
-	schema.GroupVersionKind{
-		Group:   "security.istio.io",
-		Version: "v1beta1",
-		Kind:    "AuthorizationPolicy",
-	},
 	schema.GroupVersionKind{
 		Group:   "ratelimit.solo.io",
 		Version: "v1alpha1",
@@ -112,6 +117,10 @@ type Snapshot interface {
 	PodBounceDirectives() []LabeledPodBounceDirectiveSet
 	// return the set of XdsConfigs with a given set of labels
 	XdsConfigs() []LabeledXdsConfigSet
+	// return the set of AuthorizationPolicies with a given set of labels
+	AuthorizationPolicies() []LabeledAuthorizationPolicySet
+	// return the set of RequestAuthentications with a given set of labels
+	RequestAuthentications() []LabeledRequestAuthenticationSet
 	// return the set of DestinationRules with a given set of labels
 	DestinationRules() []LabeledDestinationRuleSet
 	// return the set of EnvoyFilters with a given set of labels
@@ -124,8 +133,6 @@ type Snapshot interface {
 	VirtualServices() []LabeledVirtualServiceSet
 	// return the set of Sidecars with a given set of labels
 	Sidecars() []LabeledSidecarSet
-	// return the set of AuthorizationPolicies with a given set of labels
-	AuthorizationPolicies() []LabeledAuthorizationPolicySet
 	// return the set of RateLimitConfigs with a given set of labels
 	RateLimitConfigs() []LabeledRateLimitConfigSet
 
@@ -148,18 +155,19 @@ type Snapshot interface {
 type snapshot struct {
 	name string
 
-	issuedCertificates    []LabeledIssuedCertificateSet
-	podBounceDirectives   []LabeledPodBounceDirectiveSet
-	xdsConfigs            []LabeledXdsConfigSet
-	destinationRules      []LabeledDestinationRuleSet
-	envoyFilters          []LabeledEnvoyFilterSet
-	gateways              []LabeledGatewaySet
-	serviceEntries        []LabeledServiceEntrySet
-	virtualServices       []LabeledVirtualServiceSet
-	sidecars              []LabeledSidecarSet
-	authorizationPolicies []LabeledAuthorizationPolicySet
-	rateLimitConfigs      []LabeledRateLimitConfigSet
-	clusters              []string
+	issuedCertificates     []LabeledIssuedCertificateSet
+	podBounceDirectives    []LabeledPodBounceDirectiveSet
+	xdsConfigs             []LabeledXdsConfigSet
+	authorizationPolicies  []LabeledAuthorizationPolicySet
+	requestAuthentications []LabeledRequestAuthenticationSet
+	destinationRules       []LabeledDestinationRuleSet
+	envoyFilters           []LabeledEnvoyFilterSet
+	gateways               []LabeledGatewaySet
+	serviceEntries         []LabeledServiceEntrySet
+	virtualServices        []LabeledVirtualServiceSet
+	sidecars               []LabeledSidecarSet
+	rateLimitConfigs       []LabeledRateLimitConfigSet
+	clusters               []string
 }
 
 func NewSnapshot(
@@ -168,31 +176,33 @@ func NewSnapshot(
 	issuedCertificates []LabeledIssuedCertificateSet,
 	podBounceDirectives []LabeledPodBounceDirectiveSet,
 	xdsConfigs []LabeledXdsConfigSet,
+	authorizationPolicies []LabeledAuthorizationPolicySet,
+	requestAuthentications []LabeledRequestAuthenticationSet,
 	destinationRules []LabeledDestinationRuleSet,
 	envoyFilters []LabeledEnvoyFilterSet,
 	gateways []LabeledGatewaySet,
 	serviceEntries []LabeledServiceEntrySet,
 	virtualServices []LabeledVirtualServiceSet,
 	sidecars []LabeledSidecarSet,
-	authorizationPolicies []LabeledAuthorizationPolicySet,
 	rateLimitConfigs []LabeledRateLimitConfigSet,
 	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
 ) Snapshot {
 	return &snapshot{
 		name: name,
 
-		issuedCertificates:    issuedCertificates,
-		podBounceDirectives:   podBounceDirectives,
-		xdsConfigs:            xdsConfigs,
-		destinationRules:      destinationRules,
-		envoyFilters:          envoyFilters,
-		gateways:              gateways,
-		serviceEntries:        serviceEntries,
-		virtualServices:       virtualServices,
-		sidecars:              sidecars,
-		authorizationPolicies: authorizationPolicies,
-		rateLimitConfigs:      rateLimitConfigs,
-		clusters:              clusters,
+		issuedCertificates:     issuedCertificates,
+		podBounceDirectives:    podBounceDirectives,
+		xdsConfigs:             xdsConfigs,
+		authorizationPolicies:  authorizationPolicies,
+		requestAuthentications: requestAuthentications,
+		destinationRules:       destinationRules,
+		envoyFilters:           envoyFilters,
+		gateways:               gateways,
+		serviceEntries:         serviceEntries,
+		virtualServices:        virtualServices,
+		sidecars:               sidecars,
+		rateLimitConfigs:       rateLimitConfigs,
+		clusters:               clusters,
 	}
 }
 
@@ -207,6 +217,9 @@ func NewLabelPartitionedSnapshot(
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet,
 
+	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet,
 	envoyFilters networking_istio_io_v1alpha3_sets.EnvoyFilterSet,
 	gateways networking_istio_io_v1alpha3_sets.GatewaySet,
@@ -214,8 +227,6 @@ func NewLabelPartitionedSnapshot(
 	virtualServices networking_istio_io_v1alpha3_sets.VirtualServiceSet,
 	sidecars networking_istio_io_v1alpha3_sets.SidecarSet,
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
-
 	rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet,
 	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
 ) (Snapshot, error) {
@@ -232,6 +243,14 @@ func NewLabelPartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
+	partitionedAuthorizationPolicies, err := partitionAuthorizationPoliciesByLabel(labelKey, authorizationPolicies)
+	if err != nil {
+		return nil, err
+	}
+	partitionedRequestAuthentications, err := partitionRequestAuthenticationsByLabel(labelKey, requestAuthentications)
+	if err != nil {
+		return nil, err
+	}
 	partitionedDestinationRules, err := partitionDestinationRulesByLabel(labelKey, destinationRules)
 	if err != nil {
 		return nil, err
@@ -256,10 +275,6 @@ func NewLabelPartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	partitionedAuthorizationPolicies, err := partitionAuthorizationPoliciesByLabel(labelKey, authorizationPolicies)
-	if err != nil {
-		return nil, err
-	}
 	partitionedRateLimitConfigs, err := partitionRateLimitConfigsByLabel(labelKey, rateLimitConfigs)
 	if err != nil {
 		return nil, err
@@ -271,13 +286,14 @@ func NewLabelPartitionedSnapshot(
 		partitionedIssuedCertificates,
 		partitionedPodBounceDirectives,
 		partitionedXdsConfigs,
+		partitionedAuthorizationPolicies,
+		partitionedRequestAuthentications,
 		partitionedDestinationRules,
 		partitionedEnvoyFilters,
 		partitionedGateways,
 		partitionedServiceEntries,
 		partitionedVirtualServices,
 		partitionedSidecars,
-		partitionedAuthorizationPolicies,
 		partitionedRateLimitConfigs,
 		clusters...,
 	), nil
@@ -294,6 +310,9 @@ func NewSinglePartitionedSnapshot(
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet,
 
+	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet,
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet,
 	envoyFilters networking_istio_io_v1alpha3_sets.EnvoyFilterSet,
 	gateways networking_istio_io_v1alpha3_sets.GatewaySet,
@@ -301,8 +320,6 @@ func NewSinglePartitionedSnapshot(
 	virtualServices networking_istio_io_v1alpha3_sets.VirtualServiceSet,
 	sidecars networking_istio_io_v1alpha3_sets.SidecarSet,
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet,
-
 	rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet,
 	clusters ...string, // the set of clusters to apply the snapshot to. only required for multicluster snapshots.
 ) (Snapshot, error) {
@@ -319,6 +336,14 @@ func NewSinglePartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
+	labeledAuthorizationPolicies, err := NewLabeledAuthorizationPolicySet(authorizationPolicies, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
+	labeledRequestAuthentications, err := NewLabeledRequestAuthenticationSet(requestAuthentications, snapshotLabels)
+	if err != nil {
+		return nil, err
+	}
 	labeledDestinationRules, err := NewLabeledDestinationRuleSet(destinationRules, snapshotLabels)
 	if err != nil {
 		return nil, err
@@ -343,10 +368,6 @@ func NewSinglePartitionedSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	labeledAuthorizationPolicies, err := NewLabeledAuthorizationPolicySet(authorizationPolicies, snapshotLabels)
-	if err != nil {
-		return nil, err
-	}
 	labeledRateLimitConfigs, err := NewLabeledRateLimitConfigSet(rateLimitConfigs, snapshotLabels)
 	if err != nil {
 		return nil, err
@@ -358,13 +379,14 @@ func NewSinglePartitionedSnapshot(
 		[]LabeledIssuedCertificateSet{labeledIssuedCertificates},
 		[]LabeledPodBounceDirectiveSet{labeledPodBounceDirectives},
 		[]LabeledXdsConfigSet{labeledXdsConfigs},
+		[]LabeledAuthorizationPolicySet{labeledAuthorizationPolicies},
+		[]LabeledRequestAuthenticationSet{labeledRequestAuthentications},
 		[]LabeledDestinationRuleSet{labeledDestinationRules},
 		[]LabeledEnvoyFilterSet{labeledEnvoyFilters},
 		[]LabeledGatewaySet{labeledGateways},
 		[]LabeledServiceEntrySet{labeledServiceEntries},
 		[]LabeledVirtualServiceSet{labeledVirtualServices},
 		[]LabeledSidecarSet{labeledSidecars},
-		[]LabeledAuthorizationPolicySet{labeledAuthorizationPolicies},
 		[]LabeledRateLimitConfigSet{labeledRateLimitConfigs},
 		clusters...,
 	), nil
@@ -383,6 +405,12 @@ func (s *snapshot) ApplyLocalCluster(ctx context.Context, clusterClient client.C
 	for _, outputSet := range s.xdsConfigs {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
+	for _, outputSet := range s.authorizationPolicies {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.requestAuthentications {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
 	for _, outputSet := range s.destinationRules {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
@@ -401,9 +429,6 @@ func (s *snapshot) ApplyLocalCluster(ctx context.Context, clusterClient client.C
 	for _, outputSet := range s.sidecars {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
-	for _, outputSet := range s.authorizationPolicies {
-		genericLists = append(genericLists, outputSet.Generic())
-	}
 	for _, outputSet := range s.rateLimitConfigs {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
@@ -427,6 +452,12 @@ func (s *snapshot) ApplyMultiCluster(ctx context.Context, multiClusterClient mul
 	for _, outputSet := range s.xdsConfigs {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
+	for _, outputSet := range s.authorizationPolicies {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
+	for _, outputSet := range s.requestAuthentications {
+		genericLists = append(genericLists, outputSet.Generic())
+	}
 	for _, outputSet := range s.destinationRules {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
@@ -445,9 +476,6 @@ func (s *snapshot) ApplyMultiCluster(ctx context.Context, multiClusterClient mul
 	for _, outputSet := range s.sidecars {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
-	for _, outputSet := range s.authorizationPolicies {
-		genericLists = append(genericLists, outputSet.Generic())
-	}
 	for _, outputSet := range s.rateLimitConfigs {
 		genericLists = append(genericLists, outputSet.Generic())
 	}
@@ -506,6 +534,29 @@ func (s *snapshot) ForEachObject(handleObject func(cluster string, gvk schema.Gr
 		}
 	}
 
+	for _, set := range s.authorizationPolicies {
+		for _, obj := range set.Set().List() {
+			cluster := obj.GetClusterName()
+			gvk := schema.GroupVersionKind{
+				Group:   "security.istio.io",
+				Version: "v1beta1",
+				Kind:    "AuthorizationPolicy",
+			}
+			handleObject(cluster, gvk, obj)
+		}
+	}
+	for _, set := range s.requestAuthentications {
+		for _, obj := range set.Set().List() {
+			cluster := obj.GetClusterName()
+			gvk := schema.GroupVersionKind{
+				Group:   "security.istio.io",
+				Version: "v1beta1",
+				Kind:    "RequestAuthentication",
+			}
+			handleObject(cluster, gvk, obj)
+		}
+	}
+
 	for _, set := range s.destinationRules {
 		for _, obj := range set.Set().List() {
 			cluster := obj.GetClusterName()
@@ -573,18 +624,6 @@ func (s *snapshot) ForEachObject(handleObject func(cluster string, gvk schema.Gr
 		}
 	}
 
-	for _, set := range s.authorizationPolicies {
-		for _, obj := range set.Set().List() {
-			cluster := obj.GetClusterName()
-			gvk := schema.GroupVersionKind{
-				Group:   "security.istio.io",
-				Version: "v1beta1",
-				Kind:    "AuthorizationPolicy",
-			}
-			handleObject(cluster, gvk, obj)
-		}
-	}
-
 	for _, set := range s.rateLimitConfigs {
 		for _, obj := range set.Set().List() {
 			cluster := obj.GetClusterName()
@@ -745,6 +784,104 @@ func partitionXdsConfigsByLabel(labelKey string, set xds_agent_enterprise_mesh_g
 	return partitionedXdsConfigs, nil
 }
 
+func partitionAuthorizationPoliciesByLabel(labelKey string, set security_istio_io_v1beta1_sets.AuthorizationPolicySet) ([]LabeledAuthorizationPolicySet, error) {
+	setsByLabel := map[string]security_istio_io_v1beta1_sets.AuthorizationPolicySet{}
+
+	for _, obj := range set.List() {
+		objGVK := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, objGVK, obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, objGVK, obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedAuthorizationPolicies []LabeledAuthorizationPolicySet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledAuthorizationPolicySet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedAuthorizationPolicies = append(partitionedAuthorizationPolicies, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedAuthorizationPolicies, func(i, j int) bool {
+		leftLabelValue := partitionedAuthorizationPolicies[i].Labels()[labelKey]
+		rightLabelValue := partitionedAuthorizationPolicies[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedAuthorizationPolicies, nil
+}
+
+func partitionRequestAuthenticationsByLabel(labelKey string, set security_istio_io_v1beta1_sets.RequestAuthenticationSet) ([]LabeledRequestAuthenticationSet, error) {
+	setsByLabel := map[string]security_istio_io_v1beta1_sets.RequestAuthenticationSet{}
+
+	for _, obj := range set.List() {
+		objGVK := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+		if obj.Labels == nil {
+			return nil, MissingRequiredLabelError(labelKey, objGVK, obj)
+		}
+		labelValue := obj.Labels[labelKey]
+		if labelValue == "" {
+			return nil, MissingRequiredLabelError(labelKey, objGVK, obj)
+		}
+
+		setForValue, ok := setsByLabel[labelValue]
+		if !ok {
+			setForValue = security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+			setsByLabel[labelValue] = setForValue
+		}
+		setForValue.Insert(obj)
+	}
+
+	// partition by label key
+	var partitionedRequestAuthentications []LabeledRequestAuthenticationSet
+
+	for labelValue, setForValue := range setsByLabel {
+		labels := map[string]string{labelKey: labelValue}
+
+		partitionedSet, err := NewLabeledRequestAuthenticationSet(setForValue, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		partitionedRequestAuthentications = append(partitionedRequestAuthentications, partitionedSet)
+	}
+
+	// sort for idempotency
+	sort.SliceStable(partitionedRequestAuthentications, func(i, j int) bool {
+		leftLabelValue := partitionedRequestAuthentications[i].Labels()[labelKey]
+		rightLabelValue := partitionedRequestAuthentications[j].Labels()[labelKey]
+		return leftLabelValue < rightLabelValue
+	})
+
+	return partitionedRequestAuthentications, nil
+}
+
 func partitionDestinationRulesByLabel(labelKey string, set networking_istio_io_v1alpha3_sets.DestinationRuleSet) ([]LabeledDestinationRuleSet, error) {
 	setsByLabel := map[string]networking_istio_io_v1alpha3_sets.DestinationRuleSet{}
 
@@ -1039,55 +1176,6 @@ func partitionSidecarsByLabel(labelKey string, set networking_istio_io_v1alpha3_
 	return partitionedSidecars, nil
 }
 
-func partitionAuthorizationPoliciesByLabel(labelKey string, set security_istio_io_v1beta1_sets.AuthorizationPolicySet) ([]LabeledAuthorizationPolicySet, error) {
-	setsByLabel := map[string]security_istio_io_v1beta1_sets.AuthorizationPolicySet{}
-
-	for _, obj := range set.List() {
-		objGVK := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-		if obj.Labels == nil {
-			return nil, MissingRequiredLabelError(labelKey, objGVK, obj)
-		}
-		labelValue := obj.Labels[labelKey]
-		if labelValue == "" {
-			return nil, MissingRequiredLabelError(labelKey, objGVK, obj)
-		}
-
-		setForValue, ok := setsByLabel[labelValue]
-		if !ok {
-			setForValue = security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-			setsByLabel[labelValue] = setForValue
-		}
-		setForValue.Insert(obj)
-	}
-
-	// partition by label key
-	var partitionedAuthorizationPolicies []LabeledAuthorizationPolicySet
-
-	for labelValue, setForValue := range setsByLabel {
-		labels := map[string]string{labelKey: labelValue}
-
-		partitionedSet, err := NewLabeledAuthorizationPolicySet(setForValue, labels)
-		if err != nil {
-			return nil, err
-		}
-
-		partitionedAuthorizationPolicies = append(partitionedAuthorizationPolicies, partitionedSet)
-	}
-
-	// sort for idempotency
-	sort.SliceStable(partitionedAuthorizationPolicies, func(i, j int) bool {
-		leftLabelValue := partitionedAuthorizationPolicies[i].Labels()[labelKey]
-		rightLabelValue := partitionedAuthorizationPolicies[j].Labels()[labelKey]
-		return leftLabelValue < rightLabelValue
-	})
-
-	return partitionedAuthorizationPolicies, nil
-}
-
 func partitionRateLimitConfigsByLabel(labelKey string, set ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet) ([]LabeledRateLimitConfigSet, error) {
 	setsByLabel := map[string]ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet{}
 
@@ -1149,6 +1237,14 @@ func (s snapshot) XdsConfigs() []LabeledXdsConfigSet {
 	return s.xdsConfigs
 }
 
+func (s snapshot) AuthorizationPolicies() []LabeledAuthorizationPolicySet {
+	return s.authorizationPolicies
+}
+
+func (s snapshot) RequestAuthentications() []LabeledRequestAuthenticationSet {
+	return s.requestAuthentications
+}
+
 func (s snapshot) DestinationRules() []LabeledDestinationRuleSet {
 	return s.destinationRules
 }
@@ -1173,10 +1269,6 @@ func (s snapshot) Sidecars() []LabeledSidecarSet {
 	return s.sidecars
 }
 
-func (s snapshot) AuthorizationPolicies() []LabeledAuthorizationPolicySet {
-	return s.authorizationPolicies
-}
-
 func (s snapshot) RateLimitConfigs() []LabeledRateLimitConfigSet {
 	return s.rateLimitConfigs
 }
@@ -1213,6 +1305,25 @@ func (s snapshot) MarshalJSON() ([]byte, error) {
 	}
 	snapshotMap["xdsConfigs"] = xdsConfigSet.List()
 
+	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
+	for _, set := range s.authorizationPolicies {
+		for _, obj := range set.Set().UnsortedList() {
+			// redact secret data from the snapshot
+			obj := snapshotutils.RedactSecretData(obj)
+			authorizationPolicySet.Insert(obj.(*security_istio_io_v1beta1.AuthorizationPolicy))
+		}
+	}
+	snapshotMap["authorizationPolicies"] = authorizationPolicySet.List()
+	requestAuthenticationSet := security_istio_io_v1beta1_sets.NewRequestAuthenticationSet()
+	for _, set := range s.requestAuthentications {
+		for _, obj := range set.Set().UnsortedList() {
+			// redact secret data from the snapshot
+			obj := snapshotutils.RedactSecretData(obj)
+			requestAuthenticationSet.Insert(obj.(*security_istio_io_v1beta1.RequestAuthentication))
+		}
+	}
+	snapshotMap["requestAuthentications"] = requestAuthenticationSet.List()
+
 	destinationRuleSet := networking_istio_io_v1alpha3_sets.NewDestinationRuleSet()
 	for _, set := range s.destinationRules {
 		for _, obj := range set.Set().UnsortedList() {
@@ -1268,16 +1379,6 @@ func (s snapshot) MarshalJSON() ([]byte, error) {
 	}
 	snapshotMap["sidecars"] = sidecarSet.List()
 
-	authorizationPolicySet := security_istio_io_v1beta1_sets.NewAuthorizationPolicySet()
-	for _, set := range s.authorizationPolicies {
-		for _, obj := range set.Set().UnsortedList() {
-			// redact secret data from the snapshot
-			obj := snapshotutils.RedactSecretData(obj)
-			authorizationPolicySet.Insert(obj.(*security_istio_io_v1beta1.AuthorizationPolicy))
-		}
-	}
-	snapshotMap["authorizationPolicies"] = authorizationPolicySet.List()
-
 	rateLimitConfigSet := ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet()
 	for _, set := range s.rateLimitConfigs {
 		for _, obj := range set.Set().UnsortedList() {
@@ -1365,48 +1466,192 @@ func (l labeledIssuedCertificateSet) Generic() output.ResourceList {
 	}
 }
 
-// LabeledPodBounceDirectiveSet represents a set of podBounceDirectives
+// LabeledPodBounceDirectiveSet represents a set of podBounceDirectives
+// which share a common set of labels.
+// These labels are used to find diffs between PodBounceDirectiveSets.
+type LabeledPodBounceDirectiveSet interface {
+	// returns the set of Labels shared by this PodBounceDirectiveSet
+	Labels() map[string]string
+
+	// returns the set of PodBounceDirectivees with the given labels
+	Set() certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledPodBounceDirectiveSet struct {
+	set    certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet
+	labels map[string]string
+}
+
+func NewLabeledPodBounceDirectiveSet(set certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet, labels map[string]string) (LabeledPodBounceDirectiveSet, error) {
+	// validate that each PodBounceDirective contains the labels, else this is not a valid LabeledPodBounceDirectiveSet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on PodBounceDirective %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledPodBounceDirectiveSet{set: set, labels: labels}, nil
+}
+
+func (l *labeledPodBounceDirectiveSet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledPodBounceDirectiveSet) Set() certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet {
+	return l.set
+}
+
+func (l labeledPodBounceDirectiveSet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list certificates_mesh_gloo_solo_io_v1.PodBounceDirectiveList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources: desiredResources,
+		ListFunc:  listFunc,
+		GVK: schema.GroupVersionKind{
+			Group:   "certificates.mesh.gloo.solo.io",
+			Version: "v1",
+			Kind:    "PodBounceDirective",
+		},
+	}
+}
+
+// LabeledXdsConfigSet represents a set of xdsConfigs
+// which share a common set of labels.
+// These labels are used to find diffs between XdsConfigSets.
+type LabeledXdsConfigSet interface {
+	// returns the set of Labels shared by this XdsConfigSet
+	Labels() map[string]string
+
+	// returns the set of XdsConfiges with the given labels
+	Set() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
+
+	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
+	Generic() output.ResourceList
+}
+
+type labeledXdsConfigSet struct {
+	set    xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
+	labels map[string]string
+}
+
+func NewLabeledXdsConfigSet(set xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet, labels map[string]string) (LabeledXdsConfigSet, error) {
+	// validate that each XdsConfig contains the labels, else this is not a valid LabeledXdsConfigSet
+	for _, item := range set.List() {
+		for k, v := range labels {
+			// k=v must be present in the item
+			if item.Labels[k] != v {
+				return nil, eris.Errorf("internal error: %v=%v missing on XdsConfig %v", k, v, item.Name)
+			}
+		}
+	}
+
+	return &labeledXdsConfigSet{set: set, labels: labels}, nil
+}
+
+func (l *labeledXdsConfigSet) Labels() map[string]string {
+	return l.labels
+}
+
+func (l *labeledXdsConfigSet) Set() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet {
+	return l.set
+}
+
+func (l labeledXdsConfigSet) Generic() output.ResourceList {
+	var desiredResources []ezkube.Object
+	for _, desired := range l.set.List() {
+		desiredResources = append(desiredResources, desired)
+	}
+
+	// enable list func for garbage collection
+	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
+		var list xds_agent_enterprise_mesh_gloo_solo_io_v1beta1.XdsConfigList
+		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
+			return nil, err
+		}
+		var items []ezkube.Object
+		for _, item := range list.Items {
+			item := item // pike
+			items = append(items, &item)
+		}
+		return items, nil
+	}
+
+	return output.ResourceList{
+		Resources: desiredResources,
+		ListFunc:  listFunc,
+		GVK: schema.GroupVersionKind{
+			Group:   "xds.agent.enterprise.mesh.gloo.solo.io",
+			Version: "v1beta1",
+			Kind:    "XdsConfig",
+		},
+	}
+}
+
+// LabeledAuthorizationPolicySet represents a set of authorizationPolicies
 // which share a common set of labels.
-// These labels are used to find diffs between PodBounceDirectiveSets.
-type LabeledPodBounceDirectiveSet interface {
-	// returns the set of Labels shared by this PodBounceDirectiveSet
+// These labels are used to find diffs between AuthorizationPolicySets.
+type LabeledAuthorizationPolicySet interface {
+	// returns the set of Labels shared by this AuthorizationPolicySet
 	Labels() map[string]string
 
-	// returns the set of PodBounceDirectivees with the given labels
-	Set() certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet
+	// returns the set of AuthorizationPolicyes with the given labels
+	Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet
 
 	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
 	Generic() output.ResourceList
 }
 
-type labeledPodBounceDirectiveSet struct {
-	set    certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet
+type labeledAuthorizationPolicySet struct {
+	set    security_istio_io_v1beta1_sets.AuthorizationPolicySet
 	labels map[string]string
 }
 
-func NewLabeledPodBounceDirectiveSet(set certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet, labels map[string]string) (LabeledPodBounceDirectiveSet, error) {
-	// validate that each PodBounceDirective contains the labels, else this is not a valid LabeledPodBounceDirectiveSet
+func NewLabeledAuthorizationPolicySet(set security_istio_io_v1beta1_sets.AuthorizationPolicySet, labels map[string]string) (LabeledAuthorizationPolicySet, error) {
+	// validate that each AuthorizationPolicy contains the labels, else this is not a valid LabeledAuthorizationPolicySet
 	for _, item := range set.List() {
 		for k, v := range labels {
 			// k=v must be present in the item
 			if item.Labels[k] != v {
-				return nil, eris.Errorf("internal error: %v=%v missing on PodBounceDirective %v", k, v, item.Name)
+				return nil, eris.Errorf("internal error: %v=%v missing on AuthorizationPolicy %v", k, v, item.Name)
 			}
 		}
 	}
 
-	return &labeledPodBounceDirectiveSet{set: set, labels: labels}, nil
+	return &labeledAuthorizationPolicySet{set: set, labels: labels}, nil
 }
 
-func (l *labeledPodBounceDirectiveSet) Labels() map[string]string {
+func (l *labeledAuthorizationPolicySet) Labels() map[string]string {
 	return l.labels
 }
 
-func (l *labeledPodBounceDirectiveSet) Set() certificates_mesh_gloo_solo_io_v1_sets.PodBounceDirectiveSet {
+func (l *labeledAuthorizationPolicySet) Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
 	return l.set
 }
 
-func (l labeledPodBounceDirectiveSet) Generic() output.ResourceList {
+func (l labeledAuthorizationPolicySet) Generic() output.ResourceList {
 	var desiredResources []ezkube.Object
 	for _, desired := range l.set.List() {
 		desiredResources = append(desiredResources, desired)
@@ -1414,7 +1659,7 @@ func (l labeledPodBounceDirectiveSet) Generic() output.ResourceList {
 
 	// enable list func for garbage collection
 	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
-		var list certificates_mesh_gloo_solo_io_v1.PodBounceDirectiveList
+		var list security_istio_io_v1beta1.AuthorizationPolicyList
 		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
 			return nil, err
 		}
@@ -1430,55 +1675,55 @@ func (l labeledPodBounceDirectiveSet) Generic() output.ResourceList {
 		Resources: desiredResources,
 		ListFunc:  listFunc,
 		GVK: schema.GroupVersionKind{
-			Group:   "certificates.mesh.gloo.solo.io",
-			Version: "v1",
-			Kind:    "PodBounceDirective",
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
 		},
 	}
 }
 
-// LabeledXdsConfigSet represents a set of xdsConfigs
+// LabeledRequestAuthenticationSet represents a set of requestAuthentications
 // which share a common set of labels.
-// These labels are used to find diffs between XdsConfigSets.
-type LabeledXdsConfigSet interface {
-	// returns the set of Labels shared by this XdsConfigSet
+// These labels are used to find diffs between RequestAuthenticationSets.
+type LabeledRequestAuthenticationSet interface {
+	// returns the set of Labels shared by this RequestAuthenticationSet
 	Labels() map[string]string
 
-	// returns the set of XdsConfiges with the given labels
-	Set() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
+	// returns the set of RequestAuthenticationes with the given labels
+	Set() security_istio_io_v1beta1_sets.RequestAuthenticationSet
 
 	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
 	Generic() output.ResourceList
 }
 
-type labeledXdsConfigSet struct {
-	set    xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
+type labeledRequestAuthenticationSet struct {
+	set    security_istio_io_v1beta1_sets.RequestAuthenticationSet
 	labels map[string]string
 }
 
-func NewLabeledXdsConfigSet(set xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet, labels map[string]string) (LabeledXdsConfigSet, error) {
-	// validate that each XdsConfig contains the labels, else this is not a valid LabeledXdsConfigSet
+func NewLabeledRequestAuthenticationSet(set security_istio_io_v1beta1_sets.RequestAuthenticationSet, labels map[string]string) (LabeledRequestAuthenticationSet, error) {
+	// validate that each RequestAuthentication contains the labels, else this is not a valid LabeledRequestAuthenticationSet
 	for _, item := range set.List() {
 		for k, v := range labels {
 			// k=v must be present in the item
 			if item.Labels[k] != v {
-				return nil, eris.Errorf("internal error: %v=%v missing on XdsConfig %v", k, v, item.Name)
+				return nil, eris.Errorf("internal error: %v=%v missing on RequestAuthentication %v", k, v, item.Name)
 			}
 		}
 	}
 
-	return &labeledXdsConfigSet{set: set, labels: labels}, nil
+	return &labeledRequestAuthenticationSet{set: set, labels: labels}, nil
 }
 
-func (l *labeledXdsConfigSet) Labels() map[string]string {
+func (l *labeledRequestAuthenticationSet) Labels() map[string]string {
 	return l.labels
 }
 
-func (l *labeledXdsConfigSet) Set() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet {
+func (l *labeledRequestAuthenticationSet) Set() security_istio_io_v1beta1_sets.RequestAuthenticationSet {
 	return l.set
 }
 
-func (l labeledXdsConfigSet) Generic() output.ResourceList {
+func (l labeledRequestAuthenticationSet) Generic() output.ResourceList {
 	var desiredResources []ezkube.Object
 	for _, desired := range l.set.List() {
 		desiredResources = append(desiredResources, desired)
@@ -1486,7 +1731,7 @@ func (l labeledXdsConfigSet) Generic() output.ResourceList {
 
 	// enable list func for garbage collection
 	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
-		var list xds_agent_enterprise_mesh_gloo_solo_io_v1beta1.XdsConfigList
+		var list security_istio_io_v1beta1.RequestAuthenticationList
 		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
 			return nil, err
 		}
@@ -1502,9 +1747,9 @@ func (l labeledXdsConfigSet) Generic() output.ResourceList {
 		Resources: desiredResources,
 		ListFunc:  listFunc,
 		GVK: schema.GroupVersionKind{
-			Group:   "xds.agent.enterprise.mesh.gloo.solo.io",
+			Group:   "security.istio.io",
 			Version: "v1beta1",
-			Kind:    "XdsConfig",
+			Kind:    "RequestAuthentication",
 		},
 	}
 }
@@ -1941,78 +2186,6 @@ func (l labeledSidecarSet) Generic() output.ResourceList {
 	}
 }
 
-// LabeledAuthorizationPolicySet represents a set of authorizationPolicies
-// which share a common set of labels.
-// These labels are used to find diffs between AuthorizationPolicySets.
-type LabeledAuthorizationPolicySet interface {
-	// returns the set of Labels shared by this AuthorizationPolicySet
-	Labels() map[string]string
-
-	// returns the set of AuthorizationPolicyes with the given labels
-	Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
-	// converts the set to a generic format which can be applied by the Snapshot.Apply functions
-	Generic() output.ResourceList
-}
-
-type labeledAuthorizationPolicySet struct {
-	set    security_istio_io_v1beta1_sets.AuthorizationPolicySet
-	labels map[string]string
-}
-
-func NewLabeledAuthorizationPolicySet(set security_istio_io_v1beta1_sets.AuthorizationPolicySet, labels map[string]string) (LabeledAuthorizationPolicySet, error) {
-	// validate that each AuthorizationPolicy contains the labels, else this is not a valid LabeledAuthorizationPolicySet
-	for _, item := range set.List() {
-		for k, v := range labels {
-			// k=v must be present in the item
-			if item.Labels[k] != v {
-				return nil, eris.Errorf("internal error: %v=%v missing on AuthorizationPolicy %v", k, v, item.Name)
-			}
-		}
-	}
-
-	return &labeledAuthorizationPolicySet{set: set, labels: labels}, nil
-}
-
-func (l *labeledAuthorizationPolicySet) Labels() map[string]string {
-	return l.labels
-}
-
-func (l *labeledAuthorizationPolicySet) Set() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
-	return l.set
-}
-
-func (l labeledAuthorizationPolicySet) Generic() output.ResourceList {
-	var desiredResources []ezkube.Object
-	for _, desired := range l.set.List() {
-		desiredResources = append(desiredResources, desired)
-	}
-
-	// enable list func for garbage collection
-	listFunc := func(ctx context.Context, cli client.Client) ([]ezkube.Object, error) {
-		var list security_istio_io_v1beta1.AuthorizationPolicyList
-		if err := cli.List(ctx, &list, client.MatchingLabels(l.labels)); err != nil {
-			return nil, err
-		}
-		var items []ezkube.Object
-		for _, item := range list.Items {
-			item := item // pike
-			items = append(items, &item)
-		}
-		return items, nil
-	}
-
-	return output.ResourceList{
-		Resources: desiredResources,
-		ListFunc:  listFunc,
-		GVK: schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		},
-	}
-}
-
 // LabeledRateLimitConfigSet represents a set of rateLimitConfigs
 // which share a common set of labels.
 // These labels are used to find diffs between RateLimitConfigSets.
@@ -2095,6 +2268,9 @@ type builder struct {
 
 	xdsConfigs xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	authorizationPolicies  security_istio_io_v1beta1_sets.AuthorizationPolicySet
+	requestAuthentications security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	destinationRules networking_istio_io_v1alpha3_sets.DestinationRuleSet
 	envoyFilters     networking_istio_io_v1alpha3_sets.EnvoyFilterSet
 	gateways         networking_istio_io_v1alpha3_sets.GatewaySet
@@ -2102,8 +2278,6 @@ type builder struct {
 	virtualServices  networking_istio_io_v1alpha3_sets.VirtualServiceSet
 	sidecars         networking_istio_io_v1alpha3_sets.SidecarSet
 
-	authorizationPolicies security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
 	rateLimitConfigs ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet
 }
 
@@ -2117,6 +2291,9 @@ func NewBuilder(ctx context.Context, name string) *builder {
 
 		xdsConfigs: xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.NewXdsConfigSet(),
 
+		authorizationPolicies:  security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
+		requestAuthentications: security_istio_io_v1beta1_sets.NewRequestAuthenticationSet(),
+
 		destinationRules: networking_istio_io_v1alpha3_sets.NewDestinationRuleSet(),
 		envoyFilters:     networking_istio_io_v1alpha3_sets.NewEnvoyFilterSet(),
 		gateways:         networking_istio_io_v1alpha3_sets.NewGatewaySet(),
@@ -2124,8 +2301,6 @@ func NewBuilder(ctx context.Context, name string) *builder {
 		virtualServices:  networking_istio_io_v1alpha3_sets.NewVirtualServiceSet(),
 		sidecars:         networking_istio_io_v1alpha3_sets.NewSidecarSet(),
 
-		authorizationPolicies: security_istio_io_v1beta1_sets.NewAuthorizationPolicySet(),
-
 		rateLimitConfigs: ratelimit_solo_io_v1alpha1_sets.NewRateLimitConfigSet(),
 	}
 }
@@ -2152,6 +2327,18 @@ type Builder interface {
 	// get the collected XdsConfigs
 	GetXdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1_sets.XdsConfigSet
 
+	// add AuthorizationPolicies to the collected outputs
+	AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy)
+
+	// get the collected AuthorizationPolicies
+	GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
+
+	// add RequestAuthentications to the collected outputs
+	AddRequestAuthentications(requestAuthentications ...*security_istio_io_v1beta1.RequestAuthentication)
+
+	// get the collected RequestAuthentications
+	GetRequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet
+
 	// add DestinationRules to the collected outputs
 	AddDestinationRules(destinationRules ...*networking_istio_io_v1alpha3.DestinationRule)
 
@@ -2188,12 +2375,6 @@ type Builder interface {
 	// get the collected Sidecars
 	GetSidecars() networking_istio_io_v1alpha3_sets.SidecarSet
 
-	// add AuthorizationPolicies to the collected outputs
-	AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy)
-
-	// get the collected AuthorizationPolicies
-	GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet
-
 	// add RateLimitConfigs to the collected outputs
 	AddRateLimitConfigs(rateLimitConfigs ...*ratelimit_solo_io_v1alpha1.RateLimitConfig)
 
@@ -2250,6 +2431,22 @@ func (b *builder) AddXdsConfigs(xdsConfigs ...*xds_agent_enterprise_mesh_gloo_so
 		b.xdsConfigs.Insert(obj)
 	}
 }
+func (b *builder) AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy) {
+	for _, obj := range authorizationPolicies {
+		if obj == nil {
+			continue
+		}
+		b.authorizationPolicies.Insert(obj)
+	}
+}
+func (b *builder) AddRequestAuthentications(requestAuthentications ...*security_istio_io_v1beta1.RequestAuthentication) {
+	for _, obj := range requestAuthentications {
+		if obj == nil {
+			continue
+		}
+		b.requestAuthentications.Insert(obj)
+	}
+}
 func (b *builder) AddDestinationRules(destinationRules ...*networking_istio_io_v1alpha3.DestinationRule) {
 	for _, obj := range destinationRules {
 		if obj == nil {
@@ -2298,14 +2495,6 @@ func (b *builder) AddSidecars(sidecars ...*networking_istio_io_v1alpha3.Sidecar)
 		b.sidecars.Insert(obj)
 	}
 }
-func (b *builder) AddAuthorizationPolicies(authorizationPolicies ...*security_istio_io_v1beta1.AuthorizationPolicy) {
-	for _, obj := range authorizationPolicies {
-		if obj == nil {
-			continue
-		}
-		b.authorizationPolicies.Insert(obj)
-	}
-}
 func (b *builder) AddRateLimitConfigs(rateLimitConfigs ...*ratelimit_solo_io_v1alpha1.RateLimitConfig) {
 	for _, obj := range rateLimitConfigs {
 		if obj == nil {
@@ -2326,6 +2515,13 @@ func (b *builder) GetXdsConfigs() xds_agent_enterprise_mesh_gloo_solo_io_v1beta1
 	return b.xdsConfigs
 }
 
+func (b *builder) GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
+	return b.authorizationPolicies
+}
+func (b *builder) GetRequestAuthentications() security_istio_io_v1beta1_sets.RequestAuthenticationSet {
+	return b.requestAuthentications
+}
+
 func (b *builder) GetDestinationRules() networking_istio_io_v1alpha3_sets.DestinationRuleSet {
 	return b.destinationRules
 }
@@ -2345,10 +2541,6 @@ func (b *builder) GetSidecars() networking_istio_io_v1alpha3_sets.SidecarSet {
 	return b.sidecars
 }
 
-func (b *builder) GetAuthorizationPolicies() security_istio_io_v1beta1_sets.AuthorizationPolicySet {
-	return b.authorizationPolicies
-}
-
 func (b *builder) GetRateLimitConfigs() ratelimit_solo_io_v1alpha1_sets.RateLimitConfigSet {
 	return b.rateLimitConfigs
 }
@@ -2363,6 +2555,9 @@ func (b *builder) BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, erro
 
 		b.xdsConfigs,
 
+		b.authorizationPolicies,
+		b.requestAuthentications,
+
 		b.destinationRules,
 		b.envoyFilters,
 		b.gateways,
@@ -2370,8 +2565,6 @@ func (b *builder) BuildLabelPartitionedSnapshot(labelKey string) (Snapshot, erro
 		b.virtualServices,
 		b.sidecars,
 
-		b.authorizationPolicies,
-
 		b.rateLimitConfigs,
 		b.clusters...,
 	)
@@ -2387,6 +2580,9 @@ func (b *builder) BuildSinglePartitionedSnapshot(snapshotLabels map[string]strin
 
 		b.xdsConfigs,
 
+		b.authorizationPolicies,
+		b.requestAuthentications,
+
 		b.destinationRules,
 		b.envoyFilters,
 		b.gateways,
@@ -2394,8 +2590,6 @@ func (b *builder) BuildSinglePartitionedSnapshot(snapshotLabels map[string]strin
 		b.virtualServices,
 		b.sidecars,
 
-		b.authorizationPolicies,
-
 		b.rateLimitConfigs,
 		b.clusters...,
 	)
@@ -2419,6 +2613,9 @@ func (b *builder) Merge(other Builder) {
 
 	b.AddXdsConfigs(other.GetXdsConfigs().List()...)
 
+	b.AddAuthorizationPolicies(other.GetAuthorizationPolicies().List()...)
+	b.AddRequestAuthentications(other.GetRequestAuthentications().List()...)
+
 	b.AddDestinationRules(other.GetDestinationRules().List()...)
 	b.AddEnvoyFilters(other.GetEnvoyFilters().List()...)
 	b.AddGateways(other.GetGateways().List()...)
@@ -2426,8 +2623,6 @@ func (b *builder) Merge(other Builder) {
 	b.AddVirtualServices(other.GetVirtualServices().List()...)
 	b.AddSidecars(other.GetSidecars().List()...)
 
-	b.AddAuthorizationPolicies(other.GetAuthorizationPolicies().List()...)
-
 	b.AddRateLimitConfigs(other.GetRateLimitConfigs().List()...)
 	for _, cluster := range other.Clusters() {
 		b.AddCluster(cluster)
@@ -2451,6 +2646,13 @@ func (b *builder) Clone() Builder {
 		clone.AddXdsConfigs(xdsConfig.DeepCopy())
 	}
 
+	for _, authorizationPolicy := range b.GetAuthorizationPolicies().List() {
+		clone.AddAuthorizationPolicies(authorizationPolicy.DeepCopy())
+	}
+	for _, requestAuthentication := range b.GetRequestAuthentications().List() {
+		clone.AddRequestAuthentications(requestAuthentication.DeepCopy())
+	}
+
 	for _, destinationRule := range b.GetDestinationRules().List() {
 		clone.AddDestinationRules(destinationRule.DeepCopy())
 	}
@@ -2470,10 +2672,6 @@ func (b *builder) Clone() Builder {
 		clone.AddSidecars(sidecar.DeepCopy())
 	}
 
-	for _, authorizationPolicy := range b.GetAuthorizationPolicies().List() {
-		clone.AddAuthorizationPolicies(authorizationPolicy.DeepCopy())
-	}
-
 	for _, rateLimitConfig := range b.GetRateLimitConfigs().List() {
 		clone.AddRateLimitConfigs(rateLimitConfig.DeepCopy())
 	}
@@ -2519,6 +2717,25 @@ func (b *builder) Generic() resource.ClusterSnapshot {
 		clusterSnapshots.Insert(cluster, gvk, obj)
 	}
 
+	for _, obj := range b.GetAuthorizationPolicies().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+	for _, obj := range b.GetRequestAuthentications().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+		clusterSnapshots.Insert(cluster, gvk, obj)
+	}
+
 	for _, obj := range b.GetDestinationRules().List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
@@ -2574,16 +2791,6 @@ func (b *builder) Generic() resource.ClusterSnapshot {
 		clusterSnapshots.Insert(cluster, gvk, obj)
 	}
 
-	for _, obj := range b.GetAuthorizationPolicies().List() {
-		cluster := obj.GetClusterName()
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-		clusterSnapshots.Insert(cluster, gvk, obj)
-	}
-
 	for _, obj := range b.GetRateLimitConfigs().List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
@@ -2632,6 +2839,25 @@ func (b *builder) ForEachObject(handleObject func(cluster string, gvk schema.Gro
 		handleObject(cluster, gvk, obj)
 	}
 
+	for _, obj := range b.GetAuthorizationPolicies().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "AuthorizationPolicy",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+	for _, obj := range b.GetRequestAuthentications().List() {
+		cluster := obj.GetClusterName()
+		gvk := schema.GroupVersionKind{
+			Group:   "security.istio.io",
+			Version: "v1beta1",
+			Kind:    "RequestAuthentication",
+		}
+		handleObject(cluster, gvk, obj)
+	}
+
 	for _, obj := range b.GetDestinationRules().List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
@@ -2687,16 +2913,6 @@ func (b *builder) ForEachObject(handleObject func(cluster string, gvk schema.Gro
 		handleObject(cluster, gvk, obj)
 	}
 
-	for _, obj := range b.GetAuthorizationPolicies().List() {
-		cluster := obj.GetClusterName()
-		gvk := schema.GroupVersionKind{
-			Group:   "security.istio.io",
-			Version: "v1beta1",
-			Kind:    "AuthorizationPolicy",
-		}
-		handleObject(cluster, gvk, obj)
-	}
-
 	for _, obj := range b.GetRateLimitConfigs().List() {
 		cluster := obj.GetClusterName()
 		gvk := schema.GroupVersionKind{
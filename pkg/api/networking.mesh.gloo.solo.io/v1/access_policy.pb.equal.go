@@ -113,6 +113,53 @@ func (m *AccessPolicySpec) Equal(that interface{}) bool {
 
 	}
 
+	if m.GetAction() != target.GetAction() {
+		return false
+	}
+
+	if len(m.GetNotPaths()) != len(target.GetNotPaths()) {
+		return false
+	}
+	for idx, v := range m.GetNotPaths() {
+
+		if strings.Compare(v, target.GetNotPaths()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotMethods()) != len(target.GetNotMethods()) {
+		return false
+	}
+	for idx, v := range m.GetNotMethods() {
+
+		if strings.Compare(v, target.GetNotMethods()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotPorts()) != len(target.GetNotPorts()) {
+		return false
+	}
+	for idx, v := range m.GetNotPorts() {
+
+		if v != target.GetNotPorts()[idx] {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetConditions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConditions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConditions(), target.GetConditions()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -186,3 +233,223 @@ func (m *AccessPolicyStatus) Equal(that interface{}) bool {
 
 	return true
 }
+
+// Equal function
+func (m *AccessPolicySpec_Conditions) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Conditions)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Conditions)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetClaims()) != len(target.GetClaims()) {
+		return false
+	}
+	for idx, v := range m.GetClaims() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetClaims()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetClaims()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetIpBlocks()) != len(target.GetIpBlocks()) {
+		return false
+	}
+	for idx, v := range m.GetIpBlocks() {
+
+		if strings.Compare(v, target.GetIpBlocks()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotIpBlocks()) != len(target.GetNotIpBlocks()) {
+		return false
+	}
+	for idx, v := range m.GetNotIpBlocks() {
+
+		if strings.Compare(v, target.GetNotIpBlocks()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetRemoteIpBlocks()) != len(target.GetRemoteIpBlocks()) {
+		return false
+	}
+	for idx, v := range m.GetRemoteIpBlocks() {
+
+		if strings.Compare(v, target.GetRemoteIpBlocks()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotRemoteIpBlocks()) != len(target.GetNotRemoteIpBlocks()) {
+		return false
+	}
+	for idx, v := range m.GetNotRemoteIpBlocks() {
+
+		if strings.Compare(v, target.GetNotRemoteIpBlocks()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetHeaders()) != len(target.GetHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetSni()) != len(target.GetSni()) {
+		return false
+	}
+	for idx, v := range m.GetSni() {
+
+		if strings.Compare(v, target.GetSni()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AccessPolicySpec_Conditions_ClaimCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Conditions_ClaimCondition)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Conditions_ClaimCondition)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetPath()) != len(target.GetPath()) {
+		return false
+	}
+	for idx, v := range m.GetPath() {
+
+		if strings.Compare(v, target.GetPath()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetValues()) != len(target.GetValues()) {
+		return false
+	}
+	for idx, v := range m.GetValues() {
+
+		if strings.Compare(v, target.GetValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotValues()) != len(target.GetNotValues()) {
+		return false
+	}
+	for idx, v := range m.GetNotValues() {
+
+		if strings.Compare(v, target.GetNotValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
+
+// Equal function
+func (m *AccessPolicySpec_Conditions_HeaderCondition) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*AccessPolicySpec_Conditions_HeaderCondition)
+	if !ok {
+		that2, ok := that.(AccessPolicySpec_Conditions_HeaderCondition)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if len(m.GetValues()) != len(target.GetValues()) {
+		return false
+	}
+	for idx, v := range m.GetValues() {
+
+		if strings.Compare(v, target.GetValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetNotValues()) != len(target.GetNotValues()) {
+		return false
+	}
+	for idx, v := range m.GetNotValues() {
+
+		if strings.Compare(v, target.GetNotValues()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	return true
+}
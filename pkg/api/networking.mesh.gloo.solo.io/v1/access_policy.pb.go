@@ -28,6 +28,60 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// The action taken on requests matching an AccessPolicy.
+type AccessPolicySpec_Action int32
+
+const (
+	// Allow matching requests.
+	AccessPolicySpec_ALLOW AccessPolicySpec_Action = 0
+	// Deny matching requests.
+	AccessPolicySpec_DENY AccessPolicySpec_Action = 1
+	// Audit matching requests, without affecting whether they are allowed or denied.
+	// Requires an audit provider to be configured for the mesh.
+	AccessPolicySpec_AUDIT AccessPolicySpec_Action = 2
+)
+
+// Enum value maps for AccessPolicySpec_Action.
+var (
+	AccessPolicySpec_Action_name = map[int32]string{
+		0: "ALLOW",
+		1: "DENY",
+		2: "AUDIT",
+	}
+	AccessPolicySpec_Action_value = map[string]int32{
+		"ALLOW": 0,
+		"DENY":  1,
+		"AUDIT": 2,
+	}
+)
+
+func (x AccessPolicySpec_Action) Enum() *AccessPolicySpec_Action {
+	p := new(AccessPolicySpec_Action)
+	*p = x
+	return p
+}
+
+func (x AccessPolicySpec_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessPolicySpec_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (AccessPolicySpec_Action) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes[0]
+}
+
+func (x AccessPolicySpec_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Action.Descriptor instead.
+func (AccessPolicySpec_Action) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0}
+}
+
 // Grants communication permission between selected identities (i.e. traffic sources) and Destinations (i.e. destinations).
 // Explicitly granted access permission is required if a
 // [VirtualMesh's GlobalAccessPolicy]({{% versioned_link_path fromRoot="/reference/api/virtual_mesh/#networking.mesh.gloo.solo.io.VirtualMeshSpec.GlobalAccessPolicy" %}})
@@ -62,6 +116,26 @@ type AccessPolicySpec struct {
 	//Optional. A list of ports which to allow.
 	//If not set any port is allowed.
 	AllowedPorts []uint32 `protobuf:"varint,5,rep,packed,name=allowed_ports,json=allowedPorts,proto3" json:"allowed_ports,omitempty"`
+	// The action to take on requests which match this AccessPolicy. Defaults to `ALLOW`.
+	// Requests are denied if they match any `DENY` AccessPolicy, regardless of the `ALLOW` AccessPolicies applied to the Destination.
+	// Only `ALLOW` is supported for SMI meshes.
+	Action AccessPolicySpec_Action `protobuf:"varint,6,opt,name=action,proto3,enum=networking.mesh.gloo.solo.io.AccessPolicySpec_Action" json:"action,omitempty"`
+	//
+	// Optional. A list of HTTP paths or gRPC methods which requests must not match, using the same format as `allowed_paths`.
+	// Not supported for SMI meshes.
+	NotPaths []string `protobuf:"bytes,7,rep,name=not_paths,json=notPaths,proto3" json:"not_paths,omitempty"`
+	//
+	// Optional. A list of HTTP methods which requests must not match.
+	// Not supported for SMI meshes.
+	NotMethods []string `protobuf:"bytes,8,rep,name=not_methods,json=notMethods,proto3" json:"not_methods,omitempty"`
+	//
+	// Optional. A list of ports which requests must not match.
+	// Not supported for SMI meshes.
+	NotPorts []uint32 `protobuf:"varint,9,rep,packed,name=not_ports,json=notPorts,proto3" json:"not_ports,omitempty"`
+	//
+	// Optional. Additional conditions which requests must satisfy to match this AccessPolicy.
+	// Not supported for SMI meshes.
+	Conditions *AccessPolicySpec_Conditions `protobuf:"bytes,10,opt,name=conditions,proto3" json:"conditions,omitempty"`
 }
 
 func (x *AccessPolicySpec) Reset() {
@@ -131,6 +205,41 @@ func (x *AccessPolicySpec) GetAllowedPorts() []uint32 {
 	return nil
 }
 
+func (x *AccessPolicySpec) GetAction() AccessPolicySpec_Action {
+	if x != nil {
+		return x.Action
+	}
+	return AccessPolicySpec_ALLOW
+}
+
+func (x *AccessPolicySpec) GetNotPaths() []string {
+	if x != nil {
+		return x.NotPaths
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec) GetNotMethods() []string {
+	if x != nil {
+		return x.NotMethods
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec) GetNotPorts() []uint32 {
+	if x != nil {
+		return x.NotPorts
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec) GetConditions() *AccessPolicySpec_Conditions {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
 type AccessPolicyStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -219,6 +328,245 @@ func (x *AccessPolicyStatus) GetErrors() []string {
 	return nil
 }
 
+// Conditions on the attributes of a request. All specified conditions must be satisfied for a match to occur.
+type AccessPolicySpec_Conditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify conditions on the claims of the request's JWT, which requires the request to be authenticated.
+	Claims []*AccessPolicySpec_Conditions_ClaimCondition `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims,omitempty"`
+	// Specify IP addresses or CIDR blocks (e.g. `10.0.0.0/8`) which the source IP of the connection must match.
+	IpBlocks []string `protobuf:"bytes,2,rep,name=ip_blocks,json=ipBlocks,proto3" json:"ip_blocks,omitempty"`
+	// Specify IP addresses or CIDR blocks which the source IP of the connection must not match.
+	NotIpBlocks []string `protobuf:"bytes,3,rep,name=not_ip_blocks,json=notIpBlocks,proto3" json:"not_ip_blocks,omitempty"`
+	// Specify IP addresses or CIDR blocks which the original client IP of the request, as determined by the
+	// `X-Forwarded-For` header or the proxy protocol, must match.
+	RemoteIpBlocks []string `protobuf:"bytes,4,rep,name=remote_ip_blocks,json=remoteIpBlocks,proto3" json:"remote_ip_blocks,omitempty"`
+	// Specify IP addresses or CIDR blocks which the original client IP of the request must not match.
+	NotRemoteIpBlocks []string `protobuf:"bytes,5,rep,name=not_remote_ip_blocks,json=notRemoteIpBlocks,proto3" json:"not_remote_ip_blocks,omitempty"`
+	// Specify conditions on the request's headers.
+	Headers []*AccessPolicySpec_Conditions_HeaderCondition `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty"`
+	// Specify the Server Name Indication (SNI) values, one of which the TLS connection must present.
+	Sni []string `protobuf:"bytes,7,rep,name=sni,proto3" json:"sni,omitempty"`
+}
+
+func (x *AccessPolicySpec_Conditions) Reset() {
+	*x = AccessPolicySpec_Conditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Conditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Conditions) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Conditions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Conditions.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Conditions) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *AccessPolicySpec_Conditions) GetClaims() []*AccessPolicySpec_Conditions_ClaimCondition {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions) GetIpBlocks() []string {
+	if x != nil {
+		return x.IpBlocks
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions) GetNotIpBlocks() []string {
+	if x != nil {
+		return x.NotIpBlocks
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions) GetRemoteIpBlocks() []string {
+	if x != nil {
+		return x.RemoteIpBlocks
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions) GetNotRemoteIpBlocks() []string {
+	if x != nil {
+		return x.NotRemoteIpBlocks
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions) GetHeaders() []*AccessPolicySpec_Conditions_HeaderCondition {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions) GetSni() []string {
+	if x != nil {
+		return x.Sni
+	}
+	return nil
+}
+
+// A condition on a JWT claim. At least one of `values` and `not_values` must be set.
+type AccessPolicySpec_Conditions_ClaimCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the claim, e.g. `["groups"]`, or `["realm_access", "roles"]` for a nested claim. Required.
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// The claim must match one of these values. If the claim is a list, one of its elements must match.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The claim must not match any of these values.
+	NotValues []string `protobuf:"bytes,3,rep,name=not_values,json=notValues,proto3" json:"not_values,omitempty"`
+}
+
+func (x *AccessPolicySpec_Conditions_ClaimCondition) Reset() {
+	*x = AccessPolicySpec_Conditions_ClaimCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Conditions_ClaimCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Conditions_ClaimCondition) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Conditions_ClaimCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Conditions_ClaimCondition.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Conditions_ClaimCondition) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0, 0}
+}
+
+func (x *AccessPolicySpec_Conditions_ClaimCondition) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions_ClaimCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions_ClaimCondition) GetNotValues() []string {
+	if x != nil {
+		return x.NotValues
+	}
+	return nil
+}
+
+// A condition on a request header. At least one of `values` and `not_values` must be set.
+// Values support exact, prefix (`value*`), suffix (`*value`), and presence (`*`) matches.
+type AccessPolicySpec_Conditions_HeaderCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the header. Required.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The header must match one of these values.
+	Values []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// The header must not match any of these values.
+	NotValues []string `protobuf:"bytes,3,rep,name=not_values,json=notValues,proto3" json:"not_values,omitempty"`
+}
+
+func (x *AccessPolicySpec_Conditions_HeaderCondition) Reset() {
+	*x = AccessPolicySpec_Conditions_HeaderCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessPolicySpec_Conditions_HeaderCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessPolicySpec_Conditions_HeaderCondition) ProtoMessage() {}
+
+func (x *AccessPolicySpec_Conditions_HeaderCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessPolicySpec_Conditions_HeaderCondition.ProtoReflect.Descriptor instead.
+func (*AccessPolicySpec_Conditions_HeaderCondition) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescGZIP(), []int{0, 0, 1}
+}
+
+func (x *AccessPolicySpec_Conditions_HeaderCondition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccessPolicySpec_Conditions_HeaderCondition) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AccessPolicySpec_Conditions_HeaderCondition) GetNotValues() []string {
+	if x != nil {
+		return x.NotValues
+	}
+	return nil
+}
+
 var File_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto protoreflect.FileDescriptor
 
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDesc = []byte{
@@ -240,7 +588,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawD
 	0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f,
 	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
-	0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x02, 0x0a, 0x10, 0x41, 0x63, 0x63,
+	0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x09, 0x0a, 0x10, 0x41, 0x63, 0x63,
 	0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x53, 0x0a,
 	0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
@@ -260,37 +608,92 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawD
 	0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f,
 	0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x6f,
 	0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x91, 0x03, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f,
-	0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x66,
-	0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f,
-	0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
-	0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x6d, 0x0a, 0x11,
-	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x4d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x12, 0x59, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xbc, 0x04, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x60, 0x0a, 0x06, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x48, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x74, 0x5f, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6e, 0x6f, 0x74, 0x5f,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x70, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x6f, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x49, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x63, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x6e, 0x69, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6e, 0x69,
+	0x1a, 0x5b, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x5c, 0x0a,
+	0x0f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6e, 0x6f, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x6f, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x55,
+	0x44, 0x49, 0x54, 0x10, 0x02, 0x22, 0x91, 0x03, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0c,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69,
-	0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -305,27 +708,36 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_raw
 	return file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_goTypes = []interface{}{
-	(*AccessPolicySpec)(nil),       // 0: networking.mesh.gloo.solo.io.AccessPolicySpec
-	(*AccessPolicyStatus)(nil),     // 1: networking.mesh.gloo.solo.io.AccessPolicyStatus
-	nil,                            // 2: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
-	(*v1.IdentitySelector)(nil),    // 3: common.mesh.gloo.solo.io.IdentitySelector
-	(*v1.DestinationSelector)(nil), // 4: common.mesh.gloo.solo.io.DestinationSelector
-	(v1.ApprovalState)(0),          // 5: common.mesh.gloo.solo.io.ApprovalState
-	(*ApprovalStatus)(nil),         // 6: networking.mesh.gloo.solo.io.ApprovalStatus
+	(AccessPolicySpec_Action)(0),                        // 0: networking.mesh.gloo.solo.io.AccessPolicySpec.Action
+	(*AccessPolicySpec)(nil),                            // 1: networking.mesh.gloo.solo.io.AccessPolicySpec
+	(*AccessPolicyStatus)(nil),                          // 2: networking.mesh.gloo.solo.io.AccessPolicyStatus
+	(*AccessPolicySpec_Conditions)(nil),                 // 3: networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions
+	(*AccessPolicySpec_Conditions_ClaimCondition)(nil),  // 4: networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions.ClaimCondition
+	(*AccessPolicySpec_Conditions_HeaderCondition)(nil), // 5: networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions.HeaderCondition
+	nil,                            // 6: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
+	(*v1.IdentitySelector)(nil),    // 7: common.mesh.gloo.solo.io.IdentitySelector
+	(*v1.DestinationSelector)(nil), // 8: common.mesh.gloo.solo.io.DestinationSelector
+	(v1.ApprovalState)(0),          // 9: common.mesh.gloo.solo.io.ApprovalState
+	(*ApprovalStatus)(nil),         // 10: networking.mesh.gloo.solo.io.ApprovalStatus
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_depIdxs = []int32{
-	3, // 0: networking.mesh.gloo.solo.io.AccessPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.IdentitySelector
-	4, // 1: networking.mesh.gloo.solo.io.AccessPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
-	5, // 2: networking.mesh.gloo.solo.io.AccessPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
-	2, // 3: networking.mesh.gloo.solo.io.AccessPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
-	6, // 4: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7,  // 0: networking.mesh.gloo.solo.io.AccessPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.IdentitySelector
+	8,  // 1: networking.mesh.gloo.solo.io.AccessPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	0,  // 2: networking.mesh.gloo.solo.io.AccessPolicySpec.action:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Action
+	3,  // 3: networking.mesh.gloo.solo.io.AccessPolicySpec.conditions:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions
+	9,  // 4: networking.mesh.gloo.solo.io.AccessPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	6,  // 5: networking.mesh.gloo.solo.io.AccessPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry
+	4,  // 6: networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions.claims:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions.ClaimCondition
+	5,  // 7: networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions.headers:type_name -> networking.mesh.gloo.solo.io.AccessPolicySpec.Conditions.HeaderCondition
+	10, // 8: networking.mesh.gloo.solo.io.AccessPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	9,  // [9:9] is the sub-list for method output_type
+	9,  // [9:9] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_init() }
@@ -359,19 +771,56 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_ini
 				return nil
 			}
 		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Conditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Conditions_ClaimCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessPolicySpec_Conditions_HeaderCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_goTypes,
 		DependencyIndexes: file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_depIdxs,
+		EnumInfos:         file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_enumTypes,
 		MessageInfos:      file_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto_msgTypes,
 	}.Build()
 	File_github_com_solo_io_gloo_mesh_api_networking_v1_access_policy_proto = out.File
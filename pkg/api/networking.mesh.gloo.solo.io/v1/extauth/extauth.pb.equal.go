@@ -297,6 +297,44 @@ func (m *CustomAuth) Equal(that interface{}) bool {
 
 	}
 
+	if h, ok := interface{}(m.GetServerRef()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetServerRef()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetServerRef(), target.GetServerRef()) {
+			return false
+		}
+	}
+
+	if m.GetPort() != target.GetPort() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetHttpService()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttpService()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttpService(), target.GetHttpService()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetRequestTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRequestTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRequestTimeout(), target.GetRequestTimeout()) {
+			return false
+		}
+	}
+
+	if m.GetFailureModeAllow() != target.GetFailureModeAllow() {
+		return false
+	}
+
 	return true
 }
 
@@ -451,6 +451,7 @@ type isRouteExtauth_Spec interface {
 
 type RouteExtauth_Disable struct {
 	//  Set to true to disable auth on the route.
+	// A TrafficPolicy can only disable auth for all traffic to its Destinations.
 	Disable bool `protobuf:"varint,1,opt,name=disable,proto3,oneof"`
 }
 
@@ -486,6 +487,22 @@ type CustomAuth struct {
 	// [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v2/config/filter/http/ext_authz/v2/ext_authz.proto.html?highlight=ext_authz#config-filter-http-ext-authz-v2-checksettings)
 	// for more details.
 	ContextExtensions map[string]string `protobuf:"bytes,1,rep,name=context_extensions,json=contextExtensions,proto3" json:"context_extensions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Reference to the Kubernetes Service of the custom external authorization server.
+	// This field is required when extauth is applied to a Destination with a TrafficPolicy.
+	//
+	// IMPORTANT: Envoy's extauth requests go to the port of the service with the name or protocol of
+	// the extauth service protocol (i.e., grpc or http), unless `port` is specified.
+	ServerRef *v1.ClusterObjectRef `protobuf:"bytes,2,opt,name=server_ref,json=serverRef,proto3" json:"server_ref,omitempty"`
+	// The port of the external authorization server's service to send authorization requests to.
+	// Required if the service has multiple ports and none of them are named or have the protocol of the extauth service protocol.
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// If this is set, communication to the external authorization server will be via HTTP and not GRPC.
+	HttpService *HttpService `protobuf:"bytes,4,opt,name=http_service,json=httpService,proto3" json:"http_service,omitempty"`
+	// Timeout for the external authorization server to respond. Defaults to 2000ms.
+	RequestTimeout *duration.Duration `protobuf:"bytes,5,opt,name=request_timeout,json=requestTimeout,proto3" json:"request_timeout,omitempty"`
+	// In case of a failure or timeout querying the external authorization server, normally a request is denied.
+	// If this is set to true, the request will be allowed.
+	FailureModeAllow bool `protobuf:"varint,6,opt,name=failure_mode_allow,json=failureModeAllow,proto3" json:"failure_mode_allow,omitempty"`
 }
 
 func (x *CustomAuth) Reset() {
@@ -527,6 +544,41 @@ func (x *CustomAuth) GetContextExtensions() map[string]string {
 	return nil
 }
 
+func (x *CustomAuth) GetServerRef() *v1.ClusterObjectRef {
+	if x != nil {
+		return x.ServerRef
+	}
+	return nil
+}
+
+func (x *CustomAuth) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *CustomAuth) GetHttpService() *HttpService {
+	if x != nil {
+		return x.HttpService
+	}
+	return nil
+}
+
+func (x *CustomAuth) GetRequestTimeout() *duration.Duration {
+	if x != nil {
+		return x.RequestTimeout
+	}
+	return nil
+}
+
+func (x *CustomAuth) GetFailureModeAllow() bool {
+	if x != nil {
+		return x.FailureModeAllow
+	}
+	return false
+}
+
 type HttpService_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -767,7 +819,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_extauth_extauth_proto_ra
 	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
 	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x75, 0x73, 0x74,
 	0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x41, 0x75, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0xca, 0x01, 0x0a,
+	0x41, 0x75, 0x74, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0xea, 0x03, 0x0a,
 	0x0a, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x12, 0x76, 0x0a, 0x12, 0x63,
 	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74,
@@ -776,7 +828,25 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_extauth_extauth_proto_ra
 	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
 	0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
 	0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x1a, 0x44, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x78,
+	0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65,
+	0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x68,
+	0x74, 0x74, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x1a, 0x44, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x78,
 	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
@@ -816,6 +886,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_extauth_extauth_proto_go
 	nil,                            // 9: extauth.networking.mesh.gloo.solo.io.CustomAuth.ContextExtensionsEntry
 	(*v1.ObjectRef)(nil),           // 10: core.skv2.solo.io.ObjectRef
 	(*duration.Duration)(nil),      // 11: google.protobuf.Duration
+	(*v1.ClusterObjectRef)(nil),    // 12: core.skv2.solo.io.ClusterObjectRef
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_extauth_extauth_proto_depIdxs = []int32{
 	10, // 0: extauth.networking.mesh.gloo.solo.io.GatewayExtauth.extauthz_ref:type_name -> core.skv2.solo.io.ObjectRef
@@ -828,12 +899,15 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_extauth_extauth_proto_de
 	10, // 7: extauth.networking.mesh.gloo.solo.io.RouteExtauth.config_ref:type_name -> core.skv2.solo.io.ObjectRef
 	5,  // 8: extauth.networking.mesh.gloo.solo.io.RouteExtauth.custom_auth:type_name -> extauth.networking.mesh.gloo.solo.io.CustomAuth
 	9,  // 9: extauth.networking.mesh.gloo.solo.io.CustomAuth.context_extensions:type_name -> extauth.networking.mesh.gloo.solo.io.CustomAuth.ContextExtensionsEntry
-	8,  // 10: extauth.networking.mesh.gloo.solo.io.HttpService.Request.headers_to_add:type_name -> extauth.networking.mesh.gloo.solo.io.HttpService.Request.HeadersToAddEntry
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	12, // 10: extauth.networking.mesh.gloo.solo.io.CustomAuth.server_ref:type_name -> core.skv2.solo.io.ClusterObjectRef
+	2,  // 11: extauth.networking.mesh.gloo.solo.io.CustomAuth.http_service:type_name -> extauth.networking.mesh.gloo.solo.io.HttpService
+	11, // 12: extauth.networking.mesh.gloo.solo.io.CustomAuth.request_timeout:type_name -> google.protobuf.Duration
+	8,  // 13: extauth.networking.mesh.gloo.solo.io.HttpService.Request.headers_to_add:type_name -> extauth.networking.mesh.gloo.solo.io.HttpService.Request.HeadersToAddEntry
+	14, // [14:14] is the sub-list for method output_type
+	14, // [14:14] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_extauth_extauth_proto_init() }
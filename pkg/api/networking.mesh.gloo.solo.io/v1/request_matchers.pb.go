@@ -460,6 +460,116 @@ func (*DeprecatedHttpMatcher_Exact) isDeprecatedHttpMatcher_PathSpecifier() {}
 
 func (*DeprecatedHttpMatcher_Regex) isDeprecatedHttpMatcher_PathSpecifier() {}
 
+// Specify TCP connection level match criteria. All specified conditions must be satisfied for a match to occur.
+type TcpMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the port of the Destination on which connections must be received.
+	// Omit to match connections on any port of the Destination.
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *TcpMatcher) Reset() {
+	*x = TcpMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TcpMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpMatcher) ProtoMessage() {}
+
+func (x *TcpMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpMatcher.ProtoReflect.Descriptor instead.
+func (*TcpMatcher) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TcpMatcher) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// Specify TLS connection level match criteria. All specified conditions must be satisfied for a match to occur.
+type TlsMatcher struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Specify the port of the Destination on which connections must be received.
+	// Omit to match connections on any port of the Destination.
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// Specify the Server Name Indication (SNI) values the TLS handshake must present, one of which must match.
+	// Wildcard prefixes (e.g. `*.example.com`) are allowed. Required.
+	SniHosts []string `protobuf:"bytes,2,rep,name=sni_hosts,json=sniHosts,proto3" json:"sni_hosts,omitempty"`
+}
+
+func (x *TlsMatcher) Reset() {
+	*x = TlsMatcher{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TlsMatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TlsMatcher) ProtoMessage() {}
+
+func (x *TlsMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TlsMatcher.ProtoReflect.Descriptor instead.
+func (*TlsMatcher) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TlsMatcher) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *TlsMatcher) GetSniHosts() []string {
+	if x != nil {
+		return x.SniHosts
+	}
+	return nil
+}
+
 // Specify match criteria against the target URL's query parameters.
 type HttpMatcher_QueryParameterMatcher struct {
 	state         protoimpl.MessageState
@@ -477,7 +587,7 @@ type HttpMatcher_QueryParameterMatcher struct {
 func (x *HttpMatcher_QueryParameterMatcher) Reset() {
 	*x = HttpMatcher_QueryParameterMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -490,7 +600,7 @@ func (x *HttpMatcher_QueryParameterMatcher) String() string {
 func (*HttpMatcher_QueryParameterMatcher) ProtoMessage() {}
 
 func (x *HttpMatcher_QueryParameterMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +654,7 @@ type DeprecatedHttpMatcher_QueryParameterMatcher struct {
 func (x *DeprecatedHttpMatcher_QueryParameterMatcher) Reset() {
 	*x = DeprecatedHttpMatcher_QueryParameterMatcher{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -557,7 +667,7 @@ func (x *DeprecatedHttpMatcher_QueryParameterMatcher) String() string {
 func (*DeprecatedHttpMatcher_QueryParameterMatcher) ProtoMessage() {}
 
 func (x *DeprecatedHttpMatcher_QueryParameterMatcher) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -680,12 +790,18 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_r
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
 	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x10, 0x0a, 0x0e, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x73,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x20, 0x0a, 0x0a, 0x54, 0x63, 0x70, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x3d, 0x0a, 0x0a, 0x54, 0x6c,
+	0x73, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x6e, 0x69, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x6e, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -701,25 +817,27 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 }
 
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_goTypes = []interface{}{
 	(StatusCodeMatcher_Comparator)(0),                   // 0: networking.mesh.gloo.solo.io.StatusCodeMatcher.Comparator
 	(*HeaderMatcher)(nil),                               // 1: networking.mesh.gloo.solo.io.HeaderMatcher
 	(*StatusCodeMatcher)(nil),                           // 2: networking.mesh.gloo.solo.io.StatusCodeMatcher
 	(*HttpMatcher)(nil),                                 // 3: networking.mesh.gloo.solo.io.HttpMatcher
 	(*DeprecatedHttpMatcher)(nil),                       // 4: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher
-	(*HttpMatcher_QueryParameterMatcher)(nil),           // 5: networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
-	(*DeprecatedHttpMatcher_QueryParameterMatcher)(nil), // 6: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.QueryParameterMatcher
-	(*v1.StringMatch)(nil),                              // 7: common.mesh.gloo.solo.io.StringMatch
+	(*TcpMatcher)(nil),                                  // 5: networking.mesh.gloo.solo.io.TcpMatcher
+	(*TlsMatcher)(nil),                                  // 6: networking.mesh.gloo.solo.io.TlsMatcher
+	(*HttpMatcher_QueryParameterMatcher)(nil),           // 7: networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
+	(*DeprecatedHttpMatcher_QueryParameterMatcher)(nil), // 8: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.QueryParameterMatcher
+	(*v1.StringMatch)(nil),                              // 9: common.mesh.gloo.solo.io.StringMatch
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_depIdxs = []int32{
 	0, // 0: networking.mesh.gloo.solo.io.StatusCodeMatcher.comparator:type_name -> networking.mesh.gloo.solo.io.StatusCodeMatcher.Comparator
-	7, // 1: networking.mesh.gloo.solo.io.HttpMatcher.uri:type_name -> common.mesh.gloo.solo.io.StringMatch
+	9, // 1: networking.mesh.gloo.solo.io.HttpMatcher.uri:type_name -> common.mesh.gloo.solo.io.StringMatch
 	1, // 2: networking.mesh.gloo.solo.io.HttpMatcher.headers:type_name -> networking.mesh.gloo.solo.io.HeaderMatcher
-	5, // 3: networking.mesh.gloo.solo.io.HttpMatcher.query_parameters:type_name -> networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
-	7, // 4: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.uri:type_name -> common.mesh.gloo.solo.io.StringMatch
+	7, // 3: networking.mesh.gloo.solo.io.HttpMatcher.query_parameters:type_name -> networking.mesh.gloo.solo.io.HttpMatcher.QueryParameterMatcher
+	9, // 4: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.uri:type_name -> common.mesh.gloo.solo.io.StringMatch
 	1, // 5: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.headers:type_name -> networking.mesh.gloo.solo.io.HeaderMatcher
-	6, // 6: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.query_parameters:type_name -> networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.QueryParameterMatcher
+	8, // 6: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.query_parameters:type_name -> networking.mesh.gloo.solo.io.DeprecatedHttpMatcher.QueryParameterMatcher
 	7, // [7:7] is the sub-list for method output_type
 	7, // [7:7] is the sub-list for method input_type
 	7, // [7:7] is the sub-list for extension type_name
@@ -782,7 +900,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HttpMatcher_QueryParameterMatcher); i {
+			switch v := v.(*TcpMatcher); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -794,6 +912,30 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TlsMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpMatcher_QueryParameterMatcher); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeprecatedHttpMatcher_QueryParameterMatcher); i {
 			case 0:
 				return &v.state
@@ -817,7 +959,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_request_matchers_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
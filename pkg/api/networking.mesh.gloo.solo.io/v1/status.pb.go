@@ -39,7 +39,9 @@ type ApprovalStatus struct {
 	// will have an acceptance_order of 0, the second 1, etc.
 	// When conflicts are detected in the system,
 	// the Policy with the lowest acceptance_order
-	// will be chosen and all other conflicting policies will be rejected.
+	// will be chosen and all other conflicting policies will be rejected,
+	// unless the policies specify different priorities, in which case the Policy with
+	// the highest priority is chosen and the conflicting lower priority policies are shadowed.
 	AcceptanceOrder uint32 `protobuf:"varint,1,opt,name=acceptance_order,json=acceptanceOrder,proto3" json:"acceptance_order,omitempty"`
 	// The result of attempting to apply the policy to the discovery resource.
 	State v1.ApprovalState `protobuf:"varint,2,opt,name=state,proto3,enum=common.mesh.gloo.solo.io.ApprovalState" json:"state,omitempty"`
@@ -124,6 +124,44 @@ func (m *TrafficPolicySpec) Equal(that interface{}) bool {
 		}
 	}
 
+	if m.GetPriority() != target.GetPriority() {
+		return false
+	}
+
+	if len(m.GetTcpRequestMatchers()) != len(target.GetTcpRequestMatchers()) {
+		return false
+	}
+	for idx, v := range m.GetTcpRequestMatchers() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTcpRequestMatchers()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetTcpRequestMatchers()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetTlsRequestMatchers()) != len(target.GetTlsRequestMatchers()) {
+		return false
+	}
+	for idx, v := range m.GetTlsRequestMatchers() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetTlsRequestMatchers()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetTlsRequestMatchers()[idx]) {
+				return false
+			}
+		}
+
+	}
+
 	return true
 }
 
@@ -391,6 +429,36 @@ func (m *TrafficPolicySpec_Policy) Equal(that interface{}) bool {
 		}
 	}
 
+	if h, ok := interface{}(m.GetLoadBalancer()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetLoadBalancer()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetLoadBalancer(), target.GetLoadBalancer()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetConnectionPool()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConnectionPool()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConnectionPool(), target.GetConnectionPool()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetJwt()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetJwt()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetJwt(), target.GetJwt()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -518,6 +586,48 @@ func (m *TrafficPolicySpec_Policy_RetryPolicy) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetRetryOn()) != len(target.GetRetryOn()) {
+		return false
+	}
+	for idx, v := range m.GetRetryOn() {
+
+		if strings.Compare(v, target.GetRetryOn()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetRetriableStatusCodes()) != len(target.GetRetriableStatusCodes()) {
+		return false
+	}
+	for idx, v := range m.GetRetriableStatusCodes() {
+
+		if v != target.GetRetriableStatusCodes()[idx] {
+			return false
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetRetryRemoteLocalities()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetRetryRemoteLocalities()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetRetryRemoteLocalities(), target.GetRetryRemoteLocalities()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetBackoff()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBackoff()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBackoff(), target.GetBackoff()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -587,6 +697,16 @@ func (m *TrafficPolicySpec_Policy_FaultInjection) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetDelay()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetDelay()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetDelay(), target.GetDelay()) {
+			return false
+		}
+	}
+
 	switch m.FaultInjectionType.(type) {
 
 	case *TrafficPolicySpec_Policy_FaultInjection_FixedDelay:
@@ -831,6 +951,165 @@ func (m *TrafficPolicySpec_Policy_OutlierDetection) Equal(that interface{}) bool
 	return true
 }
 
+// Equal function
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancerPolicy)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancerPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetSubsets()) != len(target.GetSubsets()) {
+		return false
+	}
+	for idx, v := range m.GetSubsets() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetSubsets()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetSubsets()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	switch m.Policy.(type) {
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple:
+		if _, ok := target.Policy.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple); !ok {
+			return false
+		}
+
+		if m.GetSimple() != target.GetSimple() {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash:
+		if _, ok := target.Policy.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConsistentHash()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConsistentHash()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConsistentHash(), target.GetConsistentHash()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Policy != target.Policy {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_ConnectionPool) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_ConnectionPool)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_ConnectionPool)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTcp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTcp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTcp(), target.GetTcp()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetHttp()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetHttp()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetHttp(), target.GetHttp()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_JwtAuthentication) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_JwtAuthentication)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_JwtAuthentication)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetProviders()) != len(target.GetProviders()) {
+		return false
+	}
+	for idx, v := range m.GetProviders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetProviders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetProviders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	return true
+}
+
 // Equal function
 func (m *TrafficPolicySpec_Policy_MTLS) Equal(that interface{}) bool {
 	if that == nil {
@@ -949,6 +1228,50 @@ func (m *TrafficPolicySpec_Policy_ExtAuth) Equal(that interface{}) bool {
 	return true
 }
 
+// Equal function
+func (m *TrafficPolicySpec_Policy_RetryPolicy_Backoff) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_RetryPolicy_Backoff)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_RetryPolicy_Backoff)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetBaseInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetBaseInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetBaseInterval(), target.GetBaseInterval()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetMaxInterval()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMaxInterval()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMaxInterval(), target.GetMaxInterval()) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equal function
 func (m *TrafficPolicySpec_Policy_FaultInjection_Abort) Equal(that interface{}) bool {
 	if that == nil {
@@ -974,6 +1297,475 @@ func (m *TrafficPolicySpec_Policy_FaultInjection_Abort) Equal(that interface{})
 		return false
 	}
 
+	if strings.Compare(m.GetGrpcStatus(), target.GetGrpcStatus()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetPercentage()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPercentage()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPercentage(), target.GetPercentage()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_FaultInjection_Delay) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_FaultInjection_Delay)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_FaultInjection_Delay)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetFixedDelay()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFixedDelay()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFixedDelay(), target.GetFixedDelay()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetPercentage()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetPercentage()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetPercentage(), target.GetPercentage()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMinimumRingSize() != target.GetMinimumRingSize() {
+		return false
+	}
+
+	switch m.HashKey.(type) {
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetHttpHeaderName(), target.GetHttpHeaderName()) != 0 {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetHttpCookie()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetHttpCookie()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetHttpCookie(), target.GetHttpCookie()) {
+				return false
+			}
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp); !ok {
+			return false
+		}
+
+		if m.GetUseSourceIp() != target.GetUseSourceIp() {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName:
+		if _, ok := target.HashKey.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetHttpQueryParameterName(), target.GetHttpQueryParameterName()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.HashKey != target.HashKey {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if len(m.GetLabels()) != len(target.GetLabels()) {
+		return false
+	}
+	for k, v := range m.GetLabels() {
+
+		if strings.Compare(v, target.GetLabels()[k]) != 0 {
+			return false
+		}
+
+	}
+
+	switch m.Policy.(type) {
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple:
+		if _, ok := target.Policy.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple); !ok {
+			return false
+		}
+
+		if m.GetSimple() != target.GetSimple() {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash:
+		if _, ok := target.Policy.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash); !ok {
+			return false
+		}
+
+		if h, ok := interface{}(m.GetConsistentHash()).(equality.Equalizer); ok {
+			if !h.Equal(target.GetConsistentHash()) {
+				return false
+			}
+		} else {
+			if !proto.Equal(m.GetConsistentHash(), target.GetConsistentHash()) {
+				return false
+			}
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.Policy != target.Policy {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPath(), target.GetPath()) != 0 {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetTtl()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetTtl()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetTtl(), target.GetTtl()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_ConnectionPool_TCP) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_ConnectionPool_TCP)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_ConnectionPool_TCP)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMaxConnections() != target.GetMaxConnections() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetConnectTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetConnectTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetConnectTimeout(), target.GetConnectTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_ConnectionPool_HTTP) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_ConnectionPool_HTTP)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_ConnectionPool_HTTP)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if m.GetMaxPendingRequests() != target.GetMaxPendingRequests() {
+		return false
+	}
+
+	if m.GetMaxRequests() != target.GetMaxRequests() {
+		return false
+	}
+
+	if m.GetMaxRequestsPerConnection() != target.GetMaxRequestsPerConnection() {
+		return false
+	}
+
+	if m.GetMaxRetries() != target.GetMaxRetries() {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetIdleTimeout()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIdleTimeout()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIdleTimeout(), target.GetIdleTimeout()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_JwtAuthentication_Provider) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_JwtAuthentication_Provider)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_JwtAuthentication_Provider)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetIssuer(), target.GetIssuer()) != 0 {
+		return false
+	}
+
+	if len(m.GetAudiences()) != len(target.GetAudiences()) {
+		return false
+	}
+	for idx, v := range m.GetAudiences() {
+
+		if strings.Compare(v, target.GetAudiences()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if len(m.GetFromHeaders()) != len(target.GetFromHeaders()) {
+		return false
+	}
+	for idx, v := range m.GetFromHeaders() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetFromHeaders()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetFromHeaders()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if len(m.GetFromParams()) != len(target.GetFromParams()) {
+		return false
+	}
+	for idx, v := range m.GetFromParams() {
+
+		if strings.Compare(v, target.GetFromParams()[idx]) != 0 {
+			return false
+		}
+
+	}
+
+	if strings.Compare(m.GetOutputPayloadToHeader(), target.GetOutputPayloadToHeader()) != 0 {
+		return false
+	}
+
+	if m.GetForwardOriginalToken() != target.GetForwardOriginalToken() {
+		return false
+	}
+
+	switch m.JwksSource.(type) {
+
+	case *TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri:
+		if _, ok := target.JwksSource.(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetJwksUri(), target.GetJwksUri()) != 0 {
+			return false
+		}
+
+	case *TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks:
+		if _, ok := target.JwksSource.(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks); !ok {
+			return false
+		}
+
+		if strings.Compare(m.GetJwks(), target.GetJwks()) != 0 {
+			return false
+		}
+
+	default:
+		// m is nil but target is not nil
+		if m.JwksSource != target.JwksSource {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader)
+	if !ok {
+		that2, ok := that.(TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if strings.Compare(m.GetName(), target.GetName()) != 0 {
+		return false
+	}
+
+	if strings.Compare(m.GetPrefix(), target.GetPrefix()) != 0 {
+		return false
+	}
+
 	return true
 }
 
@@ -35,6 +35,63 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+// Standard load balancing algorithms.
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB int32
+
+const (
+	// Select hosts in a round robin fashion. This is the default.
+	TrafficPolicySpec_Policy_LoadBalancerPolicy_ROUND_ROBIN TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB = 0
+	// Select the host with the fewer active requests of two random hosts.
+	TrafficPolicySpec_Policy_LoadBalancerPolicy_LEAST_REQUEST TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB = 1
+	// Select a random host.
+	TrafficPolicySpec_Policy_LoadBalancerPolicy_RANDOM TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB = 2
+	// Forward the connection to the original IP address requested by the caller, without load balancing.
+	TrafficPolicySpec_Policy_LoadBalancerPolicy_PASSTHROUGH TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB = 3
+)
+
+// Enum value maps for TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB.
+var (
+	TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB_name = map[int32]string{
+		0: "ROUND_ROBIN",
+		1: "LEAST_REQUEST",
+		2: "RANDOM",
+		3: "PASSTHROUGH",
+	}
+	TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB_value = map[string]int32{
+		"ROUND_ROBIN":   0,
+		"LEAST_REQUEST": 1,
+		"RANDOM":        2,
+		"PASSTHROUGH":   3,
+	}
+)
+
+func (x TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB) Enum() *TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB {
+	p := new(TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB)
+	*p = x
+	return p
+}
+
+func (x TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0].Descriptor()
+}
+
+func (TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB) Type() protoreflect.EnumType {
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0]
+}
+
+func (x TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB.Descriptor instead.
+func (TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0}
+}
+
 // TLS connection mode. Enums correspond to those
 // [defined here](https://github.com/istio/api/blob/00636152b9d9254b614828a65723840282a177d3/networking/v1beta1/destination_rule.proto#L886)
 type TrafficPolicySpec_Policy_MTLS_Istio_TLSmode int32
@@ -77,11 +134,11 @@ func (x TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) String() string {
 }
 
 func (TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Descriptor() protoreflect.EnumDescriptor {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0].Descriptor()
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[1].Descriptor()
 }
 
 func (TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Type() protoreflect.EnumType {
-	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[0]
+	return &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes[1]
 }
 
 func (x TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Number() protoreflect.EnumNumber {
@@ -90,7 +147,7 @@ func (x TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) Number() protoreflect.EnumN
 
 // Deprecated: Use TrafficPolicySpec_Policy_MTLS_Istio_TLSmode.Descriptor instead.
 func (TrafficPolicySpec_Policy_MTLS_Istio_TLSmode) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0, 0}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9, 0, 0}
 }
 
 // Applies L7 routing and post-routing configuration on selected network edges.
@@ -122,6 +179,24 @@ type TrafficPolicySpec struct {
 	HttpRequestMatchers []*DeprecatedHttpMatcher `protobuf:"bytes,3,rep,name=http_request_matchers,json=httpRequestMatchers,proto3" json:"http_request_matchers,omitempty"`
 	// Specify L7 routing and post-routing configuration.
 	Policy *TrafficPolicySpec_Policy `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"`
+	// The priority of this TrafficPolicy relative to other TrafficPolicies applied to the same Destination.
+	// If multiple TrafficPolicies configure the same field, the TrafficPolicy with the highest priority takes precedence
+	// and the lower priority TrafficPolicies are shadowed for that field: they remain accepted, and the shadowing is reported
+	// as a warning in their status.
+	// TrafficPolicies with equal priority (by default, 0) conflict, in which case the TrafficPolicy accepted first takes precedence
+	// and the others are rejected.
+	Priority int32 `protobuf:"varint,6,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Specify criteria that TCP connections must satisfy for the TrafficPolicy to apply.
+	// When set, the TrafficPolicy's traffic shift is applied to TCP connections instead of HTTP requests,
+	// which allows shifting traffic on non-HTTP ports (e.g. databases or message brokers).
+	// HTTP-only policies (request timeouts, retries, fault injection, CORS, mirroring, and header manipulation)
+	// cannot be combined with TCP or TLS matchers, nor can `http_request_matchers`.
+	// Conditions defined between different matchers are disjunctive.
+	TcpRequestMatchers []*TcpMatcher `protobuf:"bytes,7,rep,name=tcp_request_matchers,json=tcpRequestMatchers,proto3" json:"tcp_request_matchers,omitempty"`
+	// Specify criteria that TLS connections must satisfy for the TrafficPolicy to apply, matching on the SNI presented by the client.
+	// The same restrictions as `tcp_request_matchers` apply.
+	// Conditions defined between different matchers are disjunctive.
+	TlsRequestMatchers []*TlsMatcher `protobuf:"bytes,8,rep,name=tls_request_matchers,json=tlsRequestMatchers,proto3" json:"tls_request_matchers,omitempty"`
 }
 
 func (x *TrafficPolicySpec) Reset() {
@@ -191,6 +266,27 @@ func (x *TrafficPolicySpec) GetPolicy() *TrafficPolicySpec_Policy {
 	return nil
 }
 
+func (x *TrafficPolicySpec) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec) GetTcpRequestMatchers() []*TcpMatcher {
+	if x != nil {
+		return x.TcpRequestMatchers
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec) GetTlsRequestMatchers() []*TlsMatcher {
+	if x != nil {
+		return x.TlsRequestMatchers
+	}
+	return nil
+}
+
 type TrafficPolicyStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -370,8 +466,23 @@ type TrafficPolicySpec_Policy struct {
 	Csrf *csrf.CsrfPolicy `protobuf:"bytes,13,opt,name=csrf,proto3" json:"csrf,omitempty"`
 	// Configure the Envoy based Ratelimit filter
 	RateLimit *ratelimit.RouteRateLimit `protobuf:"bytes,14,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
-	// Configure the Envoy based Extauth filter
+	// Configure the Envoy based Extauth filter.
+	// For Istio Destinations, extauth is enforced by the Destination's sidecars for all traffic. If `http_request_matchers`
+	// or `source_selector` are specified, it is instead enforced by the clients' sidecars for the selected routes,
+	// and cannot be disabled.
 	Extauth *extauth.RouteExtauth `protobuf:"bytes,15,opt,name=extauth,proto3" json:"extauth,omitempty"`
+	// Configure the load balancing policy for requests to the selected destinations.
+	// Specifying this field requires an empty `source_selector` because it must apply to all traffic.
+	LoadBalancer *TrafficPolicySpec_Policy_LoadBalancerPolicy `protobuf:"bytes,16,opt,name=load_balancer,json=loadBalancer,proto3" json:"load_balancer,omitempty"`
+	// Configure connection pool limits (circuit breaking) for the selected destinations.
+	// Connection pool limits are only supported for Istio meshes, and are reported as unsupported for SMI meshes including OSM.
+	// Specifying this field requires an empty `source_selector` because it must apply to all traffic.
+	ConnectionPool *TrafficPolicySpec_Policy_ConnectionPool `protobuf:"bytes,17,opt,name=connection_pool,json=connectionPool,proto3" json:"connection_pool,omitempty"`
+	// Require requests to the selected destinations to carry valid JSON Web Tokens (JWT) from the specified issuers.
+	// Requests without a token are accepted, use an AccessPolicy with claim conditions to require a valid token.
+	// For Istio Destinations, specifying this field requires an empty `source_selector` and `http_request_matchers`
+	// because it is enforced by the Destination's sidecars for all traffic.
+	Jwt *TrafficPolicySpec_Policy_JwtAuthentication `protobuf:"bytes,18,opt,name=jwt,proto3" json:"jwt,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy) Reset() {
@@ -490,6 +601,27 @@ func (x *TrafficPolicySpec_Policy) GetExtauth() *extauth.RouteExtauth {
 	return nil
 }
 
+func (x *TrafficPolicySpec_Policy) GetLoadBalancer() *TrafficPolicySpec_Policy_LoadBalancerPolicy {
+	if x != nil {
+		return x.LoadBalancer
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy) GetConnectionPool() *TrafficPolicySpec_Policy_ConnectionPool {
+	if x != nil {
+		return x.ConnectionPool
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy) GetJwt() *TrafficPolicySpec_Policy_JwtAuthentication {
+	if x != nil {
+		return x.Jwt
+	}
+	return nil
+}
+
 // Specify selected gateway traffic by specifying which gateway
 // resources (virtualHosts or routeTables) to select. You can optionally further
 // filter by using route labels to only select a subset of routes within those resources.
@@ -593,6 +725,21 @@ type TrafficPolicySpec_Policy_RetryPolicy struct {
 	Attempts int32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	// Timeout per retry attempt for a given request. Format: `1h`/`1m`/`1s`/`1ms`. *Must be >= 1ms*.
 	PerTryTimeout *duration.Duration `protobuf:"bytes,2,opt,name=per_try_timeout,json=perTryTimeout,proto3" json:"per_try_timeout,omitempty"`
+	// The conditions under which a request is retried, e.g. `connect-failure`, `refused-stream`, `5xx`,
+	// or gRPC status conditions such as `unavailable` and `resource-exhausted`.
+	// Refer to the [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-on)
+	// and [gRPC conditions](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/router_filter#x-envoy-retry-grpc-on)
+	// for the supported values. If omitted, the mesh default conditions are used.
+	RetryOn []string `protobuf:"bytes,3,rep,name=retry_on,json=retryOn,proto3" json:"retry_on,omitempty"`
+	// HTTP status codes, in addition to those matched by `retry_on`, for which a request is retried.
+	RetriableStatusCodes []uint32 `protobuf:"varint,4,rep,packed,name=retriable_status_codes,json=retriableStatusCodes,proto3" json:"retriable_status_codes,omitempty"`
+	// If true, retries may be sent to endpoints in other localities than the one of the failed attempt.
+	// If omitted, the mesh default is used.
+	RetryRemoteLocalities *wrappers.BoolValue `protobuf:"bytes,5,opt,name=retry_remote_localities,json=retryRemoteLocalities,proto3" json:"retry_remote_localities,omitempty"`
+	// Configure the exponential backoff between retry attempts.
+	// Backoff is applied to all retried requests sent to the selected Destinations from sidecars in the Destinations' mesh.
+	// Backoff cannot be scoped to individual routes, so a TrafficPolicy which sets backoff must not specify source selectors or http request matchers.
+	Backoff *TrafficPolicySpec_Policy_RetryPolicy_Backoff `protobuf:"bytes,6,opt,name=backoff,proto3" json:"backoff,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy_RetryPolicy) Reset() {
@@ -641,6 +788,34 @@ func (x *TrafficPolicySpec_Policy_RetryPolicy) GetPerTryTimeout() *duration.Dura
 	return nil
 }
 
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetryOn() []string {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetriableStatusCodes() []uint32 {
+	if x != nil {
+		return x.RetriableStatusCodes
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetRetryRemoteLocalities() *wrappers.BoolValue {
+	if x != nil {
+		return x.RetryRemoteLocalities
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy) GetBackoff() *TrafficPolicySpec_Policy_RetryPolicy_Backoff {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
 // Specify a traffic shift destination.
 type TrafficPolicySpec_Policy_MultiDestination struct {
 	state         protoimpl.MessageState
@@ -697,6 +872,7 @@ type TrafficPolicySpec_Policy_FaultInjection struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Specify the type of fault to inject.
+	// To inject both a delay and an abort, specify `abort` together with `delay`.
 	//
 	// Types that are assignable to FaultInjectionType:
 	//	*TrafficPolicySpec_Policy_FaultInjection_FixedDelay
@@ -704,6 +880,10 @@ type TrafficPolicySpec_Policy_FaultInjection struct {
 	FaultInjectionType isTrafficPolicySpec_Policy_FaultInjection_FaultInjectionType `protobuf_oneof:"fault_injection_type"`
 	// Percentage of requests to be faulted. Values range between 0 and 100. If omitted all requests will be faulted.
 	Percentage float64 `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// Add a delay before sending the request. May be specified together with `abort`, in which case
+	// the delay and the abort are applied to independent percentages of requests.
+	// Mutually exclusive with `fixed_delay`.
+	Delay *TrafficPolicySpec_Policy_FaultInjection_Delay `protobuf:"bytes,5,opt,name=delay,proto3" json:"delay,omitempty"`
 }
 
 func (x *TrafficPolicySpec_Policy_FaultInjection) Reset() {
@@ -766,12 +946,20 @@ func (x *TrafficPolicySpec_Policy_FaultInjection) GetPercentage() float64 {
 	return 0
 }
 
+func (x *TrafficPolicySpec_Policy_FaultInjection) GetDelay() *TrafficPolicySpec_Policy_FaultInjection_Delay {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
 type isTrafficPolicySpec_Policy_FaultInjection_FaultInjectionType interface {
 	isTrafficPolicySpec_Policy_FaultInjection_FaultInjectionType()
 }
 
 type TrafficPolicySpec_Policy_FaultInjection_FixedDelay struct {
 	// Add a delay of a fixed duration before sending the request. Format: `1h`/`1m`/`1s`/`1ms`. MUST be >=1ms.
+	// Mutually exclusive with `delay`.
 	FixedDelay *duration.Duration `protobuf:"bytes,1,opt,name=fixed_delay,json=fixedDelay,proto3,oneof"`
 }
 
@@ -1054,6 +1242,213 @@ func (x *TrafficPolicySpec_Policy_OutlierDetection) GetMaxEjectionPercent() uint
 	return 0
 }
 
+// Configure the load balancing policy for requests to the selected destinations.
+type TrafficPolicySpec_Policy_LoadBalancerPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The load balancing policy for the destinations.
+	//
+	// Types that are assignable to Policy:
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash
+	Policy isTrafficPolicySpec_Policy_LoadBalancerPolicy_Policy `protobuf_oneof:"policy"`
+	// Override the load balancing policy for subsets of the destinations.
+	// Each subset must be the target of a traffic shift, which determines the subsets of a destination.
+	Subsets []*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy `protobuf:"bytes,3,rep,name=subsets,proto3" json:"subsets,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancerPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancerPolicy.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6}
+}
+
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy) GetPolicy() isTrafficPolicySpec_Policy_LoadBalancerPolicy_Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy) GetSimple() TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB {
+	if x, ok := x.GetPolicy().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple); ok {
+		return x.Simple
+	}
+	return TrafficPolicySpec_Policy_LoadBalancerPolicy_ROUND_ROBIN
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy) GetConsistentHash() *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB {
+	if x, ok := x.GetPolicy().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash); ok {
+		return x.ConsistentHash
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy) GetSubsets() []*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy {
+	if x != nil {
+		return x.Subsets
+	}
+	return nil
+}
+
+type isTrafficPolicySpec_Policy_LoadBalancerPolicy_Policy interface {
+	isTrafficPolicySpec_Policy_LoadBalancerPolicy_Policy()
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple struct {
+	// Use a standard load balancing algorithm.
+	Simple TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB `protobuf:"varint,1,opt,name=simple,proto3,enum=networking.mesh.gloo.solo.io.TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash struct {
+	// Use consistent hashing to provide soft session affinity, based on HTTP headers, cookies or other properties.
+	// This policy only applies to HTTP connections.
+	ConsistentHash *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB `protobuf:"bytes,2,opt,name=consistent_hash,json=consistentHash,proto3,oneof"`
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple) isTrafficPolicySpec_Policy_LoadBalancerPolicy_Policy() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash) isTrafficPolicySpec_Policy_LoadBalancerPolicy_Policy() {
+}
+
+// Configure connection pool limits for the upstream connections to the selected destinations.
+// The limits apply to each individual client workload.
+type TrafficPolicySpec_Policy_ConnectionPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Settings common to both TCP and HTTP connections.
+	Tcp *TrafficPolicySpec_Policy_ConnectionPool_TCP `protobuf:"bytes,1,opt,name=tcp,proto3" json:"tcp,omitempty"`
+	// Settings for HTTP/1.1 and HTTP/2 connections.
+	Http *TrafficPolicySpec_Policy_ConnectionPool_HTTP `protobuf:"bytes,2,opt,name=http,proto3" json:"http,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) Reset() {
+	*x = TrafficPolicySpec_Policy_ConnectionPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_ConnectionPool) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_ConnectionPool.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_ConnectionPool) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) GetTcp() *TrafficPolicySpec_Policy_ConnectionPool_TCP {
+	if x != nil {
+		return x.Tcp
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool) GetHttp() *TrafficPolicySpec_Policy_ConnectionPool_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+// Configure JSON Web Token (JWT) authentication for requests to the selected destinations.
+type TrafficPolicySpec_Policy_JwtAuthentication struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The JWT providers whose tokens are accepted. A request carrying a token is rejected
+	// if the token is not valid for any of the providers.
+	Providers []*TrafficPolicySpec_Policy_JwtAuthentication_Provider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication) Reset() {
+	*x = TrafficPolicySpec_Policy_JwtAuthentication{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_JwtAuthentication) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_JwtAuthentication.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_JwtAuthentication) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8}
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication) GetProviders() []*TrafficPolicySpec_Policy_JwtAuthentication_Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
 // Configure mTLS settings on destinations. If specified this overrides the global default defined in Settings.
 type TrafficPolicySpec_Policy_MTLS struct {
 	state         protoimpl.MessageState
@@ -1067,7 +1462,7 @@ type TrafficPolicySpec_Policy_MTLS struct {
 func (x *TrafficPolicySpec_Policy_MTLS) Reset() {
 	*x = TrafficPolicySpec_Policy_MTLS{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1080,7 +1475,7 @@ func (x *TrafficPolicySpec_Policy_MTLS) String() string {
 func (*TrafficPolicySpec_Policy_MTLS) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_MTLS) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,7 +1488,7 @@ func (x *TrafficPolicySpec_Policy_MTLS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficPolicySpec_Policy_MTLS.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_MTLS) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9}
 }
 
 func (x *TrafficPolicySpec_Policy_MTLS) GetIstio() *TrafficPolicySpec_Policy_MTLS_Istio {
@@ -1116,7 +1511,7 @@ type TrafficPolicySpec_Policy_Transform struct {
 func (x *TrafficPolicySpec_Policy_Transform) Reset() {
 	*x = TrafficPolicySpec_Policy_Transform{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1129,7 +1524,7 @@ func (x *TrafficPolicySpec_Policy_Transform) String() string {
 func (*TrafficPolicySpec_Policy_Transform) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_Transform) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1537,7 @@ func (x *TrafficPolicySpec_Policy_Transform) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TrafficPolicySpec_Policy_Transform.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_Transform) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 10}
 }
 
 func (x *TrafficPolicySpec_Policy_Transform) GetTodo() string {
@@ -1165,7 +1560,7 @@ type TrafficPolicySpec_Policy_DLPPolicy struct {
 func (x *TrafficPolicySpec_Policy_DLPPolicy) Reset() {
 	*x = TrafficPolicySpec_Policy_DLPPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1178,7 +1573,7 @@ func (x *TrafficPolicySpec_Policy_DLPPolicy) String() string {
 func (*TrafficPolicySpec_Policy_DLPPolicy) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_DLPPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,7 +1586,7 @@ func (x *TrafficPolicySpec_Policy_DLPPolicy) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use TrafficPolicySpec_Policy_DLPPolicy.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_DLPPolicy) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 11}
 }
 
 func (x *TrafficPolicySpec_Policy_DLPPolicy) GetTodo() string {
@@ -1202,6 +1597,7 @@ func (x *TrafficPolicySpec_Policy_DLPPolicy) GetTodo() string {
 }
 
 // ExtAuth filter config.
+// Deprecated: use the `extauth` field to configure external authorization.
 type TrafficPolicySpec_Policy_ExtAuth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1214,7 +1610,7 @@ type TrafficPolicySpec_Policy_ExtAuth struct {
 func (x *TrafficPolicySpec_Policy_ExtAuth) Reset() {
 	*x = TrafficPolicySpec_Policy_ExtAuth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1227,7 +1623,7 @@ func (x *TrafficPolicySpec_Policy_ExtAuth) String() string {
 func (*TrafficPolicySpec_Policy_ExtAuth) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_ExtAuth) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1636,7 @@ func (x *TrafficPolicySpec_Policy_ExtAuth) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrafficPolicySpec_Policy_ExtAuth.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_ExtAuth) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 12}
 }
 
 func (x *TrafficPolicySpec_Policy_ExtAuth) GetTodo() string {
@@ -1250,33 +1646,36 @@ func (x *TrafficPolicySpec_Policy_ExtAuth) GetTodo() string {
 	return ""
 }
 
-// Abort the request and return the specified error code back to traffic source.
-type TrafficPolicySpec_Policy_FaultInjection_Abort struct {
+// Exponential backoff between retry attempts.
+type TrafficPolicySpec_Policy_RetryPolicy_Backoff struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Required. HTTP status code to use to abort the request.
-	HttpStatus int32 `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// The base interval between retry attempts. *Must be >= 1ms*.
+	BaseInterval *duration.Duration `protobuf:"bytes,1,opt,name=base_interval,json=baseInterval,proto3" json:"base_interval,omitempty"`
+	// The maximum interval between retry attempts. Defaults to 10 times the `base_interval`.
+	// *Must be >= base_interval*.
+	MaxInterval *duration.Duration `protobuf:"bytes,2,opt,name=max_interval,json=maxInterval,proto3" json:"max_interval,omitempty"`
 }
 
-func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) Reset() {
-	*x = TrafficPolicySpec_Policy_FaultInjection_Abort{}
+func (x *TrafficPolicySpec_Policy_RetryPolicy_Backoff) Reset() {
+	*x = TrafficPolicySpec_Policy_RetryPolicy_Backoff{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) String() string {
+func (x *TrafficPolicySpec_Policy_RetryPolicy_Backoff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoMessage() {}
+func (*TrafficPolicySpec_Policy_RetryPolicy_Backoff) ProtoMessage() {}
 
-func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15]
+func (x *TrafficPolicySpec_Policy_RetryPolicy_Backoff) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,16 +1686,793 @@ func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrafficPolicySpec_Policy_FaultInjection_Abort.ProtoReflect.Descriptor instead.
-func (*TrafficPolicySpec_Policy_FaultInjection_Abort) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 0}
+// Deprecated: Use TrafficPolicySpec_Policy_RetryPolicy_Backoff.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_RetryPolicy_Backoff) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 0, 0}
 }
 
-func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetHttpStatus() int32 {
+func (x *TrafficPolicySpec_Policy_RetryPolicy_Backoff) GetBaseInterval() *duration.Duration {
+	if x != nil {
+		return x.BaseInterval
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_RetryPolicy_Backoff) GetMaxInterval() *duration.Duration {
+	if x != nil {
+		return x.MaxInterval
+	}
+	return nil
+}
+
+// Abort the request and return the specified error code back to traffic source.
+type TrafficPolicySpec_Policy_FaultInjection_Abort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// HTTP status code to use to abort the request.
+	// Exactly one of `http_status` and `grpc_status` must be specified.
+	HttpStatus int32 `protobuf:"varint,1,opt,name=http_status,json=httpStatus,proto3" json:"http_status,omitempty"`
+	// gRPC status code to use to abort the request, specified by name (e.g. `UNAVAILABLE`).
+	// Refer to the [gRPC documentation](https://github.com/grpc/grpc/blob/master/doc/statuscodes.md) for the supported codes.
+	// Exactly one of `http_status` and `grpc_status` must be specified.
+	GrpcStatus string `protobuf:"bytes,2,opt,name=grpc_status,json=grpcStatus,proto3" json:"grpc_status,omitempty"`
+	// Percentage of requests to be aborted. Values range between 0 and 100.
+	// If omitted, the `percentage` of the FaultInjection is used.
+	Percentage *wrappers.DoubleValue `protobuf:"bytes,3,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) Reset() {
+	*x = TrafficPolicySpec_Policy_FaultInjection_Abort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_FaultInjection_Abort.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_FaultInjection_Abort) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetHttpStatus() int32 {
 	if x != nil {
 		return x.HttpStatus
 	}
-	return 0
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetGrpcStatus() string {
+	if x != nil {
+		return x.GrpcStatus
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Abort) GetPercentage() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
+// Delay the request before sending it to the destination.
+type TrafficPolicySpec_Policy_FaultInjection_Delay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. Add a delay of a fixed duration before sending the request. Format: `1h`/`1m`/`1s`/`1ms`. MUST be >=1ms.
+	FixedDelay *duration.Duration `protobuf:"bytes,1,opt,name=fixed_delay,json=fixedDelay,proto3" json:"fixed_delay,omitempty"`
+	// Percentage of requests to be delayed. Values range between 0 and 100.
+	// If omitted, the `percentage` of the FaultInjection is used.
+	Percentage *wrappers.DoubleValue `protobuf:"bytes,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Delay) Reset() {
+	*x = TrafficPolicySpec_Policy_FaultInjection_Delay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Delay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_FaultInjection_Delay) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Delay) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_FaultInjection_Delay.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_FaultInjection_Delay) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 2, 1}
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Delay) GetFixedDelay() *duration.Duration {
+	if x != nil {
+		return x.FixedDelay
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_FaultInjection_Delay) GetPercentage() *wrappers.DoubleValue {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
+// Consistent hash-based load balancing.
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The request property to compute the hash from.
+	//
+	// Types that are assignable to HashKey:
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName
+	HashKey isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey `protobuf_oneof:"hash_key"`
+	// The minimum number of virtual nodes to use for the hash ring. Defaults to 1024.
+	// Larger ring sizes result in more granular load distributions.
+	MinimumRingSize uint64 `protobuf:"varint,5,opt,name=minimum_ring_size,json=minimumRingSize,proto3" json:"minimum_ring_size,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0}
+}
+
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) GetHashKey() isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey {
+	if m != nil {
+		return m.HashKey
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) GetHttpHeaderName() string {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName); ok {
+		return x.HttpHeaderName
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) GetHttpCookie() *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie); ok {
+		return x.HttpCookie
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) GetUseSourceIp() bool {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp); ok {
+		return x.UseSourceIp
+	}
+	return false
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) GetHttpQueryParameterName() string {
+	if x, ok := x.GetHashKey().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName); ok {
+		return x.HttpQueryParameterName
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB) GetMinimumRingSize() uint64 {
+	if x != nil {
+		return x.MinimumRingSize
+	}
+	return 0
+}
+
+type isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey interface {
+	isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey()
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName struct {
+	// Hash based on the value of the given HTTP header.
+	HttpHeaderName string `protobuf:"bytes,1,opt,name=http_header_name,json=httpHeaderName,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie struct {
+	// Hash based on the given HTTP cookie.
+	HttpCookie *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie `protobuf:"bytes,2,opt,name=http_cookie,json=httpCookie,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp struct {
+	// Hash based on the source IP address.
+	UseSourceIp bool `protobuf:"varint,3,opt,name=use_source_ip,json=useSourceIp,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName struct {
+	// Hash based on the value of the given HTTP query parameter.
+	HttpQueryParameterName string `protobuf:"bytes,4,opt,name=http_query_parameter_name,json=httpQueryParameterName,proto3,oneof"`
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName) isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie) isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp) isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName) isTrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HashKey() {
+}
+
+// The load balancing policy for a subset of the destinations.
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The labels identifying the subset, which must equal the subset labels of a traffic shift to the destination.
+	Labels map[string]string `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The load balancing policy for the subset.
+	//
+	// Types that are assignable to Policy:
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple
+	//	*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash
+	Policy isTrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Policy `protobuf_oneof:"policy"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 1}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (m *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) GetPolicy() isTrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) GetSimple() TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB {
+	if x, ok := x.GetPolicy().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple); ok {
+		return x.Simple
+	}
+	return TrafficPolicySpec_Policy_LoadBalancerPolicy_ROUND_ROBIN
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy) GetConsistentHash() *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB {
+	if x, ok := x.GetPolicy().(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash); ok {
+		return x.ConsistentHash
+	}
+	return nil
+}
+
+type isTrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Policy interface {
+	isTrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Policy()
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple struct {
+	// Use a standard load balancing algorithm.
+	Simple TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB `protobuf:"varint,2,opt,name=simple,proto3,enum=networking.mesh.gloo.solo.io.TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB,oneof"`
+}
+
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash struct {
+	// Use consistent hashing to provide soft session affinity, based on HTTP headers, cookies or other properties.
+	// This policy only applies to HTTP connections.
+	ConsistentHash *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB `protobuf:"bytes,3,opt,name=consistent_hash,json=consistentHash,proto3,oneof"`
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple) isTrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Policy() {
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash) isTrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Policy() {
+}
+
+// Describes an HTTP cookie used as the hash key.
+type TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cookie.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Path to set for the cookie.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Lifetime of the cookie. If specified, a cookie with the given lifetime is generated if not present.
+	Ttl *duration.Duration `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) Reset() {
+	*x = TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie) GetTtl() *duration.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// TCP connection pool settings.
+type TrafficPolicySpec_Policy_ConnectionPool_TCP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of TCP connections to a destination. If unset the mesh default is used.
+	MaxConnections uint32 `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// TCP connection timeout. Format: `1h`/`1m`/`1s`/`1ms`. Must be >= `1ms`. If unset the mesh default is used.
+	ConnectTimeout *duration.Duration `protobuf:"bytes,2,opt,name=connect_timeout,json=connectTimeout,proto3" json:"connect_timeout,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_TCP) Reset() {
+	*x = TrafficPolicySpec_Policy_ConnectionPool_TCP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_TCP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_ConnectionPool_TCP) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_TCP) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_ConnectionPool_TCP.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_ConnectionPool_TCP) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_TCP) GetMaxConnections() uint32 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_TCP) GetConnectTimeout() *duration.Duration {
+	if x != nil {
+		return x.ConnectTimeout
+	}
+	return nil
+}
+
+// HTTP connection pool settings.
+type TrafficPolicySpec_Policy_ConnectionPool_HTTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of requests that will be queued while waiting for a ready connection pool connection.
+	// If unset the mesh default is used.
+	MaxPendingRequests uint32 `protobuf:"varint,1,opt,name=max_pending_requests,json=maxPendingRequests,proto3" json:"max_pending_requests,omitempty"`
+	// Maximum number of active requests to a destination. If unset the mesh default is used.
+	MaxRequests uint32 `protobuf:"varint,2,opt,name=max_requests,json=maxRequests,proto3" json:"max_requests,omitempty"`
+	// Maximum number of requests per connection to a destination. Setting this to 1 disables keep alive.
+	// If unset there is no limit.
+	MaxRequestsPerConnection uint32 `protobuf:"varint,3,opt,name=max_requests_per_connection,json=maxRequestsPerConnection,proto3" json:"max_requests_per_connection,omitempty"`
+	// Maximum number of retries that can be outstanding to all hosts in a cluster at a given time.
+	// If unset the mesh default is used.
+	MaxRetries uint32 `protobuf:"varint,4,opt,name=max_retries,json=maxRetries,proto3" json:"max_retries,omitempty"`
+	// The idle timeout for upstream connection pool connections, after which the connection is closed if
+	// there are no active requests. Format: `1h`/`1m`/`1s`/`1ms`. Must be >= `1ms`. If unset the mesh default is used.
+	IdleTimeout *duration.Duration `protobuf:"bytes,5,opt,name=idle_timeout,json=idleTimeout,proto3" json:"idle_timeout,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) Reset() {
+	*x = TrafficPolicySpec_Policy_ConnectionPool_HTTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_ConnectionPool_HTTP) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_ConnectionPool_HTTP.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_ConnectionPool_HTTP) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 7, 1}
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) GetMaxPendingRequests() uint32 {
+	if x != nil {
+		return x.MaxPendingRequests
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) GetMaxRequests() uint32 {
+	if x != nil {
+		return x.MaxRequests
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) GetMaxRequestsPerConnection() uint32 {
+	if x != nil {
+		return x.MaxRequestsPerConnection
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) GetMaxRetries() uint32 {
+	if x != nil {
+		return x.MaxRetries
+	}
+	return 0
+}
+
+func (x *TrafficPolicySpec_Policy_ConnectionPool_HTTP) GetIdleTimeout() *duration.Duration {
+	if x != nil {
+		return x.IdleTimeout
+	}
+	return nil
+}
+
+// A JWT provider.
+type TrafficPolicySpec_Policy_JwtAuthentication_Provider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifies the issuer that issued the JWT, matched against the `iss` claim of the token.
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The intended audiences of the token, matched against the `aud` claim. If omitted, tokens for any audience are accepted.
+	Audiences []string `protobuf:"bytes,2,rep,name=audiences,proto3" json:"audiences,omitempty"`
+	// The JSON Web Key Set (JWKS) used to validate the signature of the token.
+	//
+	// Types that are assignable to JwksSource:
+	//	*TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri
+	//	*TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks
+	JwksSource isTrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksSource `protobuf_oneof:"jwks_source"`
+	// The headers from which the token is extracted. If neither `from_headers` nor `from_params` are specified,
+	// the token is extracted from the `Authorization: Bearer <token>` header or the `access_token` query parameter.
+	FromHeaders []*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader `protobuf:"bytes,5,rep,name=from_headers,json=fromHeaders,proto3" json:"from_headers,omitempty"`
+	// The query parameters from which the token is extracted.
+	FromParams []string `protobuf:"bytes,6,rep,name=from_params,json=fromParams,proto3" json:"from_params,omitempty"`
+	// If set, the base64 encoded payload of a successfully verified token is forwarded to the destination in this header.
+	OutputPayloadToHeader string `protobuf:"bytes,7,opt,name=output_payload_to_header,json=outputPayloadToHeader,proto3" json:"output_payload_to_header,omitempty"`
+	// If true, the original token is forwarded to the destination. Otherwise it is removed from the request.
+	ForwardOriginalToken bool `protobuf:"varint,8,opt,name=forward_original_token,json=forwardOriginalToken,proto3" json:"forward_original_token,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) Reset() {
+	*x = TrafficPolicySpec_Policy_JwtAuthentication_Provider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_JwtAuthentication_Provider) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_JwtAuthentication_Provider.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_JwtAuthentication_Provider) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetAudiences() []string {
+	if x != nil {
+		return x.Audiences
+	}
+	return nil
+}
+
+func (m *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetJwksSource() isTrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksSource {
+	if m != nil {
+		return m.JwksSource
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetJwksUri() string {
+	if x, ok := x.GetJwksSource().(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri); ok {
+		return x.JwksUri
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetJwks() string {
+	if x, ok := x.GetJwksSource().(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks); ok {
+		return x.Jwks
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetFromHeaders() []*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader {
+	if x != nil {
+		return x.FromHeaders
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetFromParams() []string {
+	if x != nil {
+		return x.FromParams
+	}
+	return nil
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetOutputPayloadToHeader() string {
+	if x != nil {
+		return x.OutputPayloadToHeader
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider) GetForwardOriginalToken() bool {
+	if x != nil {
+		return x.ForwardOriginalToken
+	}
+	return false
+}
+
+type isTrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksSource interface {
+	isTrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksSource()
+}
+
+type TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri struct {
+	// URL of the provider's public key set, e.g. `https://www.googleapis.com/oauth2/v1/certs`.
+	JwksUri string `protobuf:"bytes,3,opt,name=jwks_uri,json=jwksUri,proto3,oneof"`
+}
+
+type TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks struct {
+	// The JSON Web Key Set inlined as a JSON string.
+	Jwks string `protobuf:"bytes,4,opt,name=jwks,proto3,oneof"`
+}
+
+func (*TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri) isTrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksSource() {
+}
+
+func (*TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks) isTrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksSource() {
+}
+
+// A header from which the token is extracted.
+type TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the header.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The prefix which precedes the token in the header value, e.g. `Bearer `.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) Reset() {
+	*x = TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) ProtoMessage() {}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader.ProtoReflect.Descriptor instead.
+func (*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 8, 0, 0}
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
 }
 
 // Istio TLS settings.
@@ -1312,7 +2488,7 @@ type TrafficPolicySpec_Policy_MTLS_Istio struct {
 func (x *TrafficPolicySpec_Policy_MTLS_Istio) Reset() {
 	*x = TrafficPolicySpec_Policy_MTLS_Istio{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[16]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1325,7 +2501,7 @@ func (x *TrafficPolicySpec_Policy_MTLS_Istio) String() string {
 func (*TrafficPolicySpec_Policy_MTLS_Istio) ProtoMessage() {}
 
 func (x *TrafficPolicySpec_Policy_MTLS_Istio) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[16]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,7 +2514,7 @@ func (x *TrafficPolicySpec_Policy_MTLS_Istio) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use TrafficPolicySpec_Policy_MTLS_Istio.ProtoReflect.Descriptor instead.
 func (*TrafficPolicySpec_Policy_MTLS_Istio) Descriptor() ([]byte, []int) {
-	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 6, 0}
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescGZIP(), []int{0, 0, 9, 0}
 }
 
 func (x *TrafficPolicySpec_Policy_MTLS_Istio) GetTlsMode() TrafficPolicySpec_Policy_MTLS_Istio_TLSmode {
@@ -1404,7 +2580,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_raw
 	0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
 	0x6b, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x73, 0x72, 0x66, 0x2f, 0x63, 0x73, 0x72,
 	0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x1d, 0x0a, 0x11,
+	0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x3b, 0x0a, 0x11,
 	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65,
 	0x63, 0x12, 0x53, 0x0a, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6d,
@@ -1435,257 +2611,498 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_raw
 	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
 	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0x8b, 0x15, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x6c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x73, 0x68,
-	0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53, 0x68, 0x69, 0x66, 0x74,
-	0x12, 0x6e, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6e, 0x65, 0x74, 0x77,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x5a, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x54, 0x63, 0x70, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x12, 0x74, 0x63, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x12,
+	0x5a, 0x0a, 0x14, 0x74, 0x6c, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x6c, 0x73,
+	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x12, 0x74, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x73, 0x1a, 0xcb, 0x31, 0x0a, 0x06,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x6c, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x12, 0x6e, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61,
+	0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x5c, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77,
 	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
 	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
 	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0e, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x12, 0x5c, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x52, 0x65,
-	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
-	0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x73,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x55, 0x0a, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d,
-	0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x61, 0x0a,
-	0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x6e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x74, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x6e, 0x65,
+	0x79, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x62, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x73, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65,
 	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
 	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
 	0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c,
-	0x53, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x63, 0x73, 0x72, 0x66, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x73, 0x72, 0x66, 0x2e, 0x6e, 0x65, 0x74,
+	0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a,
+	0x63, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x55, 0x0a, 0x06, 0x6d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x73, 0x72, 0x66, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x04, 0x63, 0x73, 0x72, 0x66, 0x12, 0x55, 0x0a, 0x0a, 0x72, 0x61,
-	0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x61, 0x0a, 0x13, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x69,
+	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x12, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x69, 0x70, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x74, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f,
+	0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x47, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x04, 0x6d, 0x74,
+	0x6c, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x4c, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x6e, 0x65, 0x74,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x52, 0x04, 0x6d, 0x74, 0x6c, 0x73, 0x12, 0x41, 0x0a, 0x04, 0x63,
+	0x73, 0x72, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x73, 0x72, 0x66,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x73,
+	0x72, 0x66, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x04, 0x63, 0x73, 0x72, 0x66, 0x12, 0x55,
+	0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x72, 0x61, 0x74, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4c, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x45, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68, 0x52, 0x07, 0x65, 0x78, 0x74, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x6e, 0x0a, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x45,
-	0x78, 0x74, 0x61, 0x75, 0x74, 0x68, 0x52, 0x07, 0x65, 0x78, 0x74, 0x61, 0x75, 0x74, 0x68, 0x1a,
-	0x6c, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a,
-	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x65,
-	0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
-	0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x69, 0x0a,
-	0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x44,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x95, 0x02, 0x0a, 0x0e, 0x46, 0x61, 0x75,
-	0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x66,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x12, 0x6e, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x5a, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x48, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4a, 0x77, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x1a,
+	0x81, 0x04, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x70,
+	0x65, 0x72, 0x5f, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x70, 0x65, 0x72, 0x54, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x74,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x14, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x52, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x1a, 0x87, 0x01, 0x0a, 0x07, 0x42, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x3e, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x1a, 0x69, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x55, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xdc,
+	0x04, 0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x63, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x61,
+	0x62, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x12, 0x61, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x1a, 0x87, 0x01, 0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x1a, 0x81, 0x01, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x66,
 	0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x63, 0x0a, 0x05, 0x61, 0x62, 0x6f,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xc6, 0x02,
+	0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x4a, 0x0a, 0x0d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f,
+	0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x12, 0x47, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x9a, 0x01, 0x0a, 0x06, 0x4d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x48, 0x0a, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
+	0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0b,
+	0x6b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x42,
+	0x12, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x1a, 0xf3, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x47,
+	0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6a, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x65,
+	0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0x8a, 0x0b, 0x0a, 0x12, 0x4c, 0x6f,
+	0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x6c, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x52, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65,
+	0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x53, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x4c, 0x42, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x85,
+	0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x5a, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
 	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
 	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
 	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x41, 0x62, 0x6f, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x1a, 0x28,
-	0x0a, 0x05, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x68, 0x74,
-	0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x16, 0x0a, 0x14, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x5f, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x1a, 0xc6, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x72, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x4a, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0c, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73,
-	0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x5f,
-	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65,
-	0x78, 0x70, 0x6f, 0x73, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x07,
-	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65,
-	0x12, 0x47, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x9a, 0x01, 0x0a, 0x06, 0x4d, 0x69,
-	0x72, 0x72, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x0c, 0x6b, 0x75, 0x62, 0x65, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x48,
-	0x00, 0x52, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x1a, 0xf3, 0x01, 0x0a, 0x10, 0x4f, 0x75, 0x74, 0x6c, 0x69,
-	0x65, 0x72, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x63,
-	0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x76, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x12, 0x47, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x62, 0x61, 0x73, 0x65, 0x45, 0x6a,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61,
-	0x78, 0x5f, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x45, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x1a, 0x85, 0x02, 0x0a,
-	0x04, 0x4d, 0x54, 0x4c, 0x53, 0x12, 0x57, 0x0a, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61,
+	0x73, 0x68, 0x4c, 0x42, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x7c, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x62, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x73, 0x65, 0x74, 0x73, 0x1a, 0xc7, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x42, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63,
+	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x65, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x42, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x6f, 0x6f, 0x6b,
+	0x69, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x49, 0x70, 0x12, 0x3b, 0x0a, 0x19, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x16, 0x68, 0x74, 0x74,
+	0x70, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x52, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x7a, 0x65, 0x1a,
+	0x61, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74,
+	0x74, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x1a, 0xde,
+	0x03, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x86, 0x01, 0x0a, 0x06,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x6e, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x65, 0x74, 0x4c, 0x6f,
+	0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x6c, 0x0a, 0x06, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x52, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x42, 0x48, 0x00, 0x52, 0x06, 0x73, 0x69, 0x6d, 0x70,
+	0x6c, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x5a, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66,
+	0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4c, 0x42, 0x48, 0x00, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0x4b, 0x0a, 0x08, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x4c, 0x42, 0x12, 0x0f, 0x0a, 0x0b, 0x52,
+	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x42, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x4c, 0x45, 0x41, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x41, 0x53, 0x53, 0x54, 0x48, 0x52, 0x4f, 0x55, 0x47, 0x48, 0x10, 0x03, 0x42, 0x08, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xbd, 0x04, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x5b, 0x0a, 0x03, 0x74, 0x63, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x54, 0x43,
+	0x50, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x5e, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
 	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
 	0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c,
-	0x53, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x69, 0x73, 0x74, 0x69, 0x6f, 0x1a, 0xa3,
-	0x01, 0x0a, 0x05, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x64, 0x0a, 0x08, 0x74, 0x6c, 0x73, 0x5f,
-	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49, 0x2e, 0x6e, 0x65, 0x74,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x72, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0xf9, 0x01, 0x0a, 0x04, 0x48,
+	0x54, 0x54, 0x50, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6d,
+	0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x69, 0x64, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x64, 0x6c, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0xd7, 0x04, 0x0a, 0x11, 0x4a, 0x77, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6f, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x51, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4a, 0x77, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65,
+	0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x1a, 0xd0, 0x03,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x08, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6a, 0x77, 0x6b, 0x73, 0x55, 0x72, 0x69, 0x12, 0x14, 0x0a,
+	0x04, 0x6a, 0x77, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a,
+	0x77, 0x6b, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x5d, 0x2e, 0x6e, 0x65, 0x74,
 	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
 	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
 	0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x2e, 0x54, 0x4c,
-	0x53, 0x6d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x34,
-	0x0a, 0x07, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x49, 0x53,
-	0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4d, 0x50, 0x4c, 0x45,
-	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x54, 0x49, 0x4f, 0x5f, 0x4d, 0x55, 0x54, 0x55,
-	0x41, 0x4c, 0x10, 0x02, 0x1a, 0x1f, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1f, 0x0a, 0x09, 0x44, 0x4c, 0x50, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1d, 0x0a, 0x07, 0x45, 0x78, 0x74, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x9a, 0x04, 0x0a, 0x0d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x11, 0x76, 0x69, 0x72, 0x74, 0x75,
-	0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x66,
-	0x73, 0x12, 0x55, 0x0a, 0x15, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73,
-	0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c,
-	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x52, 0x13, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73, 0x74,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x66, 0x73,
-	0x12, 0x53, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x54, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x53, 0x70, 0x65, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74,
-	0x63, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x1a, 0x44, 0x0a, 0x16,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65,
-	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xef, 0x04, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
-	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x67, 0x0a, 0x0c, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x43, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x63, 0x79, 0x2e, 0x4a, 0x77, 0x74, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x6f, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x34, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x39, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x42, 0x0d, 0x0a, 0x0b, 0x6a, 0x77, 0x6b, 0x73, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x1a, 0x85, 0x02, 0x0a, 0x04, 0x4d, 0x54, 0x4c, 0x53, 0x12, 0x57, 0x0a, 0x05, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x52, 0x05, 0x69, 0x73, 0x74,
+	0x69, 0x6f, 0x1a, 0xa3, 0x01, 0x0a, 0x05, 0x49, 0x73, 0x74, 0x69, 0x6f, 0x12, 0x64, 0x0a, 0x08,
+	0x74, 0x6c, 0x73, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x49,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x2e, 0x49, 0x73, 0x74, 0x69,
+	0x6f, 0x2e, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x74, 0x6c, 0x73, 0x4d, 0x6f,
+	0x64, 0x65, 0x22, 0x34, 0x0a, 0x07, 0x54, 0x4c, 0x53, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49,
+	0x4d, 0x50, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x53, 0x54, 0x49, 0x4f, 0x5f,
+	0x4d, 0x55, 0x54, 0x55, 0x41, 0x4c, 0x10, 0x02, 0x1a, 0x1f, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1f, 0x0a, 0x09, 0x44, 0x4c, 0x50,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x1d, 0x0a, 0x07, 0x45, 0x78,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x6f, 0x64, 0x6f, 0x1a, 0x9a, 0x04, 0x0a, 0x0d, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x48, 0x0a, 0x11, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x66, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b,
+	0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x48, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x66, 0x73, 0x12, 0x55, 0x0a, 0x15, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x13, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x10,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x66, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b,
+	0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x66, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x66, 0x73, 0x12, 0x53, 0x0a, 0x14, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x84, 0x01, 0x0a, 0x13, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x54, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x1a, 0x44, 0x0a, 0x16, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xef, 0x04, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x66, 0x66,
+	0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f,
+	0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x67,
+	0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c,
+	0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x6b, 0x0a,
+	0x0e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
 	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
-	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x6b, 0x0a, 0x0e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x44, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x12, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x41, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x42, 0x4a, 0x5a,
-	0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
-	0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x12, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x41, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x73, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73,
+	0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1700,92 +3117,136 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_ra
 	return file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDescData
 }
 
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_goTypes = []interface{}{
-	(TrafficPolicySpec_Policy_MTLS_Istio_TLSmode)(0),      // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
-	(*TrafficPolicySpec)(nil),                             // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec
-	(*TrafficPolicyStatus)(nil),                           // 2: networking.mesh.gloo.solo.io.TrafficPolicyStatus
-	(*GatewayRoutes)(nil),                                 // 3: networking.mesh.gloo.solo.io.GatewayRoutes
-	(*TrafficPolicySpec_Policy)(nil),                      // 4: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
-	(*TrafficPolicySpec_RouteSelector)(nil),               // 5: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector
-	(*TrafficPolicySpec_Policy_RetryPolicy)(nil),          // 6: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
-	(*TrafficPolicySpec_Policy_MultiDestination)(nil),     // 7: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
-	(*TrafficPolicySpec_Policy_FaultInjection)(nil),       // 8: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
-	(*TrafficPolicySpec_Policy_CorsPolicy)(nil),           // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
-	(*TrafficPolicySpec_Policy_Mirror)(nil),               // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
-	(*TrafficPolicySpec_Policy_OutlierDetection)(nil),     // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
-	(*TrafficPolicySpec_Policy_MTLS)(nil),                 // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
-	(*TrafficPolicySpec_Policy_Transform)(nil),            // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Transform
-	(*TrafficPolicySpec_Policy_DLPPolicy)(nil),            // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.DLPPolicy
-	(*TrafficPolicySpec_Policy_ExtAuth)(nil),              // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ExtAuth
-	(*TrafficPolicySpec_Policy_FaultInjection_Abort)(nil), // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
-	(*TrafficPolicySpec_Policy_MTLS_Istio)(nil),           // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
-	nil,                              // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.RouteLabelMatcherEntry
-	nil,                              // 19: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
-	nil,                              // 20: networking.mesh.gloo.solo.io.TrafficPolicyStatus.GatewayRoutesEntry
-	(*v1.WorkloadSelector)(nil),      // 21: common.mesh.gloo.solo.io.WorkloadSelector
-	(*v1.DestinationSelector)(nil),   // 22: common.mesh.gloo.solo.io.DestinationSelector
-	(*DeprecatedHttpMatcher)(nil),    // 23: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher
-	(v1.ApprovalState)(0),            // 24: common.mesh.gloo.solo.io.ApprovalState
-	(*duration.Duration)(nil),        // 25: google.protobuf.Duration
-	(*HeaderManipulation)(nil),       // 26: networking.mesh.gloo.solo.io.HeaderManipulation
-	(*csrf.CsrfPolicy)(nil),          // 27: csrf.networking.mesh.gloo.solo.io.CsrfPolicy
-	(*ratelimit.RouteRateLimit)(nil), // 28: ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
-	(*extauth.RouteExtauth)(nil),     // 29: extauth.networking.mesh.gloo.solo.io.RouteExtauth
-	(*v11.ObjectRef)(nil),            // 30: core.skv2.solo.io.ObjectRef
-	(*v11.ObjectSelector)(nil),       // 31: core.skv2.solo.io.ObjectSelector
-	(*WeightedDestination)(nil),      // 32: networking.mesh.gloo.solo.io.WeightedDestination
-	(*v1.StringMatch)(nil),           // 33: common.mesh.gloo.solo.io.StringMatch
-	(*wrappers.BoolValue)(nil),       // 34: google.protobuf.BoolValue
-	(*v11.ClusterObjectRef)(nil),     // 35: core.skv2.solo.io.ClusterObjectRef
-	(*ApprovalStatus)(nil),           // 36: networking.mesh.gloo.solo.io.ApprovalStatus
+	(TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB)(0),                       // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SimpleLB
+	(TrafficPolicySpec_Policy_MTLS_Istio_TLSmode)(0),                                // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
+	(*TrafficPolicySpec)(nil),                                                       // 2: networking.mesh.gloo.solo.io.TrafficPolicySpec
+	(*TrafficPolicyStatus)(nil),                                                     // 3: networking.mesh.gloo.solo.io.TrafficPolicyStatus
+	(*GatewayRoutes)(nil),                                                           // 4: networking.mesh.gloo.solo.io.GatewayRoutes
+	(*TrafficPolicySpec_Policy)(nil),                                                // 5: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
+	(*TrafficPolicySpec_RouteSelector)(nil),                                         // 6: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector
+	(*TrafficPolicySpec_Policy_RetryPolicy)(nil),                                    // 7: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
+	(*TrafficPolicySpec_Policy_MultiDestination)(nil),                               // 8: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
+	(*TrafficPolicySpec_Policy_FaultInjection)(nil),                                 // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
+	(*TrafficPolicySpec_Policy_CorsPolicy)(nil),                                     // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
+	(*TrafficPolicySpec_Policy_Mirror)(nil),                                         // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
+	(*TrafficPolicySpec_Policy_OutlierDetection)(nil),                               // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
+	(*TrafficPolicySpec_Policy_LoadBalancerPolicy)(nil),                             // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy
+	(*TrafficPolicySpec_Policy_ConnectionPool)(nil),                                 // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool
+	(*TrafficPolicySpec_Policy_JwtAuthentication)(nil),                              // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication
+	(*TrafficPolicySpec_Policy_MTLS)(nil),                                           // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
+	(*TrafficPolicySpec_Policy_Transform)(nil),                                      // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Transform
+	(*TrafficPolicySpec_Policy_DLPPolicy)(nil),                                      // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.DLPPolicy
+	(*TrafficPolicySpec_Policy_ExtAuth)(nil),                                        // 19: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ExtAuth
+	(*TrafficPolicySpec_Policy_RetryPolicy_Backoff)(nil),                            // 20: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.Backoff
+	(*TrafficPolicySpec_Policy_FaultInjection_Abort)(nil),                           // 21: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
+	(*TrafficPolicySpec_Policy_FaultInjection_Delay)(nil),                           // 22: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Delay
+	(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB)(nil),            // 23: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB
+	(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy)(nil),    // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy
+	(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie)(nil), // 25: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB.HTTPCookie
+	nil, // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy.LabelsEntry
+	(*TrafficPolicySpec_Policy_ConnectionPool_TCP)(nil),                     // 27: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.TCP
+	(*TrafficPolicySpec_Policy_ConnectionPool_HTTP)(nil),                    // 28: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.HTTP
+	(*TrafficPolicySpec_Policy_JwtAuthentication_Provider)(nil),             // 29: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication.Provider
+	(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader)(nil), // 30: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication.Provider.TokenHeader
+	(*TrafficPolicySpec_Policy_MTLS_Istio)(nil),                             // 31: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
+	nil,                              // 32: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.RouteLabelMatcherEntry
+	nil,                              // 33: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
+	nil,                              // 34: networking.mesh.gloo.solo.io.TrafficPolicyStatus.GatewayRoutesEntry
+	(*v1.WorkloadSelector)(nil),      // 35: common.mesh.gloo.solo.io.WorkloadSelector
+	(*v1.DestinationSelector)(nil),   // 36: common.mesh.gloo.solo.io.DestinationSelector
+	(*DeprecatedHttpMatcher)(nil),    // 37: networking.mesh.gloo.solo.io.DeprecatedHttpMatcher
+	(*TcpMatcher)(nil),               // 38: networking.mesh.gloo.solo.io.TcpMatcher
+	(*TlsMatcher)(nil),               // 39: networking.mesh.gloo.solo.io.TlsMatcher
+	(v1.ApprovalState)(0),            // 40: common.mesh.gloo.solo.io.ApprovalState
+	(*duration.Duration)(nil),        // 41: google.protobuf.Duration
+	(*HeaderManipulation)(nil),       // 42: networking.mesh.gloo.solo.io.HeaderManipulation
+	(*csrf.CsrfPolicy)(nil),          // 43: csrf.networking.mesh.gloo.solo.io.CsrfPolicy
+	(*ratelimit.RouteRateLimit)(nil), // 44: ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
+	(*extauth.RouteExtauth)(nil),     // 45: extauth.networking.mesh.gloo.solo.io.RouteExtauth
+	(*v11.ObjectRef)(nil),            // 46: core.skv2.solo.io.ObjectRef
+	(*v11.ObjectSelector)(nil),       // 47: core.skv2.solo.io.ObjectSelector
+	(*wrappers.BoolValue)(nil),       // 48: google.protobuf.BoolValue
+	(*WeightedDestination)(nil),      // 49: networking.mesh.gloo.solo.io.WeightedDestination
+	(*v1.StringMatch)(nil),           // 50: common.mesh.gloo.solo.io.StringMatch
+	(*v11.ClusterObjectRef)(nil),     // 51: core.skv2.solo.io.ClusterObjectRef
+	(*wrappers.DoubleValue)(nil),     // 52: google.protobuf.DoubleValue
+	(*ApprovalStatus)(nil),           // 53: networking.mesh.gloo.solo.io.ApprovalStatus
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_depIdxs = []int32{
-	21, // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.WorkloadSelector
-	22, // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
-	5,  // 2: networking.mesh.gloo.solo.io.TrafficPolicySpec.route_selector:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector
-	23, // 3: networking.mesh.gloo.solo.io.TrafficPolicySpec.http_request_matchers:type_name -> networking.mesh.gloo.solo.io.DeprecatedHttpMatcher
-	4,  // 4: networking.mesh.gloo.solo.io.TrafficPolicySpec.policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
-	24, // 5: networking.mesh.gloo.solo.io.TrafficPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
-	19, // 6: networking.mesh.gloo.solo.io.TrafficPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
-	20, // 7: networking.mesh.gloo.solo.io.TrafficPolicyStatus.gateway_routes:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.GatewayRoutesEntry
-	7,  // 8: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.traffic_shift:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
-	8,  // 9: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.fault_injection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
-	25, // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.request_timeout:type_name -> google.protobuf.Duration
-	6,  // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.retries:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
-	9,  // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.cors_policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
-	10, // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mirror:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
-	26, // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.header_manipulation:type_name -> networking.mesh.gloo.solo.io.HeaderManipulation
-	11, // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.outlier_detection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
-	12, // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mtls:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
-	27, // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.csrf:type_name -> csrf.networking.mesh.gloo.solo.io.CsrfPolicy
-	28, // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.rate_limit:type_name -> ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
-	29, // 19: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.extauth:type_name -> extauth.networking.mesh.gloo.solo.io.RouteExtauth
-	30, // 20: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.virtual_host_refs:type_name -> core.skv2.solo.io.ObjectRef
-	31, // 21: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.virtual_host_selector:type_name -> core.skv2.solo.io.ObjectSelector
-	30, // 22: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.route_table_refs:type_name -> core.skv2.solo.io.ObjectRef
-	31, // 23: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.route_table_selector:type_name -> core.skv2.solo.io.ObjectSelector
-	18, // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.route_label_matcher:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.RouteLabelMatcherEntry
-	25, // 25: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.per_try_timeout:type_name -> google.protobuf.Duration
-	32, // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination.destinations:type_name -> networking.mesh.gloo.solo.io.WeightedDestination
-	25, // 27: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.fixed_delay:type_name -> google.protobuf.Duration
-	16, // 28: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.abort:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
-	33, // 29: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_origins:type_name -> common.mesh.gloo.solo.io.StringMatch
-	25, // 30: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.max_age:type_name -> google.protobuf.Duration
-	34, // 31: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_credentials:type_name -> google.protobuf.BoolValue
-	35, // 32: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror.kube_service:type_name -> core.skv2.solo.io.ClusterObjectRef
-	25, // 33: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.interval:type_name -> google.protobuf.Duration
-	25, // 34: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.base_ejection_time:type_name -> google.protobuf.Duration
-	17, // 35: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.istio:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
-	0,  // 36: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.tls_mode:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
-	36, // 37: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
-	3,  // 38: networking.mesh.gloo.solo.io.TrafficPolicyStatus.GatewayRoutesEntry.value:type_name -> networking.mesh.gloo.solo.io.GatewayRoutes
-	39, // [39:39] is the sub-list for method output_type
-	39, // [39:39] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	35, // 0: networking.mesh.gloo.solo.io.TrafficPolicySpec.source_selector:type_name -> common.mesh.gloo.solo.io.WorkloadSelector
+	36, // 1: networking.mesh.gloo.solo.io.TrafficPolicySpec.destination_selector:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	6,  // 2: networking.mesh.gloo.solo.io.TrafficPolicySpec.route_selector:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector
+	37, // 3: networking.mesh.gloo.solo.io.TrafficPolicySpec.http_request_matchers:type_name -> networking.mesh.gloo.solo.io.DeprecatedHttpMatcher
+	5,  // 4: networking.mesh.gloo.solo.io.TrafficPolicySpec.policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy
+	38, // 5: networking.mesh.gloo.solo.io.TrafficPolicySpec.tcp_request_matchers:type_name -> networking.mesh.gloo.solo.io.TcpMatcher
+	39, // 6: networking.mesh.gloo.solo.io.TrafficPolicySpec.tls_request_matchers:type_name -> networking.mesh.gloo.solo.io.TlsMatcher
+	40, // 7: networking.mesh.gloo.solo.io.TrafficPolicyStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	33, // 8: networking.mesh.gloo.solo.io.TrafficPolicyStatus.destinations:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry
+	34, // 9: networking.mesh.gloo.solo.io.TrafficPolicyStatus.gateway_routes:type_name -> networking.mesh.gloo.solo.io.TrafficPolicyStatus.GatewayRoutesEntry
+	8,  // 10: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.traffic_shift:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination
+	9,  // 11: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.fault_injection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection
+	41, // 12: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.request_timeout:type_name -> google.protobuf.Duration
+	7,  // 13: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.retries:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy
+	10, // 14: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.cors_policy:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy
+	11, // 15: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mirror:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror
+	42, // 16: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.header_manipulation:type_name -> networking.mesh.gloo.solo.io.HeaderManipulation
+	12, // 17: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.outlier_detection:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection
+	16, // 18: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.mtls:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS
+	43, // 19: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.csrf:type_name -> csrf.networking.mesh.gloo.solo.io.CsrfPolicy
+	44, // 20: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.rate_limit:type_name -> ratelimit.networking.mesh.gloo.solo.io.RouteRateLimit
+	45, // 21: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.extauth:type_name -> extauth.networking.mesh.gloo.solo.io.RouteExtauth
+	13, // 22: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.load_balancer:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy
+	14, // 23: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.connection_pool:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool
+	15, // 24: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.jwt:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication
+	46, // 25: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.virtual_host_refs:type_name -> core.skv2.solo.io.ObjectRef
+	47, // 26: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.virtual_host_selector:type_name -> core.skv2.solo.io.ObjectSelector
+	46, // 27: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.route_table_refs:type_name -> core.skv2.solo.io.ObjectRef
+	47, // 28: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.route_table_selector:type_name -> core.skv2.solo.io.ObjectSelector
+	32, // 29: networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.route_label_matcher:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.RouteSelector.RouteLabelMatcherEntry
+	41, // 30: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.per_try_timeout:type_name -> google.protobuf.Duration
+	48, // 31: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.retry_remote_localities:type_name -> google.protobuf.BoolValue
+	20, // 32: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.backoff:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.Backoff
+	49, // 33: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MultiDestination.destinations:type_name -> networking.mesh.gloo.solo.io.WeightedDestination
+	41, // 34: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.fixed_delay:type_name -> google.protobuf.Duration
+	21, // 35: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.abort:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort
+	22, // 36: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.delay:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Delay
+	50, // 37: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_origins:type_name -> common.mesh.gloo.solo.io.StringMatch
+	41, // 38: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.max_age:type_name -> google.protobuf.Duration
+	48, // 39: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.CorsPolicy.allow_credentials:type_name -> google.protobuf.BoolValue
+	51, // 40: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.Mirror.kube_service:type_name -> core.skv2.solo.io.ClusterObjectRef
+	41, // 41: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.interval:type_name -> google.protobuf.Duration
+	41, // 42: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.OutlierDetection.base_ejection_time:type_name -> google.protobuf.Duration
+	0,  // 43: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.simple:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SimpleLB
+	23, // 44: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.consistent_hash:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB
+	24, // 45: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.subsets:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy
+	27, // 46: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.tcp:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.TCP
+	28, // 47: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.http:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.HTTP
+	29, // 48: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication.providers:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication.Provider
+	31, // 49: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.istio:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio
+	41, // 50: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.Backoff.base_interval:type_name -> google.protobuf.Duration
+	41, // 51: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.RetryPolicy.Backoff.max_interval:type_name -> google.protobuf.Duration
+	52, // 52: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Abort.percentage:type_name -> google.protobuf.DoubleValue
+	41, // 53: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Delay.fixed_delay:type_name -> google.protobuf.Duration
+	52, // 54: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.FaultInjection.Delay.percentage:type_name -> google.protobuf.DoubleValue
+	25, // 55: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB.http_cookie:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB.HTTPCookie
+	26, // 56: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy.labels:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy.LabelsEntry
+	0,  // 57: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy.simple:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SimpleLB
+	23, // 58: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.SubsetLoadBalancerPolicy.consistent_hash:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB
+	41, // 59: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.LoadBalancerPolicy.ConsistentHashLB.HTTPCookie.ttl:type_name -> google.protobuf.Duration
+	41, // 60: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.TCP.connect_timeout:type_name -> google.protobuf.Duration
+	41, // 61: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.ConnectionPool.HTTP.idle_timeout:type_name -> google.protobuf.Duration
+	30, // 62: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication.Provider.from_headers:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.JwtAuthentication.Provider.TokenHeader
+	1,  // 63: networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.tls_mode:type_name -> networking.mesh.gloo.solo.io.TrafficPolicySpec.Policy.MTLS.Istio.TLSmode
+	53, // 64: networking.mesh.gloo.solo.io.TrafficPolicyStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	4,  // 65: networking.mesh.gloo.solo.io.TrafficPolicyStatus.GatewayRoutesEntry.value:type_name -> networking.mesh.gloo.solo.io.GatewayRoutes
+	66, // [66:66] is the sub-list for method output_type
+	66, // [66:66] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_init() }
@@ -1930,7 +3391,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_MTLS); i {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancerPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1942,7 +3403,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_Transform); i {
+			switch v := v.(*TrafficPolicySpec_Policy_ConnectionPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1954,7 +3415,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_DLPPolicy); i {
+			switch v := v.(*TrafficPolicySpec_Policy_JwtAuthentication); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1966,7 +3427,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_ExtAuth); i {
+			switch v := v.(*TrafficPolicySpec_Policy_MTLS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1978,7 +3439,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection_Abort); i {
+			switch v := v.(*TrafficPolicySpec_Policy_Transform); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1990,6 +3451,150 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_DLPPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_ExtAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_RetryPolicy_Backoff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection_Abort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_FaultInjection_Delay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_ConnectionPool_TCP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_ConnectionPool_HTTP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_JwtAuthentication_Provider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrafficPolicySpec_Policy_MTLS_Istio); i {
 			case 0:
 				return &v.state
@@ -2009,13 +3614,31 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_in
 	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*TrafficPolicySpec_Policy_Mirror_KubeService)(nil),
 	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash)(nil),
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName)(nil),
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple)(nil),
+		(*TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash)(nil),
+	}
+	file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri)(nil),
+		(*TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_traffic_policy_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      2,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -327,6 +327,33 @@ func (m *VirtualMeshStatus) Equal(that interface{}) bool {
 		}
 	}
 
+	if len(m.GetMeshCertificates()) != len(target.GetMeshCertificates()) {
+		return false
+	}
+	for k, v := range m.GetMeshCertificates() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMeshCertificates()[k]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMeshCertificates()[k]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetFailedSharedTrust()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetFailedSharedTrust()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetFailedSharedTrust(), target.GetFailedSharedTrust()) {
+			return false
+		}
+	}
+
 	return true
 }
 
@@ -532,6 +559,87 @@ func (m *VirtualMeshSpec_MTLSConfig_LimitedTrust) Equal(that interface{}) bool {
 		return false
 	}
 
+	if h, ok := interface{}(m.GetGeneratedRootCertOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetGeneratedRootCertOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetGeneratedRootCertOptions(), target.GetGeneratedRootCertOptions()) {
+			return false
+		}
+	}
+
+	if len(m.GetMeshCertificateAuthorities()) != len(target.GetMeshCertificateAuthorities()) {
+		return false
+	}
+	for idx, v := range m.GetMeshCertificateAuthorities() {
+
+		if h, ok := interface{}(v).(equality.Equalizer); ok {
+			if !h.Equal(target.GetMeshCertificateAuthorities()[idx]) {
+				return false
+			}
+		} else {
+			if !proto.Equal(v, target.GetMeshCertificateAuthorities()[idx]) {
+				return false
+			}
+		}
+
+	}
+
+	if h, ok := interface{}(m.GetIntermediateCertOptions()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetIntermediateCertOptions()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetIntermediateCertOptions(), target.GetIntermediateCertOptions()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal function
+func (m *VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) Equal(that interface{}) bool {
+	if that == nil {
+		return m == nil
+	}
+
+	target, ok := that.(*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority)
+	if !ok {
+		that2, ok := that.(VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority)
+		if ok {
+			target = &that2
+		} else {
+			return false
+		}
+	}
+	if target == nil {
+		return m == nil
+	} else if m == nil {
+		return false
+	}
+
+	if h, ok := interface{}(m.GetMesh()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetMesh()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetMesh(), target.GetMesh()) {
+			return false
+		}
+	}
+
+	if h, ok := interface{}(m.GetSecret()).(equality.Equalizer); ok {
+		if !h.Equal(target.GetSecret()) {
+			return false
+		}
+	} else {
+		if !proto.Equal(m.GetSecret(), target.GetSecret()) {
+			return false
+		}
+	}
+
 	return true
 }
 
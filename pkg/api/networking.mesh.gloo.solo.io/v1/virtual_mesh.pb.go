@@ -368,6 +368,11 @@ type VirtualMeshStatus struct {
 	// A copy of the shared_trust object currently deployed in the cluster. If the shared trust object in the spec
 	// is different from this, we need to start a new rotation.
 	DeployedSharedTrust *SharedTrust `protobuf:"bytes,7,opt,name=deployed_shared_trust,json=deployedSharedTrust,proto3" json:"deployed_shared_trust,omitempty"`
+	// Details of the certificate most recently issued to each Mesh to which the VirtualMesh has been applied.
+	MeshCertificates map[string]*v11.IssuedCertificateInfo `protobuf:"bytes,8,rep,name=mesh_certificates,json=meshCertificates,proto3" json:"mesh_certificates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// A copy of the shared_trust object whose automatic rotation most recently failed. A new rotation is not started
+	// until the shared trust object in the spec differs from this.
+	FailedSharedTrust *SharedTrust `protobuf:"bytes,9,opt,name=failed_shared_trust,json=failedSharedTrust,proto3" json:"failed_shared_trust,omitempty"`
 }
 
 func (x *VirtualMeshStatus) Reset() {
@@ -451,6 +456,20 @@ func (x *VirtualMeshStatus) GetDeployedSharedTrust() *SharedTrust {
 	return nil
 }
 
+func (x *VirtualMeshStatus) GetMeshCertificates() map[string]*v11.IssuedCertificateInfo {
+	if x != nil {
+		return x.MeshCertificates
+	}
+	return nil
+}
+
+func (x *VirtualMeshStatus) GetFailedSharedTrust() *SharedTrust {
+	if x != nil {
+		return x.FailedSharedTrust
+	}
+	return nil
+}
+
 // Specify mTLS options.
 // This includes options for configuring Mutual TLS within an individual Mesh, as
 // well as enabling mTLS across Meshes by establishing cross-mesh trust.
@@ -562,7 +581,7 @@ type VirtualMeshSpec_MTLSConfig_Shared struct {
 }
 
 type VirtualMeshSpec_MTLSConfig_Limited struct {
-	// Limited trust (selectively allow communication between Workloads and Destinations in the grouped Meshes). *Currently not available.*
+	// Limited trust (selectively allow communication between Workloads and Destinations in the grouped Meshes).
 	Limited *VirtualMeshSpec_MTLSConfig_LimitedTrust `protobuf:"bytes,2,opt,name=limited,proto3,oneof"`
 }
 
@@ -697,12 +716,12 @@ type VirtualMeshSpec_Federation_Permissive struct {
 func (*VirtualMeshSpec_Federation_Permissive) isVirtualMeshSpec_Federation_Mode() {}
 
 // Limited trust is a trust model which does not require trusting Meshes to share the same root certificate
-// or identity. Instead, trust is established between different Meshes
-// by connecting their ingress/egress gateways with a common certificate/identity. In this model all requests
-// between different have the following request path when communicating between clusters
+// or identity. Instead, each Mesh keeps its own root certificate, which is added to the trust bundles of the other Meshes
+// in the VirtualMesh, and mTLS is terminated and re-originated at the ingress gateways. In this model all requests
+// between different Meshes have the following request path when communicating between clusters
 // ```
-//                cluster 1 MTLS               shared MTLS                  cluster 2 MTLS
-// client/workload <-----------> egress gateway <----------> ingress gateway <--------------> server
+//                     cluster 1 MTLS                          cluster 2 MTLS
+// client/workload <-----------------------> ingress gateway <--------------> server
 // ```
 // This approach has the downside of not maintaining identity from client to server, but allows for ad-hoc
 // addition of additional Meshes into a VirtualMesh.
@@ -710,6 +729,14 @@ type VirtualMeshSpec_MTLSConfig_LimitedTrust struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Options for the root certificates generated by Gloo Mesh for each Mesh which does not specify
+	// its own root certificate authority in `mesh_certificate_authorities`.
+	GeneratedRootCertOptions *v11.CommonCertOptions `protobuf:"bytes,1,opt,name=generated_root_cert_options,json=generatedRootCertOptions,proto3" json:"generated_root_cert_options,omitempty"`
+	// Root certificate authorities provided for individual Meshes.
+	MeshCertificateAuthorities []*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority `protobuf:"bytes,2,rep,name=mesh_certificate_authorities,json=meshCertificateAuthorities,proto3" json:"mesh_certificate_authorities,omitempty"`
+	// Configuration options for the intermediate certs signed by each Mesh's root certificate authority.
+	IntermediateCertOptions *v11.CommonCertOptions `protobuf:"bytes,3,opt,name=intermediate_cert_options,json=intermediateCertOptions,proto3" json:"intermediate_cert_options,omitempty"`
 }
 
 func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust) Reset() {
@@ -744,6 +771,86 @@ func (*VirtualMeshSpec_MTLSConfig_LimitedTrust) Descriptor() ([]byte, []int) {
 	return file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawDescGZIP(), []int{0, 0, 0}
 }
 
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust) GetGeneratedRootCertOptions() *v11.CommonCertOptions {
+	if x != nil {
+		return x.GeneratedRootCertOptions
+	}
+	return nil
+}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust) GetMeshCertificateAuthorities() []*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority {
+	if x != nil {
+		return x.MeshCertificateAuthorities
+	}
+	return nil
+}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust) GetIntermediateCertOptions() *v11.CommonCertOptions {
+	if x != nil {
+		return x.IntermediateCertOptions
+	}
+	return nil
+}
+
+// A root certificate authority for a single Mesh.
+type VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The Mesh which uses this root certificate authority.
+	Mesh *v1.ObjectRef `protobuf:"bytes,1,opt,name=mesh,proto3" json:"mesh,omitempty"`
+	// Reference to a Kubernetes Secret containing the root certificate authority.
+	// Provided secrets must conform to a specified format, [documented here]({{< versioned_link_path fromRoot="/guides/federate_identity/" >}}).
+	Secret *v1.ObjectRef `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) Reset() {
+	*x = VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) ProtoMessage() {}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority.ProtoReflect.Descriptor instead.
+func (*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) Descriptor() ([]byte, []int) {
+	return file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawDescGZIP(), []int{0, 0, 0, 0}
+}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) GetMesh() *v1.ObjectRef {
+	if x != nil {
+		return x.Mesh
+	}
+	return nil
+}
+
+func (x *VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority) GetSecret() *v1.ObjectRef {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
 // Selects a set of Destinations to federate to the referenced Meshes.
 type VirtualMeshSpec_Federation_FederationSelector struct {
 	state         protoimpl.MessageState
@@ -761,7 +868,7 @@ type VirtualMeshSpec_Federation_FederationSelector struct {
 func (x *VirtualMeshSpec_Federation_FederationSelector) Reset() {
 	*x = VirtualMeshSpec_Federation_FederationSelector{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[7]
+		mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -774,7 +881,7 @@ func (x *VirtualMeshSpec_Federation_FederationSelector) String() string {
 func (*VirtualMeshSpec_Federation_FederationSelector) ProtoMessage() {}
 
 func (x *VirtualMeshSpec_Federation_FederationSelector) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[7]
+	mi := &file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +947,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawDe
 	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x12, 0x65, 0x78, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x9d, 0x0c, 0x0a, 0x0f, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d,
+	0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x10, 0x0a, 0x0f, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d,
 	0x65, 0x73, 0x68, 0x53, 0x70, 0x65, 0x63, 0x12, 0x34, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73,
 	0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65,
@@ -863,7 +970,7 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawDe
 	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53, 0x70, 0x65, 0x63, 0x2e,
 	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69,
 	0x63, 0x79, 0x52, 0x12, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xf3, 0x03, 0x0a, 0x0a, 0x4d, 0x54, 0x4c, 0x53, 0x43,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x1a, 0xfd, 0x07, 0x0a, 0x0a, 0x4d, 0x54, 0x4c, 0x53, 0x43,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
 	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
@@ -892,142 +999,196 @@ var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawDe
 	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e,
 	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x10, 0x72, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x0e, 0x0a,
-	0x0c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x42, 0x0d, 0x0a,
-	0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0xf1, 0x04, 0x0a,
-	0x0a, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x19, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
-	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x52, 0x17, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x09, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74,
-	0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x46, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
-	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x48, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6c, 0x61, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x75,
-	0x66, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x4b, 0x0a, 0x0d, 0x74, 0x63,
-	0x70, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x43, 0x50,
-	0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65,
-	0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x1a, 0xae, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x64, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x62,
-	0x0a, 0x15, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x14, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73,
-	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66,
-	0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x22, 0x41, 0x0a, 0x12, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x45, 0x53, 0x48, 0x5f, 0x44,
-	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x41, 0x42,
-	0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45,
-	0x44, 0x10, 0x02, 0x22, 0xb2, 0x01, 0x0a, 0x18, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x51, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x1a, 0x97, 0x04,
+	0x0a, 0x0c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x70,
+	0x0a, 0x1b, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
 	0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
 	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e,
-	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x66, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63,
-	0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x0b, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x76, 0x0a, 0x1a, 0x72, 0x6f, 0x6f, 0x74,
-	0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
-	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x74,
-	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x18, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x90, 0x01, 0x0a, 0x22, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
-	0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e,
-	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48,
-	0x00, 0x52, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x6d, 0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0xa0, 0x01, 0x0a, 0x1c, 0x6d, 0x65, 0x73, 0x68, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x5e, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x68, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x52, 0x1a, 0x6d, 0x65, 0x73, 0x68, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69,
 	0x61, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
 	0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e,
 	0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65,
 	0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72,
 	0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xec, 0x05, 0x0a, 0x11,
-	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12,
-	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
-	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70,
-	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x6d, 0x65, 0x73,
-	0x68, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
-	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
-	0x4d, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x12, 0x65,
-	0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
-	0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5c, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x65, 0x72, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
-	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x5d, 0x0a, 0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x13, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x75,
-	0x73, 0x74, 0x1a, 0x67, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e,
+	0x6e, 0x73, 0x1a, 0x82, 0x01, 0x0a, 0x18, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x30, 0x0a, 0x04, 0x6d, 0x65, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x04, 0x6d, 0x65, 0x73,
+	0x68, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0xf1, 0x04, 0x0a, 0x0a, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x19, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f,
+	0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x17, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x12, 0x69, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73,
+	0x68, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x38,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x61, 0x74,
+	0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x66, 0x6c, 0x61, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x53, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x12, 0x4b, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6b, 0x65, 0x65, 0x70,
+	0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x54, 0x43, 0x50, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c,
+	0x69, 0x76, 0x65, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76,
+	0x65, 0x1a, 0xae, 0x01, 0x0a, 0x12, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x62, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x14, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x34, 0x0a, 0x06,
+	0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
+	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x6d, 0x65, 0x73, 0x68,
+	0x65, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a, 0x12, 0x47, 0x6c,
+	0x6f, 0x62, 0x61, 0x6c, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x45, 0x53, 0x48, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x22, 0xb2, 0x01,
+	0x0a, 0x18, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x51, 0x0a, 0x09, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x48, 0x00, 0x52, 0x09, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x73, 0x6b, 0x76, 0x32, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
+	0x6f, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x61, 0x5f, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x12, 0x76, 0x0a, 0x1a, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x18, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x90, 0x01, 0x0a, 0x22, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f,
+	0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x20, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x6d, 0x0a,
+	0x19, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e,
 	0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69,
-	0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x11, 0x44,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f,
-	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x4a, 0x5a, 0x44, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f, 0x6c, 0x6f, 0x2d, 0x69, 0x6f,
-	0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2f,
-	0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x17, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x43, 0x65, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x22, 0xb7, 0x08, 0x0a, 0x11, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x6f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e,
+	0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x0c, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0c, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x5c, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5d, 0x0a,
+	0x15, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67,
+	0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x13, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x65,
+	0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x12, 0x72, 0x0a, 0x11,
+	0x6d, 0x65, 0x73, 0x68, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73,
+	0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10,
+	0x6d, 0x65, 0x73, 0x68, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x59, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x5f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e,
+	0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x52, 0x11, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x54, 0x72, 0x75, 0x73, 0x74, 0x1a, 0x67, 0x0a, 0x0b, 0x4d,
+	0x65, 0x73, 0x68, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c,
+	0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x11, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f,
+	0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x7a, 0x0a, 0x15, 0x4d, 0x65, 0x73, 0x68, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4b,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c, 0x6f, 0x2e, 0x69, 0x6f, 0x2e, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x4a, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x6f,
+	0x6c, 0x6f, 0x2d, 0x69, 0x6f, 0x2f, 0x67, 0x6c, 0x6f, 0x6f, 0x2d, 0x6d, 0x65, 0x73, 0x68, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x2e, 0x67, 0x6c, 0x6f, 0x6f, 0x2e, 0x73, 0x6f, 0x6c,
+	0x6f, 0x2e, 0x69, 0x6f, 0x2f, 0x76, 0x31, 0xc0, 0xf5, 0x04, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1043,64 +1204,75 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawD
 }
 
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_goTypes = []interface{}{
-	(VirtualMeshSpec_GlobalAccessPolicy)(0),               // 0: networking.mesh.gloo.solo.io.VirtualMeshSpec.GlobalAccessPolicy
-	(*VirtualMeshSpec)(nil),                               // 1: networking.mesh.gloo.solo.io.VirtualMeshSpec
-	(*RootCertificateAuthority)(nil),                      // 2: networking.mesh.gloo.solo.io.RootCertificateAuthority
-	(*SharedTrust)(nil),                                   // 3: networking.mesh.gloo.solo.io.SharedTrust
-	(*VirtualMeshStatus)(nil),                             // 4: networking.mesh.gloo.solo.io.VirtualMeshStatus
-	(*VirtualMeshSpec_MTLSConfig)(nil),                    // 5: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig
-	(*VirtualMeshSpec_Federation)(nil),                    // 6: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation
-	(*VirtualMeshSpec_MTLSConfig_LimitedTrust)(nil),       // 7: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust
-	(*VirtualMeshSpec_Federation_FederationSelector)(nil), // 8: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector
-	nil,                           // 9: networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshesEntry
-	nil,                           // 10: networking.mesh.gloo.solo.io.VirtualMeshStatus.DestinationsEntry
-	(*v1.ObjectRef)(nil),          // 11: core.skv2.solo.io.ObjectRef
-	(*v11.CommonCertOptions)(nil), // 12: certificates.mesh.gloo.solo.io.CommonCertOptions
-	(*v11.IntermediateCertificateAuthority)(nil),      // 13: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
-	(v12.ApprovalState)(0),                            // 14: common.mesh.gloo.solo.io.ApprovalState
-	(*v11.CertificateRotationCondition)(nil),          // 15: certificates.mesh.gloo.solo.io.CertificateRotationCondition
-	(*v11.CertificateRotationVerificationMethod)(nil), // 16: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod
-	(v11.CertificateRotationStrategy)(0),              // 17: certificates.mesh.gloo.solo.io.CertificateRotationStrategy
-	(*v12.IngressGatewaySelector)(nil),                // 18: common.mesh.gloo.solo.io.IngressGatewaySelector
-	(*empty.Empty)(nil),                               // 19: google.protobuf.Empty
-	(*v12.TCPKeepalive)(nil),                          // 20: common.mesh.gloo.solo.io.TCPKeepalive
-	(*v12.DestinationSelector)(nil),                   // 21: common.mesh.gloo.solo.io.DestinationSelector
-	(*ApprovalStatus)(nil),                            // 22: networking.mesh.gloo.solo.io.ApprovalStatus
+	(VirtualMeshSpec_GlobalAccessPolicy)(0),                                  // 0: networking.mesh.gloo.solo.io.VirtualMeshSpec.GlobalAccessPolicy
+	(*VirtualMeshSpec)(nil),                                                  // 1: networking.mesh.gloo.solo.io.VirtualMeshSpec
+	(*RootCertificateAuthority)(nil),                                         // 2: networking.mesh.gloo.solo.io.RootCertificateAuthority
+	(*SharedTrust)(nil),                                                      // 3: networking.mesh.gloo.solo.io.SharedTrust
+	(*VirtualMeshStatus)(nil),                                                // 4: networking.mesh.gloo.solo.io.VirtualMeshStatus
+	(*VirtualMeshSpec_MTLSConfig)(nil),                                       // 5: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig
+	(*VirtualMeshSpec_Federation)(nil),                                       // 6: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation
+	(*VirtualMeshSpec_MTLSConfig_LimitedTrust)(nil),                          // 7: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust
+	(*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority)(nil), // 8: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.MeshCertificateAuthority
+	(*VirtualMeshSpec_Federation_FederationSelector)(nil),                    // 9: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector
+	nil,                           // 10: networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshesEntry
+	nil,                           // 11: networking.mesh.gloo.solo.io.VirtualMeshStatus.DestinationsEntry
+	nil,                           // 12: networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshCertificatesEntry
+	(*v1.ObjectRef)(nil),          // 13: core.skv2.solo.io.ObjectRef
+	(*v11.CommonCertOptions)(nil), // 14: certificates.mesh.gloo.solo.io.CommonCertOptions
+	(*v11.IntermediateCertificateAuthority)(nil),      // 15: certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	(v12.ApprovalState)(0),                            // 16: common.mesh.gloo.solo.io.ApprovalState
+	(*v11.CertificateRotationCondition)(nil),          // 17: certificates.mesh.gloo.solo.io.CertificateRotationCondition
+	(*v11.CertificateRotationVerificationMethod)(nil), // 18: certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod
+	(v11.CertificateRotationStrategy)(0),              // 19: certificates.mesh.gloo.solo.io.CertificateRotationStrategy
+	(*v12.IngressGatewaySelector)(nil),                // 20: common.mesh.gloo.solo.io.IngressGatewaySelector
+	(*empty.Empty)(nil),                               // 21: google.protobuf.Empty
+	(*v12.TCPKeepalive)(nil),                          // 22: common.mesh.gloo.solo.io.TCPKeepalive
+	(*v12.DestinationSelector)(nil),                   // 23: common.mesh.gloo.solo.io.DestinationSelector
+	(*ApprovalStatus)(nil),                            // 24: networking.mesh.gloo.solo.io.ApprovalStatus
+	(*v11.IssuedCertificateInfo)(nil),                 // 25: certificates.mesh.gloo.solo.io.IssuedCertificateInfo
 }
 var file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_depIdxs = []int32{
-	11, // 0: networking.mesh.gloo.solo.io.VirtualMeshSpec.meshes:type_name -> core.skv2.solo.io.ObjectRef
+	13, // 0: networking.mesh.gloo.solo.io.VirtualMeshSpec.meshes:type_name -> core.skv2.solo.io.ObjectRef
 	5,  // 1: networking.mesh.gloo.solo.io.VirtualMeshSpec.mtls_config:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig
 	6,  // 2: networking.mesh.gloo.solo.io.VirtualMeshSpec.federation:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation
 	0,  // 3: networking.mesh.gloo.solo.io.VirtualMeshSpec.global_access_policy:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.GlobalAccessPolicy
-	12, // 4: networking.mesh.gloo.solo.io.RootCertificateAuthority.generated:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
-	11, // 5: networking.mesh.gloo.solo.io.RootCertificateAuthority.secret:type_name -> core.skv2.solo.io.ObjectRef
+	14, // 4: networking.mesh.gloo.solo.io.RootCertificateAuthority.generated:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
+	13, // 5: networking.mesh.gloo.solo.io.RootCertificateAuthority.secret:type_name -> core.skv2.solo.io.ObjectRef
 	2,  // 6: networking.mesh.gloo.solo.io.SharedTrust.root_certificate_authority:type_name -> networking.mesh.gloo.solo.io.RootCertificateAuthority
-	13, // 7: networking.mesh.gloo.solo.io.SharedTrust.intermediate_certificate_authority:type_name -> certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
-	12, // 8: networking.mesh.gloo.solo.io.SharedTrust.intermediate_cert_options:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
-	14, // 9: networking.mesh.gloo.solo.io.VirtualMeshStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
-	9,  // 10: networking.mesh.gloo.solo.io.VirtualMeshStatus.meshes:type_name -> networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshesEntry
-	10, // 11: networking.mesh.gloo.solo.io.VirtualMeshStatus.destinations:type_name -> networking.mesh.gloo.solo.io.VirtualMeshStatus.DestinationsEntry
-	15, // 12: networking.mesh.gloo.solo.io.VirtualMeshStatus.conditions:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationCondition
+	15, // 7: networking.mesh.gloo.solo.io.SharedTrust.intermediate_certificate_authority:type_name -> certificates.mesh.gloo.solo.io.IntermediateCertificateAuthority
+	14, // 8: networking.mesh.gloo.solo.io.SharedTrust.intermediate_cert_options:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
+	16, // 9: networking.mesh.gloo.solo.io.VirtualMeshStatus.state:type_name -> common.mesh.gloo.solo.io.ApprovalState
+	10, // 10: networking.mesh.gloo.solo.io.VirtualMeshStatus.meshes:type_name -> networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshesEntry
+	11, // 11: networking.mesh.gloo.solo.io.VirtualMeshStatus.destinations:type_name -> networking.mesh.gloo.solo.io.VirtualMeshStatus.DestinationsEntry
+	17, // 12: networking.mesh.gloo.solo.io.VirtualMeshStatus.conditions:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationCondition
 	3,  // 13: networking.mesh.gloo.solo.io.VirtualMeshStatus.deployed_shared_trust:type_name -> networking.mesh.gloo.solo.io.SharedTrust
-	3,  // 14: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.shared:type_name -> networking.mesh.gloo.solo.io.SharedTrust
-	7,  // 15: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.limited:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust
-	16, // 16: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.rotation_verification_method:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod
-	17, // 17: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.rotation_strategy:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationStrategy
-	18, // 18: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.ingress_gateway_selectors:type_name -> common.mesh.gloo.solo.io.IngressGatewaySelector
-	8,  // 19: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.selectors:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector
-	19, // 20: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.permissive:type_name -> google.protobuf.Empty
-	20, // 21: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.tcp_keepalive:type_name -> common.mesh.gloo.solo.io.TCPKeepalive
-	21, // 22: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector.destination_selectors:type_name -> common.mesh.gloo.solo.io.DestinationSelector
-	11, // 23: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector.meshes:type_name -> core.skv2.solo.io.ObjectRef
-	22, // 24: networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshesEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
-	22, // 25: networking.mesh.gloo.solo.io.VirtualMeshStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
-	26, // [26:26] is the sub-list for method output_type
-	26, // [26:26] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	12, // 14: networking.mesh.gloo.solo.io.VirtualMeshStatus.mesh_certificates:type_name -> networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshCertificatesEntry
+	3,  // 15: networking.mesh.gloo.solo.io.VirtualMeshStatus.failed_shared_trust:type_name -> networking.mesh.gloo.solo.io.SharedTrust
+	3,  // 16: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.shared:type_name -> networking.mesh.gloo.solo.io.SharedTrust
+	7,  // 17: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.limited:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust
+	18, // 18: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.rotation_verification_method:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationVerificationMethod
+	19, // 19: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.rotation_strategy:type_name -> certificates.mesh.gloo.solo.io.CertificateRotationStrategy
+	20, // 20: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.ingress_gateway_selectors:type_name -> common.mesh.gloo.solo.io.IngressGatewaySelector
+	9,  // 21: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.selectors:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector
+	21, // 22: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.permissive:type_name -> google.protobuf.Empty
+	22, // 23: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.tcp_keepalive:type_name -> common.mesh.gloo.solo.io.TCPKeepalive
+	14, // 24: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.generated_root_cert_options:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
+	8,  // 25: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.mesh_certificate_authorities:type_name -> networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.MeshCertificateAuthority
+	14, // 26: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.intermediate_cert_options:type_name -> certificates.mesh.gloo.solo.io.CommonCertOptions
+	13, // 27: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.MeshCertificateAuthority.mesh:type_name -> core.skv2.solo.io.ObjectRef
+	13, // 28: networking.mesh.gloo.solo.io.VirtualMeshSpec.MTLSConfig.LimitedTrust.MeshCertificateAuthority.secret:type_name -> core.skv2.solo.io.ObjectRef
+	23, // 29: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector.destination_selectors:type_name -> common.mesh.gloo.solo.io.DestinationSelector
+	13, // 30: networking.mesh.gloo.solo.io.VirtualMeshSpec.Federation.FederationSelector.meshes:type_name -> core.skv2.solo.io.ObjectRef
+	24, // 31: networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshesEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	24, // 32: networking.mesh.gloo.solo.io.VirtualMeshStatus.DestinationsEntry.value:type_name -> networking.mesh.gloo.solo.io.ApprovalStatus
+	25, // 33: networking.mesh.gloo.solo.io.VirtualMeshStatus.MeshCertificatesEntry.value:type_name -> certificates.mesh.gloo.solo.io.IssuedCertificateInfo
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_init() }
@@ -1195,6 +1367,18 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_init
 			}
 		}
 		file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VirtualMeshSpec_Federation_FederationSelector); i {
 			case 0:
 				return &v.state
@@ -1228,7 +1412,7 @@ func file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_init
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_solo_io_gloo_mesh_api_networking_v1_virtual_mesh_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -285,6 +285,17 @@ func (m *DiscoverySettings_Istio) Equal(that interface{}) bool {
 
 	}
 
+	if len(m.GetRevisionTags()) != len(target.GetRevisionTags()) {
+		return false
+	}
+	for k, v := range m.GetRevisionTags() {
+
+		if strings.Compare(v, target.GetRevisionTags()[k]) != 0 {
+			return false
+		}
+
+	}
+
 	return true
 }
 
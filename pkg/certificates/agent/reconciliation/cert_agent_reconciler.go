@@ -2,7 +2,9 @@ package reconciliation
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/solo-io/skv2/contrib/pkg/output"
@@ -17,15 +19,21 @@ import (
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	podbouncer "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/certinfo"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
+	skinput "github.com/solo-io/skv2/contrib/pkg/input"
 	"github.com/solo-io/skv2/contrib/pkg/output/errhandlers"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
 	"github.com/solo-io/skv2/pkg/reconcile"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 var (
@@ -52,14 +60,36 @@ var (
 		"certs",
 		certagent.SnapshotGVKs,
 	)
+
+	issuedCertificateExpiry = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gloo_mesh_cert_agent_issued_certificate_expiry_seconds",
+			Help: "The number of seconds until the certificate issued for each IssuedCertificate (one per Mesh) expires.",
+		},
+		[]string{"issued_certificate", "namespace"},
+	)
+
+	// certRenewalId is a special identifier for a reconcile event triggered when an issued certificate enters its renewal window
+	certRenewalId = &skv2corev1.ObjectRef{
+		Name: "cert-renewal-event",
+	}
 )
 
+func init() {
+	metrics.Registry.MustRegister(issuedCertificateExpiry)
+}
+
 type certAgentReconciler struct {
 	ctx         context.Context
 	builder     input.Builder
 	localClient client.Client
 	podBouncer  podbouncer.PodBouncer
 	translator  translation.Translator
+
+	reconciler skinput.InputReconciler
+	// timer which triggers a reconcile once the next issued certificate enters its renewal window
+	renewalTimer     *time.Timer
+	renewalTimerLock sync.Mutex
 }
 
 func Start(
@@ -78,7 +108,7 @@ func Start(
 		podBouncer:  podBouncer,
 		translator:  translator,
 	}
-	_, err := input.RegisterSingleClusterReconciler(
+	reconciler, err := input.RegisterSingleClusterReconciler(
 		ctx,
 		mgr,
 		d.reconcile,
@@ -88,7 +118,11 @@ func Start(
 		reconciliation.FilterServiceAccountTokenSecret,
 		reconciliation.FilterKubeSystemConfigMap,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	d.reconciler = reconciler
+	return nil
 }
 
 // reconcile global state
@@ -101,6 +135,9 @@ func (r *certAgentReconciler) reconcile(_ ezkube.ResourceId) (bool, error) {
 
 	outputs := certagent.NewBuilder(r.ctx, "agent")
 
+	// drop metrics for IssuedCertificates which no longer exist
+	issuedCertificateExpiry.Reset()
+
 	// process issued certificates
 	for _, issuedCertificate := range inputSnap.IssuedCertificates().List() {
 		if err := r.reconcileIssuedCertificate(
@@ -112,6 +149,8 @@ func (r *certAgentReconciler) reconcile(_ ezkube.ResourceId) (bool, error) {
 			issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_FAILED
 		}
 	}
+	r.scheduleRenewal(inputSnap.IssuedCertificates().List())
+
 	outSnap, err := outputs.BuildSinglePartitionedSnapshot(agentLabels())
 	if err != nil {
 		return false, eris.Wrap(err, "building output snapshot")
@@ -155,16 +194,27 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 
 	// if observed generation is out of sync, treat the issued certificate as Pending (spec has been modified)
 	if issuedCertificate.Status.ObservedGeneration != issuedCertificate.Generation {
-		issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_PENDING
-		// Also need to reset PBD status so that pods get bounced again to pick up new cert
-		if issuedCertificate.Spec.PodBounceDirective != nil {
-			podBounceDirective, err := inputSnap.PodBounceDirectives().Find(issuedCertificate.Spec.PodBounceDirective)
-			if err != nil {
-				return eris.Wrap(err, "failed to find specified pod bounce directive")
+		if err := resetIssuedCertificate(issuedCertificate, inputSnap); err != nil {
+			return err
+		}
+	}
+
+	// re-issue the certificate once it has entered its renewal window
+	if issuedCertificate.Status.State == certificatesv1.IssuedCertificateStatus_FINISHED {
+		if cert := r.updateIssuedCertificateInfo(issuedCertificate, inputSnap); cert != nil && renewalDue(issuedCertificate, cert) {
+			contextutils.LoggerFrom(r.ctx).Infof(
+				"renewing certificate for IssuedCertificate %v, which expires at %v",
+				sets.Key(issuedCertificate),
+				cert.NotAfter,
+			)
+			if err := resetIssuedCertificate(issuedCertificate, inputSnap); err != nil {
+				return err
 			}
-			podBounceDirective.Status = certificatesv1.PodBounceDirectiveStatus{}
 		}
 	}
+	if issuedCertificate.Status.State == certificatesv1.IssuedCertificateStatus_FINISHED {
+		updateRotationStatus(issuedCertificate, inputSnap)
+	}
 
 	// reset & update status
 	issuedCertificate.Status.ObservedGeneration = issuedCertificate.Generation
@@ -263,9 +313,103 @@ func (r *certAgentReconciler) reconcileIssuedCertificate(
 
 		// mark issued certificate as finished
 		issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_FINISHED
+		r.updateIssuedCertificateInfo(issuedCertificate, inputSnap)
+		updateRotationStatus(issuedCertificate, inputSnap)
 	default:
 		return eris.Errorf("unknown issued certificate state: %v", issuedCertificate.Status.State)
 	}
 
 	return nil
 }
+
+// treat the issued certificate as Pending so that it is re-issued
+func resetIssuedCertificate(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) error {
+	issuedCertificate.Status.State = certificatesv1.IssuedCertificateStatus_PENDING
+	// Also need to reset PBD status so that pods get bounced again to pick up new cert
+	if issuedCertificate.Spec.PodBounceDirective != nil {
+		podBounceDirective, err := inputSnap.PodBounceDirectives().Find(issuedCertificate.Spec.PodBounceDirective)
+		if err != nil {
+			return eris.Wrap(err, "failed to find specified pod bounce directive")
+		}
+		podBounceDirective.Status = certificatesv1.PodBounceDirectiveStatus{}
+	}
+	return nil
+}
+
+// record the details and expiry of the certificate stored in the issued certificate secret.
+// returns nil if the certificate cannot be read, e.g. if the cert agent does not store it in a secret.
+func (r *certAgentReconciler) updateIssuedCertificateInfo(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) *x509.Certificate {
+	secretRef := issuedCertificate.Spec.GetIssuedCertificateSecret()
+	if secretRef == nil {
+		return nil
+	}
+	secret, err := inputSnap.Secrets().Find(secretRef)
+	if err != nil {
+		contextutils.LoggerFrom(r.ctx).Debugf("failed to find issued certificate secret %v: %v", sets.Key(secretRef), err)
+		return nil
+	}
+	caData := secrets.CADataFromSecretData(secret.Data)
+	cert, err := certinfo.ParseCertificate(caData.CaCert)
+	if err != nil {
+		contextutils.LoggerFrom(r.ctx).Debugf("failed to parse issued certificate in secret %v: %v", sets.Key(secretRef), err)
+		return nil
+	}
+
+	issuedCertificate.Status.IssuedCertificateInfo = certinfo.BuildIssuedCertificateInfo(
+		cert,
+		caData.RootCert,
+		issuedCertificate.Spec.GetCertOptions().GetSecretRotationGracePeriodRatio(),
+	)
+	issuedCertificateExpiry.WithLabelValues(issuedCertificate.GetName(), issuedCertificate.GetNamespace()).
+		Set(time.Until(cert.NotAfter).Seconds())
+
+	return cert
+}
+
+// returns true if the certificate has entered the renewal window of the issued certificate
+func renewalDue(issuedCertificate *certificatesv1.IssuedCertificate, cert *x509.Certificate) bool {
+	gracePeriodRatio := issuedCertificate.Spec.GetCertOptions().GetSecretRotationGracePeriodRatio()
+	if gracePeriodRatio <= 0 {
+		// renewal is disabled
+		return false
+	}
+	return !time.Now().Before(certinfo.RenewalTime(cert, gracePeriodRatio))
+}
+
+// schedule a reconcile for when the next finished issued certificate enters its renewal window
+func (r *certAgentReconciler) scheduleRenewal(issuedCertificates []*certificatesv1.IssuedCertificate) {
+	var nextRenewal time.Time
+	for _, issuedCertificate := range issuedCertificates {
+		if issuedCertificate.Status.State != certificatesv1.IssuedCertificateStatus_FINISHED ||
+			issuedCertificate.Spec.GetCertOptions().GetSecretRotationGracePeriodRatio() <= 0 {
+			continue
+		}
+		renewalTime, err := time.Parse(time.RFC3339, issuedCertificate.Status.GetIssuedCertificateInfo().GetRenewalTime())
+		if err != nil {
+			continue
+		}
+		if nextRenewal.IsZero() || renewalTime.Before(nextRenewal) {
+			nextRenewal = renewalTime
+		}
+	}
+
+	r.renewalTimerLock.Lock()
+	defer r.renewalTimerLock.Unlock()
+	if r.renewalTimer != nil {
+		r.renewalTimer.Stop()
+		r.renewalTimer = nil
+	}
+	if nextRenewal.IsZero() || r.reconciler == nil {
+		return
+	}
+	r.renewalTimer = time.AfterFunc(time.Until(nextRenewal), func() {
+		// ignore error because underlying impl should never error here
+		_, _ = r.reconciler.ReconcileLocalGeneric(certRenewalId)
+	})
+}
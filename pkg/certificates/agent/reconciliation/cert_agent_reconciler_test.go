@@ -2,6 +2,7 @@ package reconciliation
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
@@ -13,7 +14,10 @@ import (
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	mock_podbouncer "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer/mocks"
 	mock_translation "github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/certinfo"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	"istio.io/istio/security/pkg/pki/util"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -397,5 +401,111 @@ var _ = Describe("CertAgentReconciler", func() {
 			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_FAILED))
 		})
 
+		It("Will record the issued certificate info", func() {
+
+			reconciler := &certAgentReconciler{
+				ctx:        ctx,
+				podBouncer: mockPodBouncer,
+				translator: mockTranslator,
+			}
+
+			issuedCert.Spec.CertOptions = &certificatesv1.CommonCertOptions{
+				SecretRotationGracePeriodRatio: 0.1,
+			}
+			issuedCertSecret.Data = generateCaSecretData(time.Now().Add(-time.Hour*24), time.Hour*24*10)
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{issuedCertSecret}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true)
+
+			mockTranslator.EXPECT().
+				IssuedCertificateFinished(gomock.Any(), issuedCert, inputSnap, mockOutput).
+				Return(nil)
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_FINISHED))
+
+			cert, err := certinfo.ParseCertificate(issuedCertSecret.Data[secrets.CaCertID])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.IssuedCertificateInfo).To(Equal(certinfo.BuildIssuedCertificateInfo(
+				cert,
+				issuedCertSecret.Data[secrets.RootCertID],
+				0.1,
+			)))
+		})
+
+		It("Will renew the certificate once it enters its renewal window", func() {
+
+			reconciler := &certAgentReconciler{
+				ctx:        ctx,
+				podBouncer: mockPodBouncer,
+				translator: mockTranslator,
+			}
+
+			pbd := &certificatesv1.PodBounceDirective{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "hello",
+					Namespace: "world",
+				},
+				Status: certificatesv1.PodBounceDirectiveStatus{
+					PodsBounced: []*certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{
+						{BouncedPods: []string{"istiod"}},
+					},
+				},
+			}
+			issuedCert.Spec.PodBounceDirective = ezkube.MakeObjectRef(pbd)
+			issuedCert.Spec.CertOptions = &certificatesv1.CommonCertOptions{
+				SecretRotationGracePeriodRatio: 0.1,
+			}
+			// 95% of the certificate's lifetime has elapsed
+			issuedCertSecret.Data = generateCaSecretData(time.Now().Add(-time.Hour*24*19), time.Hour*24*20)
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddPodBounceDirectives([]*certificatesv1.PodBounceDirective{pbd}).
+				AddSecrets([]*corev1.Secret{issuedCertSecret}).
+				Build()
+
+			mockTranslator.EXPECT().
+				ShouldProcess(gomock.Any(), issuedCert).
+				Return(true)
+
+			mockTranslator.EXPECT().
+				IssuedCertificatePending(gomock.Any(), issuedCert, inputSnap, mockOutput).
+				Return([]byte("I'm a CSR"), nil)
+
+			mockOutput.EXPECT().AddCertificateRequests(gomock.Any())
+
+			err := reconciler.reconcileIssuedCertificate(issuedCert, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuedCert.Status.State).To(Equal(certificatesv1.IssuedCertificateStatus_REQUESTED))
+			Expect(issuedCert.Status.IssuedCertificateInfo).NotTo(BeNil())
+			// pods must be bounced again to pick up the renewed certificate
+			Expect(&pbd.Status).To(Equal(&certificatesv1.PodBounceDirectiveStatus{}))
+		})
+
 	})
 })
+
+// generate the data of a secret containing a self signed CA certificate
+func generateCaSecretData(notBefore time.Time, ttl time.Duration) map[string][]byte {
+	cert, key, err := util.GenCertKeyFromOptions(util.CertOptions{
+		Org:          "gloo-mesh",
+		IsCA:         true,
+		IsSelfSigned: true,
+		NotBefore:    notBefore,
+		TTL:          ttl,
+		RSAKeySize:   2048,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return secrets.CAData{
+		RootCert:     cert,
+		CertChain:    cert,
+		CaCert:       cert,
+		CaPrivateKey: key,
+	}.ToSecretData()
+}
@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 //go:generate mockgen -source ./pod_bouncer.go -destination mocks/pod_bouncer.go
@@ -30,7 +31,7 @@ type RootCertMatcher interface {
 	) (matches bool, err error)
 }
 
-// bounce (delete) the listed pods
+// bounce (restart or delete) the listed pods
 // returns true if we need to wait before proceeding to process the podBounceDirective.
 // we must wait for the following conditions:
 // 1. istiod control plane has come back online after it has been restarted
@@ -46,22 +47,29 @@ type PodBouncer interface {
 	) (bool, error)
 }
 
+// the kubeReader is used to read the controllers of the pods, and should read from the API server directly
+// (e.g. the manager's API reader), as the agent is not permitted to list and watch them.
 func NewPodBouncer(
 	podClient corev1client.PodClient,
+	kubeClient client.Client,
+	kubeReader client.Reader,
 	rootCertMatcher RootCertMatcher,
 ) PodBouncer {
 	return &podBouncer{
 		podClient:       podClient,
+		kubeClient:      kubeClient,
+		kubeReader:      kubeReader,
 		rootCertMatcher: rootCertMatcher,
 	}
 }
 
 type podBouncer struct {
 	podClient       corev1client.PodClient
+	kubeClient      client.Client
+	kubeReader      client.Reader
 	rootCertMatcher RootCertMatcher
 }
 
-// TODO: Figure out how to bounce pods without any downtime
 func (p *podBouncer) BouncePods(
 	ctx context.Context,
 	podBounceDirective *certificatesv1.PodBounceDirective,
@@ -130,6 +138,20 @@ func (p *podBouncer) BouncePods(
 			}
 		}
 
+		if podBounceDirective.Spec.GetRestartStrategy() == certificatesv1.PodBounceDirectiveSpec_ROLLING_RESTART {
+			wait, err := p.restartControllers(ctx, podBounceDirective, i, selector, pods)
+			if err != nil || wait {
+				return true, err
+			}
+
+			// update the status to show we've restarted the controllers for this selector already
+			podBounceDirective.Status.PodsBounced = append(
+				podBounceDirective.Status.PodsBounced,
+				&certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{},
+			)
+			continue
+		}
+
 		podsToDelete := pods.List(func(pod *corev1.Pod) bool {
 			return !isPodSelected(pod, selector)
 		})
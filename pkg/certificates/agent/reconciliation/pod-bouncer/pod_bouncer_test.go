@@ -2,16 +2,31 @@ package podbouncer_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
 	corev1client "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/mocks"
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 	. "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("PodBouncer", func() {
@@ -33,7 +48,7 @@ var _ = Describe("PodBouncer", func() {
 
 	It("can signal that bounced pods are not ready", func() {
 		// Don't mock this dependency as we want to test together
-		podBouncer := NewPodBouncer(podClientMock, NewSecretRootCertMatcher())
+		podBouncer := NewPodBouncer(podClientMock, nil, nil, NewSecretRootCertMatcher())
 
 		pbd := &certificatesv1.PodBounceDirective{
 			Spec: certificatesv1.PodBounceDirectiveSpec{
@@ -73,4 +88,432 @@ var _ = Describe("PodBouncer", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(wait).To(BeTrue())
 	})
+
+	It("deletes the selected pods when using the delete strategy", func() {
+		podBouncer := NewPodBouncer(podClientMock, nil, nil, NewSecretRootCertMatcher())
+
+		pbd := &certificatesv1.PodBounceDirective{
+			Spec: certificatesv1.PodBounceDirectiveSpec{
+				RestartStrategy: certificatesv1.PodBounceDirectiveSpec_DELETE_PODS,
+				PodsToBounce: []*certificatesv1.PodBounceDirectiveSpec_PodSelector{
+					{
+						Namespace: "bookinfo",
+						Labels:    map[string]string{"app": "gloo"},
+					},
+				},
+			},
+		}
+
+		pods := corev1sets.NewPodSet(
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "bookinfo",
+					Labels:    map[string]string{"app": "gloo"},
+				},
+			},
+			&corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod2",
+					Namespace: "bookinfo",
+					Labels:    map[string]string{"app": "hello"},
+				},
+			},
+		)
+
+		podClientMock.EXPECT().DeletePod(ctx, client.ObjectKey{Name: "pod1", Namespace: "bookinfo"})
+
+		wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(pbd.Status.PodsBounced).To(Equal([]*certificatesv1.PodBounceDirectiveStatus_BouncedPodSet{
+			{BouncedPods: []string{"pod1"}},
+		}))
+	})
+
+	Context("rolling restart", func() {
+		var (
+			selector *certificatesv1.PodBounceDirectiveSpec_PodSelector
+			pbd      *certificatesv1.PodBounceDirective
+		)
+
+		controllerRef := func(kind, name string) []metav1.OwnerReference {
+			return []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       kind,
+				Name:       name,
+				Controller: pointer.BoolPtr(true),
+			}}
+		}
+
+		selectedPod := func(name string, owners []metav1.OwnerReference) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       "bookinfo",
+					Labels:          map[string]string{"app": "gloo"},
+					OwnerReferences: owners,
+				},
+			}
+		}
+
+		podTemplate := corev1.PodTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{"app": "gloo"},
+			},
+		}
+
+		deployment := func(status appsv1.DeploymentStatus) *appsv1.Deployment {
+			return &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "gloo", Namespace: "bookinfo"},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: pointer.Int32Ptr(1),
+					Template: podTemplate,
+				},
+				Status: status,
+			}
+		}
+
+		replicaSet := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "gloo-7d4c8b",
+				Namespace:       "bookinfo",
+				OwnerReferences: controllerRef("Deployment", "gloo"),
+			},
+		}
+
+		BeforeEach(func() {
+			selector = &certificatesv1.PodBounceDirectiveSpec_PodSelector{
+				Namespace: "bookinfo",
+				Labels:    map[string]string{"app": "gloo"},
+			}
+			pbd = &certificatesv1.PodBounceDirective{
+				Spec: certificatesv1.PodBounceDirectiveSpec{
+					PodsToBounce: []*certificatesv1.PodBounceDirectiveSpec_PodSelector{selector},
+				},
+			}
+		})
+
+		It("restarts the controllers of the selected pods", func() {
+			kubeClient := fake.NewClientBuilder().WithObjects(deployment(appsv1.DeploymentStatus{}), replicaSet).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(
+				selectedPod("gloo-7d4c8b-1", controllerRef("ReplicaSet", "gloo-7d4c8b")),
+				selectedPod("gloo-7d4c8b-2", controllerRef("ReplicaSet", "gloo-7d4c8b")),
+			)
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.PodsBounced).To(BeEmpty())
+
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			restart := pbd.Status.ControllerRestarts[0]
+			Expect(restart.GetKind()).To(Equal("Deployment"))
+			Expect(restart.GetController()).To(Equal(&skv2corev1.ObjectRef{Name: "gloo", Namespace: "bookinfo"}))
+			Expect(restart.GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+			Expect(restart.GetRestartedAt()).NotTo(BeNil())
+
+			restarted := &appsv1.Deployment{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "gloo", Namespace: "bookinfo"}, restarted)).To(Succeed())
+			Expect(restarted.Spec.Template.Annotations).To(HaveKey("kubectl.kubernetes.io/restartedAt"))
+		})
+
+		It("reads controllers from the API reader rather than the cache-backed client", func() {
+			kubeClient := fake.NewClientBuilder().WithObjects(deployment(appsv1.DeploymentStatus{}), replicaSet).Build()
+			podBouncer := NewPodBouncer(podClientMock, forbiddenReadsClient{Client: kubeClient}, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-7d4c8b-1", controllerRef("ReplicaSet", "gloo-7d4c8b")))
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+
+			restarted := &appsv1.Deployment{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "gloo", Namespace: "bookinfo"}, restarted)).To(Succeed())
+			Expect(restarted.Spec.Template.Annotations).To(HaveKey("kubectl.kubernetes.io/restartedAt"))
+		})
+
+		It("deletes selected pods whose controllers do not support rolling restarts", func() {
+			kubeClient := fake.NewClientBuilder().Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+			pbd.Spec.MaxConcurrentRestartsPerNamespace = 2
+
+			pods := corev1sets.NewPodSet(
+				selectedPod("standalone", nil),
+				selectedPod("migrate-x7k2p", controllerRef("Job", "migrate")),
+			)
+
+			podClientMock.EXPECT().DeletePod(ctx, client.ObjectKey{Name: "standalone", Namespace: "bookinfo"})
+			podClientMock.EXPECT().DeletePod(ctx, client.ObjectKey{Name: "migrate-x7k2p", Namespace: "bookinfo"})
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(2))
+			for _, restart := range pbd.Status.ControllerRestarts {
+				Expect(restart.GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+			}
+			Expect(pbd.Status.ControllerRestarts[0].GetKind()).To(Equal("Job"))
+			Expect(pbd.Status.ControllerRestarts[0].GetMessage()).To(Equal("the Job does not support rolling restarts, so its pods were deleted"))
+			Expect(pbd.Status.ControllerRestarts[1].GetKind()).To(Equal("Pod"))
+			Expect(pbd.Status.ControllerRestarts[1].GetMessage()).To(Equal("the pod has no controller, so it was deleted"))
+
+			// the job's replacement pod is not deleted again
+			replacement := selectedPod("migrate-p9q4z", controllerRef("Job", "migrate"))
+			replacement.CreationTimestamp = metav1.Now()
+
+			wait, err = podBouncer.BouncePods(ctx, pbd, corev1sets.NewPodSet(replacement), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			for _, restart := range pbd.Status.ControllerRestarts {
+				Expect(restart.GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED))
+			}
+			Expect(pbd.Status.PodsBounced).To(HaveLen(1))
+		})
+
+		It("deletes the pods of StatefulSets using the OnDelete update strategy", func() {
+			kubeClient := fake.NewClientBuilder().WithObjects(&appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "gloo", Namespace: "bookinfo"},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas:       pointer.Int32Ptr(1),
+					Template:       podTemplate,
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType},
+				},
+				Status: appsv1.StatefulSetStatus{Replicas: 1, ReadyReplicas: 1},
+			}).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-0", controllerRef("StatefulSet", "gloo")))
+
+			podClientMock.EXPECT().DeletePod(ctx, client.ObjectKey{Name: "gloo-0", Namespace: "bookinfo"})
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+
+			// the pod is not finished until it has been replaced
+			wait, err = podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+
+			replacement := selectedPod("gloo-0", controllerRef("StatefulSet", "gloo"))
+			replacement.CreationTimestamp = metav1.Now()
+
+			wait, err = podBouncer.BouncePods(ctx, pbd, corev1sets.NewPodSet(replacement), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED))
+			Expect(pbd.Status.ControllerRestarts[0].GetMessage()).To(Equal("the StatefulSet uses the OnDelete update strategy, so its pods were deleted"))
+		})
+
+		It("restarts Argo Rollouts by setting their restart time", func() {
+			scheme := runtime.NewScheme()
+			Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+			Expect(argorolloutsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&argorolloutsv1alpha1.Rollout{
+					ObjectMeta: metav1.ObjectMeta{Name: "gloo", Namespace: "bookinfo"},
+					Spec: argorolloutsv1alpha1.RolloutSpec{
+						Replicas: pointer.Int32Ptr(1),
+						Template: podTemplate,
+					},
+				},
+				&appsv1.ReplicaSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "gloo-5f9c6d",
+						Namespace: "bookinfo",
+						OwnerReferences: []metav1.OwnerReference{{
+							APIVersion: "argoproj.io/v1alpha1",
+							Kind:       "Rollout",
+							Name:       "gloo",
+							Controller: pointer.BoolPtr(true),
+						}},
+					},
+				},
+			).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-5f9c6d-1", controllerRef("ReplicaSet", "gloo-5f9c6d")))
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetKind()).To(Equal("Rollout"))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+
+			rollout := &argorolloutsv1alpha1.Rollout{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "gloo", Namespace: "bookinfo"}, rollout)).To(Succeed())
+			Expect(rollout.Spec.RestartAt).NotTo(BeNil())
+			Expect(rollout.Spec.Template.Annotations).NotTo(HaveKey("kubectl.kubernetes.io/restartedAt"))
+
+			// the Rollout controller records the restart once the pods have been replaced
+			rollout.Status.RestartedAt = rollout.Spec.RestartAt
+			rollout.Status.AvailableReplicas = 1
+			Expect(kubeClient.Update(ctx, rollout)).To(Succeed())
+
+			wait, err = podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED))
+		})
+
+		disruptionBudget := &policyv1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: "gloo-pdb", Namespace: "bookinfo"},
+			Spec: policyv1.PodDisruptionBudgetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "gloo"}},
+			},
+			Status: policyv1.PodDisruptionBudgetStatus{DisruptionsAllowed: 0},
+		}
+
+		// a Deployment which replaces its pods without surging, reducing availability during the rollout
+		unavailableDeployment := func() *appsv1.Deployment {
+			maxSurge, maxUnavailable := intstr.FromInt(0), intstr.FromInt(1)
+			unavailable := deployment(appsv1.DeploymentStatus{})
+			unavailable.Spec.Strategy = appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+				},
+			}
+			return unavailable
+		}
+
+		It("does not restart controllers whose rollout would violate a PodDisruptionBudget", func() {
+			kubeClient := fake.NewClientBuilder().WithObjects(unavailableDeployment(), replicaSet, disruptionBudget).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-7d4c8b-1", controllerRef("ReplicaSet", "gloo-7d4c8b")))
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_BLOCKED_BY_DISRUPTION_BUDGET))
+			Expect(pbd.Status.ControllerRestarts[0].GetMessage()).To(ContainSubstring("gloo-pdb"))
+			Expect(pbd.Status.ControllerRestarts[0].GetRestartedAt()).NotTo(BeNil())
+
+			notRestarted := &appsv1.Deployment{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "gloo", Namespace: "bookinfo"}, notRestarted)).To(Succeed())
+			Expect(notRestarted.Spec.Template.Annotations).NotTo(HaveKey("kubectl.kubernetes.io/restartedAt"))
+		})
+
+		It("restarts controllers which surge replacement pods regardless of PodDisruptionBudgets", func() {
+			// a single replica Deployment with the default strategy surges a replacement pod before terminating the old one
+			kubeClient := fake.NewClientBuilder().WithObjects(deployment(appsv1.DeploymentStatus{}), replicaSet, disruptionBudget).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-7d4c8b-1", controllerRef("ReplicaSet", "gloo-7d4c8b")))
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING))
+
+			restarted := &appsv1.Deployment{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "gloo", Namespace: "bookinfo"}, restarted)).To(Succeed())
+			Expect(restarted.Spec.Template.Annotations).To(HaveKey("kubectl.kubernetes.io/restartedAt"))
+		})
+
+		It("skips restarts which remain blocked by a PodDisruptionBudget", func() {
+			kubeClient := fake.NewClientBuilder().WithObjects(unavailableDeployment(), replicaSet, disruptionBudget).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			blockedAt, err := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			pbd.Status.ControllerRestarts = []*certificatesv1.PodBounceDirectiveStatus_ControllerRestart{{
+				Kind:        "Deployment",
+				Controller:  &skv2corev1.ObjectRef{Name: "gloo", Namespace: "bookinfo"},
+				State:       certificatesv1.PodBounceDirectiveStatus_ControllerRestart_BLOCKED_BY_DISRUPTION_BUDGET,
+				RestartedAt: blockedAt,
+			}}
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-7d4c8b-1", controllerRef("ReplicaSet", "gloo-7d4c8b")))
+
+			_, err = podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED))
+			Expect(pbd.Status.ControllerRestarts[0].GetMessage()).To(ContainSubstring("gloo-pdb"))
+
+			notRestarted := &appsv1.Deployment{}
+			Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "gloo", Namespace: "bookinfo"}, notRestarted)).To(Succeed())
+			Expect(notRestarted.Spec.Template.Annotations).NotTo(HaveKey("kubectl.kubernetes.io/restartedAt"))
+		})
+
+		It("limits the number of concurrent restarts in a namespace", func() {
+			statefulSet := func(name string) *appsv1.StatefulSet {
+				return &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "bookinfo"},
+					Spec:       appsv1.StatefulSetSpec{Template: podTemplate},
+				}
+			}
+			kubeClient := fake.NewClientBuilder().WithObjects(statefulSet("gloo-a"), statefulSet("gloo-b")).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pods := corev1sets.NewPodSet(
+				selectedPod("gloo-a-0", controllerRef("StatefulSet", "gloo-a")),
+				selectedPod("gloo-b-0", controllerRef("StatefulSet", "gloo-b")),
+			)
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeTrue())
+
+			var states []certificatesv1.PodBounceDirectiveStatus_ControllerRestart_State
+			for _, restart := range pbd.Status.ControllerRestarts {
+				states = append(states, restart.GetState())
+			}
+			Expect(states).To(ConsistOf(
+				certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING,
+				certificatesv1.PodBounceDirectiveStatus_ControllerRestart_WAITING,
+			))
+		})
+
+		It("proceeds once the restarted controllers have rolled out their pods", func() {
+			kubeClient := fake.NewClientBuilder().WithObjects(
+				deployment(appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}),
+				replicaSet,
+			).Build()
+			podBouncer := NewPodBouncer(podClientMock, kubeClient, kubeClient, NewSecretRootCertMatcher())
+
+			pbd.Status.ControllerRestarts = []*certificatesv1.PodBounceDirectiveStatus_ControllerRestart{{
+				Kind:       "Deployment",
+				Controller: &skv2corev1.ObjectRef{Name: "gloo", Namespace: "bookinfo"},
+				State:      certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING,
+			}}
+
+			pods := corev1sets.NewPodSet(selectedPod("gloo-7d4c8b-1", controllerRef("ReplicaSet", "gloo-7d4c8b")))
+
+			wait, err := podBouncer.BouncePods(ctx, pbd, pods, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(pbd.Status.ControllerRestarts).To(HaveLen(1))
+			Expect(pbd.Status.ControllerRestarts[0].GetState()).To(Equal(certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED))
+			Expect(pbd.Status.PodsBounced).To(HaveLen(1))
+		})
+	})
 })
+
+// fails reads like the manager's cache-backed client does when the agent is not permitted to list and watch the objects
+type forbiddenReadsClient struct {
+	client.Client
+}
+
+func (c forbiddenReadsClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	return errors.NewForbidden(schema.GroupResource{}, key.Name, eris.New("list and watch are not permitted"))
+}
+
+func (c forbiddenReadsClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return errors.NewForbidden(schema.GroupResource{}, "", eris.New("list and watch are not permitted"))
+}
@@ -0,0 +1,488 @@
+package podbouncer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/rotisserie/eris"
+	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// the pod template annotation updated to trigger a rolling restart, as set by `kubectl rollout restart`
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+	defaultMaxConcurrentRestartsPerNamespace = 1
+
+	// the maximum amount of time a restart waits for a PodDisruptionBudget to allow disruptions, after which it is skipped
+	disruptionBudgetTimeout = 10 * time.Minute
+
+	deploymentKind  = "Deployment"
+	statefulSetKind = "StatefulSet"
+	daemonSetKind   = "DaemonSet"
+	replicaSetKind  = "ReplicaSet"
+	rolloutKind     = "Rollout"
+
+	// the kind of the restarts of pods without a controller, which are deleted
+	podKind = "Pod"
+)
+
+// perform a rolling restart of the controllers of the pods selected by the selector at the given index.
+// returns true if we need to wait for restarts to be started or finished before proceeding to the next selector.
+func (p *podBouncer) restartControllers(
+	ctx context.Context,
+	podBounceDirective *certificatesv1.PodBounceDirective,
+	selectorIndex int,
+	selector *certificatesv1.PodBounceDirectiveSpec_PodSelector,
+	pods corev1sets.PodSet,
+) (bool, error) {
+	if err := p.addControllerRestarts(ctx, podBounceDirective, selectorIndex, selector, pods); err != nil {
+		return true, err
+	}
+
+	maxConcurrentRestarts := int(podBounceDirective.Spec.GetMaxConcurrentRestartsPerNamespace())
+	if maxConcurrentRestarts == 0 {
+		maxConcurrentRestarts = defaultMaxConcurrentRestartsPerNamespace
+	}
+
+	var wait bool
+	for _, restart := range podBounceDirective.Status.ControllerRestarts {
+		if int(restart.GetSelectorIndex()) != selectorIndex {
+			continue
+		}
+
+		switch restart.GetState() {
+		case certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED:
+			continue
+		case certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING:
+			controller, err := p.getControllerObject(ctx, restart)
+			if errors.IsNotFound(err) {
+				restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED
+				restart.Message = fmt.Sprintf("the %v no longer exists", restart.GetKind())
+				continue
+			} else if err != nil {
+				return true, err
+			}
+
+			// the pods of controllers which don't support rolling restarts are deleted,
+			// so we wait for the deleted pods to be replaced instead
+			deletionReason := podDeletionReason(restart.GetKind(), controller)
+			complete := controller == nil || rolloutComplete(controller)
+			if deletionReason != "" {
+				complete = complete && podsReplaced(controlledPods(pods, restart), restart.GetRestartedAt())
+			}
+			if complete {
+				restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED
+				restart.Message = deletionReason
+				continue
+			}
+			restart.Message = "waiting for replacement pods to be rolled out"
+			wait = true
+		default:
+			wait = true
+			if countRestarting(podBounceDirective, restart.GetController().GetNamespace()) >= maxConcurrentRestarts {
+				restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_WAITING
+				restart.Message = "waiting for other controllers in the namespace to finish restarting"
+				continue
+			}
+
+			controller, err := p.getControllerObject(ctx, restart)
+			if errors.IsNotFound(err) {
+				restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED
+				restart.Message = fmt.Sprintf("the %v no longer exists", restart.GetKind())
+				continue
+			} else if err != nil {
+				return true, err
+			}
+
+			podsToRestart := controlledPods(pods, restart)
+			deletionReason := podDeletionReason(restart.GetKind(), controller)
+			if deletionReason != "" || reducesAvailability(controller) {
+				var podLabels labels.Set
+				if controller != nil {
+					podLabels = podTemplate(controller).GetLabels()
+				} else if len(podsToRestart) > 0 {
+					podLabels = podsToRestart[0].GetLabels()
+				}
+				blockingBudget, err := p.findBlockingDisruptionBudget(ctx, restart.GetController().GetNamespace(), podLabels)
+				if err != nil {
+					return true, err
+				}
+				if blockingBudget != "" {
+					// record when the restart was first blocked
+					if restart.GetState() != certificatesv1.PodBounceDirectiveStatus_ControllerRestart_BLOCKED_BY_DISRUPTION_BUDGET ||
+						restart.GetRestartedAt() == nil {
+						restart.RestartedAt = ptypes.TimestampNow()
+					}
+					if time.Since(restart.GetRestartedAt().AsTime()) >= disruptionBudgetTimeout {
+						contextutils.LoggerFrom(ctx).Warnf("skipping restart of %v %v: PodDisruptionBudget %v has not allowed disruptions for %v",
+							restart.GetKind(), sets.Key(restart.GetController()), blockingBudget, disruptionBudgetTimeout)
+						restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_FINISHED
+						restart.Message = fmt.Sprintf("skipped, as PodDisruptionBudget %v did not allow disruptions within %v; "+
+							"the pods must be restarted manually to pick up the issued certificates", blockingBudget, disruptionBudgetTimeout)
+						continue
+					}
+					restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_BLOCKED_BY_DISRUPTION_BUDGET
+					restart.Message = fmt.Sprintf("PodDisruptionBudget %v does not allow disruptions", blockingBudget)
+					continue
+				}
+			}
+
+			contextutils.LoggerFrom(ctx).Debugf("restarting %v %v", restart.GetKind(), sets.Key(restart.GetController()))
+			if deletionReason != "" {
+				err = p.deletePods(ctx, podsToRestart)
+			} else {
+				err = p.restartController(ctx, controller)
+			}
+			if err != nil {
+				return true, eris.Wrapf(err, "restarting %v %v", restart.GetKind(), sets.Key(restart.GetController()))
+			}
+			restart.State = certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING
+			restart.RestartedAt = ptypes.TimestampNow()
+			restart.Message = deletionReason
+		}
+	}
+
+	if wait {
+		contextutils.LoggerFrom(ctx).Debugf("podBounceDirective %v: waiting for controller restarts for selector %v", sets.Key(podBounceDirective), selector)
+
+		time.Sleep(time.Second)
+
+		return true, nil
+	}
+
+	// ensure the minimum number of replicas are ready before moving on to the next selector
+	if !replacementsReady(pods, selector, nil) {
+		contextutils.LoggerFrom(ctx).Debugf("podBounceDirective %v: waiting for ready pods for selector %v", sets.Key(podBounceDirective), selector)
+
+		time.Sleep(time.Second)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// add a restart to the status of the directive for each controller of the selected pods which hasn't been restarted yet.
+// pods without a controller are added as restarts of kind Pod.
+func (p *podBouncer) addControllerRestarts(
+	ctx context.Context,
+	podBounceDirective *certificatesv1.PodBounceDirective,
+	selectorIndex int,
+	selector *certificatesv1.PodBounceDirectiveSpec_PodSelector,
+	pods corev1sets.PodSet,
+) error {
+	// cache the owners of replicasets, which are shared by many pods
+	replicaSetOwners := map[string]*metav1.OwnerReference{}
+
+	for _, pod := range pods.List(func(pod *corev1.Pod) bool {
+		return !isPodSelected(pod, selector)
+	}) {
+		owner := metav1.GetControllerOf(pod)
+		if owner != nil && owner.Kind == replicaSetKind {
+			replicaSetOwner, ok := replicaSetOwners[owner.Name]
+			if !ok {
+				replicaSet := &appsv1.ReplicaSet{}
+				if err := p.kubeReader.Get(ctx, client.ObjectKey{Name: owner.Name, Namespace: pod.Namespace}, replicaSet); err != nil && !errors.IsNotFound(err) {
+					return eris.Wrapf(err, "getting replicaset %v for pod %v", owner.Name, sets.Key(pod))
+				}
+				replicaSetOwner = metav1.GetControllerOf(replicaSet)
+				replicaSetOwners[owner.Name] = replicaSetOwner
+			}
+			// replicasets without an owner are restarted themselves
+			if replicaSetOwner != nil {
+				owner = replicaSetOwner
+			}
+		}
+
+		kind, controllerRef := podKind, &skv2corev1.ObjectRef{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		}
+		if owner != nil {
+			kind, controllerRef.Name = owner.Kind, owner.Name
+		}
+		if findControllerRestart(podBounceDirective, kind, controllerRef) != nil {
+			continue
+		}
+		podBounceDirective.Status.ControllerRestarts = append(
+			podBounceDirective.Status.ControllerRestarts,
+			&certificatesv1.PodBounceDirectiveStatus_ControllerRestart{
+				Kind:          kind,
+				Controller:    controllerRef,
+				SelectorIndex: uint32(selectorIndex),
+				State:         certificatesv1.PodBounceDirectiveStatus_ControllerRestart_WAITING,
+			},
+		)
+	}
+
+	return nil
+}
+
+func findControllerRestart(
+	podBounceDirective *certificatesv1.PodBounceDirective,
+	kind string,
+	controllerRef *skv2corev1.ObjectRef,
+) *certificatesv1.PodBounceDirectiveStatus_ControllerRestart {
+	for _, restart := range podBounceDirective.Status.ControllerRestarts {
+		if restart.GetKind() == kind && restart.GetController().Equal(controllerRef) {
+			return restart
+		}
+	}
+	return nil
+}
+
+// count the controllers in the namespace which are currently being restarted
+func countRestarting(podBounceDirective *certificatesv1.PodBounceDirective, namespace string) int {
+	var restarting int
+	for _, restart := range podBounceDirective.Status.ControllerRestarts {
+		if restart.GetState() == certificatesv1.PodBounceDirectiveStatus_ControllerRestart_RESTARTING &&
+			restart.GetController().GetNamespace() == namespace {
+			restarting++
+		}
+	}
+	return restarting
+}
+
+// returns the controller being restarted, or nil if its kind does not support rolling restarts
+func (p *podBouncer) getControllerObject(
+	ctx context.Context,
+	restart *certificatesv1.PodBounceDirectiveStatus_ControllerRestart,
+) (client.Object, error) {
+	var controller client.Object
+	switch restart.GetKind() {
+	case deploymentKind:
+		controller = &appsv1.Deployment{}
+	case statefulSetKind:
+		controller = &appsv1.StatefulSet{}
+	case daemonSetKind:
+		controller = &appsv1.DaemonSet{}
+	case rolloutKind:
+		controller = &argorolloutsv1alpha1.Rollout{}
+	default:
+		return nil, nil
+	}
+	key := client.ObjectKey{
+		Name:      restart.GetController().GetName(),
+		Namespace: restart.GetController().GetNamespace(),
+	}
+	if err := p.kubeReader.Get(ctx, key, controller); err != nil {
+		return nil, eris.Wrapf(err, "getting %v %v", restart.GetKind(), sets.Key(restart.GetController()))
+	}
+	return controller, nil
+}
+
+// returns the selected pods which are restarted by the given restart
+func controlledPods(
+	pods corev1sets.PodSet,
+	restart *certificatesv1.PodBounceDirectiveStatus_ControllerRestart,
+) []*corev1.Pod {
+	return pods.List(func(pod *corev1.Pod) bool {
+		if pod.Namespace != restart.GetController().GetNamespace() {
+			return true
+		}
+		if restart.GetKind() == podKind {
+			return pod.Name != restart.GetController().GetName()
+		}
+		owner := metav1.GetControllerOf(pod)
+		return owner == nil || owner.Kind != restart.GetKind() || owner.Name != restart.GetController().GetName()
+	})
+}
+
+// returns why the pods of the restarted controller are deleted rather than restarted by the controller, if they are.
+// the controller is nil if its kind does not support rolling restarts.
+func podDeletionReason(kind string, controller client.Object) string {
+	switch controller := controller.(type) {
+	case nil:
+		if kind == podKind {
+			return "the pod has no controller, so it was deleted"
+		}
+		return fmt.Sprintf("the %v does not support rolling restarts, so its pods were deleted", kind)
+	case *appsv1.StatefulSet:
+		if controller.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+			return "the StatefulSet uses the OnDelete update strategy, so its pods were deleted"
+		}
+	case *appsv1.DaemonSet:
+		if controller.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			return "the DaemonSet uses the OnDelete update strategy, so its pods were deleted"
+		}
+	}
+	return ""
+}
+
+func (p *podBouncer) deletePods(ctx context.Context, pods []*corev1.Pod) error {
+	for _, pod := range pods {
+		contextutils.LoggerFrom(ctx).Debugf("deleting pod %v", sets.Key(pod))
+		if err := p.podClient.DeletePod(ctx, ezkube.MakeClientObjectKey(pod)); err != nil && !errors.IsNotFound(err) {
+			return eris.Wrapf(err, "deleting pod %v", sets.Key(pod))
+		}
+	}
+	return nil
+}
+
+// indicates whether the pods which existed when the restart started have been deleted
+func podsReplaced(pods []*corev1.Pod, restartedAt *timestamp.Timestamp) bool {
+	// creation timestamps only have a precision of seconds
+	restartTime := restartedAt.AsTime().Truncate(time.Second)
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil || pod.CreationTimestamp.Time.Before(restartTime) {
+			return false
+		}
+	}
+	return true
+}
+
+// returns the name of a PodDisruptionBudget selecting pods with the given labels which does not currently allow disruptions
+func (p *podBouncer) findBlockingDisruptionBudget(ctx context.Context, namespace string, podLabels labels.Set) (string, error) {
+	disruptionBudgets := &policyv1.PodDisruptionBudgetList{}
+	if err := p.kubeReader.List(ctx, disruptionBudgets, client.InNamespace(namespace)); err != nil {
+		return "", eris.Wrapf(err, "listing PodDisruptionBudgets in namespace %v", namespace)
+	}
+	for _, disruptionBudget := range disruptionBudgets.Items {
+		selector, err := metav1.LabelSelectorAsSelector(disruptionBudget.Spec.Selector)
+		if err != nil || selector.Empty() || !selector.Matches(podLabels) {
+			continue
+		}
+		if disruptionBudget.Status.DisruptionsAllowed < 1 {
+			return disruptionBudget.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// indicates whether the rolling restart of the controller may take pods out of service before their replacements are available,
+// in which case it must wait for the PodDisruptionBudgets selecting its pods to allow disruptions.
+// Controllers which surge replacement pods do not reduce availability, and Argo Rollouts evict pods for restarts,
+// which honours PodDisruptionBudgets.
+func reducesAvailability(controller client.Object) bool {
+	switch controller := controller.(type) {
+	case *appsv1.Deployment:
+		if controller.Spec.Strategy.Type == appsv1.RecreateDeploymentStrategyType {
+			return true
+		}
+		replicas := 1
+		if controller.Spec.Replicas != nil {
+			replicas = int(*controller.Spec.Replicas)
+		}
+		// resolved as by the Deployment controller, defaulting to 25% each
+		defaultValue := intstr.FromString("25%")
+		maxSurge, maxUnavailable := &defaultValue, &defaultValue
+		if rollingUpdate := controller.Spec.Strategy.RollingUpdate; rollingUpdate != nil {
+			if rollingUpdate.MaxSurge != nil {
+				maxSurge = rollingUpdate.MaxSurge
+			}
+			if rollingUpdate.MaxUnavailable != nil {
+				maxUnavailable = rollingUpdate.MaxUnavailable
+			}
+		}
+		surge, err := intstr.GetScaledValueFromIntOrPercent(maxSurge, replicas, true)
+		if err != nil {
+			return true
+		}
+		unavailable, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, replicas, false)
+		if err != nil {
+			return true
+		}
+		return unavailable > 0 || surge == 0
+	case *appsv1.StatefulSet:
+		// StatefulSets terminate each pod before creating its replacement
+		return true
+	case *appsv1.DaemonSet:
+		rollingUpdate := controller.Spec.UpdateStrategy.RollingUpdate
+		if rollingUpdate == nil || rollingUpdate.MaxSurge == nil {
+			return true
+		}
+		surge, err := intstr.GetScaledValueFromIntOrPercent(rollingUpdate.MaxSurge, int(controller.Status.DesiredNumberScheduled), true)
+		return err != nil || surge == 0
+	}
+	return false
+}
+
+// trigger a rolling restart by updating the restart annotation of the controller's pod template.
+// Argo Rollouts are instead restarted by setting their restart time, as a template update would start a new rollout.
+func (p *podBouncer) restartController(ctx context.Context, controller client.Object) error {
+	patch := client.MergeFrom(controller.DeepCopyObject().(client.Object))
+	if rollout, ok := controller.(*argorolloutsv1alpha1.Rollout); ok {
+		restartAt := metav1.Now()
+		rollout.Spec.RestartAt = &restartAt
+		return p.kubeClient.Patch(ctx, rollout, patch)
+	}
+	template := podTemplate(controller)
+	if template.Annotations == nil {
+		template.Annotations = map[string]string{}
+	}
+	template.Annotations[restartedAtAnnotation] = time.Now().Format(time.RFC3339)
+	return p.kubeClient.Patch(ctx, controller, patch)
+}
+
+func podTemplate(controller client.Object) *corev1.PodTemplateSpec {
+	switch controller := controller.(type) {
+	case *appsv1.Deployment:
+		return &controller.Spec.Template
+	case *appsv1.StatefulSet:
+		return &controller.Spec.Template
+	case *appsv1.DaemonSet:
+		return &controller.Spec.Template
+	case *argorolloutsv1alpha1.Rollout:
+		return &controller.Spec.Template
+	}
+	return &corev1.PodTemplateSpec{}
+}
+
+// indicates whether the controller has finished rolling out its pods, following the logic of `kubectl rollout status`.
+func rolloutComplete(controller client.Object) bool {
+	switch controller := controller.(type) {
+	case *appsv1.Deployment:
+		replicas := int32(1)
+		if controller.Spec.Replicas != nil {
+			replicas = *controller.Spec.Replicas
+		}
+		status := controller.Status
+		return status.ObservedGeneration >= controller.Generation &&
+			status.UpdatedReplicas >= replicas &&
+			status.Replicas == status.UpdatedReplicas &&
+			status.AvailableReplicas >= status.UpdatedReplicas
+	case *appsv1.StatefulSet:
+		replicas := int32(1)
+		if controller.Spec.Replicas != nil {
+			replicas = *controller.Spec.Replicas
+		}
+		status := controller.Status
+		return status.ObservedGeneration >= controller.Generation &&
+			status.UpdateRevision == status.CurrentRevision &&
+			status.ReadyReplicas >= replicas
+	case *appsv1.DaemonSet:
+		status := controller.Status
+		return status.ObservedGeneration >= controller.Generation &&
+			status.UpdatedNumberScheduled >= status.DesiredNumberScheduled &&
+			status.NumberAvailable >= status.DesiredNumberScheduled
+	case *argorolloutsv1alpha1.Rollout:
+		// the Rollout controller records the restart once all pods created before it have been replaced
+		replicas := int32(1)
+		if controller.Spec.Replicas != nil {
+			replicas = *controller.Spec.Replicas
+		}
+		status := controller.Status
+		return status.RestartedAt != nil &&
+			!status.RestartedAt.Before(controller.Spec.RestartAt) &&
+			status.AvailableReplicas >= replicas
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package reconciliation
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/certinfo"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
+	"istio.io/api/label"
+	"istio.io/istio/pkg/kube/inject"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+)
+
+const (
+	// name of the istio root CA configmap distributed to all namespaces
+	// copied from https://github.com/istio/istio/blob/88a2bfb/pilot/pkg/serviceregistry/kube/controller/namespacecontroller.go#L39
+	istioCaConfigMapName = "istio-ca-root-cert"
+
+	// the revision label value of proxies injected by the default Istio control plane
+	defaultRevision = "default"
+)
+
+// record the rotation state for which the certificate has been issued, and verify the current rotation step
+func updateRotationStatus(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) {
+	issuedCertificate.Status.ObservedRotationState = issuedCertificate.Spec.GetRotationState()
+	if issuedCertificate.Spec.GetRotationState() == certificatesv1.CertificateRotationState_NOT_ROTATING {
+		issuedCertificate.Status.RotationVerification = nil
+		return
+	}
+
+	verification := verifyRotation(issuedCertificate, inputSnap)
+	// retain the previous timestamp if the result is unchanged, to avoid updating the status on every reconcile
+	if previous := issuedCertificate.Status.GetRotationVerification(); previous != nil {
+		verification.Timestamp = previous.GetTimestamp()
+		if !verification.Equal(previous) {
+			verification.Timestamp = time.Now().UTC().Format(time.RFC3339)
+		}
+	}
+	issuedCertificate.Status.RotationVerification = verification
+}
+
+// verify that the workloads of the mesh have picked up the certificates issued for the current rotation step:
+// 1. the issued root certificate bundle has been distributed to the Istio namespace and all namespaces containing the mesh's proxies
+// 2. all proxies of the mesh are ready and have been (re)started since the certificate was issued,
+// and have therefore been issued workload certificates by the current intermediate CA
+//
+// The proxies of the mesh are those labeled with the revision of its control plane, which is recorded on the IssuedCertificate
+// by the networking translator, so that the proxies of other control planes on the cluster are not verified.
+func verifyRotation(
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	inputSnap input.Snapshot,
+) *certificatesv1.CertificateRotationVerificationStatus {
+	verification := &certificatesv1.CertificateRotationVerificationStatus{
+		RotationState: issuedCertificate.Spec.GetRotationState(),
+		Timestamp:     time.Now().UTC().Format(time.RFC3339),
+	}
+
+	secretRef := issuedCertificate.Spec.GetIssuedCertificateSecret()
+	if secretRef == nil {
+		verification.Errors = append(verification.Errors, "the issued certificate is not stored in a secret")
+		return verification
+	}
+	secret, err := inputSnap.Secrets().Find(secretRef)
+	if err != nil {
+		verification.Errors = append(verification.Errors, "failed to find issued certificate secret: "+err.Error())
+		return verification
+	}
+	caData := secrets.CADataFromSecretData(secret.Data)
+	cert, err := certinfo.ParseCertificate(caData.CaCert)
+	if err != nil {
+		verification.Errors = append(verification.Errors, "failed to parse issued certificate: "+err.Error())
+		return verification
+	}
+
+	revision := meshRevision(issuedCertificate)
+	// the Istio namespace, where the issued certificate is stored, receives the root certificate along with the mesh's namespaces
+	meshNamespaces := sets.NewString(secretRef.GetNamespace())
+	for _, pod := range inputSnap.Pods().List(func(pod *corev1.Pod) bool {
+		return !containsProxyContainer(pod) || proxyRevision(pod) != revision
+	}) {
+		meshNamespaces.Insert(pod.Namespace)
+		if !podutil.IsPodReady(pod) || pod.CreationTimestamp.Time.Before(cert.NotBefore) {
+			verification.PendingWorkloads = append(verification.PendingWorkloads, pod.Name+"."+pod.Namespace)
+		}
+	}
+
+	for _, configMap := range inputSnap.ConfigMaps().List(func(configMap *corev1.ConfigMap) bool {
+		return configMap.Name != istioCaConfigMapName || !meshNamespaces.Has(configMap.Namespace)
+	}) {
+		if !bytes.Equal(
+			bytes.TrimSpace([]byte(configMap.Data[secrets.RootCertID])),
+			bytes.TrimSpace(caData.RootCert),
+		) {
+			verification.PendingNamespaces = append(verification.PendingNamespaces, configMap.Namespace)
+		}
+	}
+
+	sort.Strings(verification.PendingNamespaces)
+	sort.Strings(verification.PendingWorkloads)
+	verification.Verified = len(verification.PendingNamespaces) == 0 && len(verification.PendingWorkloads) == 0
+
+	return verification
+}
+
+// the revision of the control plane for which the certificate was issued
+func meshRevision(issuedCertificate *certificatesv1.IssuedCertificate) string {
+	if revision := issuedCertificate.GetLabels()[label.IoIstioRev.Name]; revision != "" {
+		return revision
+	}
+	return defaultRevision
+}
+
+// the revision of the control plane which injected the proxy
+func proxyRevision(pod *corev1.Pod) string {
+	if revision := pod.GetLabels()[label.IoIstioRev.Name]; revision != "" {
+		return revision
+	}
+	return defaultRevision
+}
+
+func containsProxyContainer(pod *corev1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == inject.ProxyContainerName {
+			return true
+		}
+	}
+	return false
+}
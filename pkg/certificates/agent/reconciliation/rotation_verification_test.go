@@ -0,0 +1,179 @@
+package reconciliation
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Rotation verification", func() {
+	var (
+		issuedCertSecret *corev1.Secret
+		issuedCert       *certificatesv1.IssuedCertificate
+	)
+
+	proxyPod := func(name, namespace string, created time.Time, ready bool) *corev1.Pod {
+		readyCondition := corev1.ConditionFalse
+		if ready {
+			readyCondition = corev1.ConditionTrue
+		}
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "app"}, {Name: "istio-proxy"}},
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyCondition}},
+			},
+		}
+	}
+
+	rootCertConfigMap := func(namespace string, rootCert []byte) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio-ca-root-cert",
+				Namespace: namespace,
+			},
+			Data: map[string]string{
+				secrets.RootCertID: string(rootCert),
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		issuedCertSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cacerts",
+				Namespace: "istio-system",
+			},
+			Data: generateCaSecretData(time.Now().Add(-time.Hour), time.Hour*24),
+		}
+		issuedCert = &certificatesv1.IssuedCertificate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "hello",
+				Namespace: "world",
+			},
+			Spec: certificatesv1.IssuedCertificateSpec{
+				IssuedCertificateSecret: ezkube.MakeObjectRef(issuedCertSecret),
+				RotationState:           certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+			},
+		}
+	})
+
+	It("reports namespaces and workloads which have not picked up the issued certificates", func() {
+		rootCert := issuedCertSecret.Data[secrets.RootCertID]
+		inputSnap := input.NewInputSnapshotManualBuilder("hello").
+			AddSecrets([]*corev1.Secret{issuedCertSecret}).
+			AddConfigMaps([]*corev1.ConfigMap{
+				rootCertConfigMap("istio-system", rootCert),
+				rootCertConfigMap("bookinfo", rootCert),
+				rootCertConfigMap("stale", []byte("old root")),
+				// namespaces without proxies of the mesh are ignored
+				rootCertConfigMap("no-proxies", []byte("old root")),
+				// unrelated configmaps are ignored
+				{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "bookinfo"}},
+			}).
+			AddPods([]*corev1.Pod{
+				proxyPod("restarted", "bookinfo", time.Now(), true),
+				proxyPod("not-restarted", "bookinfo", time.Now().Add(-time.Hour*2), true),
+				proxyPod("not-ready", "bookinfo", time.Now(), false),
+				proxyPod("restarted", "stale", time.Now(), true),
+				// pods without a proxy are ignored
+				{ObjectMeta: metav1.ObjectMeta{Name: "no-proxy", Namespace: "no-proxies"}},
+			}).
+			Build()
+
+		updateRotationStatus(issuedCert, inputSnap)
+
+		Expect(issuedCert.Status.ObservedRotationState).To(Equal(certificatesv1.CertificateRotationState_ADDING_NEW_ROOT))
+		verification := issuedCert.Status.RotationVerification
+		Expect(verification.GetRotationState()).To(Equal(certificatesv1.CertificateRotationState_ADDING_NEW_ROOT))
+		Expect(verification.GetVerified()).To(BeFalse())
+		Expect(verification.GetPendingNamespaces()).To(Equal([]string{"stale"}))
+		Expect(verification.GetPendingWorkloads()).To(Equal([]string{"not-ready.bookinfo", "not-restarted.bookinfo"}))
+		Expect(verification.GetErrors()).To(BeEmpty())
+		Expect(verification.GetTimestamp()).NotTo(BeEmpty())
+	})
+
+	It("verifies the rotation step once all workloads have picked up the issued certificates", func() {
+		inputSnap := input.NewInputSnapshotManualBuilder("hello").
+			AddSecrets([]*corev1.Secret{issuedCertSecret}).
+			AddConfigMaps([]*corev1.ConfigMap{
+				rootCertConfigMap("bookinfo", issuedCertSecret.Data[secrets.RootCertID]),
+			}).
+			AddPods([]*corev1.Pod{
+				proxyPod("restarted", "bookinfo", time.Now(), true),
+			}).
+			Build()
+
+		issuedCert.Status.RotationVerification = &certificatesv1.CertificateRotationVerificationStatus{
+			RotationState: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+			Timestamp:     "2021-01-01T00:00:00Z",
+			Verified:      true,
+		}
+
+		updateRotationStatus(issuedCert, inputSnap)
+
+		// the timestamp is retained as the result is unchanged
+		Expect(issuedCert.Status.RotationVerification).To(Equal(&certificatesv1.CertificateRotationVerificationStatus{
+			RotationState: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+			Timestamp:     "2021-01-01T00:00:00Z",
+			Verified:      true,
+		}))
+	})
+
+	It("reports an error if the issued certificate cannot be found", func() {
+		inputSnap := input.NewInputSnapshotManualBuilder("hello").Build()
+
+		updateRotationStatus(issuedCert, inputSnap)
+
+		Expect(issuedCert.Status.RotationVerification.GetVerified()).To(BeFalse())
+		Expect(issuedCert.Status.RotationVerification.GetErrors()).To(HaveLen(1))
+	})
+
+	It("clears the verification once the rotation has finished", func() {
+		issuedCert.Spec.RotationState = certificatesv1.CertificateRotationState_NOT_ROTATING
+		issuedCert.Status.RotationVerification = &certificatesv1.CertificateRotationVerificationStatus{Verified: true}
+
+		updateRotationStatus(issuedCert, input.NewInputSnapshotManualBuilder("hello").Build())
+
+		Expect(issuedCert.Status.ObservedRotationState).To(Equal(certificatesv1.CertificateRotationState_NOT_ROTATING))
+		Expect(issuedCert.Status.RotationVerification).To(BeNil())
+	})
+
+	It("only verifies the proxies and namespaces of the mesh's control plane revision", func() {
+		issuedCert.Labels = map[string]string{"istio.io/rev": "canary"}
+		rootCert := issuedCertSecret.Data[secrets.RootCertID]
+		canaryPod := proxyPod("canary", "bookinfo", time.Now(), true)
+		canaryPod.Labels = map[string]string{"istio.io/rev": "canary"}
+		// proxies of the default revision are managed by another mesh
+		defaultPod := proxyPod("default", "legacy", time.Now().Add(-time.Hour*2), true)
+		inputSnap := input.NewInputSnapshotManualBuilder("hello").
+			AddSecrets([]*corev1.Secret{issuedCertSecret}).
+			AddConfigMaps([]*corev1.ConfigMap{
+				rootCertConfigMap("istio-system", rootCert),
+				rootCertConfigMap("bookinfo", rootCert),
+				rootCertConfigMap("legacy", []byte("other root")),
+			}).
+			AddPods([]*corev1.Pod{canaryPod, defaultPod}).
+			Build()
+
+		updateRotationStatus(issuedCert, inputSnap)
+
+		verification := issuedCert.Status.RotationVerification
+		Expect(verification.GetVerified()).To(BeTrue())
+		Expect(verification.GetPendingNamespaces()).To(BeEmpty())
+		Expect(verification.GetPendingWorkloads()).To(BeEmpty())
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation"
 	podbouncer "github.com/solo-io/gloo-mesh/pkg/certificates/agent/reconciliation/pod-bouncer"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/vault"
 	"github.com/solo-io/gloo-mesh/pkg/common/schemes"
 	"github.com/solo-io/skv2/pkg/bootstrap"
 )
@@ -37,10 +38,13 @@ func StartFuncExt(makeExtensionOpts MakeExtensionOpts) bootstrap.StartFunc {
 
 		snapshotBuilder := input.NewSingleClusterBuilder(parameters.MasterManager)
 
-		translator := translation.NewCertAgentTranslator()
+		// sign certificate requests with Vault from the agent if the IssuedCertificate uses a Vault intermediate CA
+		translator := vault.NewTranslator(translation.NewCertAgentTranslator())
 
 		podBounder := podbouncer.NewPodBouncer(
 			corev1clients.NewPodClient(parameters.MasterManager.GetClient()),
+			parameters.MasterManager.GetClient(),
+			parameters.MasterManager.GetAPIReader(),
 			extOpts.RootCertMatcher,
 		)
 
@@ -1,6 +1,7 @@
 package translation
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 
@@ -114,7 +115,10 @@ func (c *certAgentTranslator) IssuedCertificatePending(
 ) ([]byte, error) {
 
 	// create a new private key
-	privateKey, err := utils.GeneratePrivateKey(int(issuedCertificate.Spec.CertOptions.GetRsaKeySizeBytes()))
+	privateKey, err := utils.GeneratePrivateKey(
+		issuedCertificate.Spec.GetCertOptions().GetKeyAlgorithm(),
+		int(issuedCertificate.Spec.GetCertOptions().GetRsaKeySizeBytes()),
+	)
 	if err != nil {
 		return nil, eris.Wrap(err, "generating private key")
 	}
@@ -188,7 +192,7 @@ func (c *certAgentTranslator) IssuedCertificateRequested(
 	signingCaChain := certificateRequest.Status.CertChain
 
 	issuedCertificateData := secrets.CAData{
-		RootCert:     signingRootCA,
+		RootCert:     buildRootCertBundle(signingRootCA, issuedCertificate.Spec.GetTrustedRootCertificates()),
 		CertChain:    signingCaChain,
 		CaCert:       signedCert,
 		CaPrivateKey: privateKey,
@@ -247,3 +251,22 @@ func (c *certAgentTranslator) IssuedCertificateFinished(
 	outputs.AddSecrets(issuedCertificateSecret)
 	return nil
 }
+
+// append the additional trusted root certificates of the IssuedCertificate to the root certificate of the signing CA,
+// so that workloads trust certificates signed by any of them
+func buildRootCertBundle(signingRootCA []byte, trustedRootCertificates [][]byte) []byte {
+	rootCertBundle := signingRootCA
+	for _, trustedRootCert := range trustedRootCertificates {
+		trustedRootCert = bytes.TrimSpace(trustedRootCert)
+		if len(trustedRootCert) == 0 || bytes.Contains(rootCertBundle, trustedRootCert) {
+			continue
+		}
+		bundle := make([]byte, 0, len(rootCertBundle)+len(trustedRootCert)+1)
+		bundle = append(bundle, rootCertBundle...)
+		if len(bundle) > 0 && bundle[len(bundle)-1] != '\n' {
+			bundle = append(bundle, '\n')
+		}
+		rootCertBundle = append(append(bundle, trustedRootCert...), '\n')
+	}
+	return rootCertBundle
+}
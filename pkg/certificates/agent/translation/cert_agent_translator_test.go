@@ -92,6 +92,42 @@ var _ = Describe("CertAgentTranslator", func() {
 			Expect(csr.Extensions)
 		})
 
+		It("Will create the private key with the configured key algorithm", func() {
+			translator := translation.NewCertAgentTranslator()
+
+			issuedCertificate := &certificatesv1.IssuedCertificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "name",
+					Namespace: "namespace",
+				},
+				Spec: certificatesv1.IssuedCertificateSpec{
+					IssuedCertificateSecret: &skv2corev1.ObjectRef{},
+					CertOptions: &certificatesv1.CommonCertOptions{
+						OrgName:      "istio",
+						KeyAlgorithm: certificatesv1.CommonCertOptions_ECDSA_P384,
+					},
+				},
+			}
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				Build()
+
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					pemByt, _ := pem.Decode(secret.Data["private-key"])
+					Expect(pemByt.Type).To(Equal("EC PRIVATE KEY"))
+				})
+
+			csrBytes, err := translator.IssuedCertificatePending(ctx, issuedCertificate, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+
+			pemByt, _ := pem.Decode(csrBytes)
+			csr, err := x509.ParseCertificateRequest(pemByt.Bytes)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(csr.PublicKeyAlgorithm).To(Equal(x509.ECDSA))
+			Expect(csr.SignatureAlgorithm).To(Equal(x509.ECDSAWithSHA384))
+		})
+
 	})
 
 	Context("IssuedCertificateRequested", func() {
@@ -187,6 +223,41 @@ var _ = Describe("CertAgentTranslator", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("will append the trusted root certificates to the root cert of the issued cert", func() {
+			translator := translation.NewCertAgentTranslator()
+
+			csr := &certificatesv1.CertificateRequest{
+				Status: certificatesv1.CertificateRequestStatus{
+					State:             certificatesv1.CertificateRequestStatus_FINISHED,
+					SignedCertificate: []byte("I'm a signing cert"),
+					SigningRootCa:     []byte("I'm a root ca\n"),
+				},
+			}
+
+			limitedTrustIssuedCertificate := issuedCertificate.DeepCopy()
+			limitedTrustIssuedCertificate.Spec.TrustedRootCertificates = [][]byte{
+				[]byte("I'm a peer root ca"),
+				// the signing root ca is only included once
+				[]byte("I'm a root ca"),
+				[]byte("I'm another peer root ca\n"),
+			}
+
+			inputSnap := input.NewInputSnapshotManualBuilder("hello").
+				AddSecrets([]*corev1.Secret{privateKeySecret}).
+				Build()
+
+			mockOutput.EXPECT().
+				AddSecrets(gomock.Any()).
+				Do(func(secret *corev1.Secret) {
+					intCaData := secrets.CADataFromSecretData(secret.Data)
+					Expect(string(intCaData.RootCert)).To(Equal("I'm a root ca\nI'm a peer root ca\nI'm another peer root ca\n"))
+					Expect(intCaData.CaCert).To(Equal([]byte("I'm a signing cert")))
+				})
+
+			_, err := translator.IssuedCertificateRequested(ctx, limitedTrustIssuedCertificate, csr, inputSnap, mockOutput)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
 	})
 
 	Context("IssuedCertificateIssued", func() {
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rotisserie/eris"
+)
+
+const (
+	vaultTokenHeader     = "X-Vault-Token"
+	vaultNamespaceHeader = "X-Vault-Namespace"
+
+	defaultKubernetesAuthMountPath = "/v1/auth/kubernetes"
+
+	requestTimeout = 30 * time.Second
+)
+
+// the subset of a Vault API response used by the cert agent
+type vaultResponse struct {
+	Data struct {
+		// the signed certificate returned by the PKI sign endpoints, or the certificate read from a PKI cert endpoint
+		Certificate string `json:"certificate"`
+		// the certificate of the CA which signed the certificate
+		IssuingCa string `json:"issuing_ca"`
+		// the chain of the CA which signed the certificate, excluding the signed certificate
+		CaChain []string `json:"ca_chain"`
+	} `json:"data"`
+	Auth struct {
+		ClientToken string `json:"client_token"`
+	} `json:"auth"`
+	Errors []string `json:"errors"`
+}
+
+// a minimal client for the Vault HTTP API
+type vaultClient struct {
+	httpClient *http.Client
+	server     string
+	namespace  string
+	token      string
+}
+
+func newVaultClient(server, namespace string, caBundle []byte) (*vaultClient, error) {
+	if server == "" {
+		return nil, eris.New("vault server address must be specified")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caBundle) > 0 {
+		rootCas := x509.NewCertPool()
+		if !rootCas.AppendCertsFromPEM(caBundle) {
+			return nil, eris.New("failed to parse vault CA bundle")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCas}
+	}
+
+	return &vaultClient{
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+		server:    strings.TrimSuffix(server, "/"),
+		namespace: namespace,
+	}, nil
+}
+
+// authenticate with the Kubernetes auth method using the given service account token
+func (c *vaultClient) loginKubernetes(ctx context.Context, mountPath, role, serviceAccountToken string) error {
+	if mountPath == "" {
+		mountPath = defaultKubernetesAuthMountPath
+	}
+	resp, err := c.do(ctx, http.MethodPost, strings.TrimSuffix(mountPath, "/")+"/login", map[string]interface{}{
+		"role": role,
+		"jwt":  serviceAccountToken,
+	})
+	if err != nil {
+		return eris.Wrap(err, "vault kubernetes auth login failed")
+	}
+	if resp.Auth.ClientToken == "" {
+		return eris.New("vault kubernetes auth login returned no client token")
+	}
+	c.token = resp.Auth.ClientToken
+	return nil
+}
+
+// submit a PEM encoded certificate signing request to the given PKI endpoint
+func (c *vaultClient) signCsr(ctx context.Context, csrPath string, csr []byte, ttlDays uint32) (*vaultResponse, error) {
+	body := map[string]interface{}{
+		"csr":            string(csr),
+		"format":         "pem",
+		"use_csr_values": true,
+	}
+	if ttlDays > 0 {
+		body["ttl"] = fmt.Sprintf("%dh", ttlDays*24)
+	}
+	resp, err := c.do(ctx, http.MethodPost, apiPath(csrPath), body)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to sign certificate signing request at vault path %v", csrPath)
+	}
+	if resp.Data.Certificate == "" {
+		return nil, eris.Errorf("vault path %v returned no signed certificate", csrPath)
+	}
+	return resp, nil
+}
+
+// read a PEM encoded CA certificate from the given PKI endpoint
+func (c *vaultClient) readCaCert(ctx context.Context, caPath string) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, apiPath(caPath), nil)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to read CA certificate at vault path %v", caPath)
+	}
+	if resp.Data.Certificate == "" {
+		return nil, eris.Errorf("vault path %v returned no CA certificate", caPath)
+	}
+	return []byte(resp.Data.Certificate), nil
+}
+
+func (c *vaultClient) do(ctx context.Context, method, path string, body interface{}) (*vaultResponse, error) {
+	var reqBody []byte
+	if body != nil {
+		var err error
+		if reqBody, err = json.Marshal(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.server+path, bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set(vaultTokenHeader, c.token)
+	}
+	if c.namespace != "" {
+		req.Header.Set(vaultNamespaceHeader, c.namespace)
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &vaultResponse{}
+	if len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, resp); err != nil {
+			return nil, eris.Wrapf(err, "failed to decode vault response (status %v)", httpResp.StatusCode)
+		}
+	}
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return nil, eris.Errorf("vault returned status %v: %v", httpResp.StatusCode, strings.Join(resp.Errors, ", "))
+	}
+	return resp, nil
+}
+
+// convert a vault path (e.g. "pki/root/sign-intermediate") into an API path
+func apiPath(vaultPath string) string {
+	return "/v1/" + strings.TrimPrefix(vaultPath, "/")
+}
@@ -0,0 +1,215 @@
+package vault
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the map key used to store the Vault token in the Secret referenced by the token auth method
+	tokenSecretKey = "token"
+
+	// the default map key used to store the service account token used by the kubernetes auth method
+	defaultServiceAccountTokenKey = "token"
+
+	// the default directory of the service account token mounted to the cert agent's pod
+	defaultMountedServiceAccountPath = "/var/run/secrets/kubernetes.io/serviceaccount"
+)
+
+// NewTranslator returns a Translator which signs the certificate requests of IssuedCertificates
+// with a Vault intermediate certificate authority (i.e. `spec.agentCa.vault`) directly from the cert agent.
+// IssuedCertificates which do not use a Vault CA are handled by the wrapped Translator.
+func NewTranslator(translator translation.Translator) translation.Translator {
+	return &vaultTranslator{Translator: translator}
+}
+
+type vaultTranslator struct {
+	translation.Translator
+}
+
+func (t *vaultTranslator) IssuedCertificateRequested(
+	ctx context.Context,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	certificateRequest *certificatesv1.CertificateRequest,
+	inputs input.Snapshot,
+	outputs certagent.Builder,
+) (bool, error) {
+	vaultCa := issuedCertificate.Spec.GetAgentCa().GetVault()
+	if vaultCa == nil {
+		return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputs, outputs)
+	}
+
+	contextutils.LoggerFrom(ctx).Debugf("signing certificate request %v with vault CA %v", sets.Key(certificateRequest), vaultCa.GetServer())
+
+	signedCertificateRequest, err := signCertificateRequest(ctx, vaultCa, issuedCertificate, certificateRequest, inputs)
+	if err != nil {
+		return false, eris.Wrapf(err, "failed to sign certificate request %v with vault", sets.Key(certificateRequest))
+	}
+
+	// the CertificateRequest has been fulfilled, write the issued certificate to the IssuedCertificate's secret
+	return t.Translator.IssuedCertificateRequested(ctx, issuedCertificate, signedCertificateRequest, inputs, outputs)
+}
+
+// sign the CertificateRequest with the Vault CA, returning a copy of the CertificateRequest with the signed certificate in its status
+func signCertificateRequest(
+	ctx context.Context,
+	vaultCa *certificatesv1.VaultCA,
+	issuedCertificate *certificatesv1.IssuedCertificate,
+	certificateRequest *certificatesv1.CertificateRequest,
+	inputs input.Snapshot,
+) (*certificatesv1.CertificateRequest, error) {
+	if vaultCa.GetCsrPath() == "" {
+		return nil, eris.New("vault CA must specify the csr_path used to sign certificate requests")
+	}
+
+	client, err := newVaultClient(vaultCa.GetServer(), vaultCa.GetNamespace(), vaultCa.GetCaBundle())
+	if err != nil {
+		return nil, err
+	}
+	if err := authenticate(ctx, client, vaultCa, inputs); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.signCsr(
+		ctx,
+		vaultCa.GetCsrPath(),
+		certificateRequest.Spec.GetCertificateSigningRequest(),
+		issuedCertificate.Spec.GetCertOptions().GetTtlDays(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signedCert := []byte(resp.Data.Certificate)
+
+	// the chain of the signing CA, the root certificate is expected to be last
+	var signingCaChain []string
+	if len(resp.Data.CaChain) > 0 {
+		for _, cert := range resp.Data.CaChain {
+			signingCaChain = append(signingCaChain, strings.TrimSuffix(cert, "\n"))
+		}
+	} else if resp.Data.IssuingCa != "" {
+		signingCaChain = []string{strings.TrimSuffix(resp.Data.IssuingCa, "\n")}
+	}
+
+	var rootCert []byte
+	if vaultCa.GetCaPath() != "" {
+		if rootCert, err = client.readCaCert(ctx, vaultCa.GetCaPath()); err != nil {
+			return nil, err
+		}
+	} else if len(signingCaChain) > 0 {
+		rootCert = []byte(signingCaChain[len(signingCaChain)-1])
+	} else {
+		return nil, eris.New("vault returned no CA chain for the signed certificate, and no ca_path is specified to read the root certificate from")
+	}
+
+	signedCertificateRequest := certificateRequest.DeepCopy()
+	signedCertificateRequest.Status = certificatesv1.CertificateRequestStatus{
+		ObservedGeneration: certificateRequest.Generation,
+		State:              certificatesv1.CertificateRequestStatus_FINISHED,
+		SignedCertificate:  signedCert,
+		SigningRootCa:      rootCert,
+		CertChain:          utils.AppendParentCerts(signedCert, []byte(strings.Join(signingCaChain, "\n"))),
+	}
+	return signedCertificateRequest, nil
+}
+
+// authenticate the client with the auth method of the Vault CA
+func authenticate(
+	ctx context.Context,
+	client *vaultClient,
+	vaultCa *certificatesv1.VaultCA,
+	inputs input.Snapshot,
+) error {
+	switch authType := vaultCa.GetAuthType().(type) {
+	case *certificatesv1.VaultCA_TokenSecretRef:
+		tokenSecret, err := inputs.Secrets().Find(authType.TokenSecretRef)
+		if err != nil {
+			return eris.Wrapf(err, "failed to find vault token secret %v", sets.Key(authType.TokenSecretRef))
+		}
+		token := strings.TrimSpace(string(tokenSecret.Data[tokenSecretKey]))
+		if token == "" {
+			return eris.Errorf("vault token secret %v has no %v", sets.Key(tokenSecret), tokenSecretKey)
+		}
+		client.token = token
+		return nil
+	case *certificatesv1.VaultCA_KubernetesAuth:
+		kubernetesAuth := authType.KubernetesAuth
+		serviceAccountToken, err := getServiceAccountToken(kubernetesAuth, inputs)
+		if err != nil {
+			return err
+		}
+		return client.loginKubernetes(ctx, kubernetesAuth.GetMountPath(), kubernetesAuth.GetRole(), serviceAccountToken)
+	default:
+		return eris.New("vault CA must specify an auth type")
+	}
+}
+
+// get the service account token used to authenticate with the kubernetes auth method
+func getServiceAccountToken(
+	kubernetesAuth *certificatesv1.VaultKubernetesAuth,
+	inputs input.Snapshot,
+) (string, error) {
+	tokenKey := kubernetesAuth.GetSecretTokenKey()
+	if tokenKey == "" {
+		tokenKey = defaultServiceAccountTokenKey
+	}
+
+	if serviceAccountRef := kubernetesAuth.GetServiceAccountRef(); serviceAccountRef != nil {
+		tokenSecret, err := findServiceAccountTokenSecret(serviceAccountRef, inputs)
+		if err != nil {
+			return "", err
+		}
+		token := strings.TrimSpace(string(tokenSecret.Data[tokenKey]))
+		if token == "" {
+			return "", eris.Errorf("service account token secret %v has no %v", sets.Key(tokenSecret), tokenKey)
+		}
+		return token, nil
+	}
+
+	mountedSaPath := kubernetesAuth.GetMountedSaPath()
+	if mountedSaPath == "" {
+		mountedSaPath = defaultMountedServiceAccountPath
+	}
+	token, err := ioutil.ReadFile(filepath.Join(mountedSaPath, tokenKey))
+	if err != nil {
+		return "", eris.Wrapf(err, "failed to read mounted service account token")
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// find the token Secret of the referenced ServiceAccount
+func findServiceAccountTokenSecret(
+	serviceAccountRef *skv2corev1.ObjectRef,
+	inputs input.Snapshot,
+) (*corev1.Secret, error) {
+	serviceAccount, err := inputs.ServiceAccounts().Find(serviceAccountRef)
+	if err != nil {
+		return nil, eris.Wrapf(err, "failed to find service account %v", sets.Key(serviceAccountRef))
+	}
+	for _, secretRef := range serviceAccount.Secrets {
+		secret, err := inputs.Secrets().Find(&skv2corev1.ObjectRef{
+			Name:      secretRef.Name,
+			Namespace: serviceAccount.Namespace,
+		})
+		if err != nil || secret.Type != corev1.SecretTypeServiceAccountToken {
+			continue
+		}
+		return secret, nil
+	}
+	return nil, eris.Errorf("no token secret found for service account %v", sets.Key(ezkube.MakeObjectRef(serviceAccount)))
+}
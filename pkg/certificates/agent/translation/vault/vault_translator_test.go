@@ -0,0 +1,421 @@
+package vault_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/input"
+	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent"
+	mock_certagent "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/agent/output/certagent/mocks"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation"
+	mock_translation "github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/translation/vault"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// an in-process fake of the Vault HTTP API endpoints used by the cert agent
+type fakeVault struct {
+	// the token expected on authenticated requests
+	token string
+	// the namespace expected on all requests
+	namespace string
+	// the role and service account token expected by the kubernetes auth method
+	role, serviceAccountToken string
+
+	// the CSR received by the sign endpoint
+	receivedCsr string
+	// the paths of all received requests
+	requests []string
+}
+
+const (
+	signedCert       = "signed-intermediate-cert"
+	issuingCaCert    = "issuing-ca-cert"
+	vaultRootCaCert  = "vault-root-ca-cert"
+	readRootCaCert   = "read-root-ca-cert"
+	privateKey       = "private-key"
+	certificateCsr   = "certificate-signing-request"
+	kubernetesToken  = "kubernetes-client-token"
+	vaultStaticToken = "static-token"
+)
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.requests = append(f.requests, r.Method+" "+r.URL.Path)
+
+	if r.Header.Get("X-Vault-Namespace") != f.namespace {
+		writeVaultError(w, http.StatusForbidden, "unexpected namespace")
+		return
+	}
+
+	body := map[string]interface{}{}
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeVaultError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	switch r.URL.Path {
+	case "/v1/auth/kubernetes/login":
+		if body["role"] != f.role || body["jwt"] != f.serviceAccountToken {
+			writeVaultError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		writeVaultResponse(w, map[string]interface{}{
+			"auth": map[string]interface{}{"client_token": f.token},
+		})
+		return
+	}
+
+	if r.Header.Get("X-Vault-Token") != f.token {
+		writeVaultError(w, http.StatusForbidden, "permission denied")
+		return
+	}
+
+	switch r.URL.Path {
+	case "/v1/pki/root/sign-intermediate":
+		f.receivedCsr, _ = body["csr"].(string)
+		writeVaultResponse(w, map[string]interface{}{
+			"data": map[string]interface{}{
+				"certificate": signedCert,
+				"issuing_ca":  issuingCaCert,
+				"ca_chain":    []string{issuingCaCert, vaultRootCaCert},
+			},
+		})
+	case "/v1/pki/cert/ca":
+		writeVaultResponse(w, map[string]interface{}{
+			"data": map[string]interface{}{
+				"certificate": readRootCaCert,
+			},
+		})
+	default:
+		writeVaultError(w, http.StatusNotFound, "no handler for route")
+	}
+}
+
+func writeVaultResponse(w http.ResponseWriter, resp interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func writeVaultError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{msg}})
+}
+
+var _ = Describe("VaultTranslator", func() {
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockOutput *mock_certagent.MockBuilder
+
+		vaultServer *httptest.Server
+		fake        *fakeVault
+
+		privateKeySecret   *corev1.Secret
+		issuedCertificate  *certificatesv1.IssuedCertificate
+		certificateRequest *certificatesv1.CertificateRequest
+	)
+
+	BeforeEach(func() {
+		ctrl, ctx = gomock.WithContext(context.Background(), GinkgoT())
+
+		mockOutput = mock_certagent.NewMockBuilder(ctrl)
+
+		fake = &fakeVault{
+			token:               vaultStaticToken,
+			namespace:           "admin",
+			role:                "gloo-mesh-agent",
+			serviceAccountToken: "service-account-jwt",
+		}
+		vaultServer = httptest.NewServer(fake)
+
+		privateKeySecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio",
+				Namespace: "gloo-mesh",
+			},
+			Data: map[string][]byte{
+				"private-key": []byte(privateKey),
+			},
+		}
+
+		issuedCertificate = &certificatesv1.IssuedCertificate{
+			ObjectMeta: privateKeySecret.ObjectMeta,
+			Spec: certificatesv1.IssuedCertificateSpec{
+				IssuedCertificateSecret: &skv2corev1.ObjectRef{
+					Name:      "cacerts",
+					Namespace: "istio-system",
+				},
+				CertOptions: &certificatesv1.CommonCertOptions{
+					TtlDays: 30,
+				},
+				CertificateAuthority: &certificatesv1.IssuedCertificateSpec_AgentCa{
+					AgentCa: &certificatesv1.IntermediateCertificateAuthority{
+						CaSource: &certificatesv1.IntermediateCertificateAuthority_Vault{
+							Vault: &certificatesv1.VaultCA{
+								Server:    vaultServer.URL,
+								Namespace: "admin",
+								CsrPath:   "pki/root/sign-intermediate",
+								AuthType: &certificatesv1.VaultCA_TokenSecretRef{
+									TokenSecretRef: &skv2corev1.ObjectRef{
+										Name:      "vault-token",
+										Namespace: "gloo-mesh",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		certificateRequest = &certificatesv1.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "istio",
+				Namespace:  "gloo-mesh",
+				Generation: 2,
+			},
+			Spec: certificatesv1.CertificateRequestSpec{
+				CertificateSigningRequest: []byte(certificateCsr),
+			},
+			Status: certificatesv1.CertificateRequestStatus{
+				State: certificatesv1.CertificateRequestStatus_PENDING,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		vaultServer.Close()
+		ctrl.Finish()
+	})
+
+	vaultCa := func() *certificatesv1.VaultCA {
+		return issuedCertificate.Spec.GetAgentCa().GetVault()
+	}
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault-token",
+			Namespace: "gloo-mesh",
+		},
+		Data: map[string][]byte{
+			"token": []byte(vaultStaticToken + "\n"),
+		},
+	}
+
+	expectIssuedCertificateSecret := func(expectedRootCert string) {
+		mockOutput.EXPECT().
+			AddSecrets(gomock.Any()).
+			Do(func(secret *corev1.Secret) {
+				Expect(secret.Name).To(Equal("cacerts"))
+				Expect(secret.Namespace).To(Equal("istio-system"))
+				Expect(secret.Type).To(Equal(translation.IssuedCertificateSecretType()))
+				caData := secrets.CADataFromSecretData(secret.Data)
+				Expect(string(caData.CaPrivateKey)).To(Equal(privateKey))
+				Expect(string(caData.CaCert)).To(Equal(signedCert))
+				Expect(string(caData.RootCert)).To(Equal(expectedRootCert))
+				Expect(string(caData.CertChain)).To(Equal(signedCert + "\n" + issuingCaCert + "\n" + vaultRootCaCert))
+			})
+	}
+
+	It("delegates IssuedCertificates which do not use a Vault CA to the wrapped translator", func() {
+		mockTranslator := mock_translation.NewMockTranslator(ctrl)
+		translator := vault.NewTranslator(mockTranslator)
+
+		issuedCertificate.Spec.CertificateAuthority = nil
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").Build()
+
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput).
+			Return(true, nil)
+
+		wait, err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeTrue())
+		Expect(fake.requests).To(BeEmpty())
+	})
+
+	It("signs the certificate request with a Vault token and passes the finished request to the wrapped translator", func() {
+		mockTranslator := mock_translation.NewMockTranslator(ctrl)
+		translator := vault.NewTranslator(mockTranslator)
+
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").
+			AddSecrets([]*corev1.Secret{tokenSecret}).
+			Build()
+
+		mockTranslator.EXPECT().
+			IssuedCertificateRequested(ctx, issuedCertificate, gomock.Any(), inputSnap, mockOutput).
+			DoAndReturn(func(
+				_ context.Context,
+				_ *certificatesv1.IssuedCertificate,
+				signedCertificateRequest *certificatesv1.CertificateRequest,
+				_ input.Snapshot,
+				_ certagent.Builder,
+			) (bool, error) {
+				Expect(&signedCertificateRequest.Status).To(Equal(&certificatesv1.CertificateRequestStatus{
+					ObservedGeneration: 2,
+					State:              certificatesv1.CertificateRequestStatus_FINISHED,
+					SignedCertificate:  []byte(signedCert),
+					SigningRootCa:      []byte(vaultRootCaCert),
+					CertChain:          []byte(signedCert + "\n" + issuingCaCert + "\n" + vaultRootCaCert),
+				}))
+				return false, nil
+			})
+
+		wait, err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(fake.receivedCsr).To(Equal(certificateCsr))
+		Expect(fake.requests).To(Equal([]string{"POST /v1/pki/root/sign-intermediate"}))
+		// the input CertificateRequest is not modified
+		Expect(certificateRequest.Status.State).To(Equal(certificatesv1.CertificateRequestStatus_PENDING))
+	})
+
+	It("writes the certificate signed by Vault and the root certificate read from ca_path to the Istio CA secret", func() {
+		translator := vault.NewTranslator(translation.NewCertAgentTranslator())
+
+		vaultCa().CaPath = "pki/cert/ca"
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").
+			AddSecrets([]*corev1.Secret{tokenSecret, privateKeySecret}).
+			Build()
+
+		expectIssuedCertificateSecret(readRootCaCert)
+
+		wait, err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+		Expect(fake.requests).To(Equal([]string{
+			"POST /v1/pki/root/sign-intermediate",
+			"GET /v1/pki/cert/ca",
+		}))
+	})
+
+	It("authenticates with the Kubernetes auth method using the mounted service account token", func() {
+		translator := vault.NewTranslator(translation.NewCertAgentTranslator())
+
+		mountedSaPath, err := ioutil.TempDir("", "vault-sa")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(mountedSaPath)
+		err = ioutil.WriteFile(filepath.Join(mountedSaPath, "token"), []byte(fake.serviceAccountToken), 0600)
+		Expect(err).NotTo(HaveOccurred())
+
+		fake.token = kubernetesToken
+		vaultCa().AuthType = &certificatesv1.VaultCA_KubernetesAuth{
+			KubernetesAuth: &certificatesv1.VaultKubernetesAuth{
+				Role: fake.role,
+				ServiceAccountLocation: &certificatesv1.VaultKubernetesAuth_MountedSaPath{
+					MountedSaPath: mountedSaPath,
+				},
+			},
+		}
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").
+			AddSecrets([]*corev1.Secret{privateKeySecret}).
+			Build()
+
+		expectIssuedCertificateSecret(vaultRootCaCert)
+
+		_, err = translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fake.requests).To(Equal([]string{
+			"POST /v1/auth/kubernetes/login",
+			"POST /v1/pki/root/sign-intermediate",
+		}))
+	})
+
+	It("authenticates with the Kubernetes auth method using the token of the referenced service account", func() {
+		translator := vault.NewTranslator(translation.NewCertAgentTranslator())
+
+		serviceAccount := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vault-auth",
+				Namespace: "gloo-mesh",
+			},
+			Secrets: []corev1.ObjectReference{
+				{Name: "vault-auth-dockercfg"},
+				{Name: "vault-auth-token"},
+			},
+		}
+		serviceAccountSecrets := []*corev1.Secret{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "vault-auth-dockercfg", Namespace: "gloo-mesh"},
+				Type:       corev1.SecretTypeDockercfg,
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "vault-auth-token", Namespace: "gloo-mesh"},
+				Type:       corev1.SecretTypeServiceAccountToken,
+				Data: map[string][]byte{
+					"jwt": []byte(fake.serviceAccountToken),
+				},
+			},
+		}
+
+		fake.token = kubernetesToken
+		vaultCa().AuthType = &certificatesv1.VaultCA_KubernetesAuth{
+			KubernetesAuth: &certificatesv1.VaultKubernetesAuth{
+				Role:           fake.role,
+				SecretTokenKey: "jwt",
+				ServiceAccountLocation: &certificatesv1.VaultKubernetesAuth_ServiceAccountRef{
+					ServiceAccountRef: &skv2corev1.ObjectRef{
+						Name:      "vault-auth",
+						Namespace: "gloo-mesh",
+					},
+				},
+			},
+		}
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").
+			AddSecrets(append(serviceAccountSecrets, privateKeySecret)).
+			AddServiceAccounts([]*corev1.ServiceAccount{serviceAccount}).
+			Build()
+
+		expectIssuedCertificateSecret(vaultRootCaCert)
+
+		_, err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("returns an error if Vault rejects the request", func() {
+		translator := vault.NewTranslator(translation.NewCertAgentTranslator())
+
+		fake.token = "another-token"
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").
+			AddSecrets([]*corev1.Secret{tokenSecret, privateKeySecret}).
+			Build()
+
+		_, err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("vault returned status 403: permission denied"))
+	})
+
+	It("returns an error if the Vault CA is incomplete", func() {
+		translator := vault.NewTranslator(translation.NewCertAgentTranslator())
+
+		inputSnap := input.NewInputSnapshotManualBuilder("vault").
+			AddSecrets([]*corev1.Secret{privateKeySecret}).
+			Build()
+
+		_, err := translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("failed to find vault token secret vault-token.gloo-mesh"))
+
+		vaultCa().CsrPath = ""
+		_, err = translator.IssuedCertificateRequested(ctx, issuedCertificate, certificateRequest, inputSnap, mockOutput)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("vault CA must specify the csr_path"))
+		Expect(fake.requests).To(BeEmpty())
+	})
+})
@@ -1,6 +1,10 @@
 package utils
 
 import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -8,25 +12,69 @@ import (
 	"strings"
 
 	"github.com/rotisserie/eris"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	pkiutil "istio.io/istio/security/pkg/pki/util"
 )
 
 const (
 	rsaKeySize = 4096
+
+	rsaPrivateKey   = "RSA PRIVATE KEY"
+	ecPrivateKey    = "EC PRIVATE KEY"
+	pkcs8PrivateKey = "PRIVATE KEY"
 )
 
-func GeneratePrivateKey(keySize int) ([]byte, error) {
-	if keySize == 0 {
-		keySize = rsaKeySize
-	}
-	priv, err := rsa.GenerateKey(rand.Reader, keySize)
-	if err != nil {
-		return nil, eris.Errorf("RSA key generation failed (%v)", err)
-	}
-	privKey := x509.MarshalPKCS1PrivateKey(priv)
-	keyBlock := &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: privKey,
+// GeneratePrivateKey generates a PEM encoded private key using the given algorithm.
+// RSA keys are PKCS1 encoded, ECDSA keys are SEC1 encoded and Ed25519 keys are PKCS8 encoded,
+// matching the formats accepted by Istio.
+// keySize is only used for RSA keys, and defaults to 4096.
+func GeneratePrivateKey(keyAlgorithm certificatesv1.CommonCertOptions_KeyAlgorithm, keySize int) ([]byte, error) {
+	var keyBlock *pem.Block
+	switch keyAlgorithm {
+	case certificatesv1.CommonCertOptions_RSA:
+		if keySize == 0 {
+			keySize = rsaKeySize
+		}
+		priv, err := rsa.GenerateKey(rand.Reader, keySize)
+		if err != nil {
+			return nil, eris.Errorf("RSA key generation failed (%v)", err)
+		}
+		keyBlock = &pem.Block{
+			Type:  rsaPrivateKey,
+			Bytes: x509.MarshalPKCS1PrivateKey(priv),
+		}
+	case certificatesv1.CommonCertOptions_ECDSA_P256, certificatesv1.CommonCertOptions_ECDSA_P384:
+		curve := elliptic.P256()
+		if keyAlgorithm == certificatesv1.CommonCertOptions_ECDSA_P384 {
+			curve = elliptic.P384()
+		}
+		priv, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, eris.Errorf("ECDSA key generation failed (%v)", err)
+		}
+		privKey, err := x509.MarshalECPrivateKey(priv)
+		if err != nil {
+			return nil, eris.Wrap(err, "encoding ECDSA private key")
+		}
+		keyBlock = &pem.Block{
+			Type:  ecPrivateKey,
+			Bytes: privKey,
+		}
+	case certificatesv1.CommonCertOptions_ED25519:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, eris.Errorf("Ed25519 key generation failed (%v)", err)
+		}
+		privKey, err := x509.MarshalPKCS8PrivateKey(priv)
+		if err != nil {
+			return nil, eris.Wrap(err, "encoding Ed25519 private key")
+		}
+		keyBlock = &pem.Block{
+			Type:  pkcs8PrivateKey,
+			Bytes: privKey,
+		}
+	default:
+		return nil, eris.Errorf("unsupported key algorithm %v", keyAlgorithm)
 	}
 	return pem.EncodeToMemory(keyBlock), nil
 }
@@ -54,11 +102,14 @@ func GenerateCertificateSigningRequest(
 	privateKey []byte,
 ) (csr []byte, err error) {
 
-	// Attempt to decode the key from the PEM format, currently only one format is supported (PKCS1)
-	block, _ := pem.Decode(privateKey)
-	priv, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	// Attempt to decode the key from the PEM format, supporting PKCS1 (RSA), SEC1 (ECDSA) and PKCS8 encoded keys
+	key, err := pkiutil.ParsePemEncodedKey(privateKey)
 	if err != nil {
-		return nil, eris.Wrapf(err, "unable to decode private key, currently only supporting PKCS1 encrypted keys")
+		return nil, eris.Wrapf(err, "unable to decode private key")
+	}
+	priv, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, eris.Errorf("unsupported private key type %T", key)
 	}
 
 	template, err := pkiutil.GenCSRTemplate(pkiutil.CertOptions{
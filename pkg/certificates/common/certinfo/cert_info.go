@@ -0,0 +1,66 @@
+package certinfo
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/rotisserie/eris"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	pkiutil "istio.io/istio/security/pkg/pki/util"
+)
+
+// ParseCertificate parses the first certificate in the given PEM encoded certificate (chain).
+func ParseCertificate(certPem []byte) (*x509.Certificate, error) {
+	if len(certPem) == 0 {
+		return nil, eris.New("no certificate data provided")
+	}
+	return pkiutil.ParsePemEncodedCertificate(certPem)
+}
+
+// RenewalTime returns the time at which the certificate should be renewed, i.e. once the remaining fraction
+// of its lifetime drops below the given grace period ratio.
+// For example, at 0.10 and a 10 day lifetime, the certificate is renewed 1 day before it expires.
+func RenewalTime(cert *x509.Certificate, gracePeriodRatio float32) time.Time {
+	if gracePeriodRatio < 0 {
+		gracePeriodRatio = 0
+	} else if gracePeriodRatio > 1 {
+		gracePeriodRatio = 1
+	}
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	// certificate validity has second precision; rounding also drops the imprecision of the float32 ratio
+	gracePeriod := time.Duration(float64(lifetime) * float64(gracePeriodRatio)).Round(time.Second)
+	return cert.NotAfter.Add(-gracePeriod)
+}
+
+// BuildIssuedCertificateInfo returns the details of the given certificate to be recorded on the status of
+// IssuedCertificates and VirtualMeshes.
+// rootCertPem is the PEM encoded root certificate (bundle) which the certificate chains to;
+// the fingerprint of the first root certificate is recorded.
+func BuildIssuedCertificateInfo(
+	cert *x509.Certificate,
+	rootCertPem []byte,
+	gracePeriodRatio float32,
+) *certificatesv1.IssuedCertificateInfo {
+	info := &certificatesv1.IssuedCertificateInfo{
+		SerialNumber:      fmt.Sprintf("%x", cert.SerialNumber),
+		NotBefore:         cert.NotBefore.UTC().Format(time.RFC3339),
+		NotAfter:          cert.NotAfter.UTC().Format(time.RFC3339),
+		Issuer:            cert.Issuer.String(),
+		Subject:           cert.Subject.String(),
+		Sha256Fingerprint: fingerprint(cert.Raw),
+		RenewalTime:       RenewalTime(cert, gracePeriodRatio).UTC().Format(time.RFC3339),
+	}
+	if rootBlock, _ := pem.Decode(rootCertPem); rootBlock != nil {
+		info.RootSha256Fingerprint = fingerprint(rootBlock.Bytes)
+	}
+	return info
+}
+
+func fingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,66 @@
+package certinfo_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/solo-io/gloo-mesh/pkg/certificates/common/certinfo"
+	"istio.io/istio/security/pkg/pki/util"
+)
+
+var _ = Describe("CertInfo", func() {
+	var (
+		notBefore = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		rootCert  []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		rootCert, _, err = util.GenCertKeyFromOptions(util.CertOptions{
+			Org:          "root-org",
+			IsCA:         true,
+			IsSelfSigned: true,
+			NotBefore:    notBefore,
+			TTL:          time.Hour * 24 * 10,
+			RSAKeySize:   2048,
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("computes the renewal time from the grace period ratio", func() {
+		cert, err := ParseCertificate(rootCert)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(RenewalTime(cert, 0.1)).To(Equal(cert.NotAfter.Add(-time.Hour * 24)))
+		Expect(RenewalTime(cert, 0)).To(Equal(cert.NotAfter))
+		Expect(RenewalTime(cert, 2)).To(Equal(cert.NotBefore))
+	})
+
+	It("builds the issued certificate info", func() {
+		cert, err := ParseCertificate(rootCert)
+		Expect(err).NotTo(HaveOccurred())
+
+		info := BuildIssuedCertificateInfo(cert, rootCert, 0.1)
+
+		fingerprint := sha256.Sum256(cert.Raw)
+		Expect(info.GetSerialNumber()).To(Equal(cert.SerialNumber.Text(16)))
+		Expect(info.GetNotBefore()).To(Equal("2021-01-01T00:00:00Z"))
+		Expect(info.GetNotAfter()).To(Equal("2021-01-11T00:00:00Z"))
+		Expect(info.GetRenewalTime()).To(Equal("2021-01-10T00:00:00Z"))
+		Expect(info.GetIssuer()).To(Equal("O=root-org"))
+		Expect(info.GetSubject()).To(Equal("O=root-org"))
+		Expect(info.GetSha256Fingerprint()).To(Equal(hex.EncodeToString(fingerprint[:])))
+		// a self signed certificate is its own root
+		Expect(info.GetRootSha256Fingerprint()).To(Equal(info.GetSha256Fingerprint()))
+	})
+
+	It("errors on missing certificate data", func() {
+		_, err := ParseCertificate(nil)
+		Expect(err).To(HaveOccurred())
+		_, err = ParseCertificate([]byte("not a certificate"))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -4,17 +4,32 @@ import (
 	"context"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rotisserie/eris"
 	"github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/issuer/input"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/certificates/common/certinfo"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/issuer/translation"
 	"github.com/solo-io/go-utils/contextutils"
 	skinput "github.com/solo-io/skv2/contrib/pkg/input"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+var issuedCertificateExpiry = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "gloo_mesh_cert_issuer_issued_certificate_expiry_seconds",
+		Help: "The number of seconds until the certificate signed for each CertificateRequest (one per Mesh) expires.",
+	},
+	[]string{"certificate_request", "namespace", "cluster"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(issuedCertificateExpiry)
+}
+
 // function which defines how the cert issuer reconciler should be registered with internal components.
 type RegisterReconcilerFunc func(
 	ctx context.Context,
@@ -80,6 +95,7 @@ func (r *certIssuerReconciler) reconcile(_ ezkube.ClusterResourceId) (bool, erro
 			certificateRequest.Status.State = certificatesv1.CertificateRequestStatus_FAILED
 		}
 	}
+	r.recordCertificateExpiry(inputSnap.CertificateRequests().List())
 
 	return false, r.syncInputStatuses(r.ctx, inputSnap)
 }
@@ -135,3 +151,25 @@ func (r *certIssuerReconciler) reconcileCertificateRequest(certificateRequest *c
 
 	return nil
 }
+
+// export the time to expiry of the signed certificates
+func (r *certIssuerReconciler) recordCertificateExpiry(certificateRequests []*certificatesv1.CertificateRequest) {
+	// drop metrics for CertificateRequests which no longer exist
+	issuedCertificateExpiry.Reset()
+	for _, certificateRequest := range certificateRequests {
+		if certificateRequest.Status.State != certificatesv1.CertificateRequestStatus_FINISHED ||
+			len(certificateRequest.Status.SignedCertificate) == 0 {
+			continue
+		}
+		cert, err := certinfo.ParseCertificate(certificateRequest.Status.SignedCertificate)
+		if err != nil {
+			contextutils.LoggerFrom(r.ctx).Debugf("failed to parse signed certificate of cert request %v: %v", sets.Key(certificateRequest), err)
+			continue
+		}
+		issuedCertificateExpiry.WithLabelValues(
+			certificateRequest.GetName(),
+			certificateRequest.GetNamespace(),
+			certificateRequest.GetClusterName(),
+		).Set(time.Until(cert.NotAfter).Seconds())
+	}
+}
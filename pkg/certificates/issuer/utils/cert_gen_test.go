@@ -2,20 +2,30 @@ package utils_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
 	"encoding/pem"
 	"time"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
 	. "github.com/solo-io/gloo-mesh/pkg/certificates/issuer/utils"
 	"istio.io/istio/security/pkg/pki/util"
 )
 
 var _ = Describe("CertGen workflow", func() {
-	assertCsrWorks := func(signingRoot, signingKey []byte) {
-		privateKey, err := utils.GeneratePrivateKey(4096)
+	assertCsrWorks := func(
+		signingRoot, signingKey []byte,
+		keyAlgorithm certificatesv1.CommonCertOptions_KeyAlgorithm,
+	) *x509.Certificate {
+		privateKey, err := utils.GeneratePrivateKey(keyAlgorithm, 4096)
 		Expect(err).NotTo(HaveOccurred())
 
 		hosts := []string{"spiffe://custom-domain/ns/istio-system/sa/istio-pilot-service-account"}
@@ -42,6 +52,18 @@ var _ = Describe("CertGen workflow", func() {
 		Expect(cert.IsCA).To(BeTrue())
 		Expect(cert.Subject.OrganizationalUnit).To(ConsistOf("mesh-name"))
 		Expect(cert.Subject.Organization).To(ConsistOf("gloo-mesh"))
+
+		// the intermediate cert must chain to the root cert
+		roots := x509.NewCertPool()
+		Expect(roots.AppendCertsFromPEM(signingRoot)).To(BeTrue())
+		_, err = cert.Verify(x509.VerifyOptions{Roots: roots})
+		Expect(err).NotTo(HaveOccurred())
+
+		// the intermediate cert must match its private key
+		_, err = tls.X509KeyPair(inetermediaryCert, privateKey)
+		Expect(err).NotTo(HaveOccurred())
+
+		return cert
 	}
 
 	It("generates a certificate using generated self signed cert, private key, and certificate signing request", func() {
@@ -57,6 +79,55 @@ var _ = Describe("CertGen workflow", func() {
 		signingRoot, signingKey, err := util.GenCertKeyFromOptions(options)
 		Expect(err).NotTo(HaveOccurred())
 
-		assertCsrWorks(signingRoot, signingKey)
+		assertCsrWorks(signingRoot, signingKey, certificatesv1.CommonCertOptions_RSA)
+	})
+
+	DescribeTable("generates certificates with mixed key algorithm chains",
+		func(rootKeyAlgorithm, intermediateKeyAlgorithm certificatesv1.CommonCertOptions_KeyAlgorithm, expectedPublicKey interface{}) {
+			rootKey, err := utils.GeneratePrivateKey(rootKeyAlgorithm, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			signingRoot, signingKey, err := util.GenRootCertFromExistingKey(util.CertOptions{
+				Org:           "org",
+				IsCA:          true,
+				IsSelfSigned:  true,
+				TTL:           time.Hour * 24 * 365,
+				SignerPrivPem: rootKey,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			cert := assertCsrWorks(signingRoot, signingKey, intermediateKeyAlgorithm)
+			Expect(cert.PublicKey).To(BeAssignableToTypeOf(expectedPublicKey))
+		},
+		Entry("ECDSA P-256 intermediate signed by an RSA root",
+			certificatesv1.CommonCertOptions_RSA, certificatesv1.CommonCertOptions_ECDSA_P256, &ecdsa.PublicKey{}),
+		Entry("ECDSA P-384 intermediate signed by an ECDSA P-256 root",
+			certificatesv1.CommonCertOptions_ECDSA_P256, certificatesv1.CommonCertOptions_ECDSA_P384, &ecdsa.PublicKey{}),
+		Entry("RSA intermediate signed by an ECDSA P-384 root",
+			certificatesv1.CommonCertOptions_ECDSA_P384, certificatesv1.CommonCertOptions_RSA, &rsa.PublicKey{}),
+		Entry("ECDSA P-256 intermediate signed by an Ed25519 root",
+			certificatesv1.CommonCertOptions_ED25519, certificatesv1.CommonCertOptions_ECDSA_P256, &ecdsa.PublicKey{}),
+	)
+
+	It("generates private keys with the requested key algorithm", func() {
+		p256Key, err := utils.GeneratePrivateKey(certificatesv1.CommonCertOptions_ECDSA_P256, 0)
+		Expect(err).NotTo(HaveOccurred())
+		key, err := util.ParsePemEncodedKey(p256Key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key.(*ecdsa.PrivateKey).Curve).To(Equal(elliptic.P256()))
+
+		p384Key, err := utils.GeneratePrivateKey(certificatesv1.CommonCertOptions_ECDSA_P384, 0)
+		Expect(err).NotTo(HaveOccurred())
+		key, err = util.ParsePemEncodedKey(p384Key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key.(*ecdsa.PrivateKey).Curve).To(Equal(elliptic.P384()))
+
+		ed25519Key, err := utils.GeneratePrivateKey(certificatesv1.CommonCertOptions_ED25519, 0)
+		Expect(err).NotTo(HaveOccurred())
+		key, err = util.ParsePemEncodedKey(ed25519Key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+
+		_, err = utils.GeneratePrivateKey(certificatesv1.CommonCertOptions_KeyAlgorithm(42), 0)
+		Expect(err).To(HaveOccurred())
 	})
 })
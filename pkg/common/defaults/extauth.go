@@ -0,0 +1,8 @@
+package defaults
+
+const (
+	// The name of the external authorization server Service, expected to be installed alongside Gloo Mesh in each managed cluster.
+	ExtAuthServiceName = "ext-auth-service"
+	// The port on which the external authorization server serves the Envoy ext_authz gRPC API.
+	ExtAuthServicePort uint32 = 8083
+)
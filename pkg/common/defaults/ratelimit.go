@@ -0,0 +1,11 @@
+package defaults
+
+const (
+	// The name of the rate limit server Service, expected to be installed alongside Gloo Mesh in each managed cluster.
+	RateLimitServiceName = "rate-limiter"
+	// The default port on which the rate limit server serves the Envoy rate limit gRPC API,
+	// used if the rate limit server's Service has not been discovered or has no port named grpc.
+	RateLimitServicePort uint32 = 18081
+	// The rate limit domain shared by all Gloo Mesh translated rate limit descriptors.
+	RateLimitDomain = "solo.io"
+)
@@ -21,9 +21,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	appmeshv1beta2 "github.com/aws/aws-app-mesh-controller-for-k8s/apis/appmesh/v1beta2"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/reconcile"
 	"github.com/solo-io/skv2/pkg/verifier"
+	istionetworkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	batchv1 "k8s.io/api/batch/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/hashicorp/go-multierror"
@@ -122,6 +126,16 @@ func Start(
 			Version: appmeshv1beta2.GroupVersion.Version,
 			Kind:    "Mesh",
 		}: verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		// only warn (avoids error) if istio resources describing non-kubernetes workloads are not available on cluster
+		istionetworkingv1alpha3.SchemeGroupVersion.WithKind("ServiceEntry"):  verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		istionetworkingv1alpha3.SchemeGroupVersion.WithKind("WorkloadEntry"): verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		istionetworkingv1alpha3.SchemeGroupVersion.WithKind("WorkloadGroup"): verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		// only warn (avoids error) if batch/v1 CronJobs (Kubernetes 1.21+) or Argo Rollouts are not available on cluster
+		batchv1.SchemeGroupVersion.WithKind("CronJob"):              verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		argorolloutsv1alpha1.SchemeGroupVersion.WithKind("Rollout"): verifier.ServerVerifyOption_IgnoreIfNotPresent,
+		// only warn (avoids error) if discovery.k8s.io/v1 EndpointSlices (Kubernetes 1.21+) are not available on cluster,
+		// in which case Destination endpoints are read from core/v1 Endpoints
+		discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice"): verifier.ServerVerifyOption_IgnoreIfNotPresent,
 	})
 	translator := translation.NewTranslator(translation.DefaultDependencyFactory)
 	r := &discoveryReconciler{
@@ -153,6 +167,24 @@ func Start(
 					Meshes: reconcile.Options{
 						Verifier: verifier,
 					},
+					ServiceEntries: reconcile.Options{
+						Verifier: verifier,
+					},
+					WorkloadEntries: reconcile.Options{
+						Verifier: verifier,
+					},
+					WorkloadGroups: reconcile.Options{
+						Verifier: verifier,
+					},
+					CronJobs: reconcile.Options{
+						Verifier: verifier,
+					},
+					Rollouts: reconcile.Options{
+						Verifier: verifier,
+					},
+					EndpointSlices: reconcile.Options{
+						Verifier: verifier,
+					},
 					Predicates: discoveryPredicates,
 				},
 				Local:             input.LocalReconcileOptions{},
@@ -201,6 +233,27 @@ func (r *discoveryReconciler) reconcile(obj ezkube.ClusterResourceId) (bool, err
 		Meshes: input.ResourceDiscoveryInputBuildOptions{
 			Verifier: r.verifier,
 		},
+		// ignore NoKindMatchError for clusters without Istio installed
+		ServiceEntries: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
+		WorkloadEntries: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
+		WorkloadGroups: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
+		// ignore NoKindMatchError for clusters without batch/v1 CronJobs or Argo Rollouts installed
+		CronJobs: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
+		Rollouts: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
+		// ignore NoKindMatchError for clusters without discovery.k8s.io/v1 EndpointSlices
+		EndpointSlices: input.ResourceDiscoveryInputBuildOptions{
+			Verifier: r.verifier,
+		},
 	})
 	if err != nil {
 		// failed to read from cache; should never happen
@@ -5,6 +5,8 @@ import (
 
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	k8sdiscoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination/detector"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
@@ -17,11 +19,13 @@ type Translator interface {
 	TranslateDestinations(
 		ctx context.Context,
 		services corev1sets.ServiceSet,
+		serviceEntries networkingv1alpha3sets.ServiceEntrySet,
 		pods corev1sets.PodSet,
 		nodes corev1sets.NodeSet,
 		workloads v1.WorkloadSet,
 		meshes v1.MeshSet,
 		endpoints corev1sets.EndpointsSet,
+		endpointSlices k8sdiscoveryv1sets.EndpointSliceSet,
 	) v1.DestinationSet
 }
 
@@ -40,15 +44,20 @@ func NewTranslator(ctx context.Context, destinationDetector detector.Destination
 func (t *translator) TranslateDestinations(
 	ctx context.Context,
 	services corev1sets.ServiceSet,
+	serviceEntries networkingv1alpha3sets.ServiceEntrySet,
 	pods corev1sets.PodSet,
 	nodes corev1sets.NodeSet,
 	workloads v1.WorkloadSet,
 	meshes v1.MeshSet,
 	endpoints corev1sets.EndpointsSet,
+	endpointSlices k8sdiscoveryv1sets.EndpointSliceSet,
 ) v1.DestinationSet {
 
 	DestinationSet := v1.NewDestinationSet()
 
+	// index the EndpointSlices once rather than scanning them for every service
+	endpointSliceIndex := detector.NewEndpointSliceIndex(endpointSlices)
+
 	for _, service := range services.List() {
 		destination := t.destinationDetector.DetectDestination(
 			ctx,
@@ -58,6 +67,7 @@ func (t *translator) TranslateDestinations(
 			workloads,
 			meshes,
 			endpoints,
+			endpointSliceIndex,
 		)
 		if destination == nil {
 			continue
@@ -65,5 +75,20 @@ func (t *translator) TranslateDestinations(
 		contextutils.LoggerFrom(t.ctx).Debugf("detected destination %v", sets.Key(destination))
 		DestinationSet.Insert(destination)
 	}
+
+	for _, serviceEntry := range serviceEntries.List() {
+		destination := t.destinationDetector.DetectServiceEntryDestination(
+			ctx,
+			serviceEntry,
+			workloads,
+			meshes,
+		)
+		if destination == nil {
+			continue
+		}
+		contextutils.LoggerFrom(t.ctx).Debugf("detected destination %v", sets.Key(destination))
+		DestinationSet.Insert(destination)
+	}
+
 	return DestinationSet
 }
@@ -7,6 +7,7 @@ import (
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	k8sdiscoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/localityutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
@@ -14,10 +15,13 @@ import (
 	sets2 "github.com/solo-io/skv2/contrib/pkg/sets"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	corev1 "k8s.io/api/core/v1"
+	k8sdiscoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilnet "k8s.io/utils/net"
 	"k8s.io/utils/pointer"
 )
 
@@ -47,6 +51,16 @@ type DestinationDetector interface {
 		workloads discoveryv1sets.WorkloadSet,
 		meshes discoveryv1sets.MeshSet,
 		endpoints corev1sets.EndpointsSet,
+		endpointSlices EndpointSliceIndex,
+	) *v1.Destination
+
+	// detects Destinations from Istio ServiceEntries which select Workloads, e.g. services backed by virtual machines,
+	// or which declare services located outside of the mesh, e.g. external APIs.
+	DetectServiceEntryDestination(
+		ctx context.Context,
+		serviceEntry *networkingv1alpha3.ServiceEntry,
+		workloads discoveryv1sets.WorkloadSet,
+		meshes discoveryv1sets.MeshSet,
 	) *v1.Destination
 }
 
@@ -64,6 +78,7 @@ func (t *destinationDetector) DetectDestination(
 	workloads discoveryv1sets.WorkloadSet,
 	meshes discoveryv1sets.MeshSet,
 	endpoints corev1sets.EndpointsSet,
+	endpointSlices EndpointSliceIndex,
 ) *v1.Destination {
 
 	kubeService := &v1.DestinationSpec_KubeService{
@@ -99,6 +114,7 @@ func (t *destinationDetector) DetectDestination(
 		workloads,
 		meshes,
 		endpoints,
+		endpointSlices,
 		nodes,
 	)
 	if !validDestination {
@@ -115,6 +131,7 @@ func addMeshForKubeService(
 	meshWorkloads discoveryv1sets.WorkloadSet,
 	meshes discoveryv1sets.MeshSet,
 	endpoints corev1sets.EndpointsSet,
+	endpointSlices EndpointSliceIndex,
 	nodes corev1sets.NodeSet,
 ) bool {
 
@@ -138,6 +155,16 @@ func addMeshForKubeService(
 					validMesh = ezkube.MakeObjectRef(mesh)
 					break
 				}
+			case *v1.MeshSpec_Linkerd:
+				if typedMesh.Linkerd.GetInstallation().GetCluster() == service.GetClusterName() {
+					validMesh = ezkube.MakeObjectRef(mesh)
+					break
+				}
+			case *v1.MeshSpec_ConsulConnect:
+				if typedMesh.ConsulConnect.GetInstallation().GetCluster() == service.GetClusterName() {
+					validMesh = ezkube.MakeObjectRef(mesh)
+					break
+				}
 			}
 		}
 
@@ -160,7 +187,7 @@ func addMeshForKubeService(
 	if len(backingWorkloads) == 0 {
 		return false
 	}
-	handleWorkloadDiscoveredMesh(ctx, tt, backingWorkloads, endpoints, nodes)
+	handleWorkloadDiscoveredMesh(ctx, tt, backingWorkloads, endpoints, endpointSlices, nodes)
 	return true
 }
 
@@ -169,6 +196,7 @@ func handleWorkloadDiscoveredMesh(
 	tt *v1.Destination,
 	backingWorkloads v1.WorkloadSlice,
 	endpoints corev1sets.EndpointsSet,
+	endpointSlices EndpointSliceIndex,
 	nodes corev1sets.NodeSet,
 ) {
 
@@ -178,6 +206,13 @@ func handleWorkloadDiscoveredMesh(
 	// derive subsets from backing workloads
 	tt.Spec.GetKubeService().Subsets = findSubsets(backingWorkloads)
 
+	// prefer EndpointSlices, which are not truncated for large services and carry topology hints
+	serviceSlices := endpointSlices.findEndpointSlicesForService(tt.Spec.GetKubeService().GetRef())
+	if len(serviceSlices) > 0 {
+		findEndpointsFromSlices(ctx, backingWorkloads, serviceSlices, nodes, tt.Spec.GetKubeService())
+		return
+	}
+
 	ep, err := endpoints.Find(tt.Spec.GetKubeService().GetRef())
 	if err != nil {
 		contextutils.LoggerFrom(ctx).Errorf(
@@ -191,69 +226,166 @@ func handleWorkloadDiscoveredMesh(
 	findEndpoints(ctx, backingWorkloads, ep, nodes, tt.Spec.GetKubeService())
 }
 
-func findEndpoints(
+// EndpointSliceIndex groups EndpointSlices by the kube service which owns them,
+// so that each service's slices can be looked up without scanning every slice.
+// An index should be built once per translation and shared by all services.
+type EndpointSliceIndex map[endpointSliceOwner][]*k8sdiscoveryv1.EndpointSlice
+
+// identifies a kube service by the cluster and namespace of its EndpointSlices and their kubernetes.io/service-name label
+type endpointSliceOwner struct {
+	clusterName string
+	namespace   string
+	serviceName string
+}
+
+// index the given EndpointSlices by their owning kube service. Slices without a service name label are ignored.
+func NewEndpointSliceIndex(endpointSlices k8sdiscoveryv1sets.EndpointSliceSet) EndpointSliceIndex {
+	index := EndpointSliceIndex{}
+	if endpointSlices == nil {
+		return index
+	}
+	for _, slice := range endpointSlices.List() {
+		serviceName, ok := slice.Labels[k8sdiscoveryv1.LabelServiceName]
+		if !ok {
+			continue
+		}
+		owner := endpointSliceOwner{
+			clusterName: slice.ClusterName,
+			namespace:   slice.Namespace,
+			serviceName: serviceName,
+		}
+		index[owner] = append(index[owner], slice)
+	}
+	return index
+}
+
+// return the EndpointSlices owned by the given kube service
+func (i EndpointSliceIndex) findEndpointSlicesForService(
+	serviceRef *skv2corev1.ClusterObjectRef,
+) []*k8sdiscoveryv1.EndpointSlice {
+	return i[endpointSliceOwner{
+		clusterName: serviceRef.GetClusterName(),
+		namespace:   serviceRef.GetNamespace(),
+		serviceName: serviceRef.GetName(),
+	}]
+}
+
+func findEndpointsFromSlices(
 	ctx context.Context,
 	backingWorkloads v1.WorkloadSlice,
-	endpoint *corev1.Endpoints,
+	endpointSlices []*k8sdiscoveryv1.EndpointSlice,
 	nodes corev1sets.NodeSet,
 	kubeService *v1.DestinationSpec_KubeService,
 ) {
 
-	for _, epSub := range endpoint.Subsets {
+	for _, slice := range endpointSlices {
 		sub := &v1.DestinationSpec_KubeService_EndpointsSubset{}
-		for _, addr := range epSub.Addresses {
-			addr := addr
-			ep := &v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
-				IpAddress: addr.IP,
+		switch slice.AddressType {
+		case k8sdiscoveryv1.AddressTypeIPv4:
+			sub.AddressFamily = v1.DestinationSpec_KubeService_EndpointsSubset_IPV4
+		case k8sdiscoveryv1.AddressTypeIPv6:
+			sub.AddressFamily = v1.DestinationSpec_KubeService_EndpointsSubset_IPV6
+		default:
+			contextutils.LoggerFrom(ctx).Debugf(
+				"skipping endpoint slice %s because address type %s is not supported",
+				sets2.Key(slice),
+				slice.AddressType,
+			)
+			continue
+		}
+
+		for _, sliceEndpoint := range slice.Endpoints {
+			sliceEndpoint := sliceEndpoint
+			// mirror Endpoints, which only list ready addresses for routing
+			if sliceEndpoint.Conditions.Ready != nil && !*sliceEndpoint.Conditions.Ready {
+				continue
 			}
 
-			if addr.NodeName != nil {
-				subLocality, err := localityutils.GetSubLocality(kubeService.GetRef().GetClusterName(), *addr.NodeName, nodes)
-				// Don't log the error but continue processing as this error can happen continuously while in a healthy state
-				if err == nil {
-					ep.SubLocality = subLocality
+			var zoneHints []string
+			if sliceEndpoint.Hints != nil {
+				for _, zone := range sliceEndpoint.Hints.ForZones {
+					zoneHints = append(zoneHints, zone.Name)
 				}
-			} else {
-				contextutils.LoggerFrom(ctx).Warnw("address does not have a node", "address", addr)
 			}
 
-			if addr.TargetRef != nil {
-				for _, workload := range backingWorkloads {
-					kubeWorkload := workload.Spec.GetKubernetes()
-					if kubeWorkload == nil {
-						continue
-					}
-					// Check if TargetRef points to a child of a backing workload to get the labels
-					if addr.TargetRef.Namespace == kubeWorkload.GetController().GetNamespace() &&
-						strings.HasPrefix(addr.TargetRef.Name, kubeWorkload.GetController().GetName()+"-") {
-						ep.Labels = kubeWorkload.GetPodLabels()
-						break
+			for _, addr := range sliceEndpoint.Addresses {
+				ep := &v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+					IpAddress:   addr,
+					SubLocality: getSubLocality(ctx, kubeService, sliceEndpoint.NodeName, nodes),
+					Labels:      getEndpointLabels(ctx, sliceEndpoint.TargetRef, backingWorkloads),
+					ZoneHints:   zoneHints,
+				}
+				// fall back to the zone recorded on the slice if the endpoint's node is unknown
+				if ep.SubLocality == nil && sliceEndpoint.Zone != nil {
+					ep.SubLocality = &v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality{
+						Zone: *sliceEndpoint.Zone,
 					}
 				}
-			} else {
-				contextutils.LoggerFrom(ctx).Debugf(
-					"skipping endpoint subset addr (%v) because targetRef is nil",
-					addr,
-				)
+				sub.Endpoints = append(sub.Endpoints, ep)
 			}
-
-			sub.Endpoints = append(sub.Endpoints, ep)
-
 		}
 
-		for _, port := range epSub.Ports {
-			port := port
+		for _, port := range slice.Ports {
 			epPort := &v1.DestinationSpec_KubeService_EndpointPort{
-				Port:        uint32(port.Port),
-				Name:        port.Name,
-				Protocol:    string(port.Protocol),
+				Name:        pointer.StringPtrDerefOr(port.Name, ""),
 				AppProtocol: pointer.StringPtrDerefOr(port.AppProtocol, ""),
 			}
+			if port.Port != nil {
+				epPort.Port = uint32(*port.Port)
+			}
+			if port.Protocol != nil {
+				epPort.Protocol = string(*port.Protocol)
+			}
 			sub.Ports = append(sub.Ports, epPort)
 		}
 
 		// Only add this subset to the list if any IPs matched the workload in question
 		if len(sub.GetEndpoints()) == 0 {
+			contextutils.LoggerFrom(ctx).Debugf(
+				"skipping endpoint slice %s because no ready ip addresses were found",
+				sets2.Key(slice),
+			)
+			continue
+		}
+
+		kubeService.EndpointSubsets = append(kubeService.EndpointSubsets, sub)
+	}
+}
+
+func findEndpoints(
+	ctx context.Context,
+	backingWorkloads v1.WorkloadSlice,
+	endpoint *corev1.Endpoints,
+	nodes corev1sets.NodeSet,
+	kubeService *v1.DestinationSpec_KubeService,
+) {
+
+	for _, epSub := range endpoint.Subsets {
+		// split the subset by address family, as a dual-stack Service may list addresses of both families
+		subsByFamily := map[v1.DestinationSpec_KubeService_EndpointsSubset_AddressFamily]*v1.DestinationSpec_KubeService_EndpointsSubset{}
+		var families []v1.DestinationSpec_KubeService_EndpointsSubset_AddressFamily
+		for _, addr := range epSub.Addresses {
+			addr := addr
+			ep := &v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+				IpAddress:   addr.IP,
+				SubLocality: getSubLocality(ctx, kubeService, addr.NodeName, nodes),
+				Labels:      getEndpointLabels(ctx, addr.TargetRef, backingWorkloads),
+			}
+
+			family := getAddressFamily(addr.IP)
+			sub, ok := subsByFamily[family]
+			if !ok {
+				sub = &v1.DestinationSpec_KubeService_EndpointsSubset{
+					AddressFamily: family,
+				}
+				subsByFamily[family] = sub
+				families = append(families, family)
+			}
+			sub.Endpoints = append(sub.Endpoints, ep)
+		}
+
+		// Only add subsets to the list if any IPs matched the workload in question
+		if len(families) == 0 {
 			contextutils.LoggerFrom(ctx).Debugf(
 				"skipping endpoint address %v because no ip addresses were found",
 				epSub,
@@ -261,8 +393,71 @@ func findEndpoints(
 			continue
 		}
 
-		kubeService.EndpointSubsets = append(kubeService.EndpointSubsets, sub)
+		for _, family := range families {
+			sub := subsByFamily[family]
+			for _, port := range epSub.Ports {
+				port := port
+				epPort := &v1.DestinationSpec_KubeService_EndpointPort{
+					Port:        uint32(port.Port),
+					Name:        port.Name,
+					Protocol:    string(port.Protocol),
+					AppProtocol: pointer.StringPtrDerefOr(port.AppProtocol, ""),
+				}
+				sub.Ports = append(sub.Ports, epPort)
+			}
+			kubeService.EndpointSubsets = append(kubeService.EndpointSubsets, sub)
+		}
+	}
+}
+
+// return the zone and sub-zone of the node hosting an endpoint
+func getSubLocality(
+	ctx context.Context,
+	kubeService *v1.DestinationSpec_KubeService,
+	nodeName *string,
+	nodes corev1sets.NodeSet,
+) *v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality {
+	if nodeName == nil {
+		contextutils.LoggerFrom(ctx).Warnw("endpoint does not have a node", "service", sets2.Key(kubeService.GetRef()))
+		return nil
+	}
+	subLocality, err := localityutils.GetSubLocality(kubeService.GetRef().GetClusterName(), *nodeName, nodes)
+	// Don't log the error but continue processing as this error can happen continuously while in a healthy state
+	if err != nil {
+		return nil
+	}
+	return subLocality
+}
+
+// return the labels of the backing workload which controls the endpoint's target
+func getEndpointLabels(
+	ctx context.Context,
+	targetRef *corev1.ObjectReference,
+	backingWorkloads v1.WorkloadSlice,
+) map[string]string {
+	if targetRef == nil {
+		contextutils.LoggerFrom(ctx).Debugf("skipping endpoint labels because targetRef is nil")
+		return nil
+	}
+	for _, workload := range backingWorkloads {
+		kubeWorkload := workload.Spec.GetKubernetes()
+		if kubeWorkload == nil {
+			continue
+		}
+		// Check if TargetRef points to a child of a backing workload to get the labels
+		if targetRef.Namespace == kubeWorkload.GetController().GetNamespace() &&
+			strings.HasPrefix(targetRef.Name, kubeWorkload.GetController().GetName()+"-") {
+			return kubeWorkload.GetPodLabels()
+		}
+	}
+	return nil
+}
+
+func getAddressFamily(ip string) v1.DestinationSpec_KubeService_EndpointsSubset_AddressFamily {
+	if utilnet.IsIPv6String(ip) {
+		return v1.DestinationSpec_KubeService_EndpointsSubset_IPV6
 	}
+	return v1.DestinationSpec_KubeService_EndpointsSubset_IPV4
 }
 
 // expects a list of just the workloads that back the service you're finding subsets for
@@ -8,12 +8,14 @@ import (
 	v1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1alpha2sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	k8sdiscoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
 	"istio.io/api/label"
 	corev1 "k8s.io/api/core/v1"
+	k8sdiscoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
@@ -124,9 +126,11 @@ var _ = Describe("DestinationDetector", func() {
 		meshes := v1alpha2sets.NewMeshSet()
 		svc := makeService()
 
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+
 		detector := NewDestinationDetector()
 
-		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints)
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
 
 		Expect(destination).To(Equal(&v1.Destination{
 			ObjectMeta: utils.DiscoveredObjectMeta(svc),
@@ -263,9 +267,11 @@ var _ = Describe("DestinationDetector", func() {
 		)
 		meshes := v1alpha2sets.NewMeshSet()
 
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+
 		detector := NewDestinationDetector()
 
-		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints)
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
 
 		Expect(destination).To(Equal(&v1.Destination{
 			ObjectMeta: utils.DiscoveredObjectMeta(svc),
@@ -380,9 +386,11 @@ var _ = Describe("DestinationDetector", func() {
 		)
 		meshes := v1alpha2sets.NewMeshSet()
 
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+
 		detector := NewDestinationDetector()
 
-		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints)
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
 
 		Expect(destination).To(Equal(&v1.Destination{
 			ObjectMeta: utils.DiscoveredObjectMeta(svc),
@@ -490,9 +498,11 @@ var _ = Describe("DestinationDetector", func() {
 		meshes := v1alpha2sets.NewMeshSet()
 		svc := makeService()
 
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+
 		detector := NewDestinationDetector()
 
-		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints)
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
 
 		Expect(destination).To(Equal(&v1.Destination{
 			ObjectMeta: utils.DiscoveredObjectMeta(svc),
@@ -580,9 +590,11 @@ var _ = Describe("DestinationDetector", func() {
 			DiscoveryMeshAnnotation: "true",
 		}
 
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+
 		detector := NewDestinationDetector()
 
-		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints)
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
 
 		Expect(destination).To(Equal(&v1.Destination{
 			ObjectMeta: utils.DiscoveredObjectMeta(svc),
@@ -691,9 +703,11 @@ var _ = Describe("DestinationDetector", func() {
 		meshes := v1alpha2sets.NewMeshSet()
 		svc := makeService()
 
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+
 		detector := NewDestinationDetector()
 
-		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints)
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
 
 		Expect(destination).To(Equal(&v1.Destination{
 			ObjectMeta: utils.DiscoveredObjectMeta(svc),
@@ -763,4 +777,230 @@ var _ = Describe("DestinationDetector", func() {
 		}))
 	})
 
+	It("translates a service with endpoint slices, preferring them over endpoints", func() {
+		endpoints := v1sets.NewEndpointsSet(
+			&corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        serviceName,
+					Namespace:   serviceNs,
+					ClusterName: serviceCluster,
+				},
+				Subsets: []corev1.EndpointSubset{
+					{
+						Addresses: []corev1.EndpointAddress{{IP: "10.0.0.1"}},
+					},
+				},
+			},
+		)
+		protocol := corev1.ProtocolTCP
+		makeSlice := func(name string, addressType k8sdiscoveryv1.AddressType, sliceEndpoints ...k8sdiscoveryv1.Endpoint) *k8sdiscoveryv1.EndpointSlice {
+			return &k8sdiscoveryv1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   serviceNs,
+					ClusterName: serviceCluster,
+					Labels: map[string]string{
+						k8sdiscoveryv1.LabelServiceName: serviceName,
+					},
+				},
+				AddressType: addressType,
+				Endpoints:   sliceEndpoints,
+				Ports: []k8sdiscoveryv1.EndpointPort{
+					{
+						Name:        pointer.StringPtr("port1"),
+						Port:        pointer.Int32Ptr(7000),
+						Protocol:    &protocol,
+						AppProtocol: pointer.StringPtr("HTTP"),
+					},
+				},
+			}
+		}
+		// the endpoint slice of another service should be ignored
+		otherServiceSlice := makeSlice("other-service-ipv4", k8sdiscoveryv1.AddressTypeIPv4,
+			k8sdiscoveryv1.Endpoint{
+				Addresses: []string{"10.0.0.3"},
+			},
+		)
+		otherServiceSlice.Labels[k8sdiscoveryv1.LabelServiceName] = "other-service"
+		// as should the endpoint slices of a service with the same name in another namespace or cluster
+		otherNamespaceSlice := makeSlice(serviceName+"-other-namespace-ipv4", k8sdiscoveryv1.AddressTypeIPv4,
+			k8sdiscoveryv1.Endpoint{
+				Addresses: []string{"10.0.0.4"},
+			},
+		)
+		otherNamespaceSlice.Namespace = "other-namespace"
+		otherClusterSlice := makeSlice(serviceName+"-other-cluster-ipv4", k8sdiscoveryv1.AddressTypeIPv4,
+			k8sdiscoveryv1.Endpoint{
+				Addresses: []string{"10.0.0.5"},
+			},
+		)
+		otherClusterSlice.ClusterName = "other-cluster"
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet(
+			makeSlice(serviceName+"-ipv4", k8sdiscoveryv1.AddressTypeIPv4,
+				k8sdiscoveryv1.Endpoint{
+					Addresses: []string{"10.0.0.1"},
+					NodeName:  pointer.StringPtr("node1"),
+					Zone:      pointer.StringPtr("zone1"),
+					Hints: &k8sdiscoveryv1.EndpointHints{
+						ForZones: []k8sdiscoveryv1.ForZone{{Name: "zone1"}},
+					},
+					TargetRef: &corev1.ObjectReference{
+						Name:      buildDeployment("v1").Name + "-819320",
+						Namespace: serviceNs,
+					},
+				},
+				k8sdiscoveryv1.Endpoint{
+					Addresses:  []string{"10.0.0.2"},
+					Conditions: k8sdiscoveryv1.EndpointConditions{Ready: pointer.BoolPtr(false)},
+				},
+			),
+			makeSlice(serviceName+"-ipv6", k8sdiscoveryv1.AddressTypeIPv6,
+				k8sdiscoveryv1.Endpoint{
+					Addresses: []string{"fd00::1"},
+					Zone:      pointer.StringPtr("zone2"),
+					TargetRef: &corev1.ObjectReference{
+						Name:      buildDeployment("v2").Name + "-332434",
+						Namespace: serviceNs,
+					},
+				},
+			),
+			otherServiceSlice,
+			otherNamespaceSlice,
+			otherClusterSlice,
+		)
+
+		pods := v1sets.NewPodSet()
+		nodes := v1sets.NewNodeSet(
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node1",
+					ClusterName: serviceCluster,
+					Labels: map[string]string{
+						corev1.LabelZoneRegionStable:        "region1",
+						corev1.LabelZoneFailureDomainStable: "zone1",
+						label.TopologySubzone.Name:          "subzone1",
+					},
+				},
+			},
+		)
+		workloads := v1alpha2sets.NewWorkloadSet(
+			makeWorkload("v1"),
+			makeWorkload("v2"),
+		)
+		meshes := v1alpha2sets.NewMeshSet()
+		svc := makeService()
+
+		detector := NewDestinationDetector()
+
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
+
+		Expect(destination.Spec.GetKubeService().GetEndpointSubsets()).To(Equal([]*v1.DestinationSpec_KubeService_EndpointsSubset{
+			{
+				AddressFamily: v1.DestinationSpec_KubeService_EndpointsSubset_IPV4,
+				Endpoints: []*v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+					{
+						IpAddress: "10.0.0.1",
+						Labels:    buildLabels("v1"),
+						SubLocality: &v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality{
+							Zone:    "zone1",
+							SubZone: "subzone1",
+						},
+						ZoneHints: []string{"zone1"},
+					},
+				},
+				Ports: []*v1.DestinationSpec_KubeService_EndpointPort{
+					{
+						Port:        7000,
+						Name:        "port1",
+						Protocol:    "TCP",
+						AppProtocol: "HTTP",
+					},
+				},
+			},
+			{
+				AddressFamily: v1.DestinationSpec_KubeService_EndpointsSubset_IPV6,
+				Endpoints: []*v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+					{
+						IpAddress: "fd00::1",
+						Labels:    buildLabels("v2"),
+						SubLocality: &v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint_SubLocality{
+							Zone: "zone2",
+						},
+					},
+				},
+				Ports: []*v1.DestinationSpec_KubeService_EndpointPort{
+					{
+						Port:        7000,
+						Name:        "port1",
+						Protocol:    "TCP",
+						AppProtocol: "HTTP",
+					},
+				},
+			},
+		}))
+	})
+
+	It("splits endpoints of both address families into separate subsets", func() {
+		endpoints := v1sets.NewEndpointsSet(
+			&corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        serviceName,
+					Namespace:   serviceNs,
+					ClusterName: serviceCluster,
+				},
+				Subsets: []corev1.EndpointSubset{
+					{
+						Addresses: []corev1.EndpointAddress{
+							{IP: "10.0.0.1"},
+							{IP: "fd00::1"},
+						},
+						Ports: []corev1.EndpointPort{
+							{
+								Name:     "port1",
+								Port:     7000,
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+		)
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet()
+		pods := v1sets.NewPodSet()
+		nodes := v1sets.NewNodeSet()
+		workloads := v1alpha2sets.NewWorkloadSet(
+			makeWorkload("v1"),
+		)
+		meshes := v1alpha2sets.NewMeshSet()
+		svc := makeService()
+
+		detector := NewDestinationDetector()
+
+		destination := detector.DetectDestination(ctx, svc, pods, nodes, workloads, meshes, endpoints, NewEndpointSliceIndex(endpointSlices))
+
+		ports := []*v1.DestinationSpec_KubeService_EndpointPort{
+			{
+				Port:     7000,
+				Name:     "port1",
+				Protocol: "TCP",
+			},
+		}
+		Expect(destination.Spec.GetKubeService().GetEndpointSubsets()).To(Equal([]*v1.DestinationSpec_KubeService_EndpointsSubset{
+			{
+				AddressFamily: v1.DestinationSpec_KubeService_EndpointsSubset_IPV4,
+				Endpoints: []*v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+					{IpAddress: "10.0.0.1"},
+				},
+				Ports: ports,
+			},
+			{
+				AddressFamily: v1.DestinationSpec_KubeService_EndpointsSubset_IPV6,
+				Endpoints: []*v1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+					{IpAddress: "fd00::1"},
+				},
+				Ports: ports,
+			},
+		}))
+	})
+
 })
@@ -0,0 +1,148 @@
+package detector
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/istio"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/go-utils/contextutils"
+	sets2 "github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	"istio.io/api/label"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+)
+
+// suffix appended to the names of Destinations discovered from ServiceEntries,
+// to avoid collisions with Destinations discovered from Services of the same name
+const serviceEntryNameSuffix = "-serviceentry"
+
+func (t *destinationDetector) DetectServiceEntryDestination(
+	ctx context.Context,
+	serviceEntry *networkingv1alpha3.ServiceEntry,
+	workloads discoveryv1sets.WorkloadSet,
+	meshes discoveryv1sets.MeshSet,
+) *v1.Destination {
+	if _, ok := serviceEntry.Labels[metautils.OwnershipLabelKey]; ok {
+		// ServiceEntries translated by Gloo Mesh, e.g. for federated Destinations, are not user-defined services
+		return nil
+	}
+
+	externalService := &v1.DestinationSpec_ExternalService{
+		Name:                   serviceEntry.Name,
+		Hosts:                  serviceEntry.Spec.GetHosts(),
+		Addresses:              serviceEntry.Spec.GetAddresses(),
+		Ports:                  convertServiceEntryPorts(serviceEntry),
+		Ref:                    ezkube.MakeClusterObjectRef(serviceEntry),
+		WorkloadSelectorLabels: serviceEntry.Spec.GetWorkloadSelector().GetLabels(),
+	}
+
+	var mesh *skv2corev1.ObjectRef
+	switch {
+	case len(externalService.WorkloadSelectorLabels) > 0:
+		backingWorkloads := workloadutils.FindServiceEntryBackingWorkloads(externalService, workloads)
+		// If there are no backing workloads, then we cannot find the associated mesh
+		if len(backingWorkloads) == 0 {
+			contextutils.LoggerFrom(ctx).Debugf("no workloads found backing service entry %v", sets2.Key(serviceEntry))
+			return nil
+		}
+		externalService.Endpoints = findServiceEntryEndpoints(serviceEntry, backingWorkloads)
+		// all backing workloads should be in the same mesh
+		mesh = backingWorkloads[0].Spec.GetMesh()
+	case serviceEntry.Spec.GetLocation() == networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL:
+		// services outside of the mesh are configured by the Istio control plane which manages the ServiceEntry
+		istioMesh := istio.FindMeshForRevision(serviceEntry.ClusterName, serviceEntry.Labels[label.IoIstioRev.Name], meshes)
+		if istioMesh == nil {
+			contextutils.LoggerFrom(ctx).Debugf("no istio mesh found for service entry %v", sets2.Key(serviceEntry))
+			return nil
+		}
+		externalService.Endpoints = convertServiceEntryEndpoints(serviceEntry)
+		mesh = ezkube.MakeObjectRef(istioMesh)
+	default:
+		// ServiceEntries inside the mesh are only discovered if they select workloads
+		return nil
+	}
+
+	outputMeta := utils.DiscoveredObjectMeta(serviceEntry)
+	outputMeta.Name += serviceEntryNameSuffix
+
+	return &v1.Destination{
+		ObjectMeta: outputMeta,
+		Spec: v1.DestinationSpec{
+			Type: &v1.DestinationSpec_ExternalService_{
+				ExternalService: externalService,
+			},
+			Mesh: mesh,
+		},
+	}
+}
+
+func convertServiceEntryPorts(serviceEntry *networkingv1alpha3.ServiceEntry) []*v1.DestinationSpec_ExternalService_ServicePort {
+	var ports []*v1.DestinationSpec_ExternalService_ServicePort
+	for _, port := range serviceEntry.Spec.GetPorts() {
+		ports = append(ports, &v1.DestinationSpec_ExternalService_ServicePort{
+			Number:   port.GetNumber(),
+			Name:     port.GetName(),
+			Protocol: port.GetProtocol(),
+		})
+	}
+	return ports
+}
+
+// derive the endpoints of a ServiceEntry from the instances of the virtual machine workloads it selects
+func findServiceEntryEndpoints(
+	serviceEntry *networkingv1alpha3.ServiceEntry,
+	backingWorkloads v1.WorkloadSlice,
+) []*v1.DestinationSpec_ExternalService_ExternalEndpoint {
+	var endpoints []*v1.DestinationSpec_ExternalService_ExternalEndpoint
+	for _, workload := range backingWorkloads {
+		for _, instance := range workload.Spec.GetVirtualMachine().GetInstances() {
+			if instance.GetAddress() == "" {
+				continue
+			}
+			endpoints = append(endpoints, &v1.DestinationSpec_ExternalService_ExternalEndpoint{
+				Address: instance.GetAddress(),
+				Ports:   endpointPorts(serviceEntry, instance.GetPorts()),
+			})
+		}
+	}
+	return endpoints
+}
+
+// convert the static endpoints declared on a ServiceEntry.
+// ServiceEntries which resolve their hosts via DNS may not declare any endpoints.
+func convertServiceEntryEndpoints(serviceEntry *networkingv1alpha3.ServiceEntry) []*v1.DestinationSpec_ExternalService_ExternalEndpoint {
+	var endpoints []*v1.DestinationSpec_ExternalService_ExternalEndpoint
+	for _, endpoint := range serviceEntry.Spec.GetEndpoints() {
+		if endpoint.GetAddress() == "" {
+			continue
+		}
+		endpoints = append(endpoints, &v1.DestinationSpec_ExternalService_ExternalEndpoint{
+			Address: endpoint.GetAddress(),
+			Ports:   endpointPorts(serviceEntry, endpoint.GetPorts()),
+		})
+	}
+	return endpoints
+}
+
+// resolve the port which receives traffic for each ServiceEntry port
+func endpointPorts(serviceEntry *networkingv1alpha3.ServiceEntry, endpointPorts map[string]uint32) map[string]uint32 {
+	ports := map[string]uint32{}
+	for _, port := range serviceEntry.Spec.GetPorts() {
+		// endpoints may map service ports to different target ports by name
+		switch {
+		case endpointPorts[port.GetName()] != 0:
+			ports[port.GetName()] = endpointPorts[port.GetName()]
+		case port.GetTargetPort() != 0:
+			ports[port.GetName()] = port.GetTargetPort()
+		default:
+			ports[port.GetName()] = port.GetNumber()
+		}
+	}
+	return ports
+}
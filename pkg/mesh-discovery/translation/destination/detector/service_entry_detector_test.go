@@ -0,0 +1,221 @@
+package detector_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination/detector"
+)
+
+var _ = Describe("ServiceEntryDestinationDetector", func() {
+
+	var (
+		ctx = context.Background()
+
+		ns      = "vm-namespace"
+		cluster = "cluster"
+
+		mesh = &skv2corev1.ObjectRef{
+			Name:      "mesh",
+			Namespace: "any",
+		}
+
+		meshes = v1sets.NewMeshSet(&v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "istio",
+				Namespace: "istio-system",
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_Istio_{
+					Istio: &v1.MeshSpec_Istio{
+						Installation: &v1.MeshInstallation{
+							Cluster: cluster,
+						},
+					},
+				},
+			},
+		})
+	)
+
+	makeVirtualMachineWorkload := func(labels map[string]string, instances ...*v1.WorkloadSpec_VirtualMachineWorkload_Instance) *v1.Workload {
+		return &v1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "legacy-workloadgroup",
+				Namespace: "gloo-mesh",
+			},
+			Spec: v1.WorkloadSpec{
+				Type: &v1.WorkloadSpec_VirtualMachine{
+					VirtualMachine: &v1.WorkloadSpec_VirtualMachineWorkload{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "legacy",
+							Namespace:   ns,
+							ClusterName: cluster,
+						},
+						Kind:      "WorkloadGroup",
+						Labels:    labels,
+						Instances: instances,
+					},
+				},
+				Mesh: mesh,
+			},
+		}
+	}
+
+	makeServiceEntry := func(selectorLabels map[string]string) *networkingv1alpha3.ServiceEntry {
+		serviceEntry := &networkingv1alpha3.ServiceEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "legacy",
+				Namespace:   ns,
+				ClusterName: cluster,
+			},
+			Spec: networkingv1alpha3spec.ServiceEntry{
+				Hosts:     []string{"legacy.vm-namespace.svc.cluster.local"},
+				Addresses: []string{"240.0.0.1"},
+				Ports: []*networkingv1alpha3spec.Port{
+					{Number: 80, Name: "http", Protocol: "HTTP"},
+					{Number: 9090, Name: "metrics", Protocol: "HTTP", TargetPort: 9091},
+				},
+				Location:   networkingv1alpha3spec.ServiceEntry_MESH_INTERNAL,
+				Resolution: networkingv1alpha3spec.ServiceEntry_STATIC,
+			},
+		}
+		if selectorLabels != nil {
+			serviceEntry.Spec.WorkloadSelector = &networkingv1alpha3spec.WorkloadSelector{Labels: selectorLabels}
+		}
+		return serviceEntry
+	}
+
+	detector := NewDestinationDetector()
+
+	It("translates a ServiceEntry selecting virtual machine workloads to a destination", func() {
+		serviceEntry := makeServiceEntry(map[string]string{"app": "legacy"})
+		workloads := v1sets.NewWorkloadSet(
+			makeVirtualMachineWorkload(
+				map[string]string{"app": "legacy", "version": "v1"},
+				&v1.WorkloadSpec_VirtualMachineWorkload_Instance{
+					Address: "10.0.0.1",
+					Ports:   map[string]uint32{"http": 8080},
+				},
+			),
+		)
+
+		destination := detector.DetectServiceEntryDestination(ctx, serviceEntry, workloads, meshes)
+
+		expectedMeta := utils.DiscoveredObjectMeta(serviceEntry)
+		expectedMeta.Name += "-serviceentry"
+		Expect(destination).To(Equal(&v1.Destination{
+			ObjectMeta: expectedMeta,
+			Spec: v1.DestinationSpec{
+				Type: &v1.DestinationSpec_ExternalService_{
+					ExternalService: &v1.DestinationSpec_ExternalService{
+						Name:      "legacy",
+						Hosts:     []string{"legacy.vm-namespace.svc.cluster.local"},
+						Addresses: []string{"240.0.0.1"},
+						Ports: []*v1.DestinationSpec_ExternalService_ServicePort{
+							{Number: 80, Name: "http", Protocol: "HTTP"},
+							{Number: 9090, Name: "metrics", Protocol: "HTTP"},
+						},
+						Endpoints: []*v1.DestinationSpec_ExternalService_ExternalEndpoint{
+							{
+								Address: "10.0.0.1",
+								Ports:   map[string]uint32{"http": 8080, "metrics": 9091},
+							},
+						},
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "legacy",
+							Namespace:   ns,
+							ClusterName: cluster,
+						},
+						WorkloadSelectorLabels: map[string]string{"app": "legacy"},
+					},
+				},
+				Mesh: mesh,
+			},
+		}))
+	})
+
+	It("does not translate a ServiceEntry which does not select any workloads", func() {
+		workloads := v1sets.NewWorkloadSet(
+			makeVirtualMachineWorkload(map[string]string{"app": "other"}),
+		)
+
+		Expect(detector.DetectServiceEntryDestination(ctx, makeServiceEntry(map[string]string{"app": "legacy"}), workloads, meshes)).To(BeNil())
+		Expect(detector.DetectServiceEntryDestination(ctx, makeServiceEntry(nil), workloads, meshes)).To(BeNil())
+	})
+
+	It("translates a ServiceEntry located outside of the mesh to a destination with its static endpoints", func() {
+		serviceEntry := makeServiceEntry(nil)
+		serviceEntry.Spec.Hosts = []string{"api.example.com"}
+		serviceEntry.Spec.Addresses = nil
+		serviceEntry.Spec.Location = networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL
+		serviceEntry.Spec.Endpoints = []*networkingv1alpha3spec.WorkloadEntry{
+			{Address: "203.0.113.10"},
+			{Address: "203.0.113.11", Ports: map[string]uint32{"http": 8080}},
+		}
+
+		destination := detector.DetectServiceEntryDestination(ctx, serviceEntry, v1sets.NewWorkloadSet(), meshes)
+
+		expectedMeta := utils.DiscoveredObjectMeta(serviceEntry)
+		expectedMeta.Name += "-serviceentry"
+		Expect(destination).To(Equal(&v1.Destination{
+			ObjectMeta: expectedMeta,
+			Spec: v1.DestinationSpec{
+				Type: &v1.DestinationSpec_ExternalService_{
+					ExternalService: &v1.DestinationSpec_ExternalService{
+						Name:  "legacy",
+						Hosts: []string{"api.example.com"},
+						Ports: []*v1.DestinationSpec_ExternalService_ServicePort{
+							{Number: 80, Name: "http", Protocol: "HTTP"},
+							{Number: 9090, Name: "metrics", Protocol: "HTTP"},
+						},
+						Endpoints: []*v1.DestinationSpec_ExternalService_ExternalEndpoint{
+							{
+								Address: "203.0.113.10",
+								Ports:   map[string]uint32{"http": 80, "metrics": 9091},
+							},
+							{
+								Address: "203.0.113.11",
+								Ports:   map[string]uint32{"http": 8080, "metrics": 9091},
+							},
+						},
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "legacy",
+							Namespace:   ns,
+							ClusterName: cluster,
+						},
+					},
+				},
+				Mesh: &skv2corev1.ObjectRef{
+					Name:      "istio",
+					Namespace: "istio-system",
+				},
+			},
+		}))
+	})
+
+	It("does not translate a ServiceEntry located outside of the mesh when no istio mesh is in the cluster", func() {
+		serviceEntry := makeServiceEntry(nil)
+		serviceEntry.Spec.Location = networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL
+		serviceEntry.ClusterName = "different-" + cluster
+
+		Expect(detector.DetectServiceEntryDestination(ctx, serviceEntry, v1sets.NewWorkloadSet(), meshes)).To(BeNil())
+	})
+
+	It("does not translate ServiceEntries output by Gloo Mesh", func() {
+		serviceEntry := makeServiceEntry(nil)
+		serviceEntry.Spec.Location = networkingv1alpha3spec.ServiceEntry_MESH_EXTERNAL
+		serviceEntry.Labels = metautils.TranslatedObjectLabels()
+
+		Expect(detector.DetectServiceEntryDestination(ctx, serviceEntry, v1sets.NewWorkloadSet(), meshes)).To(BeNil())
+	})
+})
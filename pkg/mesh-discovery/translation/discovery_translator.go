@@ -52,17 +52,24 @@ func (t translator) Translate(
 		in.Deployments(),
 		in.DaemonSets(),
 		in.StatefulSets(),
+		in.Jobs(),
+		in.CronJobs(),
+		in.Rollouts(),
+		in.WorkloadGroups(),
+		in.WorkloadEntries(),
 		meshes,
 	)
 
 	destinations := destinationTranslator.TranslateDestinations(
 		ctx,
 		in.Services(),
+		in.ServiceEntries(),
 		in.Pods(),
 		in.Nodes(),
 		workloads,
 		meshes,
 		in.Endpoints(),
+		in.EndpointSlices(),
 	)
 
 	t.totalTranslates++
@@ -17,6 +17,11 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/output/discovery"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	argorolloutsv1alpha1sets "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/sets"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
+	networkingv1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	batchv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
+	k8sdiscoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/discovery.k8s.io/v1/sets"
 	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation"
 	mock_destination "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination/mocks"
@@ -24,8 +29,11 @@ import (
 	mock_mesh "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/mocks"
 	mock_workload "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/labelutils"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8sdiscoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -68,6 +76,13 @@ var _ = Describe("Translator", func() {
 		daemonSets := appsv1sets.NewDaemonSetSet(&appsv1.DaemonSet{})
 		statefulSets := appsv1sets.NewStatefulSetSet(&appsv1.StatefulSet{})
 		issuedCertificates := certificatesv1sets.NewIssuedCertificateSet(&certificatesv1.IssuedCertificate{})
+		serviceEntries := networkingv1alpha3sets.NewServiceEntrySet(&networkingv1alpha3.ServiceEntry{})
+		workloadEntries := networkingv1alpha3sets.NewWorkloadEntrySet(&networkingv1alpha3.WorkloadEntry{})
+		workloadGroups := networkingv1alpha3sets.NewWorkloadGroupSet(&networkingv1alpha3.WorkloadGroup{})
+		jobs := batchv1sets.NewJobSet(&batchv1.Job{})
+		cronJobs := batchv1sets.NewCronJobSet(&batchv1.CronJob{})
+		endpointSlices := k8sdiscoveryv1sets.NewEndpointSliceSet(&k8sdiscoveryv1.EndpointSlice{})
+		rollouts := argorolloutsv1alpha1sets.NewRolloutSet(&argorolloutsv1alpha1.Rollout{})
 		inRemote := input.NewDiscoveryInputSnapshot(
 			"mesh-discovery-remote",
 			issuedCertificates,
@@ -82,6 +97,13 @@ var _ = Describe("Translator", func() {
 			replicaSets,
 			daemonSets,
 			statefulSets,
+			serviceEntries,
+			workloadEntries,
+			workloadGroups,
+			jobs,
+			cronJobs,
+			endpointSlices,
+			rollouts,
 		)
 		settings := &settingsv1.DiscoverySettings{}
 
@@ -96,8 +118,8 @@ var _ = Describe("Translator", func() {
 		destinations := v1sets.NewDestinationSet(&v1.Destination{ObjectMeta: labeledMeta})
 
 		mockMeshTranslator.EXPECT().TranslateMeshes(inRemote, settings).Return(meshes)
-		mockWorkloadTranslator.EXPECT().TranslateWorkloads(deployments, daemonSets, statefulSets, meshes).Return(workloads)
-		mockDestinationTranslator.EXPECT().TranslateDestinations(ctx, services, pods, nodes, workloads, meshes, endpoints).Return(destinations)
+		mockWorkloadTranslator.EXPECT().TranslateWorkloads(deployments, daemonSets, statefulSets, jobs, cronJobs, rollouts, workloadGroups, workloadEntries, meshes).Return(workloads)
+		mockDestinationTranslator.EXPECT().TranslateDestinations(ctx, services, serviceEntries, pods, nodes, workloads, meshes, endpoints, endpointSlices).Return(destinations)
 
 		out, err := t.Translate(ctx, inRemote, settings)
 		Expect(err).NotTo(HaveOccurred())
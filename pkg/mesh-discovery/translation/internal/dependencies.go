@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/appmesh"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/consul"
 	appmeshsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/appmesh"
+	consulsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/consul"
 
 	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/destination"
@@ -12,10 +14,12 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh"
 	meshdetector "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/istio"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/linkerd"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/osm"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload"
 	workloaddetector "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector"
 	istiosidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/istio"
+	linkerdsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/linkerd"
 	osmsidecar "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/osm"
 )
 
@@ -48,6 +52,8 @@ func (d DependencyFactoryImpl) MakeMeshTranslator(
 		istio.NewMeshDetector(ctx),
 		appmesh.NewMeshDetector(ctx),
 		osm.NewMeshDetector(ctx),
+		linkerd.NewMeshDetector(ctx),
+		consul.NewMeshDetector(ctx),
 	}
 
 	return mesh.NewTranslator(ctx, detectors)
@@ -61,6 +67,8 @@ func (d DependencyFactoryImpl) MakeWorkloadTranslator(
 		istiosidecar.NewSidecarDetector(ctx),
 		appmeshsidecar.NewSidecarDetector(ctx),
 		osmsidecar.NewSidecarDetector(ctx),
+		linkerdsidecar.NewSidecarDetector(ctx),
+		consulsidecar.NewSidecarDetector(ctx),
 	}
 
 	injectionDetector := istiosidecar.NewWorkloadDetector(
@@ -73,10 +81,15 @@ func (d DependencyFactoryImpl) MakeWorkloadTranslator(
 		ctx,
 		in.Pods(),
 		in.ReplicaSets(),
+		in.Jobs(),
 		sidecarDetectors,
 		injectionDetector,
 	)
-	return workload.NewTranslator(ctx, workloadDetector)
+	return workload.NewTranslator(
+		ctx,
+		workloadDetector,
+		istiosidecar.NewVirtualMachineWorkloadDetector(ctx),
+	)
 }
 
 func (d DependencyFactoryImpl) MakeDestinationTranslator(ctx context.Context) destination.Translator {
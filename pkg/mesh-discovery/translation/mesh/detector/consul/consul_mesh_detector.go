@@ -0,0 +1,163 @@
+package consul
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	appsv1sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/dockerutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/localityutils"
+	"github.com/solo-io/go-utils/contextutils"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the consul helm chart names the injector deployment `<release>-consul-connect-injector`,
+	// or `<release>-consul-connect-injector-webhook-deployment` in older releases
+	connectInjectorDeploymentKeyword = "connect-injector"
+	consulK8sImageKeyword            = "consul-k8s"
+
+	consulServerStatefulSetKeyword = "consul-server"
+	// the OSS and enterprise consul server images
+	consulImageName           = "consul"
+	consulEnterpriseImageName = "consul-enterprise"
+)
+
+// detects Consul Connect if a deployment contains the consul connect injector.
+type meshDetector struct {
+	ctx context.Context
+}
+
+func NewMeshDetector(
+	ctx context.Context,
+) detector.MeshDetector {
+	return &meshDetector{
+		ctx: contextutils.WithLogger(ctx, "detector"),
+	}
+}
+
+// returns a mesh for each deployment that contains the consul connect injector image
+func (d *meshDetector) DetectMeshes(
+	in input.DiscoveryInputSnapshot,
+	_ *settingsv1.DiscoverySettings,
+) (discoveryv1.MeshSlice, error) {
+	var meshes discoveryv1.MeshSlice
+	var errs error
+	for _, deployment := range in.Deployments().List() {
+		mesh, err := d.detectMesh(deployment, in)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if mesh == nil {
+			continue
+		}
+		meshes = append(meshes, mesh)
+	}
+	return meshes, errs
+}
+
+func (d *meshDetector) detectMesh(
+	deployment *appsv1.Deployment,
+	in input.DiscoveryInputSnapshot,
+) (*discoveryv1.Mesh, error) {
+	injectorVersion, err := getConnectInjectorVersion(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	if injectorVersion == "" {
+		return nil, nil
+	}
+
+	// prefer the version of the consul servers, which determines the connect features available to the mesh.
+	// servers may run outside of the cluster, in which case we fall back to the version of the injector.
+	version, err := getConsulServerVersion(in.StatefulSets(), deployment.ClusterName, deployment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = injectorVersion
+	}
+
+	region, err := localityutils.GetClusterRegion(deployment.ClusterName, in.Nodes())
+	if err != nil {
+		contextutils.LoggerFrom(d.ctx).Debugw("could not get region for cluster", deployment.ClusterName, zap.Error(err))
+	}
+
+	return &discoveryv1.Mesh{
+		ObjectMeta: utils.DiscoveredObjectMeta(deployment),
+		Spec: discoveryv1.MeshSpec{
+			Type: &discoveryv1.MeshSpec_ConsulConnect{
+				ConsulConnect: &discoveryv1.MeshSpec_ConsulConnectMesh{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: deployment.Namespace,
+						Cluster:   deployment.ClusterName,
+						PodLabels: deployment.Spec.Selector.MatchLabels,
+						Version:   version,
+						Region:    region,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+func getConnectInjectorVersion(deployment *appsv1.Deployment) (string, error) {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if isConnectInjector(deployment, &container) {
+			parsedImage, err := dockerutils.ParseImageName(container.Image)
+			if err != nil {
+				return "", eris.Wrapf(err, "failed to parse consul connect injector image tag: %s", container.Image)
+			}
+			return imageVersion(parsedImage), nil
+		}
+	}
+	return "", nil
+}
+
+// Return true if deployment is inferred to be the Consul Connect injector
+func isConnectInjector(deployment *appsv1.Deployment, container *corev1.Container) bool {
+	return strings.Contains(deployment.GetName(), connectInjectorDeploymentKeyword) &&
+		strings.Contains(container.Image, consulK8sImageKeyword)
+}
+
+// returns the version of the consul servers running in the namespace of the injector, if any
+func getConsulServerVersion(
+	statefulSets appsv1sets.StatefulSetSet,
+	cluster,
+	namespace string,
+) (string, error) {
+	for _, statefulSet := range statefulSets.List(func(statefulSet *appsv1.StatefulSet) bool {
+		return statefulSet.ClusterName != cluster ||
+			statefulSet.Namespace != namespace ||
+			!strings.Contains(statefulSet.Name, consulServerStatefulSetKeyword)
+	}) {
+		for _, container := range statefulSet.Spec.Template.Spec.Containers {
+			parsedImage, err := dockerutils.ParseImageName(container.Image)
+			if err != nil {
+				return "", eris.Wrapf(err, "failed to parse consul server image tag: %s", container.Image)
+			}
+			if imageName := path.Base(parsedImage.Path); imageName == consulImageName || imageName == consulEnterpriseImageName {
+				return imageVersion(parsedImage), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func imageVersion(parsedImage *dockerutils.Image) string {
+	if parsedImage.Digest != "" {
+		return parsedImage.Digest
+	}
+	return parsedImage.Tag
+}
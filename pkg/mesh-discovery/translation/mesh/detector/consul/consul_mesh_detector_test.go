@@ -0,0 +1,133 @@
+package consul_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/consul"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/labelutils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ConsulMeshDetector", func() {
+
+	ctx := context.Background()
+	meshNs := "consul"
+	clusterName := "cluster"
+
+	connectInjector := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   meshNs,
+				Name:        "consul-connect-injector",
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "hashicorp/consul-k8s:0.26.0",
+							},
+						},
+					},
+				},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "consul", "component": "connect-injector"},
+				},
+			},
+		}
+	}
+
+	expectedMesh := func(version string) *v1.Mesh {
+		return &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "consul-connect-injector-consul-cluster",
+				Namespace: defaults.GetPodNamespace(),
+				Labels:    labelutils.ClusterLabels(clusterName),
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_ConsulConnect{
+					ConsulConnect: &v1.MeshSpec_ConsulConnectMesh{
+						Installation: &v1.MeshInstallation{
+							Namespace: meshNs,
+							Cluster:   clusterName,
+							Version:   version,
+							PodLabels: map[string]string{"app": "consul", "component": "connect-injector"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("does not detect Consul Connect when it is not there", func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "a", Name: "a"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "test-image",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{deployment})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(BeNil())
+	})
+
+	It("detects a mesh from the connect injector deployment", func() {
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{connectInjector()})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("0.26.0")))
+	})
+
+	It("uses the version of the consul servers running alongside the injector", func() {
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{connectInjector()})
+		in.AddStatefulSets([]*appsv1.StatefulSet{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   meshNs,
+					Name:        "consul-server",
+					ClusterName: clusterName,
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{
+								{
+									Image: "hashicorp/consul:1.10.3",
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("1.10.3")))
+	})
+})
@@ -2,6 +2,7 @@ package istio
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	appsv1sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
@@ -23,6 +24,7 @@ import (
 	"github.com/solo-io/skv2/contrib/pkg/sets"
 	skv1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"go.uber.org/zap"
+	"istio.io/api/label"
 	istiov1alpha1 "istio.io/api/mesh/v1alpha1"
 	"istio.io/istio/pkg/util/gogoprotomarshal"
 	appsv1 "k8s.io/api/apps/v1"
@@ -37,6 +39,7 @@ const (
 	istioConfigMapName        = "istio"
 	istioConfigMapMeshDataKey = "mesh"
 	istioMetaDnsCaptureKey    = "ISTIO_META_DNS_CAPTURE"
+	defaultRevision           = "default"
 )
 
 var (
@@ -93,6 +96,7 @@ func (d *meshDetector) detectMesh(
 	}
 
 	revisionSuffix := getIstioRevisionSuffix(deployment)
+	revision := getIstioRevision(deployment, revisionSuffix)
 
 	meshConfig, err := getMeshConfig(in.ConfigMaps(), deployment.ClusterName, deployment.Namespace, revisionSuffix)
 	if err != nil {
@@ -139,6 +143,8 @@ func (d *meshDetector) detectMesh(
 						Region:    region,
 					},
 					SmartDnsProxyingEnabled: isSmartDnsProxyingEnabled(meshConfig),
+					Revision:                revision,
+					RevisionTags:            getRevisionTags(settings, revision),
 					TrustDomain:             meshConfig.TrustDomain,
 					// This assumes that the istiod deployment is the cert provider
 					IstiodServiceAccount: deployment.Spec.Template.Spec.ServiceAccountName,
@@ -371,6 +377,33 @@ func getIstioRevisionSuffix(deployment *appsv1.Deployment) string {
 	return strings.Replace(deployment.GetName(), istiodDeploymentName, "", 1)
 }
 
+// returns the revision of the control plane, or an empty string for the default revision
+func getIstioRevision(deployment *appsv1.Deployment, revisionSuffix string) string {
+	revision, ok := deployment.Labels[label.IoIstioRev.Name]
+	if !ok {
+		revision = strings.TrimPrefix(revisionSuffix, "-")
+	}
+	if revision == defaultRevision {
+		return ""
+	}
+	return revision
+}
+
+// returns the revision tags configured in the discovery settings which point to the given revision
+func getRevisionTags(settings *settingsv1.DiscoverySettings, revision string) []string {
+	var tags []string
+	for tag, taggedRevision := range settings.GetIstio().GetRevisionTags() {
+		if taggedRevision == defaultRevision {
+			taggedRevision = ""
+		}
+		if taggedRevision == revision {
+			tags = append(tags, tag)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
 // Return true if deployment is inferred to be an Istiod deployment
 func isIstiod(deployment *appsv1.Deployment, container *corev1.Container) bool {
 	// Istio revision deployments may take the form `istiod-<revision-name>`
@@ -163,7 +163,17 @@ var _ = Describe("IstioMeshDetector", func() {
 			ctx,
 		)
 
-		meshes, err := detector.DetectMeshes(inRemote.Build(), settings)
+		revisionSettings := &settingsv1.DiscoverySettings{
+			Istio: &settingsv1.DiscoverySettings_Istio{
+				RevisionTags: map[string]string{
+					"prod":   revisionTag,
+					"canary": revisionTag,
+					"stable": "1-9-0",
+				},
+			},
+		}
+
+		meshes, err := detector.DetectMeshes(inRemote.Build(), revisionSettings)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(meshes).To(HaveLen(1))
 		Expect(meshes[0]).To(Equal(&discoveryv1.Mesh{
@@ -183,6 +193,8 @@ var _ = Describe("IstioMeshDetector", func() {
 					},
 					TrustDomain:          trustDomain,
 					IstiodServiceAccount: serviceAccountName,
+					Revision:             revisionTag,
+					RevisionTags:         []string{"canary", "prod"},
 				}},
 			},
 		}))
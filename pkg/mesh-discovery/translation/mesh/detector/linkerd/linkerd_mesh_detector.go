@@ -0,0 +1,221 @@
+package linkerd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/go-multierror"
+	"github.com/rotisserie/eris"
+	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/dockerutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/localityutils"
+	"github.com/solo-io/go-utils/contextutils"
+	skv1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"go.uber.org/zap"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	linkerdDestinationDeploymentName = "linkerd-destination"
+	linkerdControllerImage           = "linkerd/controller"
+
+	linkerdConfigMapName = "linkerd-config"
+	// the key containing the helm values of the installation, used by linkerd 2.9+
+	linkerdConfigValuesKey = "values"
+	// the key containing the json-encoded global config, used prior to linkerd 2.9
+	linkerdConfigGlobalKey = "global"
+
+	// linkerd 2.11+ stores the trust anchors in a dedicated configmap
+	linkerdTrustRootsConfigMapName = "linkerd-identity-trust-roots"
+	linkerdTrustRootsKey           = "ca-bundle.crt"
+
+	defaultClusterDomain = "cluster.local"
+	defaultTrustDomain   = "cluster.local"
+)
+
+// detects Linkerd if a deployment contains the linkerd destination controller.
+type meshDetector struct {
+	ctx context.Context
+}
+
+func NewMeshDetector(
+	ctx context.Context,
+) detector.MeshDetector {
+	return &meshDetector{
+		ctx: contextutils.WithLogger(ctx, "detector"),
+	}
+}
+
+// returns a mesh for each deployment that contains the linkerd destination controller image
+func (d *meshDetector) DetectMeshes(
+	in input.DiscoveryInputSnapshot,
+	_ *settingsv1.DiscoverySettings,
+) (discoveryv1.MeshSlice, error) {
+	var meshes discoveryv1.MeshSlice
+	var errs error
+	for _, deployment := range in.Deployments().List() {
+		mesh, err := d.detectMesh(deployment, in)
+		if err != nil {
+			errs = multierror.Append(errs, err)
+		}
+		if mesh == nil {
+			continue
+		}
+		meshes = append(meshes, mesh)
+	}
+	return meshes, errs
+}
+
+func (d *meshDetector) detectMesh(
+	deployment *appsv1.Deployment,
+	in input.DiscoveryInputSnapshot,
+) (*discoveryv1.Mesh, error) {
+	version, err := getLinkerdVersion(deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == "" {
+		return nil, nil
+	}
+
+	config, err := getLinkerdConfig(in.ConfigMaps(), deployment.ClusterName, deployment.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	region, err := localityutils.GetClusterRegion(deployment.ClusterName, in.Nodes())
+	if err != nil {
+		contextutils.LoggerFrom(d.ctx).Debugw("could not get region for cluster", deployment.ClusterName, zap.Error(err))
+	}
+
+	return &discoveryv1.Mesh{
+		ObjectMeta: utils.DiscoveredObjectMeta(deployment),
+		Spec: discoveryv1.MeshSpec{
+			Type: &discoveryv1.MeshSpec_Linkerd{
+				Linkerd: &discoveryv1.MeshSpec_LinkerdMesh{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: deployment.Namespace,
+						Cluster:   deployment.ClusterName,
+						PodLabels: deployment.Spec.Selector.MatchLabels,
+						Version:   version,
+						Region:    region,
+					},
+					ClusterDomain:   config.ClusterDomain,
+					TrustDomain:     config.IdentityTrustDomain,
+					TrustAnchorsPem: config.IdentityTrustAnchorsPEM,
+				},
+			},
+		},
+	}, nil
+}
+
+func getLinkerdVersion(deployment *appsv1.Deployment) (string, error) {
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if isLinkerdController(deployment, &container) {
+			parsedImage, err := dockerutils.ParseImageName(container.Image)
+			if err != nil {
+				return "", eris.Wrapf(err, "failed to parse linkerd controller image tag: %s", container.Image)
+			}
+			version := parsedImage.Tag
+			if parsedImage.Digest != "" {
+				version = parsedImage.Digest
+			}
+			return version, nil
+		}
+	}
+	return "", nil
+}
+
+// Return true if deployment is inferred to be the Linkerd destination controller
+func isLinkerdController(deployment *appsv1.Deployment, container *corev1.Container) bool {
+	return deployment.GetName() == linkerdDestinationDeploymentName &&
+		strings.Contains(container.Image, linkerdControllerImage)
+}
+
+// the subset of the linkerd installation config relevant to discovery
+type linkerdConfig struct {
+	ClusterDomain           string `json:"clusterDomain,omitempty"`
+	IdentityTrustDomain     string `json:"identityTrustDomain,omitempty"`
+	IdentityTrustAnchorsPEM string `json:"identityTrustAnchorsPEM,omitempty"`
+
+	// linkerd 2.9 nests the config under the global key
+	Global *linkerdConfig `json:"global,omitempty"`
+}
+
+// the global config used prior to linkerd 2.9
+type legacyGlobalConfig struct {
+	ClusterDomain   string `json:"clusterDomain,omitempty"`
+	IdentityContext struct {
+		TrustDomain     string `json:"trustDomain,omitempty"`
+		TrustAnchorsPem string `json:"trustAnchorsPem,omitempty"`
+	} `json:"identityContext,omitempty"`
+}
+
+func getLinkerdConfig(
+	configMaps corev1sets.ConfigMapSet,
+	cluster,
+	namespace string,
+) (*linkerdConfig, error) {
+	linkerdConfigMap, err := configMaps.Find(&skv1.ClusterObjectRef{
+		Name:        linkerdConfigMapName,
+		Namespace:   namespace,
+		ClusterName: cluster,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	config := &linkerdConfig{}
+	if values, ok := linkerdConfigMap.Data[linkerdConfigValuesKey]; ok {
+		if err := yaml.Unmarshal([]byte(values), config); err != nil {
+			return nil, eris.Wrapf(err, "failed to parse '%s' entry in ConfigMap with name/namespace/cluster %s/%s/%s", linkerdConfigValuesKey, linkerdConfigMapName, namespace, cluster)
+		}
+		if global := config.Global; global != nil {
+			config = &linkerdConfig{
+				ClusterDomain:           firstNonEmpty(config.ClusterDomain, global.ClusterDomain),
+				IdentityTrustDomain:     firstNonEmpty(config.IdentityTrustDomain, global.IdentityTrustDomain),
+				IdentityTrustAnchorsPEM: firstNonEmpty(config.IdentityTrustAnchorsPEM, global.IdentityTrustAnchorsPEM),
+			}
+		}
+	} else if global, ok := linkerdConfigMap.Data[linkerdConfigGlobalKey]; ok {
+		var legacyConfig legacyGlobalConfig
+		if err := yaml.Unmarshal([]byte(global), &legacyConfig); err != nil {
+			return nil, eris.Wrapf(err, "failed to parse '%s' entry in ConfigMap with name/namespace/cluster %s/%s/%s", linkerdConfigGlobalKey, linkerdConfigMapName, namespace, cluster)
+		}
+		config.ClusterDomain = legacyConfig.ClusterDomain
+		config.IdentityTrustDomain = legacyConfig.IdentityContext.TrustDomain
+		config.IdentityTrustAnchorsPEM = legacyConfig.IdentityContext.TrustAnchorsPem
+	} else {
+		return nil, eris.Errorf("Failed to find '%s' or '%s' entry in ConfigMap with name/namespace/cluster %s/%s/%s", linkerdConfigValuesKey, linkerdConfigGlobalKey, linkerdConfigMapName, namespace, cluster)
+	}
+
+	if trustRootsConfigMap, err := configMaps.Find(&skv1.ClusterObjectRef{
+		Name:        linkerdTrustRootsConfigMapName,
+		Namespace:   namespace,
+		ClusterName: cluster,
+	}); err == nil && trustRootsConfigMap.Data[linkerdTrustRootsKey] != "" {
+		config.IdentityTrustAnchorsPEM = trustRootsConfigMap.Data[linkerdTrustRootsKey]
+	}
+
+	config.ClusterDomain = firstNonEmpty(config.ClusterDomain, defaultClusterDomain)
+	config.IdentityTrustDomain = firstNonEmpty(config.IdentityTrustDomain, defaultTrustDomain)
+
+	return config, nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,172 @@
+package linkerd_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/mesh/detector/linkerd"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/labelutils"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("LinkerdMeshDetector", func() {
+
+	ctx := context.Background()
+	meshNs := "linkerd"
+	clusterName := "cluster"
+	trustAnchors := "-----BEGIN CERTIFICATE-----\nroot\n-----END CERTIFICATE-----\n"
+
+	linkerdDestination := func() *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   meshNs,
+				Name:        "linkerd-destination",
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "cr.l5d.io/linkerd/controller:stable-2.11.1",
+							},
+							{
+								Image: "cr.l5d.io/linkerd/proxy:stable-2.11.1",
+							},
+						},
+					},
+				},
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"linkerd.io/control-plane-component": "destination"},
+				},
+			},
+		}
+	}
+
+	linkerdConfig := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   meshNs,
+				Name:        "linkerd-config",
+				ClusterName: clusterName,
+			},
+			Data: data,
+		}
+	}
+
+	expectedMesh := func(clusterDomain, trustDomain, trustAnchorsPem string) *v1.Mesh {
+		return &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "linkerd-destination-linkerd-cluster",
+				Namespace: defaults.GetPodNamespace(),
+				Labels:    labelutils.ClusterLabels(clusterName),
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_Linkerd{
+					Linkerd: &v1.MeshSpec_LinkerdMesh{
+						Installation: &v1.MeshInstallation{
+							Namespace: meshNs,
+							Cluster:   clusterName,
+							Version:   "stable-2.11.1",
+							PodLabels: map[string]string{"linkerd.io/control-plane-component": "destination"},
+						},
+						ClusterDomain:   clusterDomain,
+						TrustDomain:     trustDomain,
+						TrustAnchorsPem: trustAnchorsPem,
+					},
+				},
+			},
+		}
+	}
+
+	It("does not detect Linkerd when it is not there", func() {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "a", Name: "a"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "test-image",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{deployment})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(BeNil())
+	})
+
+	It("detects a mesh from the linkerd-destination deployment and its values config", func() {
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination()})
+		in.AddConfigMaps([]*corev1.ConfigMap{
+			linkerdConfig(map[string]string{
+				"values": "clusterDomain: custom.domain\nidentityTrustDomain: custom.trust\nidentityTrustAnchorsPEM: |\n  -----BEGIN CERTIFICATE-----\n  old\n  -----END CERTIFICATE-----\n",
+			}),
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:   meshNs,
+					Name:        "linkerd-identity-trust-roots",
+					ClusterName: clusterName,
+				},
+				Data: map[string]string{"ca-bundle.crt": trustAnchors},
+			},
+		})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("custom.domain", "custom.trust", trustAnchors)))
+	})
+
+	It("detects a mesh configured with global values", func() {
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination()})
+		in.AddConfigMaps([]*corev1.ConfigMap{
+			linkerdConfig(map[string]string{
+				"values": "global:\n  identityTrustAnchorsPEM: |\n    -----BEGIN CERTIFICATE-----\n    root\n    -----END CERTIFICATE-----\n",
+			}),
+		})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("cluster.local", "cluster.local", trustAnchors)))
+	})
+
+	It("detects a mesh configured with the legacy global config", func() {
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination()})
+		in.AddConfigMaps([]*corev1.ConfigMap{
+			linkerdConfig(map[string]string{
+				"global": `{"clusterDomain":"custom.domain","identityContext":{"trustDomain":"custom.trust","trustAnchorsPem":"-----BEGIN CERTIFICATE-----\nroot\n-----END CERTIFICATE-----\n"}}`,
+			}),
+		})
+
+		meshes, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(meshes).To(HaveLen(1))
+		Expect(meshes[0]).To(Equal(expectedMesh("custom.domain", "custom.trust", trustAnchors)))
+	})
+
+	It("returns an error when the linkerd config cannot be found", func() {
+		in := input.NewInputDiscoveryInputSnapshotManualBuilder("")
+		in.AddDeployments([]*appsv1.Deployment{linkerdDestination()})
+
+		_, err := NewMeshDetector(ctx).DetectMeshes(in.Build(), nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
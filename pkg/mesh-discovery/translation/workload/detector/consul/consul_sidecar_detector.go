@@ -0,0 +1,66 @@
+package consul
+
+import (
+	"context"
+
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// the name of the envoy proxy container injected by consul connect
+	sidecarContainerName = "consul-connect-envoy-sidecar"
+
+	// annotation set by the consul connect injector on injected pods
+	injectStatusAnnotation = "consul.hashicorp.com/connect-inject-status"
+	injectedStatus         = "injected"
+)
+
+// detects a consul connect sidecar
+type sidecarDetector struct {
+	ctx context.Context
+}
+
+func NewSidecarDetector(ctx context.Context) *sidecarDetector {
+	ctx = contextutils.WithLogger(ctx, "consul-sidecar-detector")
+	return &sidecarDetector{ctx: ctx}
+}
+
+/*
+	Pods are considered injected if they contain the consul connect envoy sidecar container,
+	or if they have been marked as injected by the consul connect injector.
+*/
+func (d *sidecarDetector) DetectMeshSidecar(pod *corev1.Pod, meshes v1sets.MeshSet) *v1.Mesh {
+	if !(pod.Annotations[injectStatusAnnotation] == injectedStatus || containsSidecarContainer(pod.Spec.Containers)) {
+		return nil
+	}
+
+	for _, mesh := range meshes.List() {
+		consulMesh := mesh.Spec.GetConsulConnect()
+		if consulMesh == nil {
+			continue
+		}
+
+		// pods are assigned to the Consul mesh installed on their cluster, which assumes a single Consul mesh per cluster.
+		// TODO: match the pod to its Consul datacenter to support multiple Consul meshes on a cluster
+		if consulMesh.Installation.GetCluster() == pod.ClusterName {
+			return mesh
+		}
+	}
+
+	contextutils.LoggerFrom(d.ctx).Warnw("warning: no mesh found corresponding to pod with consul connect sidecar", "pod", sets.Key(pod))
+
+	return nil
+}
+
+func containsSidecarContainer(containers []corev1.Container) bool {
+	for _, container := range containers {
+		if container.Name == sidecarContainerName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package consul_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/consul"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ConsulSidecarDetector", func() {
+	ns := "namespace"
+	clusterName := "cluster"
+	podName := "pod"
+
+	pod := func() *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ns,
+				Name:        podName,
+				ClusterName: clusterName,
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name: "app",
+					},
+					{
+						Name: "consul-connect-envoy-sidecar",
+					},
+				},
+			},
+		}
+	}
+
+	consulMeshes := func(cluster string) v1sets.MeshSet {
+		return v1sets.NewMeshSet(
+			&v1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "consul",
+					Name:      "consul-cluster",
+				},
+				Spec: v1.MeshSpec{
+					Type: &v1.MeshSpec_ConsulConnect{
+						ConsulConnect: &v1.MeshSpec_ConsulConnectMesh{
+							Installation: &v1.MeshInstallation{
+								Cluster: cluster,
+							},
+						},
+					},
+				},
+			},
+		)
+	}
+
+	detector := NewSidecarDetector(context.TODO())
+
+	It("detects workload when sidecar mesh is in cluster", func() {
+		meshes := consulMeshes(clusterName)
+
+		mesh := detector.DetectMeshSidecar(pod(), meshes)
+		Expect(mesh).To(Equal(meshes.List()[0]))
+	})
+	It("detects workload marked as injected by the connect injector", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   ns,
+				Name:        podName,
+				ClusterName: clusterName,
+				Annotations: map[string]string{"consul.hashicorp.com/connect-inject-status": "injected"},
+			},
+		}
+
+		meshes := consulMeshes(clusterName)
+
+		mesh := detector.DetectMeshSidecar(pod, meshes)
+		Expect(mesh).To(Equal(meshes.List()[0]))
+	})
+	It("does not detect workload when sidecar mesh is of different cluster", func() {
+		mesh := detector.DetectMeshSidecar(pod(), consulMeshes("different-"+clusterName))
+		Expect(mesh).To(BeNil())
+	})
+	It("does not detect workload when sidecar is not present", func() {
+		pod := pod()
+		pod.Spec.Containers = pod.Spec.Containers[:1]
+
+		mesh := detector.DetectMeshSidecar(pod, consulMeshes(clusterName))
+		Expect(mesh).To(BeNil())
+	})
+})
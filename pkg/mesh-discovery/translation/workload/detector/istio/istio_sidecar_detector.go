@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	"istio.io/api/label"
 	"istio.io/istio/pkg/kube/inject"
 
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
@@ -13,7 +14,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-// TODO(ilackarms): currently we produce a mesh ref that maps directly to the cluster
+// the revision of the default (non-revisioned) Istio control plane
+const defaultRevision = "default"
 
 // detects an istio sidecar
 type sidecarDetector struct {
@@ -30,23 +32,59 @@ func (d sidecarDetector) DetectMeshSidecar(pod *corev1.Pod, meshes v1sets.MeshSe
 		return nil
 	}
 
+	// injected pods are labeled with the revision of the control plane which injected them
+	if mesh := FindMeshForRevision(pod.ClusterName, pod.Labels[label.IoIstioRev.Name], meshes); mesh != nil {
+		return mesh
+	}
+
+	contextutils.LoggerFrom(d.ctx).Warnw("warning: no mesh found corresponding to pod with istio sidecar", "pod", sets.Key(pod))
+
+	return nil
+}
+
+// FindMeshForRevision returns the Istio mesh in the cluster whose control plane has the given revision or revision tag.
+// if no mesh matches, we fall back to the mesh of the default revision, or any Istio mesh in the cluster.
+func FindMeshForRevision(cluster, revision string, meshes v1sets.MeshSet) *v1.Mesh {
+	if revision == "" {
+		revision = defaultRevision
+	}
+
+	var revisionMesh, defaultMesh, clusterMesh *v1.Mesh
 	for _, mesh := range meshes.List() {
 		istio := mesh.Spec.GetIstio()
-		if istio == nil {
+		if istio == nil || istio.GetInstallation().GetCluster() != cluster {
 			continue
 		}
 
-		// TODO(ilackarms): currently we assume one mesh per cluster,
-		// and that the control plane for a given sidecar is always
-		// the mesh
-		if istio.Installation.GetCluster() == pod.ClusterName {
-			return mesh
+		// tags take precedence over revisions, as the default tag may point to a revisioned control plane
+		for _, tag := range istio.GetRevisionTags() {
+			if tag == revision {
+				return mesh
+			}
 		}
-	}
 
-	contextutils.LoggerFrom(d.ctx).Warnw("warning: no mesh found corresponding to pod with istio sidecar", "pod", sets.Key(pod))
+		meshRevision := istio.GetRevision()
+		if meshRevision == "" {
+			meshRevision = defaultRevision
+			if defaultMesh == nil {
+				defaultMesh = mesh
+			}
+		}
+		if meshRevision == revision && revisionMesh == nil {
+			revisionMesh = mesh
+		}
+		if clusterMesh == nil {
+			clusterMesh = mesh
+		}
+	}
 
-	return nil
+	switch {
+	case revisionMesh != nil:
+		return revisionMesh
+	case defaultMesh != nil:
+		return defaultMesh
+	}
+	return clusterMesh
 }
 
 func containsSidecarContainer(containers []corev1.Container) bool {
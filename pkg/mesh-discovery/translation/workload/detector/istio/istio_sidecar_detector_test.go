@@ -107,4 +107,39 @@ var _ = Describe("IstioSidecarDetector", func() {
 		Expect(workload).To(BeNil())
 	})
 
+	It("detects the mesh of the revision which injected the pod", func() {
+		revisionMesh := func(name, revision string, tags ...string) *v1.Mesh {
+			return &v1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "istio-system",
+					Name:      name,
+				},
+				Spec: v1.MeshSpec{
+					Type: &v1.MeshSpec_Istio_{
+						Istio: &v1.MeshSpec_Istio{
+							Installation: &v1.MeshInstallation{
+								Cluster: clusterName,
+							},
+							Revision:     revision,
+							RevisionTags: tags,
+						},
+					},
+				},
+			}
+		}
+		defaultMesh := revisionMesh("istiod-cluster", "")
+		canaryMesh := revisionMesh("istiod-1-11-cluster", "1-11", "canary")
+		meshes := v1sets.NewMeshSet(canaryMesh, defaultMesh)
+
+		pod := pod()
+		pod.Labels = map[string]string{"istio.io/rev": "1-11"}
+		Expect(detector.DetectMeshSidecar(pod, meshes)).To(Equal(canaryMesh))
+
+		pod.Labels = map[string]string{"istio.io/rev": "default"}
+		Expect(detector.DetectMeshSidecar(pod, meshes)).To(Equal(defaultMesh))
+
+		// pods injected by an unknown revision fall back to the default revision
+		pod.Labels = map[string]string{"istio.io/rev": "1-12"}
+		Expect(detector.DetectMeshSidecar(pod, meshes)).To(Equal(defaultMesh))
+	})
 })
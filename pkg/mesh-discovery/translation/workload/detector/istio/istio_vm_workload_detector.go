@@ -0,0 +1,184 @@
+package istio
+
+import (
+	"context"
+	"strings"
+
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	"istio.io/api/label"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	workloadGroupKind = "WorkloadGroup"
+	workloadEntryKind = "WorkloadEntry"
+
+	// the annotation which istiod adds to WorkloadEntries it auto-registers for a WorkloadGroup
+	autoRegistrationGroupAnnotation = "istio.io/autoRegistrationGroup"
+)
+
+// detects workloads registered with Istio through WorkloadGroups and WorkloadEntries
+type vmWorkloadDetector struct {
+	ctx context.Context
+}
+
+func NewVirtualMachineWorkloadDetector(ctx context.Context) detector.VirtualMachineWorkloadDetector {
+	ctx = contextutils.WithLogger(ctx, "istio-vm-workload-detector")
+	return &vmWorkloadDetector{ctx: ctx}
+}
+
+func (d *vmWorkloadDetector) DetectVirtualMachineWorkloads(
+	workloadGroups networkingv1alpha3sets.WorkloadGroupSet,
+	workloadEntries networkingv1alpha3sets.WorkloadEntrySet,
+	meshes v1sets.MeshSet,
+) v1.WorkloadSlice {
+	var workloads v1.WorkloadSlice
+
+	// entries which belong to a group are instances of the group's workload
+	groupedEntries := map[string][]*networkingv1alpha3.WorkloadEntry{}
+	for _, workloadEntry := range workloadEntries.List() {
+		groupRef := &metav1.ObjectMeta{
+			Name:        getWorkloadGroupName(workloadEntry),
+			Namespace:   workloadEntry.Namespace,
+			ClusterName: workloadEntry.ClusterName,
+		}
+		if groupRef.Name == "" || !workloadGroups.Has(groupRef) {
+			// standalone entries define their own workload
+			if workload := d.detectWorkloadEntryWorkload(workloadEntry, meshes); workload != nil {
+				workloads = append(workloads, workload)
+			}
+			continue
+		}
+		groupKey := sets.Key(groupRef)
+		groupedEntries[groupKey] = append(groupedEntries[groupKey], workloadEntry)
+	}
+
+	for _, workloadGroup := range workloadGroups.List() {
+		if workload := d.detectWorkloadGroupWorkload(workloadGroup, groupedEntries[sets.Key(workloadGroup)], meshes); workload != nil {
+			workloads = append(workloads, workload)
+		}
+	}
+
+	return workloads
+}
+
+func (d *vmWorkloadDetector) detectWorkloadGroupWorkload(
+	workloadGroup *networkingv1alpha3.WorkloadGroup,
+	workloadEntries []*networkingv1alpha3.WorkloadEntry,
+	meshes v1sets.MeshSet,
+) *v1.Workload {
+	workloadLabels := workloadGroup.Spec.GetMetadata().GetLabels()
+
+	mesh := FindMeshForRevision(workloadGroup.ClusterName, getRevision(workloadGroup.Labels, workloadLabels), meshes)
+	if mesh == nil {
+		contextutils.LoggerFrom(d.ctx).Debugw("no mesh found for WorkloadGroup", "workloadgroup", sets.Key(workloadGroup))
+		return nil
+	}
+
+	template := workloadGroup.Spec.GetTemplate()
+
+	var instances []*v1.WorkloadSpec_VirtualMachineWorkload_Instance
+	for _, workloadEntry := range workloadEntries {
+		instances = append(instances, makeInstance(workloadEntry))
+	}
+
+	return makeVirtualMachineWorkload(
+		workloadGroup,
+		workloadGroupKind,
+		mesh,
+		workloadLabels,
+		template.GetServiceAccount(),
+		template.GetNetwork(),
+		instances,
+	)
+}
+
+func (d *vmWorkloadDetector) detectWorkloadEntryWorkload(
+	workloadEntry *networkingv1alpha3.WorkloadEntry,
+	meshes v1sets.MeshSet,
+) *v1.Workload {
+	workloadLabels := workloadEntry.Spec.GetLabels()
+
+	mesh := FindMeshForRevision(workloadEntry.ClusterName, getRevision(workloadEntry.Labels, workloadLabels), meshes)
+	if mesh == nil {
+		contextutils.LoggerFrom(d.ctx).Debugw("no mesh found for WorkloadEntry", "workloadentry", sets.Key(workloadEntry))
+		return nil
+	}
+
+	return makeVirtualMachineWorkload(
+		workloadEntry,
+		workloadEntryKind,
+		mesh,
+		workloadLabels,
+		workloadEntry.Spec.GetServiceAccount(),
+		workloadEntry.Spec.GetNetwork(),
+		[]*v1.WorkloadSpec_VirtualMachineWorkload_Instance{makeInstance(workloadEntry)},
+	)
+}
+
+func makeVirtualMachineWorkload(
+	obj metav1.Object,
+	kind string,
+	mesh *v1.Mesh,
+	workloadLabels map[string]string,
+	serviceAccount string,
+	network string,
+	instances []*v1.WorkloadSpec_VirtualMachineWorkload_Instance,
+) *v1.Workload {
+	outputMeta := utils.DiscoveredObjectMeta(obj)
+	// append workload kind for uniqueness
+	outputMeta.Name += "-" + strings.ToLower(kind)
+
+	return &v1.Workload{
+		ObjectMeta: outputMeta,
+		Spec: v1.WorkloadSpec{
+			Type: &v1.WorkloadSpec_VirtualMachine{
+				VirtualMachine: &v1.WorkloadSpec_VirtualMachineWorkload{
+					Ref:                ezkube.MakeClusterObjectRef(obj),
+					Kind:               kind,
+					Labels:             workloadLabels,
+					ServiceAccountName: serviceAccount,
+					Network:            network,
+					Instances:          instances,
+				},
+			},
+			Mesh: ezkube.MakeObjectRef(mesh),
+		},
+	}
+}
+
+func makeInstance(workloadEntry *networkingv1alpha3.WorkloadEntry) *v1.WorkloadSpec_VirtualMachineWorkload_Instance {
+	return &v1.WorkloadSpec_VirtualMachineWorkload_Instance{
+		WorkloadEntry: ezkube.MakeClusterObjectRef(workloadEntry),
+		Address:       workloadEntry.Spec.GetAddress(),
+		Ports:         workloadEntry.Spec.GetPorts(),
+		Locality:      workloadEntry.Spec.GetLocality(),
+	}
+}
+
+// returns the name of the WorkloadGroup which the WorkloadEntry belongs to, if any
+func getWorkloadGroupName(workloadEntry *networkingv1alpha3.WorkloadEntry) string {
+	for _, owner := range workloadEntry.OwnerReferences {
+		if owner.Kind == workloadGroupKind {
+			return owner.Name
+		}
+	}
+	return workloadEntry.Annotations[autoRegistrationGroupAnnotation]
+}
+
+// the revision of the control plane a virtual machine workload connects to is selected with the
+// `istio.io/rev` label, either on the resource itself or on the labels of the workload
+func getRevision(objectLabels, workloadLabels map[string]string) string {
+	if revision, ok := objectLabels[label.IoIstioRev.Name]; ok {
+		return revision
+	}
+	return workloadLabels[label.IoIstioRev.Name]
+}
@@ -0,0 +1,181 @@
+package istio_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
+	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/istio"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("IstioVirtualMachineWorkloadDetector", func() {
+	ns := "vm-namespace"
+	clusterName := "cluster"
+	vmLabels := map[string]string{"app": "legacy"}
+
+	istioMeshes := func(cluster string) v1sets.MeshSet {
+		return v1sets.NewMeshSet(
+			&v1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "istio-system",
+					Name:      "istio-cluster",
+				},
+				Spec: v1.MeshSpec{
+					Type: &v1.MeshSpec_Istio_{
+						Istio: &v1.MeshSpec_Istio{
+							Installation: &v1.MeshInstallation{
+								Cluster: cluster,
+							},
+						},
+					},
+				},
+			},
+		)
+	}
+
+	workloadGroup := func() *networkingv1alpha3.WorkloadGroup {
+		return &networkingv1alpha3.WorkloadGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "legacy",
+				Namespace:   ns,
+				ClusterName: clusterName,
+			},
+			Spec: networkingv1alpha3spec.WorkloadGroup{
+				Metadata: &networkingv1alpha3spec.WorkloadGroup_ObjectMeta{
+					Labels: vmLabels,
+				},
+				Template: &networkingv1alpha3spec.WorkloadEntry{
+					ServiceAccount: "legacy-sa",
+					Network:        "vm-network",
+				},
+			},
+		}
+	}
+
+	workloadEntry := func(name, address string, ownerRefs ...metav1.OwnerReference) *networkingv1alpha3.WorkloadEntry {
+		return &networkingv1alpha3.WorkloadEntry{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       ns,
+				ClusterName:     clusterName,
+				OwnerReferences: ownerRefs,
+			},
+			Spec: networkingv1alpha3spec.WorkloadEntry{
+				Address:        address,
+				Ports:          map[string]uint32{"http": 8080},
+				Labels:         map[string]string{"app": "standalone"},
+				ServiceAccount: "standalone-sa",
+				Locality:       "us-east-1/us-east-1a",
+			},
+		}
+	}
+
+	expectedInstance := func(entry *networkingv1alpha3.WorkloadEntry) *v1.WorkloadSpec_VirtualMachineWorkload_Instance {
+		return &v1.WorkloadSpec_VirtualMachineWorkload_Instance{
+			WorkloadEntry: ezkube.MakeClusterObjectRef(entry),
+			Address:       entry.Spec.Address,
+			Ports:         entry.Spec.Ports,
+			Locality:      entry.Spec.Locality,
+		}
+	}
+
+	detector := NewVirtualMachineWorkloadDetector(context.TODO())
+
+	It("detects a workload for a WorkloadGroup with the entries which belong to it as instances", func() {
+		group := workloadGroup()
+		groupedEntry := workloadEntry("legacy-10.0.0.1", "10.0.0.1", metav1.OwnerReference{
+			Kind: "WorkloadGroup",
+			Name: group.Name,
+		})
+		standaloneEntry := workloadEntry("standalone", "10.0.0.2")
+
+		meshes := istioMeshes(clusterName)
+
+		workloads := detector.DetectVirtualMachineWorkloads(
+			networkingv1alpha3sets.NewWorkloadGroupSet(group),
+			networkingv1alpha3sets.NewWorkloadEntrySet(groupedEntry, standaloneEntry),
+			meshes,
+		)
+
+		groupMeta := utils.DiscoveredObjectMeta(group)
+		groupMeta.Name += "-workloadgroup"
+		entryMeta := utils.DiscoveredObjectMeta(standaloneEntry)
+		entryMeta.Name += "-workloadentry"
+		meshRef := ezkube.MakeObjectRef(meshes.List()[0])
+
+		Expect(workloads).To(ConsistOf(
+			&v1.Workload{
+				ObjectMeta: entryMeta,
+				Spec: v1.WorkloadSpec{
+					Type: &v1.WorkloadSpec_VirtualMachine{
+						VirtualMachine: &v1.WorkloadSpec_VirtualMachineWorkload{
+							Ref: &skv2corev1.ClusterObjectRef{
+								Name:        standaloneEntry.Name,
+								Namespace:   ns,
+								ClusterName: clusterName,
+							},
+							Kind:               "WorkloadEntry",
+							Labels:             standaloneEntry.Spec.Labels,
+							ServiceAccountName: "standalone-sa",
+							Instances:          []*v1.WorkloadSpec_VirtualMachineWorkload_Instance{expectedInstance(standaloneEntry)},
+						},
+					},
+					Mesh: meshRef,
+				},
+			},
+			&v1.Workload{
+				ObjectMeta: groupMeta,
+				Spec: v1.WorkloadSpec{
+					Type: &v1.WorkloadSpec_VirtualMachine{
+						VirtualMachine: &v1.WorkloadSpec_VirtualMachineWorkload{
+							Ref: &skv2corev1.ClusterObjectRef{
+								Name:        group.Name,
+								Namespace:   ns,
+								ClusterName: clusterName,
+							},
+							Kind:               "WorkloadGroup",
+							Labels:             vmLabels,
+							ServiceAccountName: "legacy-sa",
+							Network:            "vm-network",
+							Instances:          []*v1.WorkloadSpec_VirtualMachineWorkload_Instance{expectedInstance(groupedEntry)},
+						},
+					},
+					Mesh: meshRef,
+				},
+			},
+		))
+	})
+
+	It("treats entries auto-registered for a missing WorkloadGroup as standalone workloads", func() {
+		entry := workloadEntry("legacy-10.0.0.1", "10.0.0.1")
+		entry.Annotations = map[string]string{"istio.io/autoRegistrationGroup": "missing"}
+
+		workloads := detector.DetectVirtualMachineWorkloads(
+			networkingv1alpha3sets.NewWorkloadGroupSet(),
+			networkingv1alpha3sets.NewWorkloadEntrySet(entry),
+			istioMeshes(clusterName),
+		)
+
+		Expect(workloads).To(HaveLen(1))
+		Expect(workloads[0].Spec.GetVirtualMachine().GetKind()).To(Equal("WorkloadEntry"))
+	})
+
+	It("does not detect workloads when no istio mesh is in the cluster", func() {
+		workloads := detector.DetectVirtualMachineWorkloads(
+			networkingv1alpha3sets.NewWorkloadGroupSet(workloadGroup()),
+			networkingv1alpha3sets.NewWorkloadEntrySet(workloadEntry("standalone", "10.0.0.2")),
+			istioMeshes("different-"+clusterName),
+		)
+
+		Expect(workloads).To(BeEmpty())
+	})
+})
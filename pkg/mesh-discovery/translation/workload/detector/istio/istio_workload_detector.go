@@ -12,6 +12,7 @@ import (
 	skv1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
 	"istio.io/api/annotation"
+	"istio.io/api/label"
 	"istio.io/istio/galley/pkg/config/analysis/analyzers/injection"
 	"istio.io/istio/galley/pkg/config/analysis/analyzers/util"
 	"istio.io/istio/pkg/config/constants"
@@ -75,8 +76,8 @@ type workloadDetector struct {
 	// map of each istio mesh id to its corresponding injector config, if found
 	// we maintain a cache as to not recalculate the injector config multiple times for the same mesh
 	cachedInjectorConfigs map[string]*inject.Config
-	// map of each namespace to a boolean indicating the namespace has istio injection enabled
-	injectedNamespaces map[string]bool
+	// map of each namespace with istio injection enabled to the revision of the control plane which injects it
+	injectedNamespaces map[string]string
 }
 
 func NewWorkloadDetector(
@@ -88,10 +89,10 @@ func NewWorkloadDetector(
 
 	reconciliation.RecorderFromContext(ctx).RegisterCustomCounter(istioInjectionConfigParseFailed)
 
-	injectedNamespaces := map[string]bool{}
+	injectedNamespaces := map[string]string{}
 	for _, namespace := range namespaces.UnsortedList() {
-		if namespaceInjectionEnabled(namespace) {
-			injectedNamespaces[namespaceKey(namespace.Name, namespace.ClusterName)] = true
+		if revision, injected := namespaceInjectionRevision(namespace); injected {
+			injectedNamespaces[namespaceKey(namespace.Name, namespace.ClusterName)] = revision
 		}
 	}
 
@@ -108,55 +109,61 @@ func namespaceKey(name, cluster string) string {
 }
 
 // logic modeled based on https://github.com/istio/istio/blob/master/galley/pkg/config/analysis/analyzers/injection/injection.go
-func namespaceInjectionEnabled(namespace *corev1.Namespace) bool {
+// returns the revision of the control plane which injects workloads in the namespace, if injection is enabled.
+// the legacy injection label selects the default revision.
+func namespaceInjectionRevision(namespace *corev1.Namespace) (string, bool) {
 	name := namespace.Name
 	if name == constants.IstioSystemNamespace {
-		return false
+		return "", false
 	}
 	if util.IsSystemNamespace(resource.Namespace(name)) {
-		return false
+		return "", false
 	}
 
 	injectionLabel := namespace.Labels[util.InjectionLabelName]
-
-	// TODO(ilackarms): store revision instead of a boolean when supported
-	_, okNewInjectionLabel := namespace.Labels[injection.RevisionInjectionLabelName]
+	if injectionLabel == util.InjectionLabelEnableValue {
+		return "", true
+	}
 
 	// If legacy label has any value other than the enablement value, they are deliberately not injecting it, so ignore
-	return okNewInjectionLabel || injectionLabel == util.InjectionLabelEnableValue
+	revision, okNewInjectionLabel := namespace.Labels[injection.RevisionInjectionLabelName]
+	return revision, okNewInjectionLabel
 }
 
 func (d workloadDetector) DetectMeshForWorkload(workload types.Workload, meshes v1sets.MeshSet) *v1.Mesh {
-	for _, mesh := range meshes.List() {
-		istio := mesh.Spec.GetIstio()
-		if istio == nil {
-			// only care about istio workloads
-			continue
-		}
+	revisionLabel := workload.GetPodTemplate().Labels[label.IoIstioRev.Name]
 
-		if istio.GetInstallation().GetCluster() != workload.GetClusterName() {
-			continue
-		}
+	// if the workload's pod spec already contains the proxy,
+	// we consider it to belong to the mesh of the revision it is labeled with.
+	if hasMeshProxyContainer(workload) {
+		// the workload is either a gateway or has had its proxy manually injected
+		return FindMeshForRevision(workload.GetClusterName(), revisionLabel, meshes)
+	}
 
-		// if the workload's pod spec already contains the proxy and is in the mesh's cluster,
-		// we consider it to belong to that mesh.
-		// TODO(ilackarms): we will need to rely on revision or some other property to correctly attribute workloads to their owning mesh when we support multiple meshes per cluster
-		if hasMeshProxyContainer(workload) {
-			// the workload is either a gateway or has had its proxy manually injected
-			return mesh
-		}
+	// the revision which injects the workload is selected by the namespace labels,
+	// or by the workload's revision label if the namespace is not labeled for injection
+	revision, injectionEnabled := d.injectedNamespaces[namespaceKey(workload.GetNamespace(), workload.GetClusterName())]
+	if !injectionEnabled && revisionLabel != "" {
+		revision, injectionEnabled = revisionLabel, true
+	}
 
-		isInjected := d.meshInjectsWorkload(
-			d.ctx,
-			workload,
-			mesh,
-		)
+	mesh := FindMeshForRevision(workload.GetClusterName(), revision, meshes)
+	if mesh == nil {
+		// only care about istio workloads
+		return nil
+	}
 
-		if isInjected {
-			return mesh
-		}
+	isInjected := d.meshInjectsWorkload(
+		d.ctx,
+		workload,
+		mesh,
+		injectionEnabled,
+	)
 
+	if isInjected {
+		return mesh
 	}
+
 	return nil
 }
 
@@ -175,10 +182,9 @@ func (d workloadDetector) meshInjectsWorkload(
 	ctx context.Context,
 	workload types.Workload,
 	mesh *v1.Mesh,
+	namespaceInjected bool,
 ) bool {
 
-	namespaceInjected := d.injectedNamespaces[namespaceKey(workload.GetNamespace(), workload.GetClusterName())]
-
 	cfg, ok := d.cachedInjectorConfigs[sets.Key(mesh)]
 	if !ok {
 		var err error
@@ -203,7 +209,7 @@ func getInjectorConfig(
 	configMaps corev1sets.ConfigMapSet,
 ) (*inject.Config, error) {
 	injectorCm, err := configMaps.Find(&skv1.ClusterObjectRef{
-		Name:        getInjectorConfigMapName(""), // TODO(ilackarms): support mesh revisions here
+		Name:        getInjectorConfigMapName(istioMesh.GetRevision()),
 		Namespace:   istioMesh.GetInstallation().GetNamespace(),
 		ClusterName: istioMesh.GetInstallation().GetCluster(),
 	})
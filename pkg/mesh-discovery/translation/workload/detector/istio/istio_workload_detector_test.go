@@ -350,4 +350,124 @@ var _ = Describe("IstioWorkloadDetector", func() {
 		mesh := detector.DetectMeshForWorkload(types.ToWorkload(workload), meshes)
 		Expect(mesh).To(Equal(meshes.List()[0]))
 	})
+
+	It("detects the mesh of the revision selected by the namespace revision label", func() {
+		canaryMesh := &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "gloo-mesh",
+				Name:      "my-istio-1-11",
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_Istio_{
+					Istio: &v1.MeshSpec_Istio{
+						Installation: &v1.MeshInstallation{
+							Cluster:   clusterName,
+							Namespace: istioNamespace,
+						},
+						Revision:     "1-11",
+						RevisionTags: []string{"canary"},
+					},
+				},
+			},
+		}
+		meshes := v1sets.NewMeshSet(mesh, canaryMesh)
+
+		canaryInjectorConfigMap := sidecarConfigMap(inject.Config{Policy: inject.InjectionPolicyEnabled})
+		canaryInjectorConfigMap.Name = defaultInjectorConfigMapName + "-1-11"
+
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        workloadNamespace,
+				ClusterName: clusterName,
+				Labels: map[string]string{
+					injection.RevisionInjectionLabelName: "canary",
+				},
+			},
+		}
+		detector := NewWorkloadDetector(
+			context.TODO(),
+			corev1sets.NewNamespaceSet(namespace),
+			corev1sets.NewConfigMapSet(sidecarConfigMap(inject.Config{}), canaryInjectorConfigMap),
+		)
+
+		workload := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   workloadNamespace,
+				Name:        workloadName,
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Image: "some-image",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		Expect(detector.DetectMeshForWorkload(types.ToWorkload(workload), meshes)).To(Equal(canaryMesh))
+	})
+
+	It("detects the mesh of the revision which a workload is labeled with", func() {
+		canaryMesh := &v1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "gloo-mesh",
+				Name:      "my-istio-1-11",
+			},
+			Spec: v1.MeshSpec{
+				Type: &v1.MeshSpec_Istio_{
+					Istio: &v1.MeshSpec_Istio{
+						Installation: &v1.MeshInstallation{
+							Cluster:   clusterName,
+							Namespace: istioNamespace,
+						},
+						Revision: "1-11",
+					},
+				},
+			},
+		}
+		meshes := v1sets.NewMeshSet(mesh, canaryMesh)
+
+		namespace := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        workloadNamespace,
+				ClusterName: clusterName,
+			},
+		}
+		detector := NewWorkloadDetector(
+			context.TODO(),
+			corev1sets.NewNamespaceSet(namespace),
+			corev1sets.NewConfigMapSet(),
+		)
+
+		// a gateway deployed with the canary revision
+		workload := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   workloadNamespace,
+				Name:        workloadName,
+				ClusterName: clusterName,
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"istio.io/rev": "1-11"},
+					},
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:  inject.ProxyContainerName,
+								Image: "proxyv2",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		Expect(detector.DetectMeshForWorkload(types.ToWorkload(workload), meshes)).To(Equal(canaryMesh))
+	})
 })
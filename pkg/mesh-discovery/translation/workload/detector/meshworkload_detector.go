@@ -10,6 +10,7 @@ import (
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	batchv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/types"
 	"github.com/solo-io/go-utils/contextutils"
@@ -32,6 +33,9 @@ type WorkloadDetector interface {
 const (
 	replicaSetKind = "ReplicaSet"
 	deploymentKind = "Deployment"
+	rolloutKind    = "Rollout"
+	jobKind        = "Job"
+	cronJobKind    = "CronJob"
 )
 
 // detects a workload
@@ -39,6 +43,7 @@ type workloadDetector struct {
 	ctx               context.Context
 	pods              corev1sets.PodSet
 	replicaSets       appsv1sets.ReplicaSetSet
+	jobs              batchv1sets.JobSet
 	sidecarDetector   SidecarDetector
 	injectionDetector InjectedWorkloadDetector
 }
@@ -47,6 +52,7 @@ func NewWorkloadDetector(
 	ctx context.Context,
 	pods corev1sets.PodSet,
 	replicaSets appsv1sets.ReplicaSetSet,
+	jobs batchv1sets.JobSet,
 	sidecarDetector SidecarDetector,
 	injectionDetector InjectedWorkloadDetector,
 ) WorkloadDetector {
@@ -54,6 +60,7 @@ func NewWorkloadDetector(
 		ctx:               contextutils.WithLogger(ctx, "mesh-workload-detector"),
 		pods:              pods,
 		replicaSets:       replicaSets,
+		jobs:              jobs,
 		sidecarDetector:   sidecarDetector,
 		injectionDetector: injectionDetector,
 	}
@@ -137,11 +144,12 @@ func (d workloadDetector) podIsOwnedOwnedByWorkload(pod *corev1.Pod, workload ty
 	}
 
 	// track the controlled object;
-	// in the case of deployments
+	// in the case of deployments, rollouts and cronjobs
+	// this is the intermediate controller which creates the pods
 	var workloadReplica metav1.Object
 	switch workload.Kind() {
-	case deploymentKind:
-		// pods created by deployments are owned by replicasets
+	case deploymentKind, rolloutKind:
+		// pods created by deployments and argo rollouts are owned by replicasets
 		rsName := getControllerName(pod, replicaSetKind)
 		if rsName == "" {
 			return false
@@ -159,8 +167,27 @@ func (d workloadDetector) podIsOwnedOwnedByWorkload(pod *corev1.Pod, workload ty
 			return false
 		}
 		workloadReplica = rs
+	case cronJobKind:
+		// pods created by cronjobs are owned by jobs
+		jobName := getControllerName(pod, jobKind)
+		if jobName == "" {
+			return false
+		}
+
+		jobRef := &skv1.ClusterObjectRef{
+			Name:        jobName,
+			Namespace:   pod.Namespace,
+			ClusterName: pod.ClusterName,
+		}
+
+		job, err := d.jobs.Find(jobRef)
+		if err != nil {
+			contextutils.LoggerFrom(d.ctx).Warnw("job not found for pod", "job", sets.Key(jobRef), "pod", sets.Key(pod))
+			return false
+		}
+		workloadReplica = job
 	default:
-		// DaemonSets and StatefulSets directly
+		// DaemonSets, StatefulSets and Jobs directly
 		// create pods
 		workloadReplica = pod
 	}
@@ -12,11 +12,14 @@ import (
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
+	batchv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/utils"
 	. "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector"
 	mock_detector "github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/types"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -84,7 +87,7 @@ var _ = Describe("WorkloadDetector", func() {
 		return rs
 	}
 
-	makePod := func(rs *appsv1.ReplicaSet) *corev1.Pod {
+	makePod := func(owner metav1.Object) *corev1.Pod {
 		pod := &corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
 				Namespace:   deploymentNs,
@@ -95,7 +98,7 @@ var _ = Describe("WorkloadDetector", func() {
 				PodIP: "ip-1",
 			},
 		}
-		err := controllerutil.SetControllerReference(rs, pod, scheme.Scheme)
+		err := controllerutil.SetControllerReference(owner, pod, scheme.Scheme)
 		Expect(err).NotTo(HaveOccurred())
 		return pod
 	}
@@ -119,6 +122,7 @@ var _ = Describe("WorkloadDetector", func() {
 			context.TODO(),
 			pods,
 			replicaSets,
+			batchv1sets.NewJobSet(),
 			mockSidecarDetector,
 			mockWorkloadDetector,
 		)
@@ -162,6 +166,7 @@ var _ = Describe("WorkloadDetector", func() {
 			context.TODO(),
 			pods,
 			replicaSets,
+			batchv1sets.NewJobSet(),
 			mockSidecarDetector,
 			mockWorkloadDetector,
 		)
@@ -191,4 +196,124 @@ var _ = Describe("WorkloadDetector", func() {
 		}))
 	})
 
+	It("translates an argo rollout whose pods are owned by its replicasets to a workload", func() {
+
+		rollout := &argorolloutsv1alpha1.Rollout{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   deploymentNs,
+				ClusterName: deploymentCluster,
+				Name:        deploymentName,
+			},
+			Spec: argorolloutsv1alpha1.RolloutSpec{
+				Template: makeDeployment().Spec.Template,
+			},
+		}
+		rs := &appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   deploymentNs,
+				ClusterName: deploymentCluster,
+				Name:        "replicaset",
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(rollout, argorolloutsv1alpha1.SchemeGroupVersion.WithKind("Rollout")),
+				},
+			},
+		}
+		pod := makePod(rs)
+
+		detector := NewWorkloadDetector(
+			context.TODO(),
+			corev1sets.NewPodSet(pod),
+			appsv1sets.NewReplicaSetSet(rs),
+			batchv1sets.NewJobSet(),
+			mockSidecarDetector,
+			mockWorkloadDetector,
+		)
+
+		meshes := v1sets.NewMeshSet()
+
+		mockWorkloadDetector.EXPECT().DetectMeshForWorkload(types.ToWorkload(rollout), meshes).Return(nil)
+		mockSidecarDetector.EXPECT().DetectMeshSidecar(pod, meshes).Return(mesh)
+
+		workload := detector.DetectWorkload(types.ToWorkload(rollout), meshes)
+
+		outputMeta := utils.DiscoveredObjectMeta(rollout)
+		// expect appended workload kind
+		outputMeta.Name += "-rollout"
+
+		Expect(workload).To(Equal(&v1.Workload{
+			ObjectMeta: outputMeta,
+			Spec: v1.WorkloadSpec{
+				Type: &v1.WorkloadSpec_Kubernetes{
+					Kubernetes: &v1.WorkloadSpec_KubernetesWorkload{
+						Controller:         ezkube.MakeClusterObjectRef(rollout),
+						PodLabels:          podLabels,
+						ServiceAccountName: serviceAccountName,
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(mesh),
+			},
+		}))
+	})
+
+	It("translates a cronjob whose pods are owned by its jobs to a workload", func() {
+
+		cronJob := &batchv1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   deploymentNs,
+				ClusterName: deploymentCluster,
+				Name:        deploymentName,
+			},
+			Spec: batchv1.CronJobSpec{
+				JobTemplate: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: makeDeployment().Spec.Template,
+					},
+				},
+			},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   deploymentNs,
+				ClusterName: deploymentCluster,
+				Name:        "job",
+			},
+		}
+		Expect(controllerutil.SetControllerReference(cronJob, job, scheme.Scheme)).NotTo(HaveOccurred())
+		pod := makePod(job)
+
+		detector := NewWorkloadDetector(
+			context.TODO(),
+			corev1sets.NewPodSet(pod),
+			appsv1sets.NewReplicaSetSet(),
+			batchv1sets.NewJobSet(job),
+			mockSidecarDetector,
+			mockWorkloadDetector,
+		)
+
+		meshes := v1sets.NewMeshSet()
+
+		mockWorkloadDetector.EXPECT().DetectMeshForWorkload(types.ToWorkload(cronJob), meshes).Return(nil)
+		mockSidecarDetector.EXPECT().DetectMeshSidecar(pod, meshes).Return(mesh)
+
+		workload := detector.DetectWorkload(types.ToWorkload(cronJob), meshes)
+
+		outputMeta := utils.DiscoveredObjectMeta(cronJob)
+		// expect appended workload kind
+		outputMeta.Name += "-cronjob"
+
+		Expect(workload).To(Equal(&v1.Workload{
+			ObjectMeta: outputMeta,
+			Spec: v1.WorkloadSpec{
+				Type: &v1.WorkloadSpec_Kubernetes{
+					Kubernetes: &v1.WorkloadSpec_KubernetesWorkload{
+						Controller:         ezkube.MakeClusterObjectRef(cronJob),
+						PodLabels:          podLabels,
+						ServiceAccountName: serviceAccountName,
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(mesh),
+			},
+		}))
+	})
+
 })
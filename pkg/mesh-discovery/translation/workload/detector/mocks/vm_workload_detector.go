@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./vm_workload_detector.go
+
+// Package mock_detector is a generated GoMock package.
+package mock_detector
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+)
+
+// MockVirtualMachineWorkloadDetector is a mock of VirtualMachineWorkloadDetector interface.
+type MockVirtualMachineWorkloadDetector struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirtualMachineWorkloadDetectorMockRecorder
+}
+
+// MockVirtualMachineWorkloadDetectorMockRecorder is the mock recorder for MockVirtualMachineWorkloadDetector.
+type MockVirtualMachineWorkloadDetectorMockRecorder struct {
+	mock *MockVirtualMachineWorkloadDetector
+}
+
+// NewMockVirtualMachineWorkloadDetector creates a new mock instance.
+func NewMockVirtualMachineWorkloadDetector(ctrl *gomock.Controller) *MockVirtualMachineWorkloadDetector {
+	mock := &MockVirtualMachineWorkloadDetector{ctrl: ctrl}
+	mock.recorder = &MockVirtualMachineWorkloadDetectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirtualMachineWorkloadDetector) EXPECT() *MockVirtualMachineWorkloadDetectorMockRecorder {
+	return m.recorder
+}
+
+// DetectVirtualMachineWorkloads mocks base method.
+func (m *MockVirtualMachineWorkloadDetector) DetectVirtualMachineWorkloads(workloadGroups v1alpha3sets.WorkloadGroupSet, workloadEntries v1alpha3sets.WorkloadEntrySet, meshes v1sets.MeshSet) v1.WorkloadSlice {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectVirtualMachineWorkloads", workloadGroups, workloadEntries, meshes)
+	ret0, _ := ret[0].(v1.WorkloadSlice)
+	return ret0
+}
+
+// DetectVirtualMachineWorkloads indicates an expected call of DetectVirtualMachineWorkloads.
+func (mr *MockVirtualMachineWorkloadDetectorMockRecorder) DetectVirtualMachineWorkloads(workloadGroups, workloadEntries, meshes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectVirtualMachineWorkloads", reflect.TypeOf((*MockVirtualMachineWorkloadDetector)(nil).DetectVirtualMachineWorkloads), workloadGroups, workloadEntries, meshes)
+}
@@ -0,0 +1,21 @@
+package detector
+
+import (
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+)
+
+//go:generate mockgen -source ./vm_workload_detector.go -destination mocks/vm_workload_detector.go
+
+// the VirtualMachineWorkloadDetector detects Workloads running outside of Kubernetes (e.g. virtual machines)
+// which are registered with a Mesh.
+type VirtualMachineWorkloadDetector interface {
+	// returns a Workload for each WorkloadGroup, and for each WorkloadEntry which does not belong to a WorkloadGroup,
+	// which is associated with a Mesh
+	DetectVirtualMachineWorkloads(
+		workloadGroups networkingv1alpha3sets.WorkloadGroupSet,
+		workloadEntries networkingv1alpha3sets.WorkloadEntrySet,
+		meshes v1sets.MeshSet,
+	) v1.WorkloadSlice
+}
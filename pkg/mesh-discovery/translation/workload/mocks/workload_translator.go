@@ -10,6 +10,9 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	v1sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
 	v1sets0 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1alpha1sets "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/sets"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	v1sets1 "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
 )
 
 // MockTranslator is a mock of Translator interface.
@@ -36,15 +39,15 @@ func (m *MockTranslator) EXPECT() *MockTranslatorMockRecorder {
 }
 
 // TranslateWorkloads mocks base method.
-func (m *MockTranslator) TranslateWorkloads(deployments v1sets.DeploymentSet, daemonSets v1sets.DaemonSetSet, statefulSets v1sets.StatefulSetSet, meshes v1sets0.MeshSet) v1sets0.WorkloadSet {
+func (m *MockTranslator) TranslateWorkloads(deployments v1sets.DeploymentSet, daemonSets v1sets.DaemonSetSet, statefulSets v1sets.StatefulSetSet, jobs v1sets1.JobSet, cronJobs v1sets1.CronJobSet, rollouts v1alpha1sets.RolloutSet, workloadGroups v1alpha3sets.WorkloadGroupSet, workloadEntries v1alpha3sets.WorkloadEntrySet, meshes v1sets0.MeshSet) v1sets0.WorkloadSet {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TranslateWorkloads", deployments, daemonSets, statefulSets, meshes)
+	ret := m.ctrl.Call(m, "TranslateWorkloads", deployments, daemonSets, statefulSets, jobs, cronJobs, rollouts, workloadGroups, workloadEntries, meshes)
 	ret0, _ := ret[0].(v1sets0.WorkloadSet)
 	return ret0
 }
 
 // TranslateWorkloads indicates an expected call of TranslateWorkloads.
-func (mr *MockTranslatorMockRecorder) TranslateWorkloads(deployments, daemonSets, statefulSets, meshes interface{}) *gomock.Call {
+func (mr *MockTranslatorMockRecorder) TranslateWorkloads(deployments, daemonSets, statefulSets, jobs, cronJobs, rollouts, workloadGroups, workloadEntries, meshes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateWorkloads", reflect.TypeOf((*MockTranslator)(nil).TranslateWorkloads), deployments, daemonSets, statefulSets, meshes)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TranslateWorkloads", reflect.TypeOf((*MockTranslator)(nil).TranslateWorkloads), deployments, daemonSets, statefulSets, jobs, cronJobs, rollouts, workloadGroups, workloadEntries, meshes)
 }
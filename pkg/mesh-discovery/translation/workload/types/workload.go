@@ -3,7 +3,9 @@ package types
 import (
 	"fmt"
 
+	argorolloutsv1alpha1 "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/rollouts/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -13,6 +15,9 @@ import (
 // - Deployment
 // - DaemonSet
 // - StatefulSet
+// - Job
+// - CronJob
+// - Argo Rollout
 type Workload interface {
 	metav1.Object
 	Kind() string
@@ -31,6 +36,15 @@ func ToWorkload(workload metav1.Object) Workload {
 	case *appsv1.StatefulSet:
 		w := StatefulSet(*workloadType)
 		return &w
+	case *batchv1.Job:
+		w := Job(*workloadType)
+		return &w
+	case *batchv1.CronJob:
+		w := CronJob(*workloadType)
+		return &w
+	case *argorolloutsv1alpha1.Rollout:
+		w := Rollout(*workloadType)
+		return &w
 	default:
 		panic(fmt.Sprintf("invalid cast type: %T", workloadType))
 	}
@@ -65,3 +79,33 @@ func (w *StatefulSet) Kind() string {
 func (w *StatefulSet) GetPodTemplate() corev1.PodTemplateSpec {
 	return w.Spec.Template
 }
+
+type Job batchv1.Job
+
+func (w *Job) Kind() string {
+	return "Job"
+}
+
+func (w *Job) GetPodTemplate() corev1.PodTemplateSpec {
+	return w.Spec.Template
+}
+
+type CronJob batchv1.CronJob
+
+func (w *CronJob) Kind() string {
+	return "CronJob"
+}
+
+func (w *CronJob) GetPodTemplate() corev1.PodTemplateSpec {
+	return w.Spec.JobTemplate.Spec.Template
+}
+
+type Rollout argorolloutsv1alpha1.Rollout
+
+func (w *Rollout) Kind() string {
+	return "Rollout"
+}
+
+func (w *Rollout) GetPodTemplate() corev1.PodTemplateSpec {
+	return w.Spec.Template
+}
@@ -5,37 +5,63 @@ import (
 
 	appsv1sets "github.com/solo-io/external-apis/pkg/api/k8s/apps/v1/sets"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	argorolloutsv1alpha1sets "github.com/solo-io/gloo-mesh/pkg/api/external/argoproj/argoproj.io/v1alpha1/sets"
+	networkingv1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
+	batchv1sets "github.com/solo-io/gloo-mesh/pkg/api/external/k8s/batch/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/detector"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/translation/workload/types"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 //go:generate mockgen -source ./workload_translator.go -destination mocks/workload_translator.go
 
-// the mesh-workload translator converts deployments with injected sidecars into Workload CRs
+// the mesh-workload translator converts deployments with injected sidecars,
+// and workloads registered from outside of Kubernetes, into Workload CRs
 type Translator interface {
 	TranslateWorkloads(
 		deployments appsv1sets.DeploymentSet,
 		daemonSets appsv1sets.DaemonSetSet,
 		statefulSets appsv1sets.StatefulSetSet,
+		jobs batchv1sets.JobSet,
+		cronJobs batchv1sets.CronJobSet,
+		rollouts argorolloutsv1alpha1sets.RolloutSet,
+		workloadGroups networkingv1alpha3sets.WorkloadGroupSet,
+		workloadEntries networkingv1alpha3sets.WorkloadEntrySet,
 		meshes v1sets.MeshSet,
 	) v1sets.WorkloadSet
 }
 
+const cronJobKind = "CronJob"
+
 type translator struct {
-	ctx              context.Context
-	workloadDetector detector.WorkloadDetector
+	ctx                    context.Context
+	workloadDetector       detector.WorkloadDetector
+	virtualMachineDetector detector.VirtualMachineWorkloadDetector
 }
 
-func NewTranslator(ctx context.Context, workloadDetector detector.WorkloadDetector) Translator {
-	return &translator{ctx: ctx, workloadDetector: workloadDetector}
+func NewTranslator(
+	ctx context.Context,
+	workloadDetector detector.WorkloadDetector,
+	virtualMachineDetector detector.VirtualMachineWorkloadDetector,
+) Translator {
+	return &translator{
+		ctx:                    ctx,
+		workloadDetector:       workloadDetector,
+		virtualMachineDetector: virtualMachineDetector,
+	}
 }
 
 func (t *translator) TranslateWorkloads(
 	deployments appsv1sets.DeploymentSet,
 	daemonSets appsv1sets.DaemonSetSet,
 	statefulSets appsv1sets.StatefulSetSet,
+	jobs batchv1sets.JobSet,
+	cronJobs batchv1sets.CronJobSet,
+	rollouts argorolloutsv1alpha1sets.RolloutSet,
+	workloadGroups networkingv1alpha3sets.WorkloadGroupSet,
+	workloadEntries networkingv1alpha3sets.WorkloadEntrySet,
 	meshes v1sets.MeshSet,
 ) v1sets.WorkloadSet {
 	var workloads []types.Workload
@@ -48,6 +74,19 @@ func (t *translator) TranslateWorkloads(
 	for _, statefulSet := range statefulSets.List() {
 		workloads = append(workloads, types.ToWorkload(statefulSet))
 	}
+	for _, job := range jobs.List() {
+		// jobs scheduled by cronjobs are discovered as part of the cronjob workload
+		if controller := metav1.GetControllerOf(job); controller != nil && controller.Kind == cronJobKind {
+			continue
+		}
+		workloads = append(workloads, types.ToWorkload(job))
+	}
+	for _, cronJob := range cronJobs.List() {
+		workloads = append(workloads, types.ToWorkload(cronJob))
+	}
+	for _, rollout := range rollouts.List() {
+		workloads = append(workloads, types.ToWorkload(rollout))
+	}
 
 	workloadSet := v1sets.NewWorkloadSet()
 
@@ -59,5 +98,11 @@ func (t *translator) TranslateWorkloads(
 		contextutils.LoggerFrom(t.ctx).Debugf("detected workload %v", sets.Key(workload))
 		workloadSet.Insert(workload)
 	}
+
+	for _, workload := range t.virtualMachineDetector.DetectVirtualMachineWorkloads(workloadGroups, workloadEntries, meshes) {
+		contextutils.LoggerFrom(t.ctx).Debugf("detected virtual machine workload %v", sets.Key(workload))
+		workloadSet.Insert(workload)
+	}
+
 	return workloadSet
 }
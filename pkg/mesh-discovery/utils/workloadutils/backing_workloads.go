@@ -3,42 +3,73 @@ package workloadutils
 import (
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1alpha2sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"k8s.io/apimachinery/pkg/labels"
 )
 
+// returns the workloads selected by a Kubernetes Service.
+// Istio allows Services to select virtual machine workloads in addition to Kubernetes workloads.
 func FindBackingWorkloads(
 	service *v1.DestinationSpec_KubeService,
 	meshWorkloads v1alpha2sets.WorkloadSet,
 ) v1.WorkloadSlice {
 
 	return meshWorkloads.List(func(workload *v1.Workload) bool {
-		// TODO(ilackarms): refactor this to support more than just k8s workloads
-		// should probably go with a platform-based destination detector (e.g. one for k8s, one for vm, etc.)
-		return !isBackingKubeWorkload(service, workload.Spec.GetKubernetes())
+		return !isBackingWorkload(service.GetRef(), service.GetWorkloadSelectorLabels(), workload)
 	})
 }
 
-func isBackingKubeWorkload(
-	service *v1.DestinationSpec_KubeService,
-	kubeWorkload *v1.WorkloadSpec_KubernetesWorkload,
-) bool {
-	if kubeWorkload == nil {
-		return false
+// returns the workloads selected by the workload selector of an Istio ServiceEntry
+func FindServiceEntryBackingWorkloads(
+	externalService *v1.DestinationSpec_ExternalService,
+	meshWorkloads v1alpha2sets.WorkloadSet,
+) v1.WorkloadSlice {
+
+	return meshWorkloads.List(func(workload *v1.Workload) bool {
+		return !isBackingWorkload(externalService.GetRef(), externalService.GetWorkloadSelectorLabels(), workload)
+	})
+}
+
+// returns the ref of the object defining the workload, and the labels of its instances
+func GetWorkloadRefAndLabels(workload *v1.Workload) (*skv2corev1.ClusterObjectRef, map[string]string) {
+	switch workloadType := workload.Spec.GetType().(type) {
+	case *v1.WorkloadSpec_Kubernetes:
+		return workloadType.Kubernetes.GetController(), workloadType.Kubernetes.GetPodLabels()
+	case *v1.WorkloadSpec_VirtualMachine:
+		return workloadType.VirtualMachine.GetRef(), workloadType.VirtualMachine.GetLabels()
 	}
+	return nil, nil
+}
 
-	workloadRef := kubeWorkload.Controller
+// returns the name of the service account of the workload's instances
+func GetWorkloadServiceAccountName(workload *v1.Workload) string {
+	switch workloadType := workload.Spec.GetType().(type) {
+	case *v1.WorkloadSpec_Kubernetes:
+		return workloadType.Kubernetes.GetServiceAccountName()
+	case *v1.WorkloadSpec_VirtualMachine:
+		return workloadType.VirtualMachine.GetServiceAccountName()
+	}
+	return ""
+}
 
-	if workloadRef.ClusterName != service.GetRef().GetClusterName() ||
-		workloadRef.Namespace != service.GetRef().GetNamespace() {
+func isBackingWorkload(
+	selectorRef *skv2corev1.ClusterObjectRef,
+	selectorLabels map[string]string,
+	workload *v1.Workload,
+) bool {
+	workloadRef, workloadLabels := GetWorkloadRefAndLabels(workload)
+	if workloadRef == nil {
 		return false
 	}
 
-	podLabels := kubeWorkload.GetPodLabels()
-	selectorLabels := service.WorkloadSelectorLabels
+	if workloadRef.GetClusterName() != selectorRef.GetClusterName() ||
+		workloadRef.GetNamespace() != selectorRef.GetNamespace() {
+		return false
+	}
 
-	if len(podLabels) == 0 || len(selectorLabels) == 0 {
+	if len(workloadLabels) == 0 || len(selectorLabels) == 0 {
 		return false
 	}
 
-	return labels.SelectorFromSet(selectorLabels).Matches(labels.Set(podLabels))
+	return labels.SelectorFromSet(selectorLabels).Matches(labels.Set(workloadLabels))
 }
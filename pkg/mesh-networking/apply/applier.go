@@ -8,6 +8,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/rotisserie/eris"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
@@ -18,6 +19,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply/configtarget"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
 	"github.com/solo-io/go-utils/contextutils"
@@ -80,6 +82,8 @@ func (v *applier) Apply(
 	}
 
 	reportTranslationErrors(ctx, reporter, input, previousAppliedVirtualMeshes)
+
+	advanceCertificateRotations(input)
 }
 
 // Optimistically initialize policy statuses to accepted, which may be set to invalid or failed pending subsequent validation.
@@ -114,10 +118,12 @@ func initializePolicyStatuses(input input.LocalSnapshot) {
 			ObservedGeneration: virtualMesh.Generation,
 			Meshes:             map[string]*networkingv1.ApprovalStatus{},
 			Destinations:       map[string]*networkingv1.ApprovalStatus{},
+			MeshCertificates:   map[string]*certificatesv1.IssuedCertificateInfo{},
 			Errors:             nil,
 			// Need to retain previous conditions
 			Conditions:          virtualMesh.Status.Conditions,
 			DeployedSharedTrust: virtualMesh.Status.DeployedSharedTrust,
+			FailedSharedTrust:   virtualMesh.Status.FailedSharedTrust,
 		}
 	}
 }
@@ -278,18 +284,25 @@ func validateAndReturnApprovedTrafficPolicies(ctx context.Context, input input.L
 			trafficPolicy.Status.Destinations = map[string]*networkingv1.ApprovalStatus{}
 		}
 
-		if len(errsForTrafficPolicy) == 0 {
+		// fields shadowed by higher priority TrafficPolicies do not prevent the TrafficPolicy from being accepted
+		var errMsgs, warningMsgs []string
+		for _, tpErr := range errsForTrafficPolicy {
+			if fieldutils.IsFieldShadowedError(tpErr) {
+				warningMsgs = append(warningMsgs, tpErr.Error())
+			} else {
+				errMsgs = append(errMsgs, tpErr.Error())
+			}
+		}
+
+		if len(errMsgs) == 0 {
 			trafficPolicy.Status.Destinations[sets.Key(destination)] = &networkingv1.ApprovalStatus{
 				AcceptanceOrder: acceptedIndex,
 				State:           commonv1.ApprovalState_ACCEPTED,
+				Warnings:        warningMsgs,
 			}
 			validatedTrafficPolicies = append(validatedTrafficPolicies, appliedTrafficPolicy)
 			acceptedIndex++
 		} else {
-			var errMsgs []string
-			for _, tpErr := range errsForTrafficPolicy {
-				errMsgs = append(errMsgs, tpErr.Error())
-			}
 			trafficPolicy.Status.Destinations[sets.Key(destination)] = &networkingv1.ApprovalStatus{
 				State:  commonv1.ApprovalState_INVALID,
 				Errors: errMsgs,
@@ -434,6 +447,11 @@ func validateAndReturnVirtualMesh(
 		return nil
 	}
 
+	// report the certificate currently issued to the mesh
+	if certInfo := mesh.Spec.GetIssuedCertificateStatus().GetIssuedCertificateInfo(); certInfo != nil {
+		virtualMesh.Status.MeshCertificates[sets.Key(mesh)] = certInfo
+	}
+
 	if len(errsForVirtualMesh) == 0 {
 		virtualMesh.Status.Meshes[sets.Key(mesh)] = &networkingv1.ApprovalStatus{
 			State: commonv1.ApprovalState_ACCEPTED,
@@ -584,7 +602,7 @@ func getAppliedTrafficPolicies(
 		}
 	}
 
-	sortTrafficPoliciesByAcceptedDate(destination, matchingTrafficPolicies)
+	sortTrafficPoliciesByPriorityAndAcceptedDate(destination, matchingTrafficPolicies)
 
 	var appliedPolicies []*networkingv1.AppliedTrafficPolicy
 	for _, policy := range matchingTrafficPolicies {
@@ -598,13 +616,14 @@ func getAppliedTrafficPolicies(
 	return appliedPolicies
 }
 
-// sort the set of traffic policies in the order in which they were accepted.
-// Traffic policies which were accepted first and have not changed (i.e. their observedGeneration is up-to-date) take precedence.
+// sort the set of traffic policies by descending priority, then in the order in which they were accepted.
+// Traffic policies with a higher priority take precedence, regardless of the order in which they were accepted.
+// Of traffic policies with equal priority, those which were accepted first and have not changed (i.e. their observedGeneration is up-to-date) take precedence.
 // Next are policies that were previously accepted but whose observedGeneration is out of date. This permits policies which were modified but formerly correct to maintain
 // their acceptance status ahead of policies which were unomdified and previously rejected.
 // Next will be the policies which have been modified and rejected.
 // Finally, policies which are rejected and modified
-func sortTrafficPoliciesByAcceptedDate(destination *discoveryv1.Destination, trafficPolicies networkingv1.TrafficPolicySlice) {
+func sortTrafficPoliciesByPriorityAndAcceptedDate(destination *discoveryv1.Destination, trafficPolicies networkingv1.TrafficPolicySlice) {
 	isUpToDate := func(tp *networkingv1.TrafficPolicy) bool {
 		return tp.Status.ObservedGeneration == tp.Generation
 	}
@@ -612,6 +631,11 @@ func sortTrafficPoliciesByAcceptedDate(destination *discoveryv1.Destination, tra
 	sort.SliceStable(trafficPolicies, func(i, j int) bool {
 		tp1, tp2 := trafficPolicies[i], trafficPolicies[j]
 
+		if priority1, priority2 := tp1.Spec.GetPriority(), tp2.Spec.GetPriority(); priority1 != priority2 {
+			// higher priority comes before lower priority
+			return priority1 > priority2
+		}
+
 		status1 := tp1.Status.Destinations[sets.Key(destination)]
 		status2 := tp2.Status.Destinations[sets.Key(destination)]
 
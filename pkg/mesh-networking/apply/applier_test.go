@@ -3,9 +3,12 @@ package apply_test
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
 	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
@@ -14,6 +17,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
@@ -167,6 +171,472 @@ var _ = Describe("Applier", func() {
 			applier.Apply(context.TODO(), snap, nil)
 			Expect(&vmExpectCopy.Status).To(matchers.MatchProto(&virtualMesh.Status))
 		})
+
+		It("reports the certificates issued to the Meshes in a Virtual Mesh", func() {
+
+			virtualMesh := &networkingv1.VirtualMesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm",
+					Namespace: "test",
+				},
+			}
+			certInfo := &certificatesv1.IssuedCertificateInfo{
+				SerialNumber: "1a2b",
+				NotBefore:    "2021-01-01T00:00:00Z",
+				NotAfter:     "2022-01-01T00:00:00Z",
+				RenewalTime:  "2021-11-24T00:00:00Z",
+			}
+			mesh := &discoveryv1.Mesh{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "mesh1",
+					Namespace: "ns",
+				},
+				Spec: discoveryv1.MeshSpec{
+					IssuedCertificateStatus: &certificatesv1.IssuedCertificateStatus{
+						State:                 certificatesv1.IssuedCertificateStatus_FINISHED,
+						IssuedCertificateInfo: certInfo,
+					},
+				},
+			}
+			virtualMesh.Spec.Meshes = []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(mesh)}
+
+			snap := input.NewInputLocalSnapshotManualBuilder("").
+				AddVirtualMeshes([]*networkingv1.VirtualMesh{virtualMesh}).
+				AddMeshes(discoveryv1.MeshSlice{mesh}).
+				Build()
+
+			translator := testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+				// no report = accept
+			}}
+			applier := NewApplier(translator)
+			applier.Apply(context.TODO(), snap, nil)
+			Expect(virtualMesh.Status.MeshCertificates).To(HaveKey(sets.Key(mesh)))
+			Expect(virtualMesh.Status.MeshCertificates[sets.Key(mesh)]).To(matchers.MatchProto(certInfo))
+		})
+
+		Context("automatic certificate rotation verification", func() {
+			var (
+				virtualMesh *networkingv1.VirtualMesh
+				mesh        *discoveryv1.Mesh
+			)
+
+			applyWithConditions := func(conditions []*certificatesv1.CertificateRotationCondition, verification *certificatesv1.CertificateRotationVerificationStatus) {
+				virtualMesh = &networkingv1.VirtualMesh{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vm",
+						Namespace: "test",
+					},
+					Spec: networkingv1.VirtualMeshSpec{
+						MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+							RotationVerificationMethod: &certificatesv1.CertificateRotationVerificationMethod{
+								Method: &certificatesv1.CertificateRotationVerificationMethod_Automatic{
+									Automatic: &certificatesv1.CertificateRotationVerificationMethod_AutomaticVerification{
+										Timeout: ptypes.DurationProto(time.Minute * 5),
+									},
+								},
+							},
+						},
+					},
+					Status: networkingv1.VirtualMeshStatus{
+						Conditions: conditions,
+					},
+				}
+				mesh = &discoveryv1.Mesh{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "mesh1",
+						Namespace: "ns",
+					},
+					Spec: discoveryv1.MeshSpec{
+						IssuedCertificateStatus: &certificatesv1.IssuedCertificateStatus{
+							State:                 certificatesv1.IssuedCertificateStatus_FINISHED,
+							ObservedRotationState: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+							RotationVerification:  verification,
+						},
+					},
+				}
+				virtualMesh.Spec.Meshes = []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(mesh)}
+
+				snap := input.NewInputLocalSnapshotManualBuilder("").
+					AddVirtualMeshes([]*networkingv1.VirtualMesh{virtualMesh}).
+					AddMeshes(discoveryv1.MeshSlice{mesh}).
+					Build()
+
+				translator := testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+					// no report = accept
+				}}
+				applier := NewApplier(translator)
+				applier.Apply(context.TODO(), snap, nil)
+			}
+
+			applyWithVerification := func(verifyingSince time.Time, verification *certificatesv1.CertificateRotationVerificationStatus) {
+				applyWithConditions([]*certificatesv1.CertificateRotationCondition{
+					{
+						State: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					},
+					{
+						State:     certificatesv1.CertificateRotationState_VERIFYING,
+						Timestamp: verifyingSince.UTC().Format(time.RFC3339),
+					},
+				}, verification)
+			}
+
+			It("starts verifying each recorded rotation step", func() {
+				applyWithConditions([]*certificatesv1.CertificateRotationCondition{
+					{
+						State: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					},
+				}, nil)
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(2))
+				Expect(virtualMesh.Status.Conditions[1].GetState()).To(Equal(certificatesv1.CertificateRotationState_VERIFYING))
+				Expect(virtualMesh.Status.Conditions[1].GetTimestamp()).NotTo(BeEmpty())
+			})
+
+			It("marks the rotation step verified once all Meshes have been verified", func() {
+				applyWithVerification(time.Now(), &certificatesv1.CertificateRotationVerificationStatus{
+					RotationState: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					Verified:      true,
+				})
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(3))
+				Expect(virtualMesh.Status.Conditions[2].GetState()).To(Equal(certificatesv1.CertificateRotationState_VERIFIED))
+			})
+
+			It("waits for Meshes which have not been verified", func() {
+				applyWithVerification(time.Now(), &certificatesv1.CertificateRotationVerificationStatus{
+					RotationState:    certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					PendingWorkloads: []string{"productpage.bookinfo"},
+				})
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(2))
+			})
+
+			It("waits for Meshes which have not been verified for the rotation step", func() {
+				applyWithVerification(time.Now(), &certificatesv1.CertificateRotationVerificationStatus{
+					RotationState: certificatesv1.CertificateRotationState_PREVIOUS_CA,
+					Verified:      true,
+				})
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(2))
+			})
+
+			It("rolls back the rotation if verification fails", func() {
+				applyWithVerification(time.Now(), &certificatesv1.CertificateRotationVerificationStatus{
+					RotationState: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					Errors:        []string{"failed to find issued certificate secret"},
+				})
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(3))
+				Expect(virtualMesh.Status.Conditions[2].GetState()).To(Equal(certificatesv1.CertificateRotationState_ROLLING_BACK))
+				Expect(virtualMesh.Status.Conditions[2].GetErrors()).To(ConsistOf(
+					"Mesh mesh1.ns.: failed to find issued certificate secret",
+				))
+			})
+
+			It("rolls back the rotation if verification does not complete within the timeout", func() {
+				applyWithVerification(time.Now().Add(-time.Minute*10), &certificatesv1.CertificateRotationVerificationStatus{
+					RotationState:    certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					PendingWorkloads: []string{"productpage.bookinfo"},
+				})
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(3))
+				Expect(virtualMesh.Status.Conditions[2].GetState()).To(Equal(certificatesv1.CertificateRotationState_ROLLING_BACK))
+				Expect(virtualMesh.Status.Conditions[2].GetErrors()).To(ConsistOf(
+					"Mesh mesh1.ns.: waiting for namespaces [] and workloads [productpage.bookinfo] to pick up the issued certificates",
+				))
+			})
+
+			It("rolls back to the previous CA", func() {
+				applyWithConditions([]*certificatesv1.CertificateRotationCondition{
+					{
+						State: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+					},
+					{
+						State: certificatesv1.CertificateRotationState_VERIFYING,
+					},
+					{
+						State: certificatesv1.CertificateRotationState_ROLLING_BACK,
+					},
+				}, nil)
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(4))
+				Expect(virtualMesh.Status.Conditions[3].GetState()).To(Equal(certificatesv1.CertificateRotationState_PREVIOUS_CA))
+			})
+
+			It("fails the rotation once the rollback to the previous CA has been verified", func() {
+				applyWithConditions([]*certificatesv1.CertificateRotationCondition{
+					{
+						State: certificatesv1.CertificateRotationState_PREVIOUS_CA,
+					},
+					{
+						State: certificatesv1.CertificateRotationState_VERIFYING,
+					},
+					{
+						State: certificatesv1.CertificateRotationState_VERIFIED,
+					},
+				}, nil)
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(4))
+				Expect(virtualMesh.Status.Conditions[3].GetState()).To(Equal(certificatesv1.CertificateRotationState_FAILED))
+			})
+
+			It("fails the rotation if the rollback to the previous CA fails verification", func() {
+				applyWithConditions([]*certificatesv1.CertificateRotationCondition{
+					{
+						State: certificatesv1.CertificateRotationState_PREVIOUS_CA,
+					},
+					{
+						State:     certificatesv1.CertificateRotationState_VERIFYING,
+						Timestamp: time.Now().UTC().Format(time.RFC3339),
+					},
+				}, &certificatesv1.CertificateRotationVerificationStatus{
+					RotationState: certificatesv1.CertificateRotationState_PREVIOUS_CA,
+					Errors:        []string{"failed to find issued certificate secret"},
+				})
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(3))
+				Expect(virtualMesh.Status.Conditions[2].GetState()).To(Equal(certificatesv1.CertificateRotationState_FAILED))
+			})
+
+			It("rolls back the rotation if the VirtualMesh is not applied to any Mesh within the timeout", func() {
+				virtualMesh = &networkingv1.VirtualMesh{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vm",
+						Namespace: "test",
+					},
+					Spec: networkingv1.VirtualMeshSpec{
+						MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+							RotationVerificationMethod: &certificatesv1.CertificateRotationVerificationMethod{
+								Method: &certificatesv1.CertificateRotationVerificationMethod_Automatic{
+									Automatic: &certificatesv1.CertificateRotationVerificationMethod_AutomaticVerification{
+										Timeout: ptypes.DurationProto(time.Minute * 5),
+									},
+								},
+							},
+						},
+					},
+					Status: networkingv1.VirtualMeshStatus{
+						Conditions: []*certificatesv1.CertificateRotationCondition{
+							{
+								State: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+							},
+							{
+								State:     certificatesv1.CertificateRotationState_VERIFYING,
+								Timestamp: time.Now().Add(-time.Minute * 10).UTC().Format(time.RFC3339),
+							},
+						},
+					},
+				}
+
+				snap := input.NewInputLocalSnapshotManualBuilder("").
+					AddVirtualMeshes([]*networkingv1.VirtualMesh{virtualMesh}).
+					Build()
+
+				applier := NewApplier(testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+					// no report = accept
+				}})
+				applier.Apply(context.TODO(), snap, nil)
+
+				Expect(virtualMesh.Status.Conditions).To(HaveLen(3))
+				Expect(virtualMesh.Status.Conditions[2].GetState()).To(Equal(certificatesv1.CertificateRotationState_ROLLING_BACK))
+				Expect(virtualMesh.Status.Conditions[2].GetErrors()).To(ConsistOf(
+					"waiting for the VirtualMesh to be applied to its Meshes",
+				))
+			})
+
+			It("reports an invalid verification timeout", func() {
+				virtualMesh = &networkingv1.VirtualMesh{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vm",
+						Namespace: "test",
+					},
+					Spec: networkingv1.VirtualMeshSpec{
+						MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+							RotationVerificationMethod: &certificatesv1.CertificateRotationVerificationMethod{
+								Method: &certificatesv1.CertificateRotationVerificationMethod_Automatic{
+									Automatic: &certificatesv1.CertificateRotationVerificationMethod_AutomaticVerification{
+										Timeout: ptypes.DurationProto(-time.Minute),
+									},
+								},
+							},
+						},
+					},
+				}
+
+				snap := input.NewInputLocalSnapshotManualBuilder("").
+					AddVirtualMeshes([]*networkingv1.VirtualMesh{virtualMesh}).
+					Build()
+
+				applier := NewApplier(testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+					// no report = accept
+				}})
+				applier.Apply(context.TODO(), snap, nil)
+
+				Expect(virtualMesh.Status.State).To(Equal(commonv1.ApprovalState_INVALID))
+				Expect(virtualMesh.Status.Errors).To(ConsistOf(
+					"automatic rotation verification timeout must be positive, got -1m0s",
+				))
+			})
+
+			Context("shared trust rotation", func() {
+				var (
+					deployedSharedTrust = &networkingv1.SharedTrust{
+						IntermediateCertOptions: &certificatesv1.CommonCertOptions{TtlDays: 365},
+					}
+					sharedTrust = &networkingv1.SharedTrust{
+						IntermediateCertOptions: &certificatesv1.CommonCertOptions{TtlDays: 30},
+					}
+					applier Applier
+					snap    input.LocalSnapshot
+				)
+
+				BeforeEach(func() {
+					virtualMesh = &networkingv1.VirtualMesh{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "vm",
+							Namespace: "test",
+						},
+						Spec: networkingv1.VirtualMeshSpec{
+							MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+								TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Shared{
+									Shared: sharedTrust,
+								},
+								RotationVerificationMethod: &certificatesv1.CertificateRotationVerificationMethod{
+									Method: &certificatesv1.CertificateRotationVerificationMethod_Automatic{
+										Automatic: &certificatesv1.CertificateRotationVerificationMethod_AutomaticVerification{},
+									},
+								},
+							},
+						},
+						Status: networkingv1.VirtualMeshStatus{
+							DeployedSharedTrust: deployedSharedTrust,
+						},
+					}
+					mesh = &discoveryv1.Mesh{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "mesh1",
+							Namespace: "ns",
+						},
+						Spec: discoveryv1.MeshSpec{
+							IssuedCertificateStatus: &certificatesv1.IssuedCertificateStatus{
+								State: certificatesv1.IssuedCertificateStatus_FINISHED,
+							},
+						},
+					}
+					virtualMesh.Spec.Meshes = []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(mesh)}
+
+					snap = input.NewInputLocalSnapshotManualBuilder("").
+						AddVirtualMeshes([]*networkingv1.VirtualMesh{virtualMesh}).
+						AddMeshes(discoveryv1.MeshSlice{mesh}).
+						Build()
+
+					applier = NewApplier(testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+						// no report = accept
+					}})
+				})
+
+				// the Gloo Mesh agent reports the verification of the rotation step propagated to the Mesh's IssuedCertificate
+				verifyMesh := func(rotationState certificatesv1.CertificateRotationState) {
+					mesh.Spec.IssuedCertificateStatus.ObservedRotationState = rotationState
+					mesh.Spec.IssuedCertificateStatus.RotationVerification = &certificatesv1.CertificateRotationVerificationStatus{
+						RotationState: rotationState,
+						Verified:      true,
+					}
+				}
+
+				latestState := func() certificatesv1.CertificateRotationState {
+					conditions := virtualMesh.Status.Conditions
+					return conditions[len(conditions)-1].GetState()
+				}
+
+				It("records the initial shared trust without a rotation", func() {
+					virtualMesh.Status.DeployedSharedTrust = nil
+
+					applier.Apply(context.TODO(), snap, nil)
+
+					Expect(virtualMesh.Status.Conditions).To(BeEmpty())
+					Expect(virtualMesh.Status.DeployedSharedTrust).To(matchers.MatchProto(sharedTrust))
+				})
+
+				It("advances from one rotation step to the next once it has been verified", func() {
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_ADDING_NEW_ROOT))
+
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_VERIFYING))
+
+					// the Mesh has not yet been verified for the rotation step
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_VERIFYING))
+
+					verifyMesh(certificatesv1.CertificateRotationState_ADDING_NEW_ROOT)
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_VERIFIED))
+
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_PROPAGATING_NEW_INTERMEDIATE))
+					Expect(virtualMesh.Status.DeployedSharedTrust).To(matchers.MatchProto(deployedSharedTrust))
+
+					// the verification of the previous step does not verify the current step
+					applier.Apply(context.TODO(), snap, nil)
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_VERIFYING))
+
+					verifyMesh(certificatesv1.CertificateRotationState_PROPAGATING_NEW_INTERMEDIATE)
+					applier.Apply(context.TODO(), snap, nil)
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_DELETING_OLD_ROOT))
+
+					applier.Apply(context.TODO(), snap, nil)
+					verifyMesh(certificatesv1.CertificateRotationState_DELETING_OLD_ROOT)
+					applier.Apply(context.TODO(), snap, nil)
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_FINISHED))
+					Expect(virtualMesh.Status.DeployedSharedTrust).To(matchers.MatchProto(sharedTrust))
+
+					// the rotation is not restarted once the shared trust has been deployed
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_FINISHED))
+				})
+
+				It("does not restart a failed rotation until the shared trust is changed", func() {
+					applier.Apply(context.TODO(), snap, nil)
+					applier.Apply(context.TODO(), snap, nil)
+					mesh.Spec.IssuedCertificateStatus.RotationVerification = &certificatesv1.CertificateRotationVerificationStatus{
+						RotationState: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+						Errors:        []string{"failed to find issued certificate secret"},
+					}
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_ROLLING_BACK))
+
+					applier.Apply(context.TODO(), snap, nil)
+					applier.Apply(context.TODO(), snap, nil)
+					verifyMesh(certificatesv1.CertificateRotationState_PREVIOUS_CA)
+					applier.Apply(context.TODO(), snap, nil)
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_FAILED))
+					Expect(virtualMesh.Status.FailedSharedTrust).To(matchers.MatchProto(sharedTrust))
+					Expect(virtualMesh.Status.DeployedSharedTrust).To(matchers.MatchProto(deployedSharedTrust))
+
+					failedConditions := virtualMesh.Status.Conditions
+					for i := 0; i < 3; i++ {
+						applier.Apply(context.TODO(), snap, nil)
+					}
+					Expect(virtualMesh.Status.Conditions).To(Equal(failedConditions))
+
+					// only the conditions of the new rotation are retained
+					virtualMesh.Spec.MtlsConfig.TrustModel = &networkingv1.VirtualMeshSpec_MTLSConfig_Shared{
+						Shared: &networkingv1.SharedTrust{
+							IntermediateCertOptions: &certificatesv1.CommonCertOptions{TtlDays: 90},
+						},
+					}
+					applier.Apply(context.TODO(), snap, nil)
+					Expect(virtualMesh.Status.Conditions).To(HaveLen(1))
+					Expect(latestState()).To(Equal(certificatesv1.CertificateRotationState_ADDING_NEW_ROOT))
+					Expect(virtualMesh.Status.FailedSharedTrust).To(BeNil())
+				})
+			})
+		})
 	})
 
 	Context("invalid traffic policies", func() {
@@ -211,6 +681,71 @@ var _ = Describe("Applier", func() {
 		})
 	})
 
+	Context("traffic policies with priorities", func() {
+		var (
+			destination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ms1",
+					Namespace: "ns",
+				},
+			}
+			defaultTrafficPolicy = &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "platform-default",
+					Namespace: "ns",
+				},
+			}
+			overrideTrafficPolicy = &networkingv1.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "app-override",
+					Namespace: "ns",
+				},
+				Spec: networkingv1.TrafficPolicySpec{
+					Priority: 10,
+				},
+			}
+			shadowedErr = eris.Wrap(fieldutils.FieldShadowedError{
+				FieldConflictError: fieldutils.FieldConflictError{
+					Field:     &defaultTrafficPolicy.Spec.Policy,
+					Owners:    []ezkube.ResourceId{overrideTrafficPolicy},
+					OwnerType: &networkingv1.TrafficPolicy{},
+					Priority:  10,
+				},
+			}, "retries")
+
+			snap = input.NewInputLocalSnapshotManualBuilder("").
+				AddDestinations(discoveryv1.DestinationSlice{destination}).
+				AddTrafficPolicies(networkingv1.TrafficPolicySlice{defaultTrafficPolicy, overrideTrafficPolicy}).
+				Build()
+		)
+
+		BeforeEach(func() {
+			translator := testIstioTranslator{callReporter: func(reporter reporting.Reporter) {
+				// the lower priority policy is shadowed by the higher priority policy
+				reporter.ReportTrafficPolicyToDestination(destination, defaultTrafficPolicy, shadowedErr)
+			}}
+			applier := NewApplier(translator)
+			applier.Apply(context.TODO(), snap, nil)
+		})
+		It("applies the higher priority policy first", func() {
+			Expect(destination.Status.AppliedTrafficPolicies).To(HaveLen(2))
+			Expect(destination.Status.AppliedTrafficPolicies[0].Ref).To(Equal(ezkube.MakeObjectRef(overrideTrafficPolicy)))
+			Expect(destination.Status.AppliedTrafficPolicies[1].Ref).To(Equal(ezkube.MakeObjectRef(defaultTrafficPolicy)))
+		})
+		It("accepts the shadowed policy with a warning", func() {
+			Expect(overrideTrafficPolicy.Status.Destinations[sets.Key(destination)]).To(Equal(&networkingv1.ApprovalStatus{
+				AcceptanceOrder: 0,
+				State:           commonv1.ApprovalState_ACCEPTED,
+			}))
+			Expect(defaultTrafficPolicy.Status.Destinations[sets.Key(destination)]).To(Equal(&networkingv1.ApprovalStatus{
+				AcceptanceOrder: 1,
+				State:           commonv1.ApprovalState_ACCEPTED,
+				Warnings:        []string{shadowedErr.Error()},
+			}))
+			Expect(defaultTrafficPolicy.Status.State).To(Equal(commonv1.ApprovalState_ACCEPTED))
+		})
+	})
+
 	Context("setting workloads status", func() {
 		var (
 			destination1 = &discoveryv1.Destination{
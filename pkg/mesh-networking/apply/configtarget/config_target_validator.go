@@ -3,9 +3,12 @@ package configtarget
 import (
 	"fmt"
 	"sort"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
@@ -25,7 +28,7 @@ type ConfigTargetValidator interface {
 		trafficPolicies v1.TrafficPolicySlice,
 	)
 
-	// Validate mesh references declared on VirtualMeshes.
+	// Validate mesh references and the rotation verification timeout declared on VirtualMeshes.
 	// Also validate that all referenced meshes are contained in at most one VirtualMesh.
 	ValidateVirtualMeshes(
 		virtualMeshes v1.VirtualMeshSlice,
@@ -138,9 +141,34 @@ func (c *configTargetValidator) validateVirtualMesh(virtualMesh *v1.VirtualMesh)
 	if meshRefErrors != nil {
 		errs = append(errs, meshRefErrors...)
 	}
+	automatic := virtualMesh.Spec.GetMtlsConfig().GetRotationVerificationMethod().GetAutomatic()
+	if _, err := RotationVerificationTimeout(automatic); err != nil {
+		errs = append(errs, err)
+	}
 	return errs
 }
 
+// The time allowed for automatic verification of a certificate rotation step when no timeout is configured.
+const DefaultRotationVerificationTimeout = 10 * time.Minute
+
+// Return the time allowed for automatic verification of each certificate rotation step,
+// or an error if the configured timeout is not a positive duration.
+func RotationVerificationTimeout(
+	automatic *certificatesv1.CertificateRotationVerificationMethod_AutomaticVerification,
+) (time.Duration, error) {
+	if automatic.GetTimeout() == nil {
+		return DefaultRotationVerificationTimeout, nil
+	}
+	timeout, err := ptypes.Duration(automatic.GetTimeout())
+	if err != nil {
+		return 0, eris.Wrap(err, "invalid automatic rotation verification timeout")
+	}
+	if timeout <= 0 {
+		return 0, eris.Errorf("automatic rotation verification timeout must be positive, got %v", timeout)
+	}
+	return timeout, nil
+}
+
 func getErrStrings(errs []error) []string {
 	var errStrings []string
 	for _, err := range errs {
@@ -0,0 +1,212 @@
+package apply
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/apply/configtarget"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/ezkube"
+)
+
+// Drive the certificate rotation of VirtualMeshes which use the automatic verification method.
+// The steps of a rotation are recorded as conditions on the VirtualMesh status, and the mTLS translator propagates the current step
+// to the IssuedCertificate of each Mesh, whose workloads are then verified by the Gloo Mesh agent.
+// A rotation is started when the VirtualMesh's shared trust differs from the deployed shared trust.
+// Each recorded step is followed by a VERIFYING condition. Once the Gloo Mesh agent has verified the workloads of all Meshes
+// in the VirtualMesh, the rotation step is marked VERIFIED and the rotation advances to the next step, until it is FINISHED.
+// If verification fails for any Mesh or does not complete within the timeout, the rotation is marked ROLLING_BACK,
+// the certificates issued by the previous CA are verified, and the rotation is marked FAILED.
+// A failed rotation is not retried until the VirtualMesh's shared trust is changed, and only the conditions of the
+// current rotation are retained.
+// A single condition is recorded per reconcile, so each step is propagated to the IssuedCertificates before it is verified.
+func advanceCertificateRotations(input input.LocalSnapshot) {
+	for _, virtualMesh := range input.VirtualMeshes().List() {
+		automatic := virtualMesh.Spec.GetMtlsConfig().GetRotationVerificationMethod().GetAutomatic()
+		if automatic == nil {
+			continue
+		}
+		sharedTrust := virtualMesh.Spec.GetMtlsConfig().GetShared()
+		conditions := virtualMesh.Status.Conditions
+		latestState := certificatesv1.CertificateRotationState_NOT_ROTATING
+		if len(conditions) > 0 {
+			latestState = conditions[len(conditions)-1].GetState()
+		}
+
+		switch {
+		case isRotationStep(latestState):
+			appendRotationCondition(virtualMesh, certificatesv1.CertificateRotationState_VERIFYING,
+				fmt.Sprintf("waiting for the workloads of all Meshes to pick up the certificates issued for rotation state %v", latestState))
+
+		case latestState == certificatesv1.CertificateRotationState_VERIFYING:
+			meshes := input.Meshes().List(func(mesh *discoveryv1.Mesh) bool {
+				appliedVirtualMesh := mesh.Status.AppliedVirtualMesh
+				return appliedVirtualMesh == nil || !ezkube.RefsMatch(appliedVirtualMesh.Ref, virtualMesh)
+			})
+			if condition := verifyRotationStep(virtualMesh, meshes, automatic, time.Now()); condition != nil {
+				virtualMesh.Status.Conditions = append(virtualMesh.Status.Conditions, condition)
+				if condition.GetState() == certificatesv1.CertificateRotationState_FAILED {
+					virtualMesh.Status.FailedSharedTrust = sharedTrust
+				}
+			}
+
+		case latestState == certificatesv1.CertificateRotationState_VERIFIED:
+			verifiedStep := currentRotationStep(conditions)
+			nextState := nextRotationState(verifiedStep)
+			appendRotationCondition(virtualMesh, nextState, fmt.Sprintf("rotation state %v has been verified", verifiedStep))
+			switch nextState {
+			case certificatesv1.CertificateRotationState_FINISHED:
+				virtualMesh.Status.DeployedSharedTrust = sharedTrust
+			case certificatesv1.CertificateRotationState_FAILED:
+				virtualMesh.Status.FailedSharedTrust = sharedTrust
+			}
+
+		case latestState == certificatesv1.CertificateRotationState_ROLLING_BACK:
+			appendRotationCondition(virtualMesh, certificatesv1.CertificateRotationState_PREVIOUS_CA,
+				"rolling back to the previously deployed certificate authority")
+
+		case virtualMesh.Status.DeployedSharedTrust == nil:
+			// the initial shared trust is deployed without a rotation
+			virtualMesh.Status.DeployedSharedTrust = sharedTrust
+
+		case virtualMesh.Status.DeployedSharedTrust.Equal(sharedTrust):
+			// the shared trust of a failed rotation may be retried once the VirtualMesh has been reverted
+			virtualMesh.Status.FailedSharedTrust = nil
+
+		case !virtualMesh.Status.FailedSharedTrust.Equal(sharedTrust):
+			// only the conditions of the current rotation are retained
+			virtualMesh.Status.Conditions = nil
+			virtualMesh.Status.FailedSharedTrust = nil
+			appendRotationCondition(virtualMesh, certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+				"the shared trust of the VirtualMesh differs from the deployed shared trust")
+		}
+	}
+}
+
+func appendRotationCondition(
+	virtualMesh *networkingv1.VirtualMesh,
+	state certificatesv1.CertificateRotationState,
+	message string,
+) {
+	virtualMesh.Status.Conditions = append(virtualMesh.Status.Conditions, &certificatesv1.CertificateRotationCondition{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		State:     state,
+		Message:   message,
+	})
+}
+
+// returns the state which follows a verified rotation step
+func nextRotationState(verifiedStep certificatesv1.CertificateRotationState) certificatesv1.CertificateRotationState {
+	switch verifiedStep {
+	case certificatesv1.CertificateRotationState_ADDING_NEW_ROOT:
+		return certificatesv1.CertificateRotationState_PROPAGATING_NEW_INTERMEDIATE
+	case certificatesv1.CertificateRotationState_PROPAGATING_NEW_INTERMEDIATE:
+		return certificatesv1.CertificateRotationState_DELETING_OLD_ROOT
+	case certificatesv1.CertificateRotationState_DELETING_OLD_ROOT:
+		return certificatesv1.CertificateRotationState_FINISHED
+	}
+	// the rollback to the previous CA has been verified
+	return certificatesv1.CertificateRotationState_FAILED
+}
+
+// returns the condition to record for the rotation step under verification, or nil if verification is still in progress
+func verifyRotationStep(
+	virtualMesh *networkingv1.VirtualMesh,
+	meshes []*discoveryv1.Mesh,
+	automatic *certificatesv1.CertificateRotationVerificationMethod_AutomaticVerification,
+	now time.Time,
+) *certificatesv1.CertificateRotationCondition {
+	conditions := virtualMesh.Status.Conditions
+	verifyingCondition := conditions[len(conditions)-1]
+	rotationState := currentRotationStep(conditions)
+
+	var failed, pending []string
+	if len(meshes) == 0 {
+		pending = append(pending, "waiting for the VirtualMesh to be applied to its Meshes")
+	}
+	for _, mesh := range meshes {
+		issuedCertificateStatus := mesh.Spec.GetIssuedCertificateStatus()
+		verification := issuedCertificateStatus.GetRotationVerification()
+		switch {
+		case issuedCertificateStatus.GetState() != certificatesv1.IssuedCertificateStatus_FINISHED ||
+			verification == nil ||
+			verification.GetRotationState() != rotationState:
+			pending = append(pending, fmt.Sprintf("Mesh %v: waiting for the certificate for rotation state %v to be issued", sets.Key(mesh), rotationState))
+		case len(verification.GetErrors()) > 0:
+			failed = append(failed, fmt.Sprintf("Mesh %v: %v", sets.Key(mesh), strings.Join(verification.GetErrors(), ", ")))
+		case !verification.GetVerified():
+			pending = append(pending, fmt.Sprintf(
+				"Mesh %v: waiting for namespaces %v and workloads %v to pick up the issued certificates",
+				sets.Key(mesh),
+				verification.GetPendingNamespaces(),
+				verification.GetPendingWorkloads(),
+			))
+		}
+	}
+
+	// a rollback which cannot be verified fails the rotation rather than rolling back again
+	failedState := certificatesv1.CertificateRotationState_ROLLING_BACK
+	if rotationState == certificatesv1.CertificateRotationState_PREVIOUS_CA {
+		failedState = certificatesv1.CertificateRotationState_FAILED
+	}
+
+	timestamp := now.UTC().Format(time.RFC3339)
+	if len(failed) > 0 {
+		return &certificatesv1.CertificateRotationCondition{
+			Timestamp: timestamp,
+			State:     failedState,
+			Message:   fmt.Sprintf("automatic verification of rotation state %v failed", rotationState),
+			Errors:    failed,
+		}
+	}
+	if len(pending) == 0 {
+		return &certificatesv1.CertificateRotationCondition{
+			Timestamp: timestamp,
+			State:     certificatesv1.CertificateRotationState_VERIFIED,
+			Message:   fmt.Sprintf("the workloads of all Meshes have picked up the certificates issued for rotation state %v", rotationState),
+		}
+	}
+
+	// invalid timeouts are reported by the config target validator, which invalidates the VirtualMesh
+	timeout, err := configtarget.RotationVerificationTimeout(automatic)
+	if err != nil {
+		timeout = configtarget.DefaultRotationVerificationTimeout
+	}
+	verifyingSince, err := time.Parse(time.RFC3339, verifyingCondition.GetTimestamp())
+	if err != nil || now.Sub(verifyingSince) < timeout {
+		return nil
+	}
+	return &certificatesv1.CertificateRotationCondition{
+		Timestamp: timestamp,
+		State:     failedState,
+		Message:   fmt.Sprintf("automatic verification of rotation state %v did not complete within %v", rotationState, timeout),
+		Errors:    pending,
+	}
+}
+
+// returns the most recent rotation step, which is under verification or has been verified
+func currentRotationStep(conditions []*certificatesv1.CertificateRotationCondition) certificatesv1.CertificateRotationState {
+	for i := len(conditions) - 1; i >= 0; i-- {
+		if state := conditions[i].GetState(); isRotationStep(state) {
+			return state
+		}
+	}
+	return certificatesv1.CertificateRotationState_NOT_ROTATING
+}
+
+// returns true for the rotation states for which certificates are issued and verified
+func isRotationStep(state certificatesv1.CertificateRotationState) bool {
+	switch state {
+	case certificatesv1.CertificateRotationState_PREVIOUS_CA,
+		certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+		certificatesv1.CertificateRotationState_PROPAGATING_NEW_INTERMEDIATE,
+		certificatesv1.CertificateRotationState_DELETING_OLD_ROOT:
+		return true
+	}
+	return false
+}
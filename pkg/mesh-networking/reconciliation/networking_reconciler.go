@@ -158,17 +158,18 @@ func Start(
 	// this should eventually reach a steady state since Gloo Mesh performs equality checks before updating existing objects
 	remoteReconcileOptions := reconcile.Options{Verifier: remoteResourceVerifier}
 	remoteReconcileOpts := input.RemoteReconcileOptions{
-		IssuedCertificates:    remoteReconcileOptions,
-		PodBounceDirectives:   remoteReconcileOptions,
-		XdsConfigs:            remoteReconcileOptions,
-		DestinationRules:      remoteReconcileOptions,
-		EnvoyFilters:          remoteReconcileOptions,
-		Gateways:              remoteReconcileOptions,
-		ServiceEntries:        remoteReconcileOptions,
-		VirtualServices:       remoteReconcileOptions,
-		AuthorizationPolicies: remoteReconcileOptions,
-		Sidecars:              remoteReconcileOptions,
-		RateLimitConfigs:      remoteReconcileOptions,
+		IssuedCertificates:     remoteReconcileOptions,
+		PodBounceDirectives:    remoteReconcileOptions,
+		XdsConfigs:             remoteReconcileOptions,
+		DestinationRules:       remoteReconcileOptions,
+		EnvoyFilters:           remoteReconcileOptions,
+		Gateways:               remoteReconcileOptions,
+		ServiceEntries:         remoteReconcileOptions,
+		VirtualServices:        remoteReconcileOptions,
+		AuthorizationPolicies:  remoteReconcileOptions,
+		RequestAuthentications: remoteReconcileOptions,
+		Sidecars:               remoteReconcileOptions,
+		RateLimitConfigs:       remoteReconcileOptions,
 		Predicates: []predicate.Predicate{
 			skv2predicate.SimplePredicate{
 				Filter: skv2predicate.SimpleEventFilterFunc(isIgnoredConfigMap),
@@ -263,17 +264,18 @@ func (r *networkingReconciler) reconcile(obj ezkube.ResourceId) (bool, error) {
 			Verifier: r.remoteResourceVerifier,
 		}
 		userSupplied, err = r.remoteBuilder.BuildSnapshot(ctx, "mesh-networking-istio-inputs", input.RemoteBuildOptions{
-			IssuedCertificates:    resourceBuildOptions,
-			PodBounceDirectives:   resourceBuildOptions,
-			XdsConfigs:            resourceBuildOptions,
-			DestinationRules:      resourceBuildOptions,
-			EnvoyFilters:          resourceBuildOptions,
-			Gateways:              resourceBuildOptions,
-			ServiceEntries:        resourceBuildOptions,
-			VirtualServices:       resourceBuildOptions,
-			AuthorizationPolicies: resourceBuildOptions,
-			Sidecars:              resourceBuildOptions,
-			RateLimitConfigs:      resourceBuildOptions,
+			IssuedCertificates:     resourceBuildOptions,
+			PodBounceDirectives:    resourceBuildOptions,
+			XdsConfigs:             resourceBuildOptions,
+			DestinationRules:       resourceBuildOptions,
+			EnvoyFilters:           resourceBuildOptions,
+			Gateways:               resourceBuildOptions,
+			ServiceEntries:         resourceBuildOptions,
+			VirtualServices:        resourceBuildOptions,
+			AuthorizationPolicies:  resourceBuildOptions,
+			RequestAuthentications: resourceBuildOptions,
+			Sidecars:               resourceBuildOptions,
+			RateLimitConfigs:       resourceBuildOptions,
 		})
 		if err != nil {
 			// failed to read from cache; should never happen
@@ -345,15 +347,20 @@ func (r *networkingReconciler) isIgnoredSecret(obj client.Object) bool {
 
 	// Search virtual meshes for secret reference
 	for _, v := range r.lastSnapshot.VirtualMeshes().List() {
-		rootCaSecret := v.Spec.MtlsConfig.GetShared().GetRootCertificateAuthority().GetSecret()
-		// If the secret reference is nil we can break out.
-		if rootCaSecret == nil {
-			continue
+		rootCaSecrets := []*v1.ObjectRef{v.Spec.MtlsConfig.GetShared().GetRootCertificateAuthority().GetSecret()}
+		for _, meshCa := range v.Spec.MtlsConfig.GetLimited().GetMeshCertificateAuthorities() {
+			rootCaSecrets = append(rootCaSecrets, meshCa.GetSecret())
 		}
-		// If the secret is being referenced, we should handle this event
-		if rootCaSecret.GetName() == secret.Name &&
-			rootCaSecret.GetNamespace() == secret.Namespace {
-			return false
+		for _, rootCaSecret := range rootCaSecrets {
+			// If the secret reference is nil we can skip it.
+			if rootCaSecret == nil {
+				continue
+			}
+			// If the secret is being referenced, we should handle this event
+			if rootCaSecret.GetName() == secret.Name &&
+				rootCaSecret.GetNamespace() == secret.Namespace {
+				return false
+			}
 		}
 	}
 	// Check if generated secret type
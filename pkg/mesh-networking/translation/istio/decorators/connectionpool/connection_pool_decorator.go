@@ -0,0 +1,111 @@
+package connectionpool
+
+import (
+	"math"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/gogoutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "connection-pool"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
+	return NewConnectionPoolDecorator()
+}
+
+// Handles setting the ConnectionPool on a DestinationRule.
+type connectionPoolDecorator struct{}
+
+var _ decorators.TrafficPolicyDestinationRuleDecorator = &connectionPoolDecorator{}
+
+func NewConnectionPoolDecorator() *connectionPoolDecorator {
+	return &connectionPoolDecorator{}
+}
+
+func (d *connectionPoolDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *connectionPoolDecorator) ApplyTrafficPolicyToDestinationRule(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	output *networkingv1alpha3spec.DestinationRule,
+	registerField decorators.RegisterField,
+) error {
+	connectionPool, err := TranslateConnectionPool(appliedPolicy.Spec.GetPolicy().GetConnectionPool())
+	if err != nil {
+		return err
+	}
+	if connectionPool != nil {
+		if err := registerField(&output.TrafficPolicy.ConnectionPool, connectionPool); err != nil {
+			return err
+		}
+		output.TrafficPolicy.ConnectionPool = connectionPool
+	}
+	return nil
+}
+
+// TranslateConnectionPool public to be used in enterprise
+func TranslateConnectionPool(
+	connectionPool *v1.TrafficPolicySpec_Policy_ConnectionPool,
+) (*networkingv1alpha3spec.ConnectionPoolSettings, error) {
+	if connectionPool == nil {
+		return nil, nil
+	}
+
+	connectionPoolSettings := &networkingv1alpha3spec.ConnectionPoolSettings{}
+
+	if tcp := connectionPool.GetTcp(); tcp != nil {
+		maxConnections, err := toInt32("tcp.max_connections", tcp.GetMaxConnections())
+		if err != nil {
+			return nil, err
+		}
+		connectionPoolSettings.Tcp = &networkingv1alpha3spec.ConnectionPoolSettings_TCPSettings{
+			MaxConnections: maxConnections,
+			ConnectTimeout: gogoutils.DurationProtoToGogo(tcp.GetConnectTimeout()),
+		}
+	}
+
+	if http := connectionPool.GetHttp(); http != nil {
+		httpSettings := &networkingv1alpha3spec.ConnectionPoolSettings_HTTPSettings{
+			IdleTimeout: gogoutils.DurationProtoToGogo(http.GetIdleTimeout()),
+		}
+		for _, limit := range []struct {
+			name   string
+			value  uint32
+			output *int32
+		}{
+			{name: "http.max_pending_requests", value: http.GetMaxPendingRequests(), output: &httpSettings.Http1MaxPendingRequests},
+			{name: "http.max_requests", value: http.GetMaxRequests(), output: &httpSettings.Http2MaxRequests},
+			{name: "http.max_requests_per_connection", value: http.GetMaxRequestsPerConnection(), output: &httpSettings.MaxRequestsPerConnection},
+			{name: "http.max_retries", value: http.GetMaxRetries(), output: &httpSettings.MaxRetries},
+		} {
+			value, err := toInt32(limit.name, limit.value)
+			if err != nil {
+				return nil, err
+			}
+			*limit.output = value
+		}
+		connectionPoolSettings.Http = httpSettings
+	}
+
+	return connectionPoolSettings, nil
+}
+
+// Istio represents connection pool limits as int32
+func toInt32(fieldName string, value uint32) (int32, error) {
+	if value > math.MaxInt32 {
+		return 0, eris.Errorf("connection pool %s must not exceed %d", fieldName, math.MaxInt32)
+	}
+	return int32(value), nil
+}
@@ -0,0 +1,113 @@
+package connectionpool_test
+
+import (
+	"math"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/connectionpool"
+	"github.com/solo-io/go-utils/testutils"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("ConnectionPoolDecorator", func() {
+	var (
+		connectionPoolDecorator decorators.TrafficPolicyDestinationRuleDecorator
+		output                  *v1alpha3.DestinationRule
+	)
+
+	BeforeEach(func() {
+		connectionPoolDecorator = connectionpool.NewConnectionPoolDecorator()
+		output = &v1alpha3.DestinationRule{
+			TrafficPolicy: &v1alpha3.TrafficPolicy{},
+		}
+	})
+
+	It("should set connection pool settings", func() {
+		registerField := func(fieldPtr, val interface{}) error {
+			return nil
+		}
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					ConnectionPool: &v1.TrafficPolicySpec_Policy_ConnectionPool{
+						Tcp: &v1.TrafficPolicySpec_Policy_ConnectionPool_TCP{
+							MaxConnections: 100,
+							ConnectTimeout: &duration.Duration{Seconds: 5},
+						},
+						Http: &v1.TrafficPolicySpec_Policy_ConnectionPool_HTTP{
+							MaxPendingRequests:       10,
+							MaxRequests:              200,
+							MaxRequestsPerConnection: 1,
+							MaxRetries:               3,
+							IdleTimeout:              &duration.Duration{Seconds: 30},
+						},
+					},
+				},
+			},
+		}
+		expectedConnectionPool := &v1alpha3.ConnectionPoolSettings{
+			Tcp: &v1alpha3.ConnectionPoolSettings_TCPSettings{
+				MaxConnections: 100,
+				ConnectTimeout: &types.Duration{Seconds: 5},
+			},
+			Http: &v1alpha3.ConnectionPoolSettings_HTTPSettings{
+				Http1MaxPendingRequests:  10,
+				Http2MaxRequests:         200,
+				MaxRequestsPerConnection: 1,
+				MaxRetries:               3,
+				IdleTimeout:              &types.Duration{Seconds: 30},
+			},
+		}
+
+		err := connectionPoolDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.ConnectionPool).To(Equal(expectedConnectionPool))
+	})
+
+	It("should return an error if a limit cannot be represented by Istio", func() {
+		registerField := func(fieldPtr, val interface{}) error {
+			return nil
+		}
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					ConnectionPool: &v1.TrafficPolicySpec_Policy_ConnectionPool{
+						Http: &v1.TrafficPolicySpec_Policy_ConnectionPool_HTTP{
+							MaxRequests: math.MaxInt32 + 1,
+						},
+					},
+				},
+			},
+		}
+
+		err := connectionPoolDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.TrafficPolicy.ConnectionPool).To(BeNil())
+	})
+
+	It("should not set connection pool settings if error during field registration", func() {
+		testErr := eris.New("registration error")
+		registerField := func(fieldPtr, val interface{}) error {
+			return testErr
+		}
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					ConnectionPool: &v1.TrafficPolicySpec_Policy_ConnectionPool{
+						Tcp: &v1.TrafficPolicySpec_Policy_ConnectionPool_TCP{MaxConnections: 1},
+					},
+				},
+			},
+		}
+
+		err := connectionPoolDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(testutils.HaveInErrorChain(testErr))
+		Expect(output.TrafficPolicy.ConnectionPool).To(BeNil())
+	})
+})
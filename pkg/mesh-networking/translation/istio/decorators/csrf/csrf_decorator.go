@@ -0,0 +1,354 @@
+package csrf
+
+import (
+	"fmt"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_csrf_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/protoutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "csrf"
+
+	// the name of the Envoy CSRF HTTP filter
+	csrfFilterName = "envoy.filters.http.csrf"
+
+	// the name of the VirtualHost and Route field containing per filter configuration
+	typedPerFilterConfigField = "typed_per_filter_config"
+
+	defaultPercentage = float64(100)
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(params decorators.Parameters) decorators.Decorator {
+	return NewCsrfDecorator(params.ClusterDomains)
+}
+
+// handles configuring the CSRF filter on an EnvoyFilter, and naming the VirtualService routes to which a CSRF policy is scoped
+type csrfDecorator struct {
+	clusterDomains hostutils.ClusterDomainRegistry
+}
+
+var _ decorators.TrafficPolicyVirtualServiceDecorator = &csrfDecorator{}
+var _ decorators.TrafficPolicyEnvoyFilterDecorator = &csrfDecorator{}
+var _ decorators.TrafficPolicyOutboundEnvoyFilterDecorator = &csrfDecorator{}
+
+func NewCsrfDecorator(clusterDomains hostutils.ClusterDomainRegistry) *csrfDecorator {
+	return &csrfDecorator{
+		clusterDomains: clusterDomains,
+	}
+}
+
+func (d *csrfDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+/*
+	CSRF policies which are scoped with request matchers or source selectors apply to the VirtualService route translated
+	for those matchers, which is given a name so that the outbound EnvoyFilter can enable the CSRF filter for that route.
+*/
+func (d *csrfDecorator) ApplyTrafficPolicyToVirtualService(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	_ *discoveryv1.MeshInstallation,
+	output *networkingv1alpha3spec.HTTPRoute,
+	registerField decorators.RegisterField,
+) error {
+	csrfPolicy := appliedPolicy.Spec.GetPolicy().GetCsrf()
+	if !isEnabled(csrfPolicy) || !isRouteScoped(appliedPolicy.Spec) {
+		return nil
+	}
+
+	routeName, err := routeName(appliedPolicy.Spec)
+	if err != nil {
+		return err
+	}
+	if err := registerField(&output.Name, routeName); err != nil {
+		return err
+	}
+	output.Name = routeName
+
+	return nil
+}
+
+/*
+	The CSRF filter is inserted into the inbound HTTP filter chain of the Destination's sidecars, disabled by default.
+	The policy itself is specified in the per filter config of the inbound virtual hosts, so that it only applies to
+	requests for the Destination.
+
+	CSRF policies which are scoped with request matchers or source selectors are instead applied to the outbound EnvoyFilter,
+	as the Destination's sidecars cannot distinguish requests by route or source workload.
+*/
+func (d *csrfDecorator) ApplyTrafficPolicyToEnvoyFilter(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	csrfPolicy := appliedPolicy.Spec.GetPolicy().GetCsrf()
+	if csrfPolicy == nil || isRouteScoped(appliedPolicy.Spec) {
+		return nil
+	}
+	if destination.Spec.GetKubeService() == nil {
+		return eris.New("CSRF policies are only supported for Kubernetes service destinations")
+	}
+
+	envoyCsrfPolicy, err := translateCsrfPolicy(csrfPolicy)
+	if err != nil {
+		return err
+	}
+	if envoyCsrfPolicy == nil {
+		return nil
+	}
+	perFilterConfigStruct, err := perFilterConfig(envoyCsrfPolicy)
+	if err != nil {
+		return err
+	}
+	csrfFilterPatch, err := envoyfilterutils.InboundHttpFilterPatch(
+		csrfFilterName,
+		&envoy_extensions_filters_http_csrf_v3.CsrfPolicy{
+			FilterEnabled: runtimeFractionalPercent(0),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// only a single TrafficPolicy may configure the CSRF policy for a Destination
+	virtualHostPatch := envoyfilterutils.FindInboundVirtualHostMergePatch(output, typedPerFilterConfigField, csrfFilterName)
+	isNewPatch := virtualHostPatch == nil
+	if isNewPatch {
+		virtualHostPatch = envoyfilterutils.InboundVirtualHostMergePatch(nil)
+	}
+	if err := registerField(&virtualHostPatch.Patch.Value, perFilterConfigStruct); err != nil {
+		return err
+	}
+	virtualHostPatch.Patch.Value = perFilterConfigStruct
+
+	if isNewPatch {
+		envoyfilterutils.AppendPatchIfMissing(output, csrfFilterPatch)
+		output.ConfigPatches = append(output.ConfigPatches, virtualHostPatch)
+	}
+
+	return nil
+}
+
+/*
+	For CSRF policies which are scoped with request matchers or source selectors, the CSRF filter is inserted into the outbound
+	HTTP filter chain of every sidecar in the mesh, disabled by default. The policy itself is specified in the per filter config
+	of the outbound routes named after the policy's matchers, so that it only applies to requests matched by the policy.
+*/
+func (d *csrfDecorator) ApplyTrafficPolicyToOutboundEnvoyFilter(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	sourceMeshInstallation *discoveryv1.MeshInstallation,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	csrfPolicy := appliedPolicy.Spec.GetPolicy().GetCsrf()
+	if csrfPolicy == nil || !isRouteScoped(appliedPolicy.Spec) {
+		return nil
+	}
+	kubeService := destination.Spec.GetKubeService()
+	if kubeService == nil {
+		return eris.New("CSRF policies are only supported for Kubernetes service destinations")
+	}
+
+	envoyCsrfPolicy, err := translateCsrfPolicy(csrfPolicy)
+	if err != nil {
+		return err
+	}
+	if envoyCsrfPolicy == nil {
+		return nil
+	}
+	routeStruct, err := perRouteFilterConfig(envoyCsrfPolicy)
+	if err != nil {
+		return err
+	}
+	routeName, err := routeName(appliedPolicy.Spec)
+	if err != nil {
+		return err
+	}
+	csrfFilterPatch, err := envoyfilterutils.OutboundHttpFilterPatch(
+		csrfFilterName,
+		&envoy_extensions_filters_http_csrf_v3.CsrfPolicy{
+			FilterEnabled: runtimeFractionalPercent(0),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	// clients in meshes to which the Destination is federated address it by its global FQDN
+	sourceCluster := kubeService.GetRef().GetClusterName()
+	if sourceMeshInstallation != nil {
+		sourceCluster = sourceMeshInstallation.GetCluster()
+	}
+	destinationFQDN := d.clusterDomains.GetDestinationFQDN(sourceCluster, kubeService.GetRef())
+
+	var routePatches []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch
+	for _, port := range kubeService.GetPorts() {
+		// Istio names outbound virtual hosts `<hostname>:<port>`
+		vhostName := fmt.Sprintf("%s:%d", destinationFQDN, port.GetPort())
+		routePatch := envoyfilterutils.FindOutboundRouteMergePatch(output, vhostName, routeName, typedPerFilterConfigField, csrfFilterName)
+		if routePatch == nil {
+			routePatch = envoyfilterutils.OutboundRouteMergePatch(vhostName, routeName, nil)
+		}
+		if err := registerField(&routePatch.Patch.Value, routeStruct); err != nil {
+			return err
+		}
+		routePatches = append(routePatches, routePatch)
+	}
+
+	for _, routePatch := range routePatches {
+		// a new route patch also requires the CSRF filter in the HTTP connection manager
+		if routePatch.Patch.Value == nil {
+			envoyfilterutils.AppendPatchIfMissing(output, csrfFilterPatch)
+			output.ConfigPatches = append(output.ConfigPatches, routePatch)
+		}
+		routePatch.Patch.Value = routeStruct
+	}
+
+	return nil
+}
+
+// CSRF policies with request matchers or source selectors only apply to the requests they select
+func isRouteScoped(trafficPolicy *v1.TrafficPolicySpec) bool {
+	return len(trafficPolicy.GetHttpRequestMatchers()) > 0 || len(trafficPolicy.GetSourceSelector()) > 0
+}
+
+func isEnabled(csrfPolicy *csrf.CsrfPolicy) bool {
+	return csrfPolicy.GetFilterEnabled() || csrfPolicy.GetShadowEnabled()
+}
+
+// the name of the VirtualService route translated for the TrafficPolicy's matchers
+func routeName(trafficPolicy *v1.TrafficPolicySpec) (string, error) {
+	return routeutils.RouteName(
+		trafficpolicyutils.ConvertDeprecatedRequestMatchers(trafficPolicy.GetHttpRequestMatchers()),
+		trafficPolicy.GetSourceSelector(),
+	)
+}
+
+// returns nil if neither enforcement nor shadow mode is enabled
+func translateCsrfPolicy(
+	csrfPolicy *csrf.CsrfPolicy,
+) (*envoy_extensions_filters_http_csrf_v3.CsrfPolicy, error) {
+	if !isEnabled(csrfPolicy) {
+		return nil, nil
+	}
+
+	percentage := csrfPolicy.GetPercentage()
+	if percentage < 0 || percentage > 100 {
+		return nil, eris.Errorf("CSRF percentage %v must be between 0 and 100", percentage)
+	}
+	if percentage == 0 {
+		percentage = defaultPercentage
+	}
+
+	additionalOrigins, err := translateStringMatches(csrfPolicy.GetAdditionalOrigins())
+	if err != nil {
+		return nil, err
+	}
+
+	envoyCsrfPolicy := &envoy_extensions_filters_http_csrf_v3.CsrfPolicy{
+		AdditionalOrigins: additionalOrigins,
+	}
+	if csrfPolicy.GetFilterEnabled() {
+		envoyCsrfPolicy.FilterEnabled = runtimeFractionalPercent(percentage)
+	} else {
+		// filter_enabled is required by Envoy
+		envoyCsrfPolicy.FilterEnabled = runtimeFractionalPercent(0)
+		envoyCsrfPolicy.ShadowEnabled = runtimeFractionalPercent(percentage)
+	}
+	return envoyCsrfPolicy, nil
+}
+
+func translateStringMatches(stringMatches []*commonv1.StringMatch) ([]*envoy_type_matcher_v3.StringMatcher, error) {
+	var stringMatchers []*envoy_type_matcher_v3.StringMatcher
+	for _, stringMatch := range stringMatches {
+		stringMatcher := &envoy_type_matcher_v3.StringMatcher{
+			IgnoreCase: stringMatch.GetIgnoreCase(),
+		}
+		switch matchType := stringMatch.GetMatchType().(type) {
+		case *commonv1.StringMatch_Exact:
+			stringMatcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Exact{Exact: matchType.Exact}
+		case *commonv1.StringMatch_Prefix:
+			stringMatcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Prefix{Prefix: matchType.Prefix}
+		case *commonv1.StringMatch_Suffix:
+			stringMatcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_Suffix{Suffix: matchType.Suffix}
+		case *commonv1.StringMatch_Regex:
+			stringMatcher.MatchPattern = &envoy_type_matcher_v3.StringMatcher_SafeRegex{
+				SafeRegex: &envoy_type_matcher_v3.RegexMatcher{
+					EngineType: &envoy_type_matcher_v3.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoy_type_matcher_v3.RegexMatcher_GoogleRE2{},
+					},
+					Regex: matchType.Regex,
+				},
+			}
+		default:
+			return nil, eris.Errorf("unknown string match type %T for additional origin", matchType)
+		}
+		stringMatchers = append(stringMatchers, stringMatcher)
+	}
+	return stringMatchers, nil
+}
+
+// construct the virtual host patch value which sets the per filter config for the CSRF filter
+func perFilterConfig(
+	envoyCsrfPolicy *envoy_extensions_filters_http_csrf_v3.CsrfPolicy,
+) (*types.Struct, error) {
+	csrfPolicyAny, err := protoutils.MessageToAnyWithError(envoyCsrfPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return protoutils.GolangMessageToGogoStruct(&envoy_config_route_v3.VirtualHost{
+		TypedPerFilterConfig: map[string]*any.Any{
+			csrfFilterName: csrfPolicyAny,
+		},
+	})
+}
+
+// construct the route patch value which sets the per filter config for the CSRF filter
+func perRouteFilterConfig(
+	envoyCsrfPolicy *envoy_extensions_filters_http_csrf_v3.CsrfPolicy,
+) (*types.Struct, error) {
+	csrfPolicyAny, err := protoutils.MessageToAnyWithError(envoyCsrfPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return protoutils.GolangMessageToGogoStruct(&envoy_config_route_v3.Route{
+		TypedPerFilterConfig: map[string]*any.Any{
+			csrfFilterName: csrfPolicyAny,
+		},
+	})
+}
+
+// percentage is converted to a fraction of a million to preserve its precision
+func runtimeFractionalPercent(percentage float64) *envoy_config_core_v3.RuntimeFractionalPercent {
+	return &envoy_config_core_v3.RuntimeFractionalPercent{
+		DefaultValue: &envoy_type_v3.FractionalPercent{
+			Numerator:   uint32(percentage * 10000),
+			Denominator: envoy_type_v3.FractionalPercent_MILLION,
+		},
+	}
+}
@@ -0,0 +1,333 @@
+package csrf_test
+
+import (
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	csrfapi "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/csrf"
+	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("CsrfDecorator", func() {
+	var (
+		ctrl                      *gomock.Controller
+		mockClusterDomainRegistry *mock_hostutils.MockClusterDomainRegistry
+		csrfDecorator             decorators.TrafficPolicyEnvoyFilterDecorator
+		destination               *discoveryv1.Destination
+		output                    *v1alpha3.EnvoyFilter
+
+		expectedHttpFilterPatch = `{
+  "applyTo": "HTTP_FILTER",
+  "match": {
+    "context": "SIDECAR_INBOUND",
+    "listener": {
+      "filterChain": {
+        "filter": {
+          "name": "envoy.filters.network.http_connection_manager",
+          "subFilter": {
+            "name": "envoy.filters.http.router"
+          }
+        }
+      }
+    }
+  },
+  "patch": {
+    "operation": "INSERT_BEFORE",
+    "value": {
+      "name": "envoy.filters.http.csrf",
+      "typed_config": {
+        "@type": "type.googleapis.com/envoy.extensions.filters.http.csrf.v3.CsrfPolicy",
+        "filter_enabled": {
+          "default_value": {
+            "denominator": "MILLION"
+          }
+        }
+      }
+    }
+  }
+}`
+	)
+
+	registerField := func(fieldPtr, val interface{}) error {
+		return nil
+	}
+
+	patchToJson := func(patch *v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch) string {
+		patchJson, err := (&jsonpb.Marshaler{}).MarshalToString(patch)
+		Expect(err).NotTo(HaveOccurred())
+		return patchJson
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterDomainRegistry = mock_hostutils.NewMockClusterDomainRegistry(ctrl)
+		csrfDecorator = csrf.NewCsrfDecorator(mockClusterDomainRegistry)
+		destination = &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+		}
+		output = &v1alpha3.EnvoyFilter{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should add the CSRF filter and enforce the CSRF policy for the Destination", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Csrf: &csrfapi.CsrfPolicy{
+						FilterEnabled: true,
+						Percentage:    50,
+						AdditionalOrigins: []*commonv1.StringMatch{
+							{MatchType: &commonv1.StringMatch_Exact{Exact: "solo.io"}},
+							{MatchType: &commonv1.StringMatch_Suffix{Suffix: ".solo.io"}, IgnoreCase: true},
+						},
+					},
+				},
+			},
+		}
+		expectedVirtualHostPatch := `{
+  "applyTo": "VIRTUAL_HOST",
+  "match": {
+    "context": "SIDECAR_INBOUND",
+    "routeConfiguration": {}
+  },
+  "patch": {
+    "operation": "MERGE",
+    "value": {
+      "typed_per_filter_config": {
+        "envoy.filters.http.csrf": {
+          "@type": "type.googleapis.com/envoy.extensions.filters.http.csrf.v3.CsrfPolicy",
+          "filter_enabled": {
+            "default_value": {
+              "numerator": 500000,
+              "denominator": "MILLION"
+            }
+          },
+          "additional_origins": [
+            {
+              "exact": "solo.io"
+            },
+            {
+              "suffix": ".solo.io",
+              "ignore_case": true
+            }
+          ]
+        }
+      }
+    }
+  }
+}`
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[0])).To(MatchJSON(expectedHttpFilterPatch))
+		Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(expectedVirtualHostPatch))
+	})
+
+	It("should configure shadow mode with the default percentage", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Csrf: &csrfapi.CsrfPolicy{
+						ShadowEnabled: true,
+					},
+				},
+			},
+		}
+		expectedVirtualHostPatchValue := `{
+  "typed_per_filter_config": {
+    "envoy.filters.http.csrf": {
+      "@type": "type.googleapis.com/envoy.extensions.filters.http.csrf.v3.CsrfPolicy",
+      "filter_enabled": {
+        "default_value": {
+          "denominator": "MILLION"
+        }
+      },
+      "shadow_enabled": {
+        "default_value": {
+          "numerator": 1000000,
+          "denominator": "MILLION"
+        }
+      }
+    }
+  }
+}`
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		valueJson, err := (&jsonpb.Marshaler{}).MarshalToString(output.ConfigPatches[1].Patch.Value)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(valueJson).To(MatchJSON(expectedVirtualHostPatchValue))
+	})
+
+	It("should not add the CSRF filter twice", func() {
+		rateLimitPatch := &v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch{ApplyTo: v1alpha3.EnvoyFilter_HTTP_FILTER}
+		output.ConfigPatches = []*v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch{rateLimitPatch}
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Csrf: &csrfapi.CsrfPolicy{FilterEnabled: true},
+				},
+			},
+		}
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(3))
+
+		// a second policy updates the existing virtual host patch
+		err = csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(3))
+		Expect(output.ConfigPatches[0]).To(Equal(rateLimitPatch))
+		Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(expectedHttpFilterPatch))
+	})
+
+	It("should not decorate if the CSRF policy is neither enabled nor shadowed", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Csrf: &csrfapi.CsrfPolicy{Percentage: 50},
+				},
+			},
+		}
+
+		err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	Context("policies scoped with request matchers", func() {
+		var (
+			appliedPolicy     *v1.AppliedTrafficPolicy
+			expectedRouteName string
+		)
+
+		BeforeEach(func() {
+			appliedPolicy = &v1.AppliedTrafficPolicy{
+				Spec: &v1.TrafficPolicySpec{
+					HttpRequestMatchers: []*v1.DeprecatedHttpMatcher{
+						{PathSpecifier: &v1.DeprecatedHttpMatcher_Prefix{Prefix: "/login"}},
+					},
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Csrf: &csrfapi.CsrfPolicy{FilterEnabled: true},
+					},
+				},
+			}
+			destination = &discoveryv1.Destination{
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{
+						KubeService: &discoveryv1.DestinationSpec_KubeService{
+							Ref: &skv2corev1.ClusterObjectRef{
+								Name:        "reviews",
+								Namespace:   "bookinfo",
+								ClusterName: "cluster",
+							},
+							Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+								{Port: 9080, Name: "http"},
+							},
+						},
+					},
+				},
+			}
+			var err error
+			expectedRouteName, err = routeutils.RouteName(
+				trafficpolicyutils.ConvertDeprecatedRequestMatchers(appliedPolicy.Spec.HttpRequestMatchers),
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not patch the inbound virtual hosts of the Destination", func() {
+			err := csrfDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(BeEmpty())
+		})
+
+		It("should name the VirtualService route translated for the request matchers", func() {
+			var virtualServiceDecorator decorators.TrafficPolicyVirtualServiceDecorator = csrf.NewCsrfDecorator(mockClusterDomainRegistry)
+			route := &v1alpha3.HTTPRoute{}
+
+			err := virtualServiceDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, destination, nil, route, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(route.Name).To(Equal(expectedRouteName))
+		})
+
+		It("should enforce the CSRF policy on the outbound routes selected by the request matchers", func() {
+			var outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator = csrf.NewCsrfDecorator(mockClusterDomainRegistry)
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN("cluster", destination.Spec.GetKubeService().Ref).
+				Return("reviews.bookinfo.svc.cluster.local").
+				Times(2)
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(HaveLen(2))
+
+			filterPatch := output.ConfigPatches[0]
+			Expect(filterPatch.ApplyTo).To(Equal(v1alpha3.EnvoyFilter_HTTP_FILTER))
+			Expect(filterPatch.Match.Context).To(Equal(v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND))
+
+			routePatch := output.ConfigPatches[1]
+			Expect(routePatch.ApplyTo).To(Equal(v1alpha3.EnvoyFilter_HTTP_ROUTE))
+			Expect(routePatch.Match.Context).To(Equal(v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND))
+			Expect(routePatch.Match.GetRouteConfiguration().GetVhost().GetName()).To(Equal("reviews.bookinfo.svc.cluster.local:9080"))
+			Expect(routePatch.Match.GetRouteConfiguration().GetVhost().GetRoute().GetName()).To(Equal(expectedRouteName))
+			Expect(routePatch.Patch.Operation).To(Equal(v1alpha3.EnvoyFilter_Patch_MERGE))
+			Expect(routePatch.Patch.Value.Fields["typed_per_filter_config"].GetStructValue().Fields).To(HaveKey("envoy.filters.http.csrf"))
+
+			// a second policy with the same request matchers updates the existing route patch
+			err = outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(HaveLen(2))
+		})
+
+		It("should not patch the outbound routes for policies without request matchers", func() {
+			var outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator = csrf.NewCsrfDecorator(mockClusterDomainRegistry)
+			appliedPolicy.Spec.HttpRequestMatchers = nil
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(BeEmpty())
+		})
+
+		It("should return an error for external services", func() {
+			var outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator = csrf.NewCsrfDecorator(mockClusterDomainRegistry)
+			destination.Spec.Type = &discoveryv1.DestinationSpec_ExternalService_{
+				ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+					Name:  "external-api",
+					Hosts: []string{"api.example.com"},
+				},
+			}
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, output, registerField)
+			Expect(err).To(MatchError("CSRF policies are only supported for Kubernetes service destinations"))
+			Expect(output.ConfigPatches).To(BeEmpty())
+		})
+	})
+})
@@ -110,3 +110,42 @@ type TrafficPolicyVirtualServiceDecorator interface {
 		registerField RegisterField,
 	) error
 }
+
+/*
+	A TrafficPolicyEnvoyFilterDecorator modifies the EnvoyFilter based on a TrafficPolicy which applies to the Destination.
+
+	The EnvoyFilter selects the Destination's backing workloads, so patches are applied to the inbound
+	(SIDECAR_INBOUND) configuration of the Destination's sidecars.
+*/
+type TrafficPolicyEnvoyFilterDecorator interface {
+	Decorator
+
+	ApplyTrafficPolicyToEnvoyFilter(
+		appliedPolicy *networkingv1.AppliedTrafficPolicy,
+		destination *discoveryv1.Destination,
+		output *networkingv1alpha3spec.EnvoyFilter,
+		registerField RegisterField,
+	) error
+}
+
+/*
+	A TrafficPolicyOutboundEnvoyFilterDecorator modifies the outbound EnvoyFilter based on a TrafficPolicy which applies to the Destination.
+
+	The outbound EnvoyFilter is output to the root namespace of a mesh and selects no workloads,
+	so patches are applied to the outbound (SIDECAR_OUTBOUND) configuration of every sidecar in the mesh.
+
+	If sourceMeshInstallation is specified, the outbound EnvoyFilter is output to that mesh, to which the Destination is federated,
+	and patches must match the Destination's global FQDN. Otherwise, the outbound EnvoyFilter is output to the Destination's mesh
+	and patches must match the Destination's local FQDN.
+*/
+type TrafficPolicyOutboundEnvoyFilterDecorator interface {
+	Decorator
+
+	ApplyTrafficPolicyToOutboundEnvoyFilter(
+		appliedPolicy *networkingv1.AppliedTrafficPolicy,
+		destination *discoveryv1.Destination,
+		sourceMeshInstallation *discoveryv1.MeshInstallation,
+		output *networkingv1alpha3spec.EnvoyFilter,
+		registerField RegisterField,
+	) error
+}
@@ -0,0 +1,556 @@
+package extauth
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/extauth"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/protoutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "extauth"
+
+	// the time Envoy waits for a response from the external authorization server
+	defaultRequestTimeout = 2 * time.Second
+
+	// the name of the VirtualHost and Route field containing per filter configuration
+	typedPerFilterConfigField = "typed_per_filter_config"
+
+	// the context extension used by the Gloo Mesh external authorization server to look up the AuthConfig for a request
+	configIdContextExtension = "config_id"
+
+	grpcProtocol = "grpc"
+	httpProtocol = "http"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(params decorators.Parameters) decorators.Decorator {
+	return NewExtAuthDecorator(params.ClusterDomains, params.Snapshot.Destinations())
+}
+
+// handles configuring external authorization on an EnvoyFilter, and naming the VirtualService routes to which an extauth policy is scoped
+type extAuthDecorator struct {
+	clusterDomains hostutils.ClusterDomainRegistry
+	destinations   discoveryv1sets.DestinationSet
+}
+
+var _ decorators.TrafficPolicyVirtualServiceDecorator = &extAuthDecorator{}
+var _ decorators.TrafficPolicyEnvoyFilterDecorator = &extAuthDecorator{}
+var _ decorators.TrafficPolicyOutboundEnvoyFilterDecorator = &extAuthDecorator{}
+
+func NewExtAuthDecorator(
+	clusterDomains hostutils.ClusterDomainRegistry,
+	destinations discoveryv1sets.DestinationSet,
+) *extAuthDecorator {
+	return &extAuthDecorator{
+		clusterDomains: clusterDomains,
+		destinations:   destinations,
+	}
+}
+
+func (d *extAuthDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+/*
+	Extauth policies which are scoped with request matchers or source selectors apply to the VirtualService route translated
+	for those matchers, which is given a name so that the outbound EnvoyFilter can enable the ext_authz filter for that route.
+*/
+func (d *extAuthDecorator) ApplyTrafficPolicyToVirtualService(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	_ *discoveryv1.MeshInstallation,
+	output *networkingv1alpha3spec.HTTPRoute,
+	registerField decorators.RegisterField,
+) error {
+	routeExtauth := appliedPolicy.Spec.GetPolicy().GetExtauth()
+	if routeExtauth == nil || routeExtauth.GetDisable() || !isRouteScoped(appliedPolicy.Spec) {
+		return nil
+	}
+
+	routeName, err := routeName(appliedPolicy.Spec)
+	if err != nil {
+		return err
+	}
+	if err := registerField(&output.Name, routeName); err != nil {
+		return err
+	}
+	output.Name = routeName
+
+	return nil
+}
+
+/*
+	The ext_authz filter, which specifies the external authorization server, is inserted into the inbound HTTP filter
+	chain of the Destination's sidecars. The check settings (or disabling of the filter) are specified in the
+	per filter config of the inbound virtual hosts.
+
+	Extauth policies which are scoped with request matchers or source selectors are instead applied to the outbound EnvoyFilter,
+	as the Destination's sidecars cannot distinguish requests by route or source workload.
+*/
+func (d *extAuthDecorator) ApplyTrafficPolicyToEnvoyFilter(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	routeExtauth := appliedPolicy.Spec.GetPolicy().GetExtauth()
+	if routeExtauth == nil || isRouteScoped(appliedPolicy.Spec) {
+		return nil
+	}
+	if destination.Spec.GetKubeService() == nil {
+		return eris.New("extauth is only supported for Kubernetes service destinations")
+	}
+
+	clusterName := destination.Spec.GetKubeService().GetRef().GetClusterName()
+	extAuthz, extAuthzPerRoute, err := d.translateExtauth(routeExtauth, appliedPolicy.GetRef().GetNamespace(), clusterName)
+	if err != nil {
+		return err
+	}
+	if extAuthzPerRoute == nil {
+		return nil
+	}
+
+	perFilterConfigStruct, err := perFilterConfig(extAuthzPerRoute)
+	if err != nil {
+		return err
+	}
+
+	// only a single TrafficPolicy may configure extauth for a Destination
+	virtualHostPatch := envoyfilterutils.FindInboundVirtualHostMergePatch(output, typedPerFilterConfigField, wellknown.HTTPExternalAuthorization)
+	isNewVirtualHostPatch := virtualHostPatch == nil
+	if isNewVirtualHostPatch {
+		virtualHostPatch = envoyfilterutils.InboundVirtualHostMergePatch(nil)
+	}
+	if err := registerField(&virtualHostPatch.Patch.Value, perFilterConfigStruct); err != nil {
+		return err
+	}
+
+	var (
+		filterPatch      *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch
+		filterValue      *types.Struct
+		isNewFilterPatch bool
+	)
+	if extAuthz != nil {
+		newFilterPatch, err := envoyfilterutils.InboundHttpFilterPatch(wellknown.HTTPExternalAuthorization, extAuthz)
+		if err != nil {
+			return err
+		}
+		filterValue = newFilterPatch.Patch.Value
+
+		filterPatch = envoyfilterutils.FindInboundHttpFilterPatch(output, wellknown.HTTPExternalAuthorization)
+		isNewFilterPatch = filterPatch == nil
+		if isNewFilterPatch {
+			filterPatch = newFilterPatch
+			filterPatch.Patch.Value = nil
+		}
+		// the external authorization server is shared by all requests to the Destination
+		if err := registerField(&filterPatch.Patch.Value, filterValue); err != nil {
+			return err
+		}
+	}
+
+	virtualHostPatch.Patch.Value = perFilterConfigStruct
+	if filterPatch != nil {
+		filterPatch.Patch.Value = filterValue
+	}
+	if isNewFilterPatch {
+		output.ConfigPatches = append(output.ConfigPatches, filterPatch)
+	}
+	if isNewVirtualHostPatch {
+		output.ConfigPatches = append(output.ConfigPatches, virtualHostPatch)
+	}
+
+	return nil
+}
+
+/*
+	For extauth policies which are scoped with request matchers or source selectors, the ext_authz filter is inserted into the
+	outbound HTTP filter chain of every sidecar in the mesh, and disabled in the per filter config of every outbound virtual host.
+	The check settings are specified in the per filter config of the outbound routes named after the policy's matchers,
+	which takes precedence over the virtual host's, so that requests are only authorized if they are matched by the policy.
+*/
+func (d *extAuthDecorator) ApplyTrafficPolicyToOutboundEnvoyFilter(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	sourceMeshInstallation *discoveryv1.MeshInstallation,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	routeExtauth := appliedPolicy.Spec.GetPolicy().GetExtauth()
+	if routeExtauth == nil || !isRouteScoped(appliedPolicy.Spec) {
+		return nil
+	}
+	kubeService := destination.Spec.GetKubeService()
+	if kubeService == nil {
+		return eris.New("extauth is only supported for Kubernetes service destinations")
+	}
+	// extauth which applies to all traffic to the Destination is enforced by the Destination's sidecars
+	if routeExtauth.GetDisable() {
+		return eris.New("extauth can only be disabled for all traffic to the Destination and cannot be scoped with http request matchers or source selectors")
+	}
+
+	// clients in meshes to which the Destination is federated address it by its global FQDN
+	sourceCluster := kubeService.GetRef().GetClusterName()
+	if sourceMeshInstallation != nil {
+		sourceCluster = sourceMeshInstallation.GetCluster()
+	}
+	extAuthz, extAuthzPerRoute, err := d.translateExtauth(routeExtauth, appliedPolicy.GetRef().GetNamespace(), sourceCluster)
+	if err != nil {
+		return err
+	}
+	if extAuthzPerRoute == nil {
+		return nil
+	}
+	routeStruct, err := perRouteFilterConfig(extAuthzPerRoute)
+	if err != nil {
+		return err
+	}
+	disabledStruct, err := perFilterConfig(disabledExtAuthz())
+	if err != nil {
+		return err
+	}
+	routeName, err := routeName(appliedPolicy.Spec)
+	if err != nil {
+		return err
+	}
+
+	newFilterPatch, err := envoyfilterutils.OutboundHttpFilterPatch(wellknown.HTTPExternalAuthorization, extAuthz)
+	if err != nil {
+		return err
+	}
+	filterValue := newFilterPatch.Patch.Value
+	filterPatch := envoyfilterutils.FindOutboundHttpFilterPatch(output, wellknown.HTTPExternalAuthorization)
+	isNewFilterPatch := filterPatch == nil
+	if isNewFilterPatch {
+		filterPatch = newFilterPatch
+		filterPatch.Patch.Value = nil
+	}
+	// the external authorization server is shared by all routes to the Destination
+	if err := registerField(&filterPatch.Patch.Value, filterValue); err != nil {
+		return err
+	}
+
+	destinationFQDN := d.clusterDomains.GetDestinationFQDN(sourceCluster, kubeService.GetRef())
+
+	var routePatches []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch
+	for _, port := range kubeService.GetPorts() {
+		// Istio names outbound virtual hosts `<hostname>:<port>`
+		vhostName := fmt.Sprintf("%s:%d", destinationFQDN, port.GetPort())
+		routePatch := envoyfilterutils.FindOutboundRouteMergePatch(output, vhostName, routeName, typedPerFilterConfigField, wellknown.HTTPExternalAuthorization)
+		if routePatch == nil {
+			routePatch = envoyfilterutils.OutboundRouteMergePatch(vhostName, routeName, nil)
+		}
+		if err := registerField(&routePatch.Patch.Value, routeStruct); err != nil {
+			return err
+		}
+		routePatches = append(routePatches, routePatch)
+	}
+
+	filterPatch.Patch.Value = filterValue
+	if isNewFilterPatch {
+		output.ConfigPatches = append(output.ConfigPatches, filterPatch)
+		envoyfilterutils.AppendPatchIfMissing(output, envoyfilterutils.OutboundVirtualHostMergePatch(disabledStruct))
+	}
+	for _, routePatch := range routePatches {
+		if routePatch.Patch.Value == nil {
+			output.ConfigPatches = append(output.ConfigPatches, routePatch)
+		}
+		routePatch.Patch.Value = routeStruct
+	}
+
+	return nil
+}
+
+// extauth policies with request matchers or source selectors only apply to the requests they select
+func isRouteScoped(trafficPolicy *v1.TrafficPolicySpec) bool {
+	return len(trafficPolicy.GetHttpRequestMatchers()) > 0 || len(trafficPolicy.GetSourceSelector()) > 0
+}
+
+// the name of the VirtualService route translated for the TrafficPolicy's matchers
+func routeName(trafficPolicy *v1.TrafficPolicySpec) (string, error) {
+	return routeutils.RouteName(
+		trafficpolicyutils.ConvertDeprecatedRequestMatchers(trafficPolicy.GetHttpRequestMatchers()),
+		trafficPolicy.GetSourceSelector(),
+	)
+}
+
+// translate the extauth policy into the ext_authz filter config, which is nil if the filter is disabled, and the per route config.
+// returns nil if the policy neither disables nor enables extauth.
+// clusterName is the cluster of the sidecars which call the external authorization server.
+func (d *extAuthDecorator) translateExtauth(
+	routeExtauth *extauth.RouteExtauth,
+	policyNamespace string,
+	clusterName string,
+) (*envoy_extensions_filters_http_ext_authz_v3.ExtAuthz, *envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute, error) {
+	switch spec := routeExtauth.GetSpec().(type) {
+	case *extauth.RouteExtauth_Disable:
+		if !spec.Disable {
+			return nil, nil, nil
+		}
+		return nil, disabledExtAuthz(), nil
+	case *extauth.RouteExtauth_ConfigRef:
+		return d.defaultExtAuthz(clusterName), checkSettings(map[string]string{
+			configIdContextExtension: configId(spec.ConfigRef, policyNamespace),
+		}), nil
+	case *extauth.RouteExtauth_CustomAuth:
+		extAuthz, err := d.customExtAuthz(spec.CustomAuth, clusterName)
+		if err != nil {
+			return nil, nil, err
+		}
+		return extAuthz, checkSettings(spec.CustomAuth.GetContextExtensions()), nil
+	default:
+		return nil, nil, eris.New("extauth must specify one of disable, config_ref, or custom_auth")
+	}
+}
+
+// construct the ext_authz filter config for the Gloo Mesh external authorization server in the Destination's cluster
+func (d *extAuthDecorator) defaultExtAuthz(
+	clusterName string,
+) *envoy_extensions_filters_http_ext_authz_v3.ExtAuthz {
+	serverFQDN := d.clusterDomains.GetDestinationFQDN(clusterName, &skv2corev1.ClusterObjectRef{
+		Name:        defaults.ExtAuthServiceName,
+		Namespace:   defaults.GetPodNamespace(),
+		ClusterName: clusterName,
+	})
+
+	return &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz{
+		Services: &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_GrpcService{
+			GrpcService: grpcService(outboundClusterName(defaults.ExtAuthServicePort, serverFQDN), defaultRequestTimeout),
+		},
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+	}
+}
+
+// construct the ext_authz filter config for a custom external authorization server
+func (d *extAuthDecorator) customExtAuthz(
+	customAuth *extauth.CustomAuth,
+	clusterName string,
+) (*envoy_extensions_filters_http_ext_authz_v3.ExtAuthz, error) {
+	serverRef := customAuth.GetServerRef()
+	if serverRef == nil {
+		return nil, eris.New("custom_auth must specify the server_ref of the external authorization server")
+	}
+	serverDestination, err := destinationutils.FindDestinationForKubeService(d.destinations.List(), serverRef)
+	if err != nil {
+		return nil, eris.Wrapf(err, "invalid external authorization server")
+	}
+	protocol := grpcProtocol
+	if customAuth.GetHttpService() != nil {
+		protocol = httpProtocol
+	}
+	port, err := selectServerPort(serverDestination.Spec.GetKubeService(), customAuth.GetPort(), protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	serverFQDN := d.clusterDomains.GetDestinationFQDN(clusterName, serverRef)
+	serverClusterName := outboundClusterName(port, serverFQDN)
+
+	requestTimeout := defaultRequestTimeout
+	if customAuth.GetRequestTimeout() != nil {
+		requestTimeout, err = ptypes.Duration(customAuth.GetRequestTimeout())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	extAuthz := &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz{
+		TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+		FailureModeAllow:    customAuth.GetFailureModeAllow(),
+	}
+	if httpService := customAuth.GetHttpService(); httpService != nil {
+		extAuthz.Services = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_HttpService{
+			HttpService: translateHttpService(httpService, serverClusterName, serverFQDN, port, requestTimeout),
+		}
+	} else {
+		extAuthz.Services = &envoy_extensions_filters_http_ext_authz_v3.ExtAuthz_GrpcService{
+			GrpcService: grpcService(serverClusterName, requestTimeout),
+		}
+	}
+	return extAuthz, nil
+}
+
+// select the port of the external authorization server's service, either the specified port or the port named or
+// with the protocol of the extauth service protocol
+func selectServerPort(
+	kubeService *discoveryv1.DestinationSpec_KubeService,
+	port uint32,
+	protocol string,
+) (uint32, error) {
+	if port != 0 {
+		if !trafficpolicyutils.ContainsPort(kubeService.GetPorts(), port) {
+			return 0, eris.Errorf("specified port %d does not exist for external authorization server %v", port, sets.Key(kubeService.GetRef()))
+		}
+		return port, nil
+	}
+	for _, servicePort := range kubeService.GetPorts() {
+		if strings.EqualFold(servicePort.GetName(), protocol) || strings.EqualFold(servicePort.GetProtocol(), protocol) {
+			return servicePort.GetPort(), nil
+		}
+	}
+	if len(kubeService.GetPorts()) == 1 {
+		return kubeService.GetPorts()[0].GetPort(), nil
+	}
+	return 0, eris.Errorf("must provide port for external authorization server %v with multiple ports, none of which are named %s", sets.Key(kubeService.GetRef()), protocol)
+}
+
+func translateHttpService(
+	httpService *extauth.HttpService,
+	serverClusterName, serverFQDN string,
+	port uint32,
+	requestTimeout time.Duration,
+) *envoy_extensions_filters_http_ext_authz_v3.HttpService {
+	envoyHttpService := &envoy_extensions_filters_http_ext_authz_v3.HttpService{
+		ServerUri: &envoy_config_core_v3.HttpUri{
+			Uri: fmt.Sprintf("http://%s:%d", serverFQDN, port),
+			HttpUpstreamType: &envoy_config_core_v3.HttpUri_Cluster{
+				Cluster: serverClusterName,
+			},
+			Timeout: ptypes.DurationProto(requestTimeout),
+		},
+		PathPrefix: httpService.GetPathPrefix(),
+	}
+
+	if request := httpService.GetRequest(); request != nil {
+		authorizationRequest := &envoy_extensions_filters_http_ext_authz_v3.AuthorizationRequest{
+			AllowedHeaders: exactListStringMatcher(request.GetAllowedHeaders()),
+		}
+		// sort the headers for a deterministic output
+		var headerNames []string
+		for name := range request.GetHeadersToAdd() {
+			headerNames = append(headerNames, name)
+		}
+		sort.Strings(headerNames)
+		for _, name := range headerNames {
+			authorizationRequest.HeadersToAdd = append(authorizationRequest.HeadersToAdd, &envoy_config_core_v3.HeaderValue{
+				Key:   name,
+				Value: request.GetHeadersToAdd()[name],
+			})
+		}
+		envoyHttpService.AuthorizationRequest = authorizationRequest
+	}
+
+	if response := httpService.GetResponse(); response != nil {
+		envoyHttpService.AuthorizationResponse = &envoy_extensions_filters_http_ext_authz_v3.AuthorizationResponse{
+			AllowedUpstreamHeaders: exactListStringMatcher(response.GetAllowedUpstreamHeaders()),
+			AllowedClientHeaders:   exactListStringMatcher(response.GetAllowedClientHeaders()),
+		}
+	}
+
+	return envoyHttpService
+}
+
+func exactListStringMatcher(values []string) *envoy_type_matcher_v3.ListStringMatcher {
+	if len(values) == 0 {
+		return nil
+	}
+	listStringMatcher := &envoy_type_matcher_v3.ListStringMatcher{}
+	for _, value := range values {
+		listStringMatcher.Patterns = append(listStringMatcher.Patterns, &envoy_type_matcher_v3.StringMatcher{
+			MatchPattern: &envoy_type_matcher_v3.StringMatcher_Exact{Exact: value},
+		})
+	}
+	return listStringMatcher
+}
+
+func grpcService(clusterName string, timeout time.Duration) *envoy_config_core_v3.GrpcService {
+	return &envoy_config_core_v3.GrpcService{
+		TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+				ClusterName: clusterName,
+			},
+		},
+		Timeout: ptypes.DurationProto(timeout),
+	}
+}
+
+// the name of the cluster Istio generates for the given service hostname and port
+func outboundClusterName(port uint32, fqdn string) string {
+	return fmt.Sprintf("outbound|%d||%s", port, fqdn)
+}
+
+func disabledExtAuthz() *envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute {
+	return &envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute{
+		Override: &envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute_Disabled{
+			Disabled: true,
+		},
+	}
+}
+
+func checkSettings(contextExtensions map[string]string) *envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute {
+	return &envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute{
+		Override: &envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute_CheckSettings{
+			CheckSettings: &envoy_extensions_filters_http_ext_authz_v3.CheckSettings{
+				ContextExtensions: contextExtensions,
+			},
+		},
+	}
+}
+
+// the AuthConfig identifier, which defaults to the TrafficPolicy's namespace
+func configId(configRef *skv2corev1.ObjectRef, policyNamespace string) string {
+	namespace := configRef.GetNamespace()
+	if namespace == "" {
+		namespace = policyNamespace
+	}
+	return fmt.Sprintf("%s.%s", namespace, configRef.GetName())
+}
+
+// construct the virtual host patch value which sets the per filter config for the ext_authz filter
+func perFilterConfig(
+	extAuthzPerRoute *envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute,
+) (*types.Struct, error) {
+	extAuthzPerRouteAny, err := protoutils.MessageToAnyWithError(extAuthzPerRoute)
+	if err != nil {
+		return nil, err
+	}
+	return protoutils.GolangMessageToGogoStruct(&envoy_config_route_v3.VirtualHost{
+		TypedPerFilterConfig: map[string]*any.Any{
+			wellknown.HTTPExternalAuthorization: extAuthzPerRouteAny,
+		},
+	})
+}
+
+// construct the route patch value which sets the per filter config for the ext_authz filter
+func perRouteFilterConfig(
+	extAuthzPerRoute *envoy_extensions_filters_http_ext_authz_v3.ExtAuthzPerRoute,
+) (*types.Struct, error) {
+	extAuthzPerRouteAny, err := protoutils.MessageToAnyWithError(extAuthzPerRoute)
+	if err != nil {
+		return nil, err
+	}
+	return protoutils.GolangMessageToGogoStruct(&envoy_config_route_v3.Route{
+		TypedPerFilterConfig: map[string]*any.Any{
+			wellknown.HTTPExternalAuthorization: extAuthzPerRouteAny,
+		},
+	})
+}
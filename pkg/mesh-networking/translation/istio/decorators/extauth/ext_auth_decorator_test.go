@@ -0,0 +1,503 @@
+package extauth_test
+
+import (
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	extauthapi "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/extauth"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/extauth"
+	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"istio.io/api/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ExtAuthDecorator", func() {
+	var (
+		ctrl                      *gomock.Controller
+		mockClusterDomainRegistry *mock_hostutils.MockClusterDomainRegistry
+		extAuthDecorator          decorators.TrafficPolicyEnvoyFilterDecorator
+		output                    *v1alpha3.EnvoyFilter
+		destination               = &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+		}
+		serverRef = &skv2corev1.ClusterObjectRef{
+			Name:        "authz",
+			Namespace:   "auth",
+			ClusterName: "cluster",
+		}
+		serverDestination = &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "authz",
+				Namespace: "gloo-mesh",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: serverRef,
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{Port: 8080, Name: "http"},
+							{Port: 9000, Name: "grpc-authz", Protocol: "GRPC"},
+						},
+					},
+				},
+			},
+		}
+	)
+
+	registerField := func(fieldPtr, val interface{}) error {
+		return nil
+	}
+
+	patchToJson := func(patch *v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch) string {
+		patchJson, err := (&jsonpb.Marshaler{}).MarshalToString(patch)
+		Expect(err).NotTo(HaveOccurred())
+		return patchJson
+	}
+
+	filterPatchJson := func(typedConfig string) string {
+		return `{
+  "applyTo": "HTTP_FILTER",
+  "match": {
+    "context": "SIDECAR_INBOUND",
+    "listener": {
+      "filterChain": {
+        "filter": {
+          "name": "envoy.filters.network.http_connection_manager",
+          "subFilter": {
+            "name": "envoy.filters.http.router"
+          }
+        }
+      }
+    }
+  },
+  "patch": {
+    "operation": "INSERT_BEFORE",
+    "value": {
+      "name": "envoy.filters.http.ext_authz",
+      "typed_config": ` + typedConfig + `
+    }
+  }
+}`
+	}
+
+	virtualHostPatchJson := func(perFilterConfig string) string {
+		return `{
+  "applyTo": "VIRTUAL_HOST",
+  "match": {
+    "context": "SIDECAR_INBOUND",
+    "routeConfiguration": {}
+  },
+  "patch": {
+    "operation": "MERGE",
+    "value": {
+      "typed_per_filter_config": {
+        "envoy.filters.http.ext_authz": ` + perFilterConfig + `
+      }
+    }
+  }
+}`
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterDomainRegistry = mock_hostutils.NewMockClusterDomainRegistry(ctrl)
+		extAuthDecorator = extauth.NewExtAuthDecorator(mockClusterDomainRegistry, discoveryv1sets.NewDestinationSet(serverDestination))
+		output = &v1alpha3.EnvoyFilter{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	appliedPolicyWithExtauth := func(routeExtauth *extauthapi.RouteExtauth) *v1.AppliedTrafficPolicy {
+		return &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Extauth: routeExtauth,
+				},
+			},
+		}
+	}
+
+	It("should add the ext_authz filter for a custom gRPC authorization server", func() {
+		appliedPolicy := appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+			Spec: &extauthapi.RouteExtauth_CustomAuth{
+				CustomAuth: &extauthapi.CustomAuth{
+					ContextExtensions: map[string]string{"tenant": "bookinfo"},
+					ServerRef:         serverRef,
+					RequestTimeout:    &duration.Duration{Nanos: 500000000},
+					FailureModeAllow:  true,
+				},
+			},
+		})
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN("cluster", serverRef).
+			Return("authz.auth.svc.cluster.local")
+
+		err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[0])).To(MatchJSON(filterPatchJson(`{
+  "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz",
+  "grpc_service": {
+    "envoy_grpc": {
+      "cluster_name": "outbound|9000||authz.auth.svc.cluster.local"
+    },
+    "timeout": "0.500s"
+  },
+  "failure_mode_allow": true,
+  "transport_api_version": "V3"
+}`)))
+		Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(virtualHostPatchJson(`{
+  "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthzPerRoute",
+  "check_settings": {
+    "context_extensions": {
+      "tenant": "bookinfo"
+    }
+  }
+}`)))
+	})
+
+	It("should add the ext_authz filter for a custom HTTP authorization server", func() {
+		appliedPolicy := appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+			Spec: &extauthapi.RouteExtauth_CustomAuth{
+				CustomAuth: &extauthapi.CustomAuth{
+					ServerRef: serverRef,
+					HttpService: &extauthapi.HttpService{
+						PathPrefix: "/check",
+						Request: &extauthapi.HttpService_Request{
+							AllowedHeaders: []string{"authorization"},
+							HeadersToAdd:   map[string]string{"x-b": "b", "x-a": "a"},
+						},
+						Response: &extauthapi.HttpService_Response{
+							AllowedUpstreamHeaders: []string{"x-user"},
+						},
+					},
+				},
+			},
+		})
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN("cluster", serverRef).
+			Return("authz.auth.svc.cluster.local")
+
+		err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[0])).To(MatchJSON(filterPatchJson(`{
+  "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz",
+  "http_service": {
+    "server_uri": {
+      "uri": "http://authz.auth.svc.cluster.local:8080",
+      "cluster": "outbound|8080||authz.auth.svc.cluster.local",
+      "timeout": "2s"
+    },
+    "path_prefix": "/check",
+    "authorization_request": {
+      "allowed_headers": {
+        "patterns": [
+          {
+            "exact": "authorization"
+          }
+        ]
+      },
+      "headers_to_add": [
+        {
+          "key": "x-a",
+          "value": "a"
+        },
+        {
+          "key": "x-b",
+          "value": "b"
+        }
+      ]
+    },
+    "authorization_response": {
+      "allowed_upstream_headers": {
+        "patterns": [
+          {
+            "exact": "x-user"
+          }
+        ]
+      }
+    }
+  },
+  "transport_api_version": "V3"
+}`)))
+	})
+
+	It("should add the ext_authz filter for the Gloo Mesh authorization server when an AuthConfig is referenced", func() {
+		appliedPolicy := appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+			Spec: &extauthapi.RouteExtauth_ConfigRef{
+				ConfigRef: &skv2corev1.ObjectRef{Name: "auth-config"},
+			},
+		})
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN("cluster", &skv2corev1.ClusterObjectRef{
+				Name:        "ext-auth-service",
+				Namespace:   "gloo-mesh",
+				ClusterName: "cluster",
+			}).
+			Return("ext-auth-service.gloo-mesh.svc.cluster.local")
+
+		err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[0])).To(MatchJSON(filterPatchJson(`{
+  "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthz",
+  "grpc_service": {
+    "envoy_grpc": {
+      "cluster_name": "outbound|8083||ext-auth-service.gloo-mesh.svc.cluster.local"
+    },
+    "timeout": "2s"
+  },
+  "transport_api_version": "V3"
+}`)))
+		Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(virtualHostPatchJson(`{
+  "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthzPerRoute",
+  "check_settings": {
+    "context_extensions": {
+      "config_id": "tp-namespace.auth-config"
+    }
+  }
+}`)))
+	})
+
+	It("should disable the ext_authz filter", func() {
+		appliedPolicy := appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+			Spec: &extauthapi.RouteExtauth_Disable{Disable: true},
+		})
+
+		err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(1))
+		Expect(patchToJson(output.ConfigPatches[0])).To(MatchJSON(virtualHostPatchJson(`{
+  "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthzPerRoute",
+  "disabled": true
+}`)))
+	})
+
+	It("should return an error if the authorization server port does not exist", func() {
+		appliedPolicy := appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+			Spec: &extauthapi.RouteExtauth_CustomAuth{
+				CustomAuth: &extauthapi.CustomAuth{
+					ServerRef: serverRef,
+					Port:      1234,
+				},
+			},
+		})
+
+		err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("specified port 1234 does not exist"))
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should return an error if the authorization server is not a known Destination", func() {
+		appliedPolicy := appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+			Spec: &extauthapi.RouteExtauth_CustomAuth{
+				CustomAuth: &extauthapi.CustomAuth{
+					ServerRef: &skv2corev1.ClusterObjectRef{
+						Name:        "missing",
+						Namespace:   "auth",
+						ClusterName: "cluster",
+					},
+				},
+			},
+		})
+
+		err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	Context("policies scoped with request matchers", func() {
+		var (
+			appliedPolicy     *v1.AppliedTrafficPolicy
+			routeDestination  *discoveryv1.Destination
+			expectedRouteName string
+		)
+
+		BeforeEach(func() {
+			appliedPolicy = appliedPolicyWithExtauth(&extauthapi.RouteExtauth{
+				Spec: &extauthapi.RouteExtauth_ConfigRef{
+					ConfigRef: &skv2corev1.ObjectRef{Name: "auth-config"},
+				},
+			})
+			appliedPolicy.Spec.HttpRequestMatchers = []*v1.DeprecatedHttpMatcher{
+				{PathSpecifier: &v1.DeprecatedHttpMatcher_Prefix{Prefix: "/admin"}},
+			}
+			routeDestination = &discoveryv1.Destination{
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{
+						KubeService: &discoveryv1.DestinationSpec_KubeService{
+							Ref: destination.Spec.GetKubeService().GetRef(),
+							Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+								{Port: 9080, Name: "http"},
+							},
+						},
+					},
+				},
+			}
+			var err error
+			expectedRouteName, err = routeutils.RouteName(
+				trafficpolicyutils.ConvertDeprecatedRequestMatchers(appliedPolicy.Spec.HttpRequestMatchers),
+				nil,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not patch the inbound virtual hosts of the Destination", func() {
+			err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, routeDestination, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(BeEmpty())
+		})
+
+		It("should name the VirtualService route translated for the request matchers", func() {
+			var virtualServiceDecorator decorators.TrafficPolicyVirtualServiceDecorator = extauth.NewExtAuthDecorator(mockClusterDomainRegistry, nil)
+			route := &v1alpha3.HTTPRoute{}
+
+			err := virtualServiceDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, routeDestination, nil, route, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(route.Name).To(Equal(expectedRouteName))
+		})
+
+		It("should authorize the outbound routes selected by the request matchers", func() {
+			var outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator = extauth.NewExtAuthDecorator(mockClusterDomainRegistry, nil)
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN("cluster", gomock.Any()).
+				DoAndReturn(func(_ string, ref *skv2corev1.ClusterObjectRef) string {
+					return ref.GetName() + "." + ref.GetNamespace() + ".svc.cluster.local"
+				}).
+				Times(4)
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, routeDestination, nil, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(HaveLen(3))
+
+			filterPatch := output.ConfigPatches[0]
+			Expect(filterPatch.ApplyTo).To(Equal(v1alpha3.EnvoyFilter_HTTP_FILTER))
+			Expect(filterPatch.Match.Context).To(Equal(v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND))
+			Expect(filterPatch.Patch.Value.Fields["name"].GetStringValue()).To(Equal("envoy.filters.http.ext_authz"))
+
+			// the filter is disabled for all other outbound traffic
+			Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(`{
+  "applyTo": "VIRTUAL_HOST",
+  "match": {
+    "context": "SIDECAR_OUTBOUND",
+    "routeConfiguration": {}
+  },
+  "patch": {
+    "operation": "MERGE",
+    "value": {
+      "typed_per_filter_config": {
+        "envoy.filters.http.ext_authz": {
+          "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthzPerRoute",
+          "disabled": true
+        }
+      }
+    }
+  }
+}`))
+
+			Expect(patchToJson(output.ConfigPatches[2])).To(MatchJSON(`{
+  "applyTo": "HTTP_ROUTE",
+  "match": {
+    "context": "SIDECAR_OUTBOUND",
+    "routeConfiguration": {
+      "vhost": {
+        "name": "reviews.bookinfo.svc.cluster.local:9080",
+        "route": {
+          "name": "` + expectedRouteName + `"
+        }
+      }
+    }
+  },
+  "patch": {
+    "operation": "MERGE",
+    "value": {
+      "typed_per_filter_config": {
+        "envoy.filters.http.ext_authz": {
+          "@type": "type.googleapis.com/envoy.extensions.filters.http.ext_authz.v3.ExtAuthzPerRoute",
+          "check_settings": {
+            "context_extensions": {
+              "config_id": "tp-namespace.auth-config"
+            }
+          }
+        }
+      }
+    }
+  }
+}`))
+
+			// a second policy with the same request matchers updates the existing patches
+			err = outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, routeDestination, nil, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(HaveLen(3))
+		})
+
+		It("should apply policies scoped with source selectors to the outbound routes", func() {
+			appliedPolicy.Spec.HttpRequestMatchers = nil
+			appliedPolicy.Spec.SourceSelector = []*commonv1.WorkloadSelector{{}}
+
+			err := extAuthDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, routeDestination, output, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.ConfigPatches).To(BeEmpty())
+
+			var virtualServiceDecorator decorators.TrafficPolicyVirtualServiceDecorator = extauth.NewExtAuthDecorator(mockClusterDomainRegistry, nil)
+			route := &v1alpha3.HTTPRoute{}
+			err = virtualServiceDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, routeDestination, nil, route, registerField)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(route.Name).NotTo(BeEmpty())
+		})
+
+		It("should return an error if extauth is disabled for the request matchers", func() {
+			var outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator = extauth.NewExtAuthDecorator(mockClusterDomainRegistry, nil)
+			appliedPolicy.Spec.Policy.Extauth = &extauthapi.RouteExtauth{
+				Spec: &extauthapi.RouteExtauth_Disable{Disable: true},
+			}
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, routeDestination, nil, output, registerField)
+			Expect(err).To(MatchError(ContainSubstring("extauth can only be disabled for all traffic to the Destination")))
+			Expect(output.ConfigPatches).To(BeEmpty())
+		})
+
+		It("should return an error for external services", func() {
+			var outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator = extauth.NewExtAuthDecorator(mockClusterDomainRegistry, nil)
+			routeDestination.Spec.Type = &discoveryv1.DestinationSpec_ExternalService_{
+				ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+					Name:  "external-api",
+					Hosts: []string{"api.example.com"},
+				},
+			}
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, routeDestination, nil, output, registerField)
+			Expect(err).To(MatchError("extauth is only supported for Kubernetes service destinations"))
+			Expect(output.ConfigPatches).To(BeEmpty())
+		})
+	})
+})
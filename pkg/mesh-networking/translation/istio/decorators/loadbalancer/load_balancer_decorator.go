@@ -0,0 +1,196 @@
+package loadbalancer
+
+import (
+	"reflect"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/gogoutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "load-balancer"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
+	return NewLoadBalancerDecorator()
+}
+
+// Handles setting the LoadBalancer on a DestinationRule and its subsets.
+type loadBalancerDecorator struct{}
+
+var _ decorators.TrafficPolicyDestinationRuleDecorator = &loadBalancerDecorator{}
+
+func NewLoadBalancerDecorator() *loadBalancerDecorator {
+	return &loadBalancerDecorator{}
+}
+
+func (d *loadBalancerDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *loadBalancerDecorator) ApplyTrafficPolicyToDestinationRule(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	_ *discoveryv1.Destination,
+	output *networkingv1alpha3spec.DestinationRule,
+	registerField decorators.RegisterField,
+) error {
+	loadBalancerPolicy := appliedPolicy.Spec.GetPolicy().GetLoadBalancer()
+	if loadBalancerPolicy == nil {
+		return nil
+	}
+
+	loadBalancer, err := translatePolicy(loadBalancerPolicy.GetPolicy())
+	if err != nil {
+		return err
+	}
+
+	var subsetLoadBalancers []subsetLoadBalancer
+	for _, subsetPolicy := range loadBalancerPolicy.GetSubsets() {
+		subset := findSubset(output.GetSubsets(), subsetPolicy.GetLabels())
+		if subset == nil {
+			return eris.Errorf("no traffic shift requires a subset with labels %v", subsetPolicy.GetLabels())
+		}
+		loadBalancer, err := translatePolicy(subsetPolicy.GetPolicy())
+		if err != nil {
+			return err
+		}
+		if loadBalancer == nil {
+			return eris.Errorf("load balancer policy for subset with labels %v must specify a policy", subsetPolicy.GetLabels())
+		}
+		trafficPolicy := subset.GetTrafficPolicy()
+		if trafficPolicy == nil {
+			trafficPolicy = &networkingv1alpha3spec.TrafficPolicy{}
+		}
+		if err := registerField(&trafficPolicy.LoadBalancer, loadBalancer); err != nil {
+			return err
+		}
+		subsetLoadBalancers = append(subsetLoadBalancers, subsetLoadBalancer{
+			subset:        subset,
+			trafficPolicy: trafficPolicy,
+			loadBalancer:  loadBalancer,
+		})
+	}
+
+	if loadBalancer != nil {
+		if err := registerField(&output.TrafficPolicy.LoadBalancer, loadBalancer); err != nil {
+			return err
+		}
+		output.TrafficPolicy.LoadBalancer = loadBalancer
+	}
+	for _, subsetLoadBalancer := range subsetLoadBalancers {
+		subsetLoadBalancer.trafficPolicy.LoadBalancer = subsetLoadBalancer.loadBalancer
+		subsetLoadBalancer.subset.TrafficPolicy = subsetLoadBalancer.trafficPolicy
+	}
+
+	return nil
+}
+
+// the load balancer translated for a DestinationRule subset, assigned once every subset policy is valid
+type subsetLoadBalancer struct {
+	subset        *networkingv1alpha3spec.Subset
+	trafficPolicy *networkingv1alpha3spec.TrafficPolicy
+	loadBalancer  *networkingv1alpha3spec.LoadBalancerSettings
+}
+
+// find the DestinationRule subset, created for a traffic shift, with the given labels
+func findSubset(
+	subsets []*networkingv1alpha3spec.Subset,
+	labels map[string]string,
+) *networkingv1alpha3spec.Subset {
+	for _, subset := range subsets {
+		if reflect.DeepEqual(subset.GetLabels(), labels) {
+			return subset
+		}
+	}
+	return nil
+}
+
+// translate the load balancer policy of either the destinations or a subset. returns nil if no policy is set.
+func translatePolicy(policy interface{}) (*networkingv1alpha3spec.LoadBalancerSettings, error) {
+	switch policyType := policy.(type) {
+	case nil:
+		return nil, nil
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple:
+		return translateSimple(policyType.Simple)
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash:
+		return translateConsistentHash(policyType.ConsistentHash)
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple:
+		return translateSimple(policyType.Simple)
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash:
+		return translateConsistentHash(policyType.ConsistentHash)
+	default:
+		return nil, eris.Errorf("unknown load balancer policy type %T", policyType)
+	}
+}
+
+func translateSimple(
+	simple v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SimpleLB,
+) (*networkingv1alpha3spec.LoadBalancerSettings, error) {
+	var simpleLB networkingv1alpha3spec.LoadBalancerSettings_SimpleLB
+	switch simple {
+	case v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ROUND_ROBIN:
+		simpleLB = networkingv1alpha3spec.LoadBalancerSettings_ROUND_ROBIN
+	case v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_LEAST_REQUEST:
+		// Istio's LEAST_CONN is implemented with Envoy's least request load balancer
+		simpleLB = networkingv1alpha3spec.LoadBalancerSettings_LEAST_CONN
+	case v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_RANDOM:
+		simpleLB = networkingv1alpha3spec.LoadBalancerSettings_RANDOM
+	case v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_PASSTHROUGH:
+		simpleLB = networkingv1alpha3spec.LoadBalancerSettings_PASSTHROUGH
+	default:
+		return nil, eris.Errorf("unknown simple load balancer type %v", simple)
+	}
+	return &networkingv1alpha3spec.LoadBalancerSettings{
+		LbPolicy: &networkingv1alpha3spec.LoadBalancerSettings_Simple{
+			Simple: simpleLB,
+		},
+	}, nil
+}
+
+func translateConsistentHash(
+	consistentHash *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB,
+) (*networkingv1alpha3spec.LoadBalancerSettings, error) {
+	consistentHashLB := &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB{
+		MinimumRingSize: consistentHash.GetMinimumRingSize(),
+	}
+	switch hashKey := consistentHash.GetHashKey().(type) {
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpHeaderName:
+		consistentHashLB.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HttpHeaderName{
+			HttpHeaderName: hashKey.HttpHeaderName,
+		}
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie:
+		if hashKey.HttpCookie.GetName() == "" {
+			return nil, eris.New("consistent hash http cookie must specify a name")
+		}
+		consistentHashLB.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+			HttpCookie: &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{
+				Name: hashKey.HttpCookie.GetName(),
+				Path: hashKey.HttpCookie.GetPath(),
+				Ttl:  gogoutils.DurationProtoToGogo(hashKey.HttpCookie.GetTtl()),
+			},
+		}
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp:
+		consistentHashLB.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_UseSourceIp{
+			UseSourceIp: hashKey.UseSourceIp,
+		}
+	case *v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpQueryParameterName:
+		consistentHashLB.HashKey = &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHashLB_HttpQueryParameterName{
+			HttpQueryParameterName: hashKey.HttpQueryParameterName,
+		}
+	default:
+		return nil, eris.New("consistent hash load balancer must specify a hash key")
+	}
+	return &networkingv1alpha3spec.LoadBalancerSettings{
+		LbPolicy: &networkingv1alpha3spec.LoadBalancerSettings_ConsistentHash{
+			ConsistentHash: consistentHashLB,
+		},
+	}, nil
+}
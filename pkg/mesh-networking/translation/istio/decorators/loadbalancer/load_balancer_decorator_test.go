@@ -0,0 +1,222 @@
+package loadbalancer_test
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/loadbalancer"
+	"github.com/solo-io/go-utils/testutils"
+	"istio.io/api/networking/v1alpha3"
+)
+
+var _ = Describe("LoadBalancerDecorator", func() {
+	var (
+		loadBalancerDecorator decorators.TrafficPolicyDestinationRuleDecorator
+		output                *v1alpha3.DestinationRule
+	)
+
+	registerField := func(fieldPtr, val interface{}) error {
+		return nil
+	}
+
+	BeforeEach(func() {
+		loadBalancerDecorator = loadbalancer.NewLoadBalancerDecorator()
+		output = &v1alpha3.DestinationRule{
+			TrafficPolicy: &v1alpha3.TrafficPolicy{},
+			Subsets: []*v1alpha3.Subset{
+				{
+					Name:   "version-v1",
+					Labels: map[string]string{"version": "v1"},
+				},
+				{
+					Name:   "version-v2",
+					Labels: map[string]string{"version": "v2"},
+				},
+			},
+		}
+	})
+
+	It("should set a simple load balancer", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy{
+						Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple{
+							Simple: v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_LEAST_REQUEST,
+						},
+					},
+				},
+			},
+		}
+		expectedLoadBalancer := &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_Simple{
+				Simple: v1alpha3.LoadBalancerSettings_LEAST_CONN,
+			},
+		}
+
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(Equal(expectedLoadBalancer))
+	})
+
+	It("should set a consistent hash load balancer on the destination and a traffic shift subset", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy{
+						Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash{
+							ConsistentHash: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB{
+								HashKey: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HttpCookie{
+									HttpCookie: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_HTTPCookie{
+										Name: "session",
+										Path: "/",
+										Ttl:  &duration.Duration{Seconds: 60},
+									},
+								},
+								MinimumRingSize: 2048,
+							},
+						},
+						Subsets: []*v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy{
+							{
+								Labels: map[string]string{"version": "v2"},
+								Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_ConsistentHash{
+									ConsistentHash: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB{
+										HashKey: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB_UseSourceIp{
+											UseSourceIp: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		expectedLoadBalancer := &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HttpCookie{
+						HttpCookie: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_HTTPCookie{
+							Name: "session",
+							Path: "/",
+							Ttl:  &types.Duration{Seconds: 60},
+						},
+					},
+					MinimumRingSize: 2048,
+				},
+			},
+		}
+		expectedSubsetLoadBalancer := &v1alpha3.LoadBalancerSettings{
+			LbPolicy: &v1alpha3.LoadBalancerSettings_ConsistentHash{
+				ConsistentHash: &v1alpha3.LoadBalancerSettings_ConsistentHashLB{
+					HashKey: &v1alpha3.LoadBalancerSettings_ConsistentHashLB_UseSourceIp{
+						UseSourceIp: true,
+					},
+				},
+			},
+		}
+
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(Equal(expectedLoadBalancer))
+		Expect(output.Subsets[0].TrafficPolicy).To(BeNil())
+		Expect(output.Subsets[1].TrafficPolicy.LoadBalancer).To(Equal(expectedSubsetLoadBalancer))
+	})
+
+	It("should return an error if a subset is not required by a traffic shift", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy{
+						Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple{
+							Simple: v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_RANDOM,
+						},
+						Subsets: []*v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy{
+							{
+								Labels: map[string]string{"version": "v3"},
+								Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple{
+									Simple: v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ROUND_ROBIN,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(BeNil())
+	})
+
+	It("should not modify any subset if a later subset policy is invalid", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy{
+						Subsets: []*v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy{
+							{
+								Labels: map[string]string{"version": "v1"},
+								Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_SubsetLoadBalancerPolicy_Simple{
+									Simple: v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ROUND_ROBIN,
+								},
+							},
+							{
+								Labels: map[string]string{"version": "v2"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.Subsets[0].TrafficPolicy).To(BeNil())
+		Expect(output.Subsets[1].TrafficPolicy).To(BeNil())
+	})
+
+	It("should return an error if a consistent hash load balancer has no hash key", func() {
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy{
+						Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHash{
+							ConsistentHash: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_ConsistentHashLB{},
+						},
+					},
+				},
+			},
+		}
+
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.TrafficPolicy.LoadBalancer).To(BeNil())
+	})
+
+	It("should not set the load balancer if error during field registration", func() {
+		testErr := eris.New("registration error")
+		registerField := func(fieldPtr, val interface{}) error {
+			return testErr
+		}
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					LoadBalancer: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy{
+						Policy: &v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_Simple{
+							Simple: v1.TrafficPolicySpec_Policy_LoadBalancerPolicy_RANDOM,
+						},
+					},
+				},
+			},
+		}
+
+		err := loadBalancerDecorator.ApplyTrafficPolicyToDestinationRule(appliedPolicy, nil, output, registerField)
+		Expect(err).To(testutils.HaveInErrorChain(testErr))
+		Expect(output.TrafficPolicy.LoadBalancer).To(BeNil())
+	})
+})
@@ -0,0 +1,175 @@
+package ratelimit
+
+import (
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	envoy_type_metadata_v3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/rotisserie/eris"
+	solov1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+)
+
+const (
+	// The descriptor value prepended to the descriptors generated from set actions.
+	// The rate limit server uses this entry to distinguish set descriptors from tree descriptors.
+	setDescriptorValue = "solo.setDescriptor.uniqueValue"
+)
+
+// convert the rate limit actions into their Envoy equivalent, one Envoy RateLimit per list of actions or set actions.
+func translateRateLimitActions(rateLimitActions []*solov1alpha1.RateLimitActions) ([]*envoy_config_route_v3.RateLimit, error) {
+	var rateLimits []*envoy_config_route_v3.RateLimit
+	for _, rateLimitAction := range rateLimitActions {
+		if len(rateLimitAction.GetActions()) > 0 {
+			actions, err := translateActions(rateLimitAction.GetActions())
+			if err != nil {
+				return nil, err
+			}
+			rateLimits = append(rateLimits, &envoy_config_route_v3.RateLimit{Actions: actions})
+		}
+		if len(rateLimitAction.GetSetActions()) > 0 {
+			actions, err := translateActions(rateLimitAction.GetSetActions())
+			if err != nil {
+				return nil, err
+			}
+			setDescriptorAction := &envoy_config_route_v3.RateLimit_Action{
+				ActionSpecifier: &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+					GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+						DescriptorValue: setDescriptorValue,
+					},
+				},
+			}
+			rateLimits = append(rateLimits, &envoy_config_route_v3.RateLimit{
+				Actions: append([]*envoy_config_route_v3.RateLimit_Action{setDescriptorAction}, actions...),
+			})
+		}
+	}
+	return rateLimits, nil
+}
+
+func translateActions(actions []*solov1alpha1.Action) ([]*envoy_config_route_v3.RateLimit_Action, error) {
+	var envoyActions []*envoy_config_route_v3.RateLimit_Action
+	for _, action := range actions {
+		envoyAction, err := translateAction(action)
+		if err != nil {
+			return nil, err
+		}
+		envoyActions = append(envoyActions, envoyAction)
+	}
+	return envoyActions, nil
+}
+
+func translateAction(action *solov1alpha1.Action) (*envoy_config_route_v3.RateLimit_Action, error) {
+	envoyAction := &envoy_config_route_v3.RateLimit_Action{}
+	switch specifier := action.GetActionSpecifier().(type) {
+	case *solov1alpha1.Action_SourceCluster_:
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_SourceCluster_{
+			SourceCluster: &envoy_config_route_v3.RateLimit_Action_SourceCluster{},
+		}
+	case *solov1alpha1.Action_DestinationCluster_:
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_DestinationCluster_{
+			DestinationCluster: &envoy_config_route_v3.RateLimit_Action_DestinationCluster{},
+		}
+	case *solov1alpha1.Action_RequestHeaders_:
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RequestHeaders_{
+			RequestHeaders: &envoy_config_route_v3.RateLimit_Action_RequestHeaders{
+				HeaderName:    specifier.RequestHeaders.GetHeaderName(),
+				DescriptorKey: specifier.RequestHeaders.GetDescriptorKey(),
+			},
+		}
+	case *solov1alpha1.Action_RemoteAddress_:
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_RemoteAddress_{
+			RemoteAddress: &envoy_config_route_v3.RateLimit_Action_RemoteAddress{},
+		}
+	case *solov1alpha1.Action_GenericKey_:
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_GenericKey_{
+			GenericKey: &envoy_config_route_v3.RateLimit_Action_GenericKey{
+				DescriptorValue: specifier.GenericKey.GetDescriptorValue(),
+			},
+		}
+	case *solov1alpha1.Action_HeaderValueMatch_:
+		headers, err := translateHeaderMatchers(specifier.HeaderValueMatch.GetHeaders())
+		if err != nil {
+			return nil, err
+		}
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch_{
+			HeaderValueMatch: &envoy_config_route_v3.RateLimit_Action_HeaderValueMatch{
+				DescriptorValue: specifier.HeaderValueMatch.GetDescriptorValue(),
+				ExpectMatch:     specifier.HeaderValueMatch.GetExpectMatch(),
+				Headers:         headers,
+			},
+		}
+	case *solov1alpha1.Action_Metadata:
+		var path []*envoy_type_metadata_v3.MetadataKey_PathSegment
+		for _, segment := range specifier.Metadata.GetMetadataKey().GetPath() {
+			path = append(path, &envoy_type_metadata_v3.MetadataKey_PathSegment{
+				Segment: &envoy_type_metadata_v3.MetadataKey_PathSegment_Key{
+					Key: segment.GetKey(),
+				},
+			})
+		}
+		envoyAction.ActionSpecifier = &envoy_config_route_v3.RateLimit_Action_Metadata{
+			Metadata: &envoy_config_route_v3.RateLimit_Action_MetaData{
+				DescriptorKey: specifier.Metadata.GetDescriptorKey(),
+				MetadataKey: &envoy_type_metadata_v3.MetadataKey{
+					Key:  specifier.Metadata.GetMetadataKey().GetKey(),
+					Path: path,
+				},
+				DefaultValue: specifier.Metadata.GetDefaultValue(),
+				Source:       envoy_config_route_v3.RateLimit_Action_MetaData_Source(specifier.Metadata.GetSource()),
+			},
+		}
+	default:
+		return nil, eris.Errorf("unknown rate limit action type %T", specifier)
+	}
+	return envoyAction, nil
+}
+
+func translateHeaderMatchers(
+	headerMatchers []*solov1alpha1.Action_HeaderValueMatch_HeaderMatcher,
+) ([]*envoy_config_route_v3.HeaderMatcher, error) {
+	var envoyHeaderMatchers []*envoy_config_route_v3.HeaderMatcher
+	for _, headerMatcher := range headerMatchers {
+		envoyHeaderMatcher := &envoy_config_route_v3.HeaderMatcher{
+			Name:        headerMatcher.GetName(),
+			InvertMatch: headerMatcher.GetInvertMatch(),
+		}
+		switch specifier := headerMatcher.GetHeaderMatchSpecifier().(type) {
+		case *solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_ExactMatch:
+			envoyHeaderMatcher.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_ExactMatch{
+				ExactMatch: specifier.ExactMatch,
+			}
+		case *solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_RegexMatch:
+			envoyHeaderMatcher.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SafeRegexMatch{
+				SafeRegexMatch: &envoy_type_matcher_v3.RegexMatcher{
+					EngineType: &envoy_type_matcher_v3.RegexMatcher_GoogleRe2{
+						GoogleRe2: &envoy_type_matcher_v3.RegexMatcher_GoogleRE2{},
+					},
+					Regex: specifier.RegexMatch,
+				},
+			}
+		case *solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_RangeMatch:
+			envoyHeaderMatcher.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_RangeMatch{
+				RangeMatch: &envoy_type_v3.Int64Range{
+					Start: specifier.RangeMatch.GetStart(),
+					End:   specifier.RangeMatch.GetEnd(),
+				},
+			}
+		case *solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_PresentMatch:
+			envoyHeaderMatcher.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PresentMatch{
+				PresentMatch: specifier.PresentMatch,
+			}
+		case *solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_PrefixMatch:
+			envoyHeaderMatcher.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_PrefixMatch{
+				PrefixMatch: specifier.PrefixMatch,
+			}
+		case *solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_SuffixMatch:
+			envoyHeaderMatcher.HeaderMatchSpecifier = &envoy_config_route_v3.HeaderMatcher_SuffixMatch{
+				SuffixMatch: specifier.SuffixMatch,
+			}
+		default:
+			return nil, eris.Errorf("unknown header matcher type %T for header %s", specifier, headerMatcher.GetName())
+		}
+		envoyHeaderMatchers = append(envoyHeaderMatchers, envoyHeaderMatcher)
+	}
+	return envoyHeaderMatchers, nil
+}
@@ -0,0 +1,229 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_config_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_extensions_filters_http_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1beta1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1/sets"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/protoutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	solov1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+const (
+	decoratorName = "rate-limit"
+
+	// the time Envoy waits for a response from the rate limit server before failing open
+	rateLimitRequestTimeout = 100 * time.Millisecond
+
+	// the name of the VirtualHost field containing the rate limit actions
+	rateLimitsField = "rate_limits"
+
+	// the name or protocol of the rate limit server's port serving the Envoy rate limit gRPC API
+	grpcProtocol = "grpc"
+)
+
+func init() {
+	decorators.Register(decoratorConstructor)
+}
+
+func decoratorConstructor(params decorators.Parameters) decorators.Decorator {
+	return NewRateLimitDecorator(params.ClusterDomains, params.Snapshot.Destinations(), params.Snapshot.RateLimitClientConfigs())
+}
+
+// handles configuring rate limiting on an EnvoyFilter
+type rateLimitDecorator struct {
+	clusterDomains         hostutils.ClusterDomainRegistry
+	destinations           discoveryv1sets.DestinationSet
+	rateLimitClientConfigs v1beta1sets.RateLimitClientConfigSet
+}
+
+var _ decorators.TrafficPolicyEnvoyFilterDecorator = &rateLimitDecorator{}
+
+func NewRateLimitDecorator(
+	clusterDomains hostutils.ClusterDomainRegistry,
+	destinations discoveryv1sets.DestinationSet,
+	rateLimitClientConfigs v1beta1sets.RateLimitClientConfigSet,
+) *rateLimitDecorator {
+	return &rateLimitDecorator{
+		clusterDomains:         clusterDomains,
+		destinations:           destinations,
+		rateLimitClientConfigs: rateLimitClientConfigs,
+	}
+}
+
+func (d *rateLimitDecorator) DecoratorName() string {
+	return decoratorName
+}
+
+func (d *rateLimitDecorator) ApplyTrafficPolicyToEnvoyFilter(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	rateLimit := appliedPolicy.Spec.GetPolicy().GetRateLimit()
+	if rateLimit == nil {
+		return nil
+	}
+
+	// rate limits are enforced by the Destination's sidecars, which cannot distinguish requests by source workload or route
+	if len(appliedPolicy.Spec.GetSourceSelector()) > 0 {
+		return eris.New("rate limits are applied to all traffic to the Destination and cannot be scoped with source selectors")
+	}
+	if len(appliedPolicy.Spec.GetHttpRequestMatchers()) > 0 {
+		return eris.New("rate limits are applied to all traffic to the Destination and cannot be scoped with http request matchers")
+	}
+	if destination.Spec.GetKubeService() == nil {
+		return eris.New("rate limits are only supported for Kubernetes service destinations")
+	}
+
+	rateLimitActions, err := d.getRateLimitActions(rateLimit, appliedPolicy.GetRef().GetNamespace())
+	if err != nil {
+		return err
+	}
+	envoyRateLimits, err := translateRateLimitActions(rateLimitActions)
+	if err != nil {
+		return err
+	}
+	if len(envoyRateLimits) == 0 {
+		return eris.New("rate limit must specify at least one action or set action")
+	}
+	rateLimitsStruct, err := protoutils.GolangMessageToGogoStruct(&envoy_config_route_v3.VirtualHost{
+		RateLimits: envoyRateLimits,
+	})
+	if err != nil {
+		return err
+	}
+	rateLimitFilterPatch, err := d.rateLimitFilterPatch(destination.Spec.GetKubeService().GetRef().GetClusterName())
+	if err != nil {
+		return err
+	}
+
+	// only a single TrafficPolicy may configure the rate limits for a Destination
+	virtualHostPatch := envoyfilterutils.FindInboundVirtualHostMergePatch(output, rateLimitsField)
+	isNewPatch := virtualHostPatch == nil
+	if isNewPatch {
+		virtualHostPatch = envoyfilterutils.InboundVirtualHostMergePatch(nil)
+	}
+	if err := registerField(&virtualHostPatch.Patch.Value, rateLimitsStruct); err != nil {
+		return err
+	}
+	virtualHostPatch.Patch.Value = rateLimitsStruct
+
+	if isNewPatch {
+		envoyfilterutils.AppendPatchIfMissing(output, rateLimitFilterPatch)
+		output.ConfigPatches = append(output.ConfigPatches, virtualHostPatch)
+	}
+
+	return nil
+}
+
+// get the client side rate limit actions, either specified inline or by reference to a RateLimitClientConfig
+func (d *rateLimitDecorator) getRateLimitActions(
+	rateLimit *ratelimit.RouteRateLimit,
+	policyNamespace string,
+) ([]*solov1alpha1.RateLimitActions, error) {
+	switch rateLimitConfig := rateLimit.GetRateLimitConfigType().(type) {
+	case *ratelimit.RouteRateLimit_Raw:
+		return rateLimitConfig.Raw.GetRateLimits(), nil
+	case *ratelimit.RouteRateLimit_RatelimitClientConfigRef:
+		clientConfigRef := &skv2corev1.ObjectRef{
+			Name:      rateLimitConfig.RatelimitClientConfigRef.GetName(),
+			Namespace: rateLimitConfig.RatelimitClientConfigRef.GetNamespace(),
+		}
+		// default to the TrafficPolicy's namespace
+		if clientConfigRef.Namespace == "" {
+			clientConfigRef.Namespace = policyNamespace
+		}
+		clientConfig, err := d.rateLimitClientConfigs.Find(clientConfigRef)
+		if err != nil {
+			return nil, eris.Wrapf(err, "finding RateLimitClientConfig %s", sets.Key(clientConfigRef))
+		}
+		return clientConfig.Spec.GetRateLimits().GetRaw().GetRateLimits(), nil
+	default:
+		return nil, eris.New("rate limit must specify either raw rate limits or a RateLimitClientConfig reference")
+	}
+}
+
+// construct the patch which adds the rate limit HTTP filter, pointing at the rate limit server in the Destination's cluster
+func (d *rateLimitDecorator) rateLimitFilterPatch(
+	clusterName string,
+) (*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch, error) {
+	rateLimitServiceRef := &skv2corev1.ClusterObjectRef{
+		Name:        defaults.RateLimitServiceName,
+		Namespace:   defaults.GetPodNamespace(),
+		ClusterName: clusterName,
+	}
+	rateLimitServiceFQDN := d.clusterDomains.GetDestinationFQDN(clusterName, rateLimitServiceRef)
+	rateLimitServicePort, err := d.getRateLimitServicePort(rateLimitServiceRef)
+	if err != nil {
+		return nil, err
+	}
+
+	return envoyfilterutils.InboundHttpFilterPatch(
+		wellknown.HTTPRateLimit,
+		&envoy_extensions_filters_http_ratelimit_v3.RateLimit{
+			Domain:          defaults.RateLimitDomain,
+			Timeout:         ptypes.DurationProto(rateLimitRequestTimeout),
+			FailureModeDeny: false,
+			RateLimitService: &envoy_config_ratelimit_v3.RateLimitServiceConfig{
+				GrpcService: &envoy_config_core_v3.GrpcService{
+					TargetSpecifier: &envoy_config_core_v3.GrpcService_EnvoyGrpc_{
+						EnvoyGrpc: &envoy_config_core_v3.GrpcService_EnvoyGrpc{
+							// the name of the cluster Istio generates for the rate limit server's Service
+							ClusterName: fmt.Sprintf("outbound|%d||%s", rateLimitServicePort, rateLimitServiceFQDN),
+						},
+					},
+				},
+				TransportApiVersion: envoy_config_core_v3.ApiVersion_V3,
+			},
+		},
+	)
+}
+
+// get the port of the rate limit server's Service serving the Envoy rate limit gRPC API,
+// falling back to the default port if the Service has not been discovered
+func (d *rateLimitDecorator) getRateLimitServicePort(
+	rateLimitServiceRef *skv2corev1.ClusterObjectRef,
+) (uint32, error) {
+	rateLimitDestination, err := destinationutils.FindDestinationForKubeService(d.destinations.List(), rateLimitServiceRef)
+	if err != nil {
+		return defaults.RateLimitServicePort, nil
+	}
+	ports := rateLimitDestination.Spec.GetKubeService().GetPorts()
+	for _, port := range ports {
+		if strings.EqualFold(port.GetName(), grpcProtocol) || strings.EqualFold(port.GetProtocol(), grpcProtocol) {
+			return port.GetPort(), nil
+		}
+	}
+	for _, port := range ports {
+		if port.GetPort() == defaults.RateLimitServicePort {
+			return port.GetPort(), nil
+		}
+	}
+	if len(ports) == 1 {
+		return ports[0].GetPort(), nil
+	}
+	return 0, eris.Errorf("rate limit server %v has multiple ports, none of which are named %s or use the default port %d",
+		sets.Key(rateLimitServiceRef), grpcProtocol, defaults.RateLimitServicePort)
+}
@@ -0,0 +1,350 @@
+package ratelimit_test
+
+import (
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
+	v1beta1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1/sets"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	ratelimitapi "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/ratelimit"
+	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	solov1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	"istio.io/api/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RateLimitDecorator", func() {
+	var (
+		ctrl                      *gomock.Controller
+		mockClusterDomainRegistry *mock_hostutils.MockClusterDomainRegistry
+		rateLimitDecorator        decorators.TrafficPolicyEnvoyFilterDecorator
+		output                    *v1alpha3.EnvoyFilter
+		destination               = &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "reviews",
+							Namespace:   "bookinfo",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+		}
+		rateLimitActions = []*solov1alpha1.RateLimitActions{
+			{
+				Actions: []*solov1alpha1.Action{
+					{
+						ActionSpecifier: &solov1alpha1.Action_RequestHeaders_{
+							RequestHeaders: &solov1alpha1.Action_RequestHeaders{
+								HeaderName:    "x-user",
+								DescriptorKey: "user",
+							},
+						},
+					},
+				},
+				SetActions: []*solov1alpha1.Action{
+					{
+						ActionSpecifier: &solov1alpha1.Action_HeaderValueMatch_{
+							HeaderValueMatch: &solov1alpha1.Action_HeaderValueMatch{
+								DescriptorValue: "beta",
+								Headers: []*solov1alpha1.Action_HeaderValueMatch_HeaderMatcher{
+									{
+										Name: "x-type",
+										HeaderMatchSpecifier: &solov1alpha1.Action_HeaderValueMatch_HeaderMatcher_PrefixMatch{
+											PrefixMatch: "beta",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		expectedHttpFilterPatch = `{
+  "applyTo": "HTTP_FILTER",
+  "match": {
+    "context": "SIDECAR_INBOUND",
+    "listener": {
+      "filterChain": {
+        "filter": {
+          "name": "envoy.filters.network.http_connection_manager",
+          "subFilter": {
+            "name": "envoy.filters.http.router"
+          }
+        }
+      }
+    }
+  },
+  "patch": {
+    "operation": "INSERT_BEFORE",
+    "value": {
+      "name": "envoy.filters.http.ratelimit",
+      "typed_config": {
+        "@type": "type.googleapis.com/envoy.extensions.filters.http.ratelimit.v3.RateLimit",
+        "domain": "solo.io",
+        "timeout": "0.100s",
+        "rate_limit_service": {
+          "grpc_service": {
+            "envoy_grpc": {
+              "cluster_name": "outbound|18081||rate-limiter.gloo-mesh.svc.cluster.local"
+            }
+          },
+          "transport_api_version": "V3"
+        }
+      }
+    }
+  }
+}`
+		expectedVirtualHostPatch = `{
+  "applyTo": "VIRTUAL_HOST",
+  "match": {
+    "context": "SIDECAR_INBOUND",
+    "routeConfiguration": {}
+  },
+  "patch": {
+    "operation": "MERGE",
+    "value": {
+      "rate_limits": [
+        {
+          "actions": [
+            {
+              "request_headers": {
+                "header_name": "x-user",
+                "descriptor_key": "user"
+              }
+            }
+          ]
+        },
+        {
+          "actions": [
+            {
+              "generic_key": {
+                "descriptor_value": "solo.setDescriptor.uniqueValue"
+              }
+            },
+            {
+              "header_value_match": {
+                "descriptor_value": "beta",
+                "headers": [
+                  {
+                    "name": "x-type",
+                    "prefix_match": "beta"
+                  }
+                ]
+              }
+            }
+          ]
+        }
+      ]
+    }
+  }
+}`
+	)
+
+	registerField := func(fieldPtr, val interface{}) error {
+		return nil
+	}
+
+	patchToJson := func(patch *v1alpha3.EnvoyFilter_EnvoyConfigObjectPatch) string {
+		patchJson, err := (&jsonpb.Marshaler{}).MarshalToString(patch)
+		Expect(err).NotTo(HaveOccurred())
+		return patchJson
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterDomainRegistry = mock_hostutils.NewMockClusterDomainRegistry(ctrl)
+		output = &v1alpha3.EnvoyFilter{}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectRateLimitServiceFQDN := func() {
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN("cluster", &skv2corev1.ClusterObjectRef{
+				Name:        "rate-limiter",
+				Namespace:   "gloo-mesh",
+				ClusterName: "cluster",
+			}).
+			Return("rate-limiter.gloo-mesh.svc.cluster.local")
+	}
+
+	It("should add the rate limit filter and raw rate limit actions", func() {
+		rateLimitDecorator = ratelimit.NewRateLimitDecorator(mockClusterDomainRegistry, discoveryv1sets.NewDestinationSet(), v1beta1sets.NewRateLimitClientConfigSet())
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimitapi.RouteRateLimit{
+						RateLimitConfigType: &ratelimitapi.RouteRateLimit_Raw{
+							Raw: &ratelimitapi.RawRateLimit{RateLimits: rateLimitActions},
+						},
+					},
+				},
+			},
+		}
+		expectRateLimitServiceFQDN()
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[0])).To(MatchJSON(expectedHttpFilterPatch))
+		Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(expectedVirtualHostPatch))
+	})
+
+	It("should point the rate limit filter at the gRPC port of the discovered rate limit server", func() {
+		rateLimitServer := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "rate-limiter",
+							Namespace:   "gloo-mesh",
+							ClusterName: "cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     9091,
+								Name:     "http-monitoring",
+								Protocol: "TCP",
+							},
+							{
+								Port:     9090,
+								Name:     "grpc",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+			},
+		}
+		rateLimitDecorator = ratelimit.NewRateLimitDecorator(
+			mockClusterDomainRegistry,
+			discoveryv1sets.NewDestinationSet(rateLimitServer),
+			v1beta1sets.NewRateLimitClientConfigSet(),
+		)
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimitapi.RouteRateLimit{
+						RateLimitConfigType: &ratelimitapi.RouteRateLimit_Raw{
+							Raw: &ratelimitapi.RawRateLimit{RateLimits: rateLimitActions},
+						},
+					},
+				},
+			},
+		}
+		expectRateLimitServiceFQDN()
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[0])).To(ContainSubstring(`"cluster_name":"outbound|9090||rate-limiter.gloo-mesh.svc.cluster.local"`))
+	})
+
+	It("should add rate limit actions from a referenced RateLimitClientConfig", func() {
+		rateLimitDecorator = ratelimit.NewRateLimitDecorator(
+			mockClusterDomainRegistry,
+			discoveryv1sets.NewDestinationSet(),
+			v1beta1sets.NewRateLimitClientConfigSet(&networkingv1beta1.RateLimitClientConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "client-config", Namespace: "tp-namespace"},
+				Spec: networkingv1beta1.RateLimitClientConfigSpec{
+					RateLimits: &ratelimitapi.RateLimitClient{
+						ConfigType: &ratelimitapi.RateLimitClient_Raw{
+							Raw: &ratelimitapi.RawRateLimit{RateLimits: rateLimitActions},
+						},
+					},
+				},
+			}),
+		)
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimitapi.RouteRateLimit{
+						RateLimitConfigType: &ratelimitapi.RouteRateLimit_RatelimitClientConfigRef{
+							// namespace defaults to the TrafficPolicy's namespace
+							RatelimitClientConfigRef: &skv2corev1.ObjectRef{Name: "client-config"},
+						},
+					},
+				},
+			},
+		}
+		expectRateLimitServiceFQDN()
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(HaveLen(2))
+		Expect(patchToJson(output.ConfigPatches[1])).To(MatchJSON(expectedVirtualHostPatch))
+	})
+
+	It("should return an error if the referenced RateLimitClientConfig does not exist", func() {
+		rateLimitDecorator = ratelimit.NewRateLimitDecorator(mockClusterDomainRegistry, discoveryv1sets.NewDestinationSet(), v1beta1sets.NewRateLimitClientConfigSet())
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimitapi.RouteRateLimit{
+						RateLimitConfigType: &ratelimitapi.RouteRateLimit_RatelimitClientConfigRef{
+							RatelimitClientConfigRef: &skv2corev1.ObjectRef{Name: "missing", Namespace: "tp-namespace"},
+						},
+					},
+				},
+			},
+		}
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("finding RateLimitClientConfig missing.tp-namespace"))
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should return an error if the TrafficPolicy is scoped to source workloads", func() {
+		rateLimitDecorator = ratelimit.NewRateLimitDecorator(mockClusterDomainRegistry, discoveryv1sets.NewDestinationSet(), v1beta1sets.NewRateLimitClientConfigSet())
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+			Spec: &v1.TrafficPolicySpec{
+				SourceSelector: []*commonv1.WorkloadSelector{
+					{KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{Namespaces: []string{"foo"}}},
+				},
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimitapi.RouteRateLimit{
+						RateLimitConfigType: &ratelimitapi.RouteRateLimit_Raw{
+							Raw: &ratelimitapi.RawRateLimit{RateLimits: rateLimitActions},
+						},
+					},
+				},
+			},
+		}
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+
+	It("should not decorate if no rate limit is specified", func() {
+		rateLimitDecorator = ratelimit.NewRateLimitDecorator(mockClusterDomainRegistry, discoveryv1sets.NewDestinationSet(), v1beta1sets.NewRateLimitClientConfigSet())
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{},
+		}
+
+		err := rateLimitDecorator.ApplyTrafficPolicyToEnvoyFilter(appliedPolicy, destination, output, registerField)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.ConfigPatches).To(BeEmpty())
+	})
+})
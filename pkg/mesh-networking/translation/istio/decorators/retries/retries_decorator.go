@@ -1,9 +1,19 @@
 package retries
 
 import (
+	"fmt"
+	"time"
+
+	envoy_config_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rotisserie/eris"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/envoyfilterutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/protoutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/trafficpolicyutils"
 	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
 )
@@ -16,18 +26,22 @@ func init() {
 	decorators.Register(decoratorConstructor)
 }
 
-func decoratorConstructor(_ decorators.Parameters) decorators.Decorator {
-	return NewRetriesDecorator()
+func decoratorConstructor(params decorators.Parameters) decorators.Decorator {
+	return NewRetriesDecorator(params.ClusterDomains)
 }
 
-// handles setting Retries on a VirtualService
+// handles setting Retries on a VirtualService, and retry backoff (which Istio does not support) on the outbound EnvoyFilter
 type retriesDecorator struct {
+	clusterDomains hostutils.ClusterDomainRegistry
 }
 
 var _ decorators.TrafficPolicyVirtualServiceDecorator = &retriesDecorator{}
+var _ decorators.TrafficPolicyOutboundEnvoyFilterDecorator = &retriesDecorator{}
 
-func NewRetriesDecorator() *retriesDecorator {
-	return &retriesDecorator{}
+func NewRetriesDecorator(clusterDomains hostutils.ClusterDomainRegistry) *retriesDecorator {
+	return &retriesDecorator{
+		clusterDomains: clusterDomains,
+	}
 }
 
 func (d *retriesDecorator) DecoratorName() string {
@@ -54,9 +68,108 @@ func (d *retriesDecorator) ApplyTrafficPolicyToVirtualService(
 	return nil
 }
 
+func (d *retriesDecorator) ApplyTrafficPolicyToOutboundEnvoyFilter(
+	appliedPolicy *v1.AppliedTrafficPolicy,
+	destination *discoveryv1.Destination,
+	sourceMeshInstallation *discoveryv1.MeshInstallation,
+	output *networkingv1alpha3spec.EnvoyFilter,
+	registerField decorators.RegisterField,
+) error {
+	backoff := appliedPolicy.Spec.GetPolicy().GetRetries().GetBackoff()
+	if backoff == nil {
+		return nil
+	}
+
+	// the backoff is merged into every route to the Destination, which cannot be narrowed to the routes selected by the TrafficPolicy
+	if len(appliedPolicy.Spec.GetSourceSelector()) > 0 {
+		return eris.New("retry backoff is applied to all requests to the Destination and cannot be scoped with source selectors")
+	}
+	if len(appliedPolicy.Spec.GetHttpRequestMatchers()) > 0 {
+		return eris.New("retry backoff is applied to all requests to the Destination and cannot be scoped with http request matchers")
+	}
+
+	routeStruct, err := translateBackoff(backoff)
+	if err != nil {
+		return err
+	}
+
+	kubeService := destination.Spec.GetKubeService()
+	if kubeService == nil {
+		return eris.New("retry backoff is only supported for Kubernetes service destinations")
+	}
+	// clients in meshes to which the Destination is federated address it by its global FQDN
+	sourceCluster := kubeService.GetRef().GetClusterName()
+	if sourceMeshInstallation != nil {
+		sourceCluster = sourceMeshInstallation.GetCluster()
+	}
+	destinationFQDN := d.clusterDomains.GetDestinationFQDN(sourceCluster, kubeService.GetRef())
+
+	var routePatches []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch
+	for _, port := range kubeService.GetPorts() {
+		// Istio names outbound virtual hosts `<hostname>:<port>`
+		vhostName := fmt.Sprintf("%s:%d", destinationFQDN, port.GetPort())
+		routePatch := envoyfilterutils.FindOutboundRouteMergePatch(output, vhostName, "", "route", "retry_policy", "retry_back_off")
+		if routePatch == nil {
+			routePatch = envoyfilterutils.OutboundRouteMergePatch(vhostName, "", nil)
+		}
+		if err := registerField(&routePatch.Patch.Value, routeStruct); err != nil {
+			return err
+		}
+		routePatches = append(routePatches, routePatch)
+	}
+
+	for _, routePatch := range routePatches {
+		if routePatch.Patch.Value == nil {
+			output.ConfigPatches = append(output.ConfigPatches, routePatch)
+		}
+		routePatch.Patch.Value = routeStruct
+	}
+
+	return nil
+}
+
 func (d *retriesDecorator) translateRetries(
 	trafficPolicy *v1.TrafficPolicySpec,
 ) (*networkingv1alpha3spec.HTTPRetry, error) {
 	retries := trafficPolicy.GetPolicy().GetRetries()
-	return trafficpolicyutils.TranslateRetries(retries), nil
+	return trafficpolicyutils.TranslateRetryPolicy(retries)
+}
+
+// translate the backoff into the partial Envoy route which is merged into the outbound routes
+func translateBackoff(
+	backoff *v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff,
+) (*types.Struct, error) {
+	baseInterval, err := ptypes.Duration(backoff.GetBaseInterval())
+	if err != nil {
+		return nil, eris.Wrap(err, "invalid retry backoff base interval")
+	}
+	if baseInterval < time.Millisecond {
+		return nil, eris.New("retry backoff base interval must be >= 1ms")
+	}
+	if backoff.GetMaxInterval() != nil {
+		maxInterval, err := ptypes.Duration(backoff.GetMaxInterval())
+		if err != nil {
+			return nil, eris.Wrap(err, "invalid retry backoff max interval")
+		}
+		if maxInterval < baseInterval {
+			return nil, eris.New("retry backoff max interval must be >= base interval")
+		}
+	}
+
+	routeStruct, err := protoutils.GolangMessageToGogoStruct(&envoy_config_route_v3.Route{
+		Action: &envoy_config_route_v3.Route_Route{
+			Route: &envoy_config_route_v3.RouteAction{
+				RetryPolicy: &envoy_config_route_v3.RetryPolicy{
+					RetryBackOff: &envoy_config_route_v3.RetryPolicy_RetryBackOff{
+						BaseInterval: backoff.GetBaseInterval(),
+						MaxInterval:  backoff.GetMaxInterval(),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, eris.Wrap(err, "converting retry backoff to struct")
+	}
+	return routeStruct, nil
 }
@@ -2,28 +2,41 @@ package retries_test
 
 import (
 	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/ptypes/duration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/rotisserie/eris"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/retries"
+	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
 	"github.com/solo-io/go-utils/testutils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"istio.io/api/networking/v1alpha3"
 )
 
 var _ = Describe("RetriesDecorator", func() {
 	var (
-		retriesDecorator decorators.TrafficPolicyVirtualServiceDecorator
-		output           *v1alpha3.HTTPRoute
+		ctrl                      *gomock.Controller
+		mockClusterDomainRegistry *mock_hostutils.MockClusterDomainRegistry
+		retriesDecorator          decorators.TrafficPolicyVirtualServiceDecorator
+		output                    *v1alpha3.HTTPRoute
 	)
 
 	BeforeEach(func() {
-		retriesDecorator = retries.NewRetriesDecorator()
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterDomainRegistry = mock_hostutils.NewMockClusterDomainRegistry(ctrl)
+		retriesDecorator = retries.NewRetriesDecorator(mockClusterDomainRegistry)
 		output = &v1alpha3.HTTPRoute{}
 	})
 
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
 	It("should set retries", func() {
 		registerField := func(fieldPtr, val interface{}) error {
 			return nil
@@ -65,4 +78,208 @@ var _ = Describe("RetriesDecorator", func() {
 		err := retriesDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, nil, nil, output, registerField)
 		Expect(err).To(testutils.HaveInErrorChain(testErr))
 	})
+
+	It("should not set retries with invalid retry conditions", func() {
+		registerField := func(fieldPtr, val interface{}) error {
+			return nil
+		}
+		appliedPolicy := &v1.AppliedTrafficPolicy{
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+						Attempts:             5,
+						RetriableStatusCodes: []uint32{700},
+					},
+				},
+			},
+		}
+		err := retriesDecorator.ApplyTrafficPolicyToVirtualService(appliedPolicy, nil, nil, output, registerField)
+		Expect(err).To(HaveOccurred())
+		Expect(output.Retries).To(BeNil())
+	})
+
+	Context("retry backoff", func() {
+		var (
+			outboundDecorator decorators.TrafficPolicyOutboundEnvoyFilterDecorator
+			envoyFilter       *v1alpha3.EnvoyFilter
+			destination       *discoveryv1.Destination
+		)
+
+		BeforeEach(func() {
+			outboundDecorator = retries.NewRetriesDecorator(mockClusterDomainRegistry)
+			envoyFilter = &v1alpha3.EnvoyFilter{}
+			destination = &discoveryv1.Destination{
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{
+						KubeService: &discoveryv1.DestinationSpec_KubeService{
+							Ref: &skv2corev1.ClusterObjectRef{
+								Name:        "reviews",
+								Namespace:   "bookinfo",
+								ClusterName: "cluster",
+							},
+							Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+								{Port: 9080, Name: "http"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should patch the outbound routes to the destination with the retry backoff", func() {
+			registerField := func(fieldPtr, val interface{}) error {
+				return nil
+			}
+			appliedPolicy := &v1.AppliedTrafficPolicy{
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Attempts: 3,
+							Backoff: &v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff{
+								BaseInterval: &duration.Duration{Nanos: 25000000},
+								MaxInterval:  &duration.Duration{Seconds: 1},
+							},
+						},
+					},
+				},
+			}
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN("cluster", destination.Spec.GetKubeService().Ref).
+				Return("reviews.bookinfo.svc.cluster.local")
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, envoyFilter, registerField)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(envoyFilter.ConfigPatches).To(HaveLen(1))
+
+			patch := envoyFilter.ConfigPatches[0]
+			Expect(patch.ApplyTo).To(Equal(v1alpha3.EnvoyFilter_HTTP_ROUTE))
+			Expect(patch.Match.Context).To(Equal(v1alpha3.EnvoyFilter_SIDECAR_OUTBOUND))
+			Expect(patch.Match.GetRouteConfiguration().GetVhost().GetName()).To(Equal("reviews.bookinfo.svc.cluster.local:9080"))
+			Expect(patch.Patch.Operation).To(Equal(v1alpha3.EnvoyFilter_Patch_MERGE))
+			backoff := patch.Patch.Value.Fields["route"].GetStructValue().
+				Fields["retry_policy"].GetStructValue().
+				Fields["retry_back_off"].GetStructValue()
+			Expect(backoff.Fields["base_interval"].GetStringValue()).To(Equal("0.025s"))
+			Expect(backoff.Fields["max_interval"].GetStringValue()).To(Equal("1s"))
+		})
+
+		It("should patch the outbound routes to the federated destination with the retry backoff", func() {
+			registerField := func(fieldPtr, val interface{}) error {
+				return nil
+			}
+			appliedPolicy := &v1.AppliedTrafficPolicy{
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Backoff: &v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff{
+								BaseInterval: &duration.Duration{Nanos: 25000000},
+							},
+						},
+					},
+				},
+			}
+			sourceMeshInstallation := &discoveryv1.MeshInstallation{
+				Namespace: "istio-system",
+				Cluster:   "remote-cluster",
+			}
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN("remote-cluster", destination.Spec.GetKubeService().Ref).
+				Return("reviews.bookinfo.svc.cluster.global")
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, sourceMeshInstallation, envoyFilter, registerField)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(envoyFilter.ConfigPatches).To(HaveLen(1))
+			Expect(envoyFilter.ConfigPatches[0].Match.GetRouteConfiguration().GetVhost().GetName()).To(Equal("reviews.bookinfo.svc.cluster.global:9080"))
+		})
+
+		It("should return an error if the TrafficPolicy is scoped to source workloads or request matchers", func() {
+			registerField := func(fieldPtr, val interface{}) error {
+				return nil
+			}
+			appliedPolicy := &v1.AppliedTrafficPolicy{
+				Spec: &v1.TrafficPolicySpec{
+					HttpRequestMatchers: []*v1.DeprecatedHttpMatcher{
+						{
+							Uri: &commonv1.StringMatch{
+								MatchType: &commonv1.StringMatch_Prefix{Prefix: "/reviews"},
+							},
+						},
+					},
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Backoff: &v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff{
+								BaseInterval: &duration.Duration{Seconds: 1},
+							},
+						},
+					},
+				},
+			}
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, envoyFilter, registerField)
+			Expect(err).To(MatchError(ContainSubstring("cannot be scoped with http request matchers")))
+			Expect(envoyFilter.ConfigPatches).To(BeEmpty())
+
+			appliedPolicy.Spec.HttpRequestMatchers = nil
+			appliedPolicy.Spec.SourceSelector = []*commonv1.WorkloadSelector{
+				{
+					KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{
+						Labels: map[string]string{"app": "productpage"},
+					},
+				},
+			}
+			err = outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, envoyFilter, registerField)
+			Expect(err).To(MatchError(ContainSubstring("cannot be scoped with source selectors")))
+			Expect(envoyFilter.ConfigPatches).To(BeEmpty())
+		})
+
+		It("should return an error if the max interval is less than the base interval", func() {
+			registerField := func(fieldPtr, val interface{}) error {
+				return nil
+			}
+			appliedPolicy := &v1.AppliedTrafficPolicy{
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Backoff: &v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff{
+								BaseInterval: &duration.Duration{Seconds: 2},
+								MaxInterval:  &duration.Duration{Seconds: 1},
+							},
+						},
+					},
+				},
+			}
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, envoyFilter, registerField)
+			Expect(err).To(HaveOccurred())
+			Expect(envoyFilter.ConfigPatches).To(BeEmpty())
+		})
+
+		It("should not patch the outbound routes if error during field registration", func() {
+			testErr := eris.New("registration error")
+			registerField := func(fieldPtr, val interface{}) error {
+				return testErr
+			}
+			appliedPolicy := &v1.AppliedTrafficPolicy{
+				Spec: &v1.TrafficPolicySpec{
+					Policy: &v1.TrafficPolicySpec_Policy{
+						Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+							Backoff: &v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff{
+								BaseInterval: &duration.Duration{Seconds: 1},
+							},
+						},
+					},
+				},
+			}
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN("cluster", destination.Spec.GetKubeService().Ref).
+				Return("reviews.bookinfo.svc.cluster.local")
+
+			err := outboundDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(appliedPolicy, destination, nil, envoyFilter, registerField)
+			Expect(err).To(testutils.HaveInErrorChain(testErr))
+			Expect(envoyFilter.ConfigPatches).To(BeEmpty())
+		})
+	})
 })
@@ -2,7 +2,9 @@ package authorizationpolicy
 
 import (
 	"fmt"
+	"net"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/rotisserie/eris"
@@ -12,6 +14,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	securityv1beta1spec "istio.io/api/security/v1beta1"
 	typesv1beta1 "istio.io/api/type/v1beta1"
@@ -30,9 +33,10 @@ var (
 	}
 )
 
-// the AuthorizationPolicy translator translates a Destination into a AuthorizationPolicy.
+// the AuthorizationPolicy translator translates a Destination into AuthorizationPolicies.
 type Translator interface {
-	// Translate translates an appropriate AuthorizationPolicy for the given Destination.
+	// Translate translates an AuthorizationPolicy for the given Destination per AccessPolicy action (ALLOW, DENY, and AUDIT),
+	// as Istio AuthorizationPolicies only support a single action.
 	// returns nil if no AuthorizationPolicy is required for the Destination (i.e. if no AuthorizationPolicy features are required, such access control).
 	//
 	// Errors caused by invalid user config will be reported using the Reporter.
@@ -42,7 +46,7 @@ type Translator interface {
 		in input.LocalSnapshot,
 		destination *discoveryv1.Destination,
 		reporter reporting.Reporter,
-	) *securityv1beta1.AuthorizationPolicy
+	) []*securityv1beta1.AuthorizationPolicy
 }
 
 type translator struct{}
@@ -55,15 +59,30 @@ func (t *translator) Translate(
 	in input.LocalSnapshot,
 	destination *discoveryv1.Destination,
 	reporter reporting.Reporter,
-) *securityv1beta1.AuthorizationPolicy {
-	kubeService := destination.Spec.GetKubeService()
-
-	if kubeService == nil {
-		// TODO(harveyxia): non kube services currently unsupported
+) []*securityv1beta1.AuthorizationPolicy {
+	var workloadSelectorLabels map[string]string
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *discoveryv1.DestinationSpec_KubeService_:
+		workloadSelectorLabels = destinationType.KubeService.GetWorkloadSelectorLabels()
+	case *discoveryv1.DestinationSpec_ExternalService_:
+		// AuthorizationPolicies are enforced by the proxies of the Destination's workloads,
+		// so they can only be translated for external services backed by mesh workloads, e.g. virtual machines
+		if destinationutils.IsMeshExternalService(destination) {
+			for _, policy := range destination.Status.AppliedAccessPolicies {
+				reporter.ReportAccessPolicyToDestination(
+					destination,
+					policy.Ref,
+					eris.Errorf("%v: AccessPolicies are unsupported for MESH_EXTERNAL destinations, which have no mesh workloads to enforce them", translatorName),
+				)
+			}
+			return nil
+		}
+		workloadSelectorLabels = destinationType.ExternalService.GetWorkloadSelectorLabels()
+	default:
 		return nil
 	}
 
-	authPolicy := t.initializeAuthorizationPolicy(destination)
+	authPolicies := map[v1.AccessPolicySpec_Action]*securityv1beta1.AuthorizationPolicy{}
 
 	for _, policy := range destination.Status.AppliedAccessPolicies {
 		rule, err := t.translateAccessPolicy(policy.Spec, in.Meshes())
@@ -71,34 +90,57 @@ func (t *translator) Translate(
 			reporter.ReportAccessPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", translatorName))
 			continue
 		}
+		action := policy.Spec.GetAction()
+		authPolicy, ok := authPolicies[action]
+		if !ok {
+			authPolicy = t.initializeAuthorizationPolicy(destination, workloadSelectorLabels, action)
+			authPolicies[action] = authPolicy
+		}
 		authPolicy.Spec.Rules = append(authPolicy.Spec.Rules, rule)
 	}
 
 	// don't output an AuthPolicy with no matching rules, which semantically denies all requests
 	// reference: https://istio.io/latest/docs/reference/config/security/authorization-policy/#AuthorizationPolicy
-	if len(authPolicy.Spec.Rules) == 0 {
-		return nil
+	var outputAuthPolicies []*securityv1beta1.AuthorizationPolicy
+	for _, action := range []v1.AccessPolicySpec_Action{
+		v1.AccessPolicySpec_ALLOW,
+		v1.AccessPolicySpec_DENY,
+		v1.AccessPolicySpec_AUDIT,
+	} {
+		if authPolicy, ok := authPolicies[action]; ok {
+			outputAuthPolicies = append(outputAuthPolicies, authPolicy)
+		}
 	}
 
-	return authPolicy
+	return outputAuthPolicies
 }
 
 func (t *translator) initializeAuthorizationPolicy(
 	destination *discoveryv1.Destination,
+	workloadSelectorLabels map[string]string,
+	action v1.AccessPolicySpec_Action,
 ) *securityv1beta1.AuthorizationPolicy {
 	meta := metautils.TranslatedObjectMeta(
-		destination.Spec.GetKubeService().Ref,
+		destinationutils.GetTranslatedObjectRef(destination),
 		destination.Annotations,
 	)
 	authPolicy := &securityv1beta1.AuthorizationPolicy{
 		ObjectMeta: meta,
 		Spec: securityv1beta1spec.AuthorizationPolicy{
 			Selector: &typesv1beta1.WorkloadSelector{
-				MatchLabels: destination.Spec.GetKubeService().WorkloadSelectorLabels,
+				MatchLabels: workloadSelectorLabels,
 			},
 			Action: securityv1beta1spec.AuthorizationPolicy_ALLOW,
 		},
 	}
+	switch action {
+	case v1.AccessPolicySpec_DENY:
+		authPolicy.Name += "-deny"
+		authPolicy.Spec.Action = securityv1beta1spec.AuthorizationPolicy_DENY
+	case v1.AccessPolicySpec_AUDIT:
+		authPolicy.Name += "-audit"
+		authPolicy.Spec.Action = securityv1beta1spec.AuthorizationPolicy_AUDIT
+	}
 	return authPolicy
 }
 
@@ -110,32 +152,146 @@ func (t *translator) translateAccessPolicy(
 	accessPolicy *v1.AccessPolicySpec,
 	meshes discoveryv1sets.MeshSet,
 ) (*securityv1beta1spec.Rule, error) {
+	conditions := accessPolicy.GetConditions()
+	if err := validateConditions(conditions); err != nil {
+		return nil, eris.Wrap(err, "invalid conditions")
+	}
+
 	var fromRules []*securityv1beta1spec.Rule_From
 	for _, sourceSelector := range accessPolicy.SourceSelector {
 		fromRule, err := t.buildSource(sourceSelector, meshes)
 		if err != nil {
 			return nil, eris.Wrap(err, "building from_rule")
 		}
+		setIpBlocks(fromRule.Source, conditions)
+		fromRules = append(fromRules, fromRule)
+	}
+	// IP blocks are properties of the source, so require a from_rule even if the AccessPolicy selects any source
+	if len(fromRules) == 0 && hasIpBlocks(conditions) {
+		fromRule := &securityv1beta1spec.Rule_From{
+			Source: &securityv1beta1spec.Source{},
+		}
+		setIpBlocks(fromRule.Source, conditions)
 		fromRules = append(fromRules, fromRule)
 	}
 	toRules := buildToRules(accessPolicy)
 	return &securityv1beta1spec.Rule{
 		From: fromRules,
 		To:   toRules,
+		When: buildConditions(conditions),
 	}, nil
 }
 
+func validateConditions(conditions *v1.AccessPolicySpec_Conditions) error {
+	var errs *multierror.Error
+	for _, claim := range conditions.GetClaims() {
+		if len(claim.GetPath()) == 0 {
+			errs = multierror.Append(errs, eris.New("claim conditions must specify a path"))
+		}
+		for _, name := range claim.GetPath() {
+			if name == "" || strings.ContainsAny(name, "[]") {
+				errs = multierror.Append(errs, eris.Errorf("invalid claim name %q in path %v", name, claim.GetPath()))
+			}
+		}
+		if len(claim.GetValues()) == 0 && len(claim.GetNotValues()) == 0 {
+			errs = multierror.Append(errs, eris.Errorf("claim condition %v must specify values or not_values", claim.GetPath()))
+		}
+	}
+	for _, header := range conditions.GetHeaders() {
+		if header.GetName() == "" {
+			errs = multierror.Append(errs, eris.New("header conditions must specify a name"))
+		}
+		if len(header.GetValues()) == 0 && len(header.GetNotValues()) == 0 {
+			errs = multierror.Append(errs, eris.Errorf("header condition %q must specify values or not_values", header.GetName()))
+		}
+	}
+	for _, ipBlocks := range [][]string{
+		conditions.GetIpBlocks(),
+		conditions.GetNotIpBlocks(),
+		conditions.GetRemoteIpBlocks(),
+		conditions.GetNotRemoteIpBlocks(),
+	} {
+		for _, ipBlock := range ipBlocks {
+			if !isIpOrCidr(ipBlock) {
+				errs = multierror.Append(errs, eris.Errorf("invalid IP address or CIDR block %q", ipBlock))
+			}
+		}
+	}
+	for _, sni := range conditions.GetSni() {
+		if sni == "" {
+			errs = multierror.Append(errs, eris.New("SNI values cannot be empty"))
+		}
+	}
+	return errs.ErrorOrNil()
+}
+
+func isIpOrCidr(ipBlock string) bool {
+	if net.ParseIP(ipBlock) != nil {
+		return true
+	}
+	_, _, err := net.ParseCIDR(ipBlock)
+	return err == nil
+}
+
+func hasIpBlocks(conditions *v1.AccessPolicySpec_Conditions) bool {
+	return len(conditions.GetIpBlocks()) > 0 ||
+		len(conditions.GetNotIpBlocks()) > 0 ||
+		len(conditions.GetRemoteIpBlocks()) > 0 ||
+		len(conditions.GetNotRemoteIpBlocks()) > 0
+}
+
+func setIpBlocks(source *securityv1beta1spec.Source, conditions *v1.AccessPolicySpec_Conditions) {
+	source.IpBlocks = conditions.GetIpBlocks()
+	source.NotIpBlocks = conditions.GetNotIpBlocks()
+	source.RemoteIpBlocks = conditions.GetRemoteIpBlocks()
+	source.NotRemoteIpBlocks = conditions.GetNotRemoteIpBlocks()
+}
+
+// Translate the request attribute conditions into Istio Conditions.
+// Reference: https://istio.io/latest/docs/reference/config/security/conditions/
+func buildConditions(conditions *v1.AccessPolicySpec_Conditions) []*securityv1beta1spec.Condition {
+	var istioConditions []*securityv1beta1spec.Condition
+	for _, claim := range conditions.GetClaims() {
+		istioConditions = append(istioConditions, &securityv1beta1spec.Condition{
+			Key:       fmt.Sprintf("request.auth.claims[%s]", strings.Join(claim.GetPath(), "][")),
+			Values:    claim.GetValues(),
+			NotValues: claim.GetNotValues(),
+		})
+	}
+	for _, header := range conditions.GetHeaders() {
+		istioConditions = append(istioConditions, &securityv1beta1spec.Condition{
+			Key:       fmt.Sprintf("request.headers[%s]", header.GetName()),
+			Values:    header.GetValues(),
+			NotValues: header.GetNotValues(),
+		})
+	}
+	if len(conditions.GetSni()) > 0 {
+		istioConditions = append(istioConditions, &securityv1beta1spec.Condition{
+			Key:    "connection.sni",
+			Values: conditions.GetSni(),
+		})
+	}
+	return istioConditions
+}
+
 func buildToRules(accessPolicy *v1.AccessPolicySpec) []*securityv1beta1spec.Rule_To {
 	allowedPaths := accessPolicy.AllowedPaths
 	allowedMethods := accessPolicy.AllowedMethods
 	allowedPorts := convertIntsToStrings(accessPolicy.AllowedPorts)
+	notPaths := accessPolicy.NotPaths
+	notMethods := accessPolicy.NotMethods
+	notPorts := convertIntsToStrings(accessPolicy.NotPorts)
 	var ruleTo []*securityv1beta1spec.Rule_To
-	if allowedPaths != nil || allowedMethods != nil || allowedPorts != nil {
+	if allowedPaths != nil || allowedMethods != nil || allowedPorts != nil ||
+		notPaths != nil || notMethods != nil || notPorts != nil {
 		ruleTo = append(ruleTo, &securityv1beta1spec.Rule_To{
 			Operation: &securityv1beta1spec.Operation{
-				Paths:   accessPolicy.AllowedPaths,
-				Methods: allowedMethods,
-				Ports:   allowedPorts,
+				Paths:      accessPolicy.AllowedPaths,
+				Methods:    allowedMethods,
+				Ports:      allowedPorts,
+				NotPaths:   notPaths,
+				NotMethods: notMethods,
+				NotPorts:   notPorts,
 			},
 		})
 	}
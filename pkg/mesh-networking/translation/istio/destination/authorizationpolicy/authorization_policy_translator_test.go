@@ -11,6 +11,7 @@ import (
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/authorizationpolicy"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
 	securityv1beta1spec "istio.io/api/security/v1beta1"
 	"istio.io/api/type/v1beta1"
 	securityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
@@ -174,8 +175,8 @@ var _ = Describe("AuthorizationPolicyTranslator", func() {
 			},
 		}
 		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").AddMeshes(meshes).Build()
-		authPolicy := translator.Translate(inputSnapshot, destination, mockReporter)
-		Expect(authPolicy).To(Equal(expectedAuthPolicy))
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
+		Expect(authPolicies).To(Equal([]*securityv1beta1.AuthorizationPolicy{expectedAuthPolicy}))
 	})
 
 	It("should handle wildcard (empty) cluster source selectors", func() {
@@ -312,8 +313,353 @@ var _ = Describe("AuthorizationPolicyTranslator", func() {
 			},
 		}
 		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").AddMeshes(meshes).Build()
-		authPolicy := translator.Translate(inputSnapshot, destination, mockReporter)
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
 		//Expect(equalityutils.DeepEqual(authPolicy, expectedAuthPolicy)).To(BeTrue())
-		Expect(authPolicy).To(Equal(expectedAuthPolicy))
+		Expect(authPolicies).To(Equal([]*securityv1beta1.AuthorizationPolicy{expectedAuthPolicy}))
+	})
+
+	It("should translate an AuthorizationPolicy for each AccessPolicy action", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{
+							"app": "kube-service",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							Action: networkingv1.AccessPolicySpec_AUDIT,
+							SourceSelector: []*commonv1.IdentitySelector{
+								{
+									KubeIdentityMatcher: &commonv1.IdentitySelector_KubeIdentityMatcher{
+										Namespaces: []string{"audited"},
+									},
+								},
+							},
+						},
+					},
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							Action: networkingv1.AccessPolicySpec_DENY,
+							SourceSelector: []*commonv1.IdentitySelector{
+								{
+									KubeIdentityMatcher: &commonv1.IdentitySelector_KubeIdentityMatcher{
+										Namespaces: []string{"compromised"},
+									},
+								},
+							},
+							NotPaths: []string{"/healthz"},
+						},
+					},
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							NotMethods: []string{"DELETE"},
+							NotPorts:   []uint32{9090},
+						},
+					},
+				},
+			},
+		}
+		objectMeta := func(name string) metav1.ObjectMeta {
+			return metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   destination.Spec.GetKubeService().Ref.Namespace,
+				ClusterName: destination.Spec.GetKubeService().Ref.ClusterName,
+				Labels: map[string]string{
+					"owner.networking.mesh.gloo.solo.io": "gloo-mesh",
+				},
+			}
+		}
+		selector := &v1beta1.WorkloadSelector{
+			MatchLabels: destination.Spec.GetKubeService().WorkloadSelectorLabels,
+		}
+		expectedAuthPolicies := []*securityv1beta1.AuthorizationPolicy{
+			{
+				ObjectMeta: objectMeta("kube-service"),
+				Spec: securityv1beta1spec.AuthorizationPolicy{
+					Selector: selector,
+					Rules: []*securityv1beta1spec.Rule{
+						{
+							To: []*securityv1beta1spec.Rule_To{
+								{
+									Operation: &securityv1beta1spec.Operation{
+										NotMethods: []string{"DELETE"},
+										NotPorts:   []string{"9090"},
+									},
+								},
+							},
+						},
+					},
+					Action: securityv1beta1spec.AuthorizationPolicy_ALLOW,
+				},
+			},
+			{
+				ObjectMeta: objectMeta("kube-service-deny"),
+				Spec: securityv1beta1spec.AuthorizationPolicy{
+					Selector: selector,
+					Rules: []*securityv1beta1spec.Rule{
+						{
+							From: []*securityv1beta1spec.Rule_From{
+								{
+									Source: &securityv1beta1spec.Source{
+										Namespaces: []string{"compromised"},
+									},
+								},
+							},
+							To: []*securityv1beta1spec.Rule_To{
+								{
+									Operation: &securityv1beta1spec.Operation{
+										NotPaths: []string{"/healthz"},
+									},
+								},
+							},
+						},
+					},
+					Action: securityv1beta1spec.AuthorizationPolicy_DENY,
+				},
+			},
+			{
+				ObjectMeta: objectMeta("kube-service-audit"),
+				Spec: securityv1beta1spec.AuthorizationPolicy{
+					Selector: selector,
+					Rules: []*securityv1beta1spec.Rule{
+						{
+							From: []*securityv1beta1spec.Rule_From{
+								{
+									Source: &securityv1beta1spec.Source{
+										Namespaces: []string{"audited"},
+									},
+								},
+							},
+						},
+					},
+					Action: securityv1beta1spec.AuthorizationPolicy_AUDIT,
+				},
+			},
+		}
+		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").Build()
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
+		Expect(authPolicies).To(Equal(expectedAuthPolicies))
+	})
+
+	It("should translate AccessPolicy conditions", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{
+							"app": "kube-service",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							SourceSelector: []*commonv1.IdentitySelector{
+								{
+									KubeIdentityMatcher: &commonv1.IdentitySelector_KubeIdentityMatcher{
+										Namespaces: []string{"source-namespace"},
+									},
+								},
+							},
+							Conditions: &networkingv1.AccessPolicySpec_Conditions{
+								Claims: []*networkingv1.AccessPolicySpec_Conditions_ClaimCondition{
+									{
+										Path:   []string{"groups"},
+										Values: []string{"admin"},
+									},
+									{
+										Path:      []string{"realm_access", "roles"},
+										NotValues: []string{"guest"},
+									},
+								},
+								IpBlocks: []string{"10.0.0.0/8"},
+								Headers: []*networkingv1.AccessPolicySpec_Conditions_HeaderCondition{
+									{
+										Name:   "x-tenant",
+										Values: []string{"acme*"},
+									},
+								},
+								Sni: []string{"kube-service.example.com"},
+							},
+						},
+					},
+					{
+						Spec: &networkingv1.AccessPolicySpec{
+							Conditions: &networkingv1.AccessPolicySpec_Conditions{
+								NotRemoteIpBlocks: []string{"192.168.1.1"},
+							},
+						},
+					},
+				},
+			},
+		}
+		expectedAuthPolicy := &securityv1beta1.AuthorizationPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        destination.Spec.GetKubeService().Ref.Name,
+				Namespace:   destination.Spec.GetKubeService().Ref.Namespace,
+				ClusterName: destination.Spec.GetKubeService().Ref.ClusterName,
+				Labels: map[string]string{
+					"owner.networking.mesh.gloo.solo.io": "gloo-mesh",
+				},
+			},
+			Spec: securityv1beta1spec.AuthorizationPolicy{
+				Selector: &v1beta1.WorkloadSelector{
+					MatchLabels: destination.Spec.GetKubeService().WorkloadSelectorLabels,
+				},
+				Rules: []*securityv1beta1spec.Rule{
+					{
+						From: []*securityv1beta1spec.Rule_From{
+							{
+								Source: &securityv1beta1spec.Source{
+									Namespaces: []string{"source-namespace"},
+									IpBlocks:   []string{"10.0.0.0/8"},
+								},
+							},
+						},
+						When: []*securityv1beta1spec.Condition{
+							{
+								Key:    "request.auth.claims[groups]",
+								Values: []string{"admin"},
+							},
+							{
+								Key:       "request.auth.claims[realm_access][roles]",
+								NotValues: []string{"guest"},
+							},
+							{
+								Key:    "request.headers[x-tenant]",
+								Values: []string{"acme*"},
+							},
+							{
+								Key:    "connection.sni",
+								Values: []string{"kube-service.example.com"},
+							},
+						},
+					},
+					{
+						From: []*securityv1beta1spec.Rule_From{
+							{
+								Source: &securityv1beta1spec.Source{
+									NotRemoteIpBlocks: []string{"192.168.1.1"},
+								},
+							},
+						},
+					},
+				},
+				Action: securityv1beta1spec.AuthorizationPolicy_ALLOW,
+			},
+		}
+		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").Build()
+		authPolicies := translator.Translate(inputSnapshot, destination, mockReporter)
+		Expect(authPolicies).To(Equal([]*securityv1beta1.AuthorizationPolicy{expectedAuthPolicy}))
+	})
+
+	It("should report invalid AccessPolicy conditions", func() {
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "invalid",
+							Namespace: "ns",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							Conditions: &networkingv1.AccessPolicySpec_Conditions{
+								Claims: []*networkingv1.AccessPolicySpec_Conditions_ClaimCondition{
+									{
+										Path: []string{"groups"},
+									},
+								},
+								RemoteIpBlocks: []string{"10.0.0.0/33"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockReporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(destination, destination.Status.AppliedAccessPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring("claim condition [groups] must specify values or not_values"))
+				Expect(err.Error()).To(ContainSubstring("invalid IP address or CIDR block \"10.0.0.0/33\""))
+			})
+
+		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").Build()
+		Expect(translator.Translate(inputSnapshot, destination, mockReporter)).To(BeNil())
+	})
+
+	It("should report AccessPolicies applied to MESH_EXTERNAL Destinations", func() {
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_ExternalService_{
+					ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+						Name:  "external-api",
+						Hosts: []string{"api.example.com"},
+						Ports: []*discoveryv1.DestinationSpec_ExternalService_ServicePort{
+							{Number: 443, Name: "https", Protocol: "HTTPS"},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "allow-api",
+							Namespace: "ns",
+						},
+						Spec: &networkingv1.AccessPolicySpec{},
+					},
+				},
+			},
+		}
+
+		mockReporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(destination, destination.Status.AppliedAccessPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring("unsupported for MESH_EXTERNAL destinations"))
+			})
+
+		inputSnapshot := input.NewInputLocalSnapshotManualBuilder("").Build()
+		Expect(translator.Translate(inputSnapshot, destination, mockReporter)).To(BeNil())
 	})
 })
@@ -38,10 +38,10 @@ func (m *MockTranslator) EXPECT() *MockTranslatorMockRecorder {
 }
 
 // Translate mocks base method.
-func (m *MockTranslator) Translate(in input.LocalSnapshot, destination *v1.Destination, reporter reporting.Reporter) *v1beta1.AuthorizationPolicy {
+func (m *MockTranslator) Translate(in input.LocalSnapshot, destination *v1.Destination, reporter reporting.Reporter) []*v1beta1.AuthorizationPolicy {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Translate", in, destination, reporter)
-	ret0, _ := ret[0].(*v1beta1.AuthorizationPolicy)
+	ret0, _ := ret[0].([]*v1beta1.AuthorizationPolicy)
 	return ret0
 }
 
@@ -9,10 +9,11 @@ import (
 
 	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 
-	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/tls"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
@@ -86,19 +87,36 @@ func (t *translator) Translate(
 	sourceMeshInstallation *discoveryv1.MeshInstallation,
 	reporter reporting.Reporter,
 ) *networkingv1alpha3.DestinationRule {
-	kubeService := destination.Spec.GetKubeService()
-
-	if kubeService == nil {
-		// TODO(ilackarms): non kube services currently unsupported
+	var sourceClusterName, hostname string
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *discoveryv1.DestinationSpec_KubeService_:
+		sourceClusterName = destinationType.KubeService.GetRef().GetClusterName()
+		if sourceMeshInstallation != nil {
+			sourceClusterName = sourceMeshInstallation.Cluster
+		}
+		hostname = t.clusterDomains.GetDestinationFQDN(sourceClusterName, destinationType.KubeService.GetRef())
+	case *discoveryv1.DestinationSpec_ExternalService_:
+		// external services are configured in the cluster of the ServiceEntry from which they were discovered
+		if sourceMeshInstallation != nil {
+			return nil
+		}
+		// services outside of the mesh are only configured by traffic policies
+		if destinationutils.IsMeshExternalService(destination) && len(destination.Status.AppliedTrafficPolicies) == 0 {
+			return nil
+		}
+		host, err := destinationutils.GetExternalServiceHost(destinationType.ExternalService)
+		if err != nil {
+			// the error is reported to the applied traffic policies by the VirtualService translator
+			contextutils.LoggerFrom(ctx).Debugf("%v", err)
+			return nil
+		}
+		sourceClusterName = destinationType.ExternalService.GetRef().GetClusterName()
+		hostname = host
+	default:
 		return nil
 	}
 
-	sourceClusterName := kubeService.Ref.ClusterName
-	if sourceMeshInstallation != nil {
-		sourceClusterName = sourceMeshInstallation.Cluster
-	}
-
-	destinationRule, err := t.initializeDestinationRule(destination, t.settings.Spec.Mtls, sourceMeshInstallation)
+	destinationRule, err := t.initializeDestinationRule(destination, hostname, t.settings.Spec.Mtls, sourceMeshInstallation)
 	if err != nil {
 		contextutils.LoggerFrom(ctx).Error(err)
 		return nil
@@ -119,7 +137,7 @@ func (t *translator) Translate(
 			continue
 		}
 
-		registerField := registerFieldFunc(destinationRuleFields, destinationRule, policy.Ref)
+		registerField := registerFieldFunc(destinationRuleFields, destinationRule, policy.Ref, policy.Spec.GetPriority())
 		for _, decorator := range drDecorators {
 
 			if destinationRuleDecorator, ok := decorator.(decorators.TrafficPolicyDestinationRuleDecorator); ok {
@@ -163,6 +181,7 @@ func registerFieldFunc(
 	destinationRuleFields fieldutils.FieldOwnershipRegistry,
 	destinationRule *networkingv1alpha3.DestinationRule,
 	policy ezkube.ResourceId,
+	priority int32,
 ) decorators.RegisterField {
 	return func(fieldPtr, val interface{}) error {
 		fieldVal := reflect.ValueOf(fieldPtr).Elem().Interface()
@@ -175,7 +194,7 @@ func registerFieldFunc(
 			fieldPtr,
 			[]ezkube.ResourceId{policy},
 			&v1.TrafficPolicy{},
-			0, //TODO(ilackarms): priority
+			priority,
 		); err != nil {
 			return err
 		}
@@ -185,23 +204,23 @@ func registerFieldFunc(
 
 func (t *translator) initializeDestinationRule(
 	destination *discoveryv1.Destination,
+	hostname string,
 	mtlsDefault *v1.TrafficPolicySpec_Policy_MTLS,
 	sourceMeshInstallation *discoveryv1.MeshInstallation,
 ) (*networkingv1alpha3.DestinationRule, error) {
 	var meta metav1.ObjectMeta
 	if sourceMeshInstallation != nil {
 		meta = metautils.FederatedObjectMeta(
-			destination.Spec.GetKubeService().Ref,
+			destinationutils.GetTranslatedObjectRef(destination),
 			sourceMeshInstallation,
 			destination.Annotations,
 		)
 	} else {
 		meta = metautils.TranslatedObjectMeta(
-			destination.Spec.GetKubeService().Ref,
+			destinationutils.GetTranslatedObjectRef(destination),
 			destination.Annotations,
 		)
 	}
-	hostname := t.clusterDomains.GetDestinationFQDN(meta.ClusterName, destination.Spec.GetKubeService().Ref)
 
 	destinationRule := &networkingv1alpha3.DestinationRule{
 		ObjectMeta: meta,
@@ -212,6 +231,11 @@ func (t *translator) initializeDestinationRule(
 		},
 	}
 
+	// services outside of the mesh do not accept Istio mTLS, so TLS is only originated if configured by a traffic policy
+	if destinationutils.IsMeshExternalService(destination) {
+		return destinationRule, nil
+	}
+
 	// Initialize Istio TLS mode with default declared in Settings
 	istioTlsMode, err := tls.MapIstioTlsMode(mtlsDefault.GetIstio().GetTlsMode())
 	if err != nil {
@@ -0,0 +1,279 @@
+package envoyfilter
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/go-utils/contextutils"
+	"github.com/solo-io/skv2/pkg/equalityutils"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+)
+
+//go:generate mockgen -source ./envoy_filter_translator.go -destination mocks/envoy_filter_translator.go
+
+// the EnvoyFilter translator translates a Destination into EnvoyFilters.
+type Translator interface {
+	// Translate translates the appropriate EnvoyFilters for the given Destination:
+	// an inbound EnvoyFilter which selects the Destination's workloads, and an outbound EnvoyFilter
+	// which applies to every sidecar in the Destination's mesh and in each mesh to which the Destination is federated.
+	// Omits each EnvoyFilter which is not required for the Destination (i.e. if no applied TrafficPolicy requires Envoy configuration
+	// which cannot be expressed with a VirtualService or DestinationRule, such as rate limiting).
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	//
+	// Note that the input snapshot DestinationSet contains the given Destination.
+	Translate(
+		ctx context.Context,
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) []*networkingv1alpha3.EnvoyFilter
+}
+
+// an outbound EnvoyFilter, along with the installation of the mesh to which it is output if the Destination is federated to that mesh
+type outboundEnvoyFilter struct {
+	sourceMeshInstallation *discoveryv1.MeshInstallation
+	envoyFilter            *networkingv1alpha3.EnvoyFilter
+}
+
+type translator struct {
+	clusterDomains   hostutils.ClusterDomainRegistry
+	decoratorFactory decorators.Factory
+}
+
+func NewTranslator(
+	clusterDomains hostutils.ClusterDomainRegistry,
+	decoratorFactory decorators.Factory,
+) Translator {
+	return &translator{
+		clusterDomains:   clusterDomains,
+		decoratorFactory: decoratorFactory,
+	}
+}
+
+func (t *translator) Translate(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) []*networkingv1alpha3.EnvoyFilter {
+	efDecorators := t.decoratorFactory.MakeDecorators(decorators.Parameters{
+		ClusterDomains: t.clusterDomains,
+		Snapshot:       in,
+	})
+
+	if destination.Spec.GetKubeService() == nil {
+		reportUnsupportedDestination(destination, efDecorators, reporter)
+		return nil
+	}
+
+	envoyFilter := t.initializeEnvoyFilter(destination)
+	outboundEnvoyFilters := t.initializeOutboundEnvoyFilters(ctx, in, destination)
+
+	// register the owners of the envoyfilter fields
+	envoyFilterFields := fieldutils.NewOwnershipRegistry()
+
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		registerField := registerFieldFunc(envoyFilterFields, envoyFilter, policy.Ref, policy.Spec.GetPriority())
+		for _, decorator := range efDecorators {
+
+			if envoyFilterDecorator, ok := decorator.(decorators.TrafficPolicyEnvoyFilterDecorator); ok {
+				if err := envoyFilterDecorator.ApplyTrafficPolicyToEnvoyFilter(
+					policy,
+					destination,
+					&envoyFilter.Spec,
+					registerField,
+				); err != nil {
+					reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", decorator.DecoratorName()))
+				}
+			}
+		}
+
+		for _, outbound := range outboundEnvoyFilters {
+			registerOutboundField := registerFieldFunc(envoyFilterFields, outbound.envoyFilter, policy.Ref, policy.Spec.GetPriority())
+			for _, decorator := range efDecorators {
+
+				if outboundEnvoyFilterDecorator, ok := decorator.(decorators.TrafficPolicyOutboundEnvoyFilterDecorator); ok {
+					if err := outboundEnvoyFilterDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(
+						policy,
+						destination,
+						outbound.sourceMeshInstallation,
+						&outbound.envoyFilter.Spec,
+						registerOutboundField,
+					); err != nil {
+						reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", decorator.DecoratorName()))
+					}
+				}
+			}
+		}
+	}
+
+	allEnvoyFilters := []*networkingv1alpha3.EnvoyFilter{envoyFilter}
+	for _, outbound := range outboundEnvoyFilters {
+		allEnvoyFilters = append(allEnvoyFilters, outbound.envoyFilter)
+	}
+
+	var envoyFilters []*networkingv1alpha3.EnvoyFilter
+	// don't output an EnvoyFilter that doesn't patch anything
+	for _, ef := range allEnvoyFilters {
+		if len(ef.Spec.ConfigPatches) > 0 {
+			envoyFilters = append(envoyFilters, ef)
+		}
+	}
+
+	return envoyFilters
+}
+
+// EnvoyFilters are only translated for kube services. The decorators are still applied to discarded EnvoyFilters
+// for other Destinations, so that each TrafficPolicy which requires an EnvoyFilter is reported as unsupported.
+func reportUnsupportedDestination(
+	destination *discoveryv1.Destination,
+	efDecorators []decorators.Decorator,
+	reporter reporting.Reporter,
+) {
+	ignoreField := func(fieldPtr, val interface{}) error {
+		return nil
+	}
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		for _, decorator := range efDecorators {
+
+			if envoyFilterDecorator, ok := decorator.(decorators.TrafficPolicyEnvoyFilterDecorator); ok {
+				if err := envoyFilterDecorator.ApplyTrafficPolicyToEnvoyFilter(
+					policy,
+					destination,
+					&networkingv1alpha3spec.EnvoyFilter{},
+					ignoreField,
+				); err != nil {
+					reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", decorator.DecoratorName()))
+				}
+			}
+
+			if outboundEnvoyFilterDecorator, ok := decorator.(decorators.TrafficPolicyOutboundEnvoyFilterDecorator); ok {
+				if err := outboundEnvoyFilterDecorator.ApplyTrafficPolicyToOutboundEnvoyFilter(
+					policy,
+					destination,
+					nil,
+					&networkingv1alpha3spec.EnvoyFilter{},
+					ignoreField,
+				); err != nil {
+					reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", decorator.DecoratorName()))
+				}
+			}
+		}
+	}
+}
+
+// construct the callback for registering fields in the envoy filter
+func registerFieldFunc(
+	envoyFilterFields fieldutils.FieldOwnershipRegistry,
+	envoyFilter *networkingv1alpha3.EnvoyFilter,
+	policy ezkube.ResourceId,
+	priority int32,
+) decorators.RegisterField {
+	return func(fieldPtr, val interface{}) error {
+		fieldVal := reflect.ValueOf(fieldPtr).Elem().Interface()
+
+		if equalityutils.DeepEqual(fieldVal, val) {
+			return nil
+		}
+		if err := envoyFilterFields.RegisterFieldOwnership(
+			envoyFilter,
+			fieldPtr,
+			[]ezkube.ResourceId{policy},
+			&v1.TrafficPolicy{},
+			priority,
+		); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func (t *translator) initializeEnvoyFilter(
+	destination *discoveryv1.Destination,
+) *networkingv1alpha3.EnvoyFilter {
+	meta := metautils.TranslatedObjectMeta(
+		destination.Spec.GetKubeService().Ref,
+		destination.Annotations,
+	)
+	return &networkingv1alpha3.EnvoyFilter{
+		ObjectMeta: meta,
+		Spec: networkingv1alpha3spec.EnvoyFilter{
+			WorkloadSelector: &networkingv1alpha3spec.WorkloadSelector{
+				Labels: destination.Spec.GetKubeService().WorkloadSelectorLabels,
+			},
+		},
+	}
+}
+
+// outbound EnvoyFilters are output to the root namespace of the Destination's Istio mesh and of each Istio mesh
+// to which the Destination is federated, which Istio applies to all sidecars in the mesh.
+// omits the outbound EnvoyFilter for each mesh which is not an Istio mesh.
+func (t *translator) initializeOutboundEnvoyFilters(
+	ctx context.Context,
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+) []outboundEnvoyFilter {
+	var outboundEnvoyFilters []outboundEnvoyFilter
+	kubeService := destination.Spec.GetKubeService()
+
+	destinationMesh, err := in.Meshes().Find(destination.Spec.GetMesh())
+	if err != nil {
+		contextutils.LoggerFrom(ctx).Errorf("Could not find parent Mesh %v for Destination %v", destination.Spec.GetMesh(), ezkube.MakeObjectRef(destination))
+	} else if istioInstallation := destinationMesh.Spec.GetIstio().GetInstallation(); istioInstallation != nil {
+		meta := metautils.TranslatedObjectMeta(
+			kubeService.Ref,
+			destination.Annotations,
+		)
+		meta.Name = strings.Join([]string{kubeService.Ref.GetName(), kubeService.Ref.GetNamespace(), "outbound"}, "-")
+		meta.Namespace = istioInstallation.GetNamespace()
+		outboundEnvoyFilters = append(outboundEnvoyFilters, outboundEnvoyFilter{
+			envoyFilter: &networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: meta,
+			},
+		})
+	}
+
+	for _, meshRef := range destination.Status.AppliedFederation.GetFederatedToMeshes() {
+		remoteMesh, err := in.Meshes().Find(meshRef)
+		if err != nil {
+			contextutils.LoggerFrom(ctx).Errorf("Could not find Mesh %v that Destination %v is federated to", meshRef, ezkube.MakeObjectRef(destination))
+			continue
+		}
+		remoteInstallation := remoteMesh.Spec.GetIstio().GetInstallation()
+		if remoteInstallation == nil {
+			continue
+		}
+		// copy the annotations, which are shared with the local outbound EnvoyFilter, before the federation annotation is added
+		annotations := make(map[string]string, len(destination.Annotations))
+		for key, value := range destination.Annotations {
+			annotations[key] = value
+		}
+		meta := metautils.FederatedObjectMeta(
+			kubeService.Ref,
+			remoteInstallation,
+			annotations,
+		)
+		meta.Name = strings.Join([]string{meta.Name, "outbound"}, "-")
+		outboundEnvoyFilters = append(outboundEnvoyFilters, outboundEnvoyFilter{
+			sourceMeshInstallation: remoteInstallation,
+			envoyFilter: &networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: meta,
+			},
+		})
+	}
+
+	return outboundEnvoyFilters
+}
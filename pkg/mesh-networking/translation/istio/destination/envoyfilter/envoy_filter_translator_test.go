@@ -0,0 +1,436 @@
+package envoyfilter_test
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes/duration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	csrfapi "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/csrf"
+	extauthapi "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/extauth"
+	ratelimitapi "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/csrf"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/extauth"
+	mock_decorators "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/retries"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/envoyfilter"
+	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("EnvoyFilterTranslator", func() {
+	var (
+		ctrl                      *gomock.Controller
+		mockClusterDomainRegistry *mock_hostutils.MockClusterDomainRegistry
+		mockDecoratorFactory      *mock_decorators.MockFactory
+		mockReporter              *mock_reporting.MockReporter
+		mockDecorator             *mock_decorators.MockTrafficPolicyEnvoyFilterDecorator
+		efDecorators              []decorators.Decorator
+		envoyFilterTranslator     envoyfilter.Translator
+		in                        input.LocalSnapshot
+		ctx                       = context.TODO()
+		destination               *discoveryv1.Destination
+		patch                     = &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+			ApplyTo: networkingv1alpha3spec.EnvoyFilter_VIRTUAL_HOST,
+			Patch: &networkingv1alpha3spec.EnvoyFilter_Patch{
+				Operation: networkingv1alpha3spec.EnvoyFilter_Patch_MERGE,
+				Value:     &types.Struct{},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockClusterDomainRegistry = mock_hostutils.NewMockClusterDomainRegistry(ctrl)
+		mockDecoratorFactory = mock_decorators.NewMockFactory(ctrl)
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		mockDecorator = mock_decorators.NewMockTrafficPolicyEnvoyFilterDecorator(ctrl)
+		envoyFilterTranslator = envoyfilter.NewTranslator(mockClusterDomainRegistry, mockDecoratorFactory)
+		in = input.NewInputLocalSnapshotManualBuilder("").Build()
+
+		destination = &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{
+							"app": "traffic-target",
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*v1.AppliedTrafficPolicy{
+					{
+						Ref: &skv2corev1.ObjectRef{
+							Name:      "tp-1",
+							Namespace: "tp-namespace-1",
+						},
+						Spec: &v1.TrafficPolicySpec{},
+					},
+				},
+			},
+		}
+
+		efDecorators = []decorators.Decorator{mockDecorator}
+	})
+
+	JustBeforeEach(func() {
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return(efDecorators)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should translate an EnvoyFilter selecting the Destination's workloads", func() {
+		expectedEnvoyFilter := &networkingv1alpha3.EnvoyFilter{
+			ObjectMeta: metautils.TranslatedObjectMeta(
+				destination.Spec.GetKubeService().Ref,
+				destination.Annotations,
+			),
+			Spec: networkingv1alpha3spec.EnvoyFilter{
+				WorkloadSelector: &networkingv1alpha3spec.WorkloadSelector{
+					Labels: map[string]string{"app": "traffic-target"},
+				},
+				ConfigPatches: []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{patch},
+			},
+		}
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToEnvoyFilter(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				gomock.Any(),
+				gomock.Any(),
+			).
+			DoAndReturn(func(
+				appliedPolicy *v1.AppliedTrafficPolicy,
+				destination *discoveryv1.Destination,
+				output *networkingv1alpha3spec.EnvoyFilter,
+				registerField decorators.RegisterField,
+			) error {
+				output.ConfigPatches = append(output.ConfigPatches, patch)
+				return nil
+			})
+
+		envoyFilters := envoyFilterTranslator.Translate(ctx, in, destination, mockReporter)
+		Expect(envoyFilters).To(Equal([]*networkingv1alpha3.EnvoyFilter{expectedEnvoyFilter}))
+	})
+
+	It("should not output an EnvoyFilter without config patches", func() {
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToEnvoyFilter(
+				destination.Status.AppliedTrafficPolicies[0],
+				destination,
+				gomock.Any(),
+				gomock.Any(),
+			).
+			Return(nil)
+
+		envoyFilters := envoyFilterTranslator.Translate(ctx, in, destination, mockReporter)
+		Expect(envoyFilters).To(BeEmpty())
+	})
+
+	It("should report conflicting fields registered by multiple TrafficPolicies", func() {
+		destination.Status.AppliedTrafficPolicies = append(destination.Status.AppliedTrafficPolicies, &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp-2",
+				Namespace: "tp-namespace-1",
+			},
+			Spec: &v1.TrafficPolicySpec{},
+		})
+
+		applyPatch := func(
+			appliedPolicy *v1.AppliedTrafficPolicy,
+			destination *discoveryv1.Destination,
+			output *networkingv1alpha3spec.EnvoyFilter,
+			registerField decorators.RegisterField,
+		) error {
+			// both policies attempt to set the value of the same patch
+			if len(output.ConfigPatches) == 0 {
+				output.ConfigPatches = append(output.ConfigPatches, &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+					Patch: &networkingv1alpha3spec.EnvoyFilter_Patch{},
+				})
+			}
+			value := &types.Struct{Fields: map[string]*types.Value{
+				"policy": {Kind: &types.Value_StringValue{StringValue: appliedPolicy.Ref.Name}},
+			}}
+			if err := registerField(&output.ConfigPatches[0].Patch.Value, value); err != nil {
+				return err
+			}
+			output.ConfigPatches[0].Patch.Value = value
+			return nil
+		}
+
+		mockDecorator.
+			EXPECT().
+			ApplyTrafficPolicyToEnvoyFilter(gomock.Any(), destination, gomock.Any(), gomock.Any()).
+			DoAndReturn(applyPatch).
+			Times(2)
+		mockDecorator.
+			EXPECT().
+			DecoratorName().
+			Return("mock-decorator")
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[1].Ref, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring("is already owned by"))
+			})
+
+		envoyFilters := envoyFilterTranslator.Translate(ctx, in, destination, mockReporter)
+		Expect(envoyFilters).To(HaveLen(1))
+		Expect(envoyFilters[0].Spec.ConfigPatches).To(HaveLen(1))
+		Expect(envoyFilters[0].Spec.ConfigPatches[0].Patch.Value.Fields["policy"].GetStringValue()).To(Equal("tp-1"))
+	})
+
+	Context("when the Destination's mesh is an Istio mesh", func() {
+		var mockOutboundDecorator *mock_decorators.MockTrafficPolicyOutboundEnvoyFilterDecorator
+
+		BeforeEach(func() {
+			mockOutboundDecorator = mock_decorators.NewMockTrafficPolicyOutboundEnvoyFilterDecorator(ctrl)
+			efDecorators = []decorators.Decorator{mockOutboundDecorator}
+			destination.Spec.Mesh = &skv2corev1.ObjectRef{
+				Name:      "istio-mesh",
+				Namespace: "gloo-mesh",
+			}
+			in = input.NewInputLocalSnapshotManualBuilder("").
+				AddMeshes([]*discoveryv1.Mesh{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "istio-mesh",
+							Namespace: "gloo-mesh",
+						},
+						Spec: discoveryv1.MeshSpec{
+							Type: &discoveryv1.MeshSpec_Istio_{
+								Istio: &discoveryv1.MeshSpec_Istio{
+									Installation: &discoveryv1.MeshInstallation{
+										Namespace: "istio-system",
+										Cluster:   "traffic-target-cluster",
+									},
+								},
+							},
+						},
+					},
+				}).
+				Build()
+		})
+
+		It("should translate an outbound EnvoyFilter in the root namespace of the Destination's mesh", func() {
+			expectedMeta := metautils.TranslatedObjectMeta(
+				destination.Spec.GetKubeService().Ref,
+				destination.Annotations,
+			)
+			expectedMeta.Name = "traffic-target-traffic-target-namespace-outbound"
+			expectedMeta.Namespace = "istio-system"
+			expectedEnvoyFilter := &networkingv1alpha3.EnvoyFilter{
+				ObjectMeta: expectedMeta,
+				Spec: networkingv1alpha3spec.EnvoyFilter{
+					ConfigPatches: []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{patch},
+				},
+			}
+
+			mockOutboundDecorator.
+				EXPECT().
+				ApplyTrafficPolicyToOutboundEnvoyFilter(
+					destination.Status.AppliedTrafficPolicies[0],
+					destination,
+					nil,
+					gomock.Any(),
+					gomock.Any(),
+				).
+				DoAndReturn(func(
+					appliedPolicy *v1.AppliedTrafficPolicy,
+					destination *discoveryv1.Destination,
+					sourceMeshInstallation *discoveryv1.MeshInstallation,
+					output *networkingv1alpha3spec.EnvoyFilter,
+					registerField decorators.RegisterField,
+				) error {
+					output.ConfigPatches = append(output.ConfigPatches, patch)
+					return nil
+				})
+
+			envoyFilters := envoyFilterTranslator.Translate(ctx, in, destination, mockReporter)
+			Expect(envoyFilters).To(Equal([]*networkingv1alpha3.EnvoyFilter{expectedEnvoyFilter}))
+		})
+
+		Context("when the Destination is federated", func() {
+			var remoteInstallation *discoveryv1.MeshInstallation
+
+			BeforeEach(func() {
+				remoteInstallation = &discoveryv1.MeshInstallation{
+					Namespace: "remote-istio-system",
+					Cluster:   "remote-cluster",
+				}
+				remoteMesh := &discoveryv1.Mesh{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "remote-istio-mesh",
+						Namespace: "gloo-mesh",
+					},
+					Spec: discoveryv1.MeshSpec{
+						Type: &discoveryv1.MeshSpec_Istio_{
+							Istio: &discoveryv1.MeshSpec_Istio{
+								Installation: remoteInstallation,
+							},
+						},
+					},
+				}
+				in = input.NewInputLocalSnapshotManualBuilder("").
+					AddMeshes(append(in.Meshes().List(), remoteMesh)).
+					Build()
+				destination.Status.AppliedFederation = &discoveryv1.DestinationStatus_AppliedFederation{
+					FederatedToMeshes: []*skv2corev1.ObjectRef{ezkube.MakeObjectRef(remoteMesh)},
+				}
+			})
+
+			It("should translate an outbound EnvoyFilter in the root namespace of each mesh the Destination is federated to", func() {
+				expectedMeta := metautils.FederatedObjectMeta(
+					destination.Spec.GetKubeService().Ref,
+					remoteInstallation,
+					nil,
+				)
+				expectedMeta.Name = "traffic-target-traffic-target-namespace-traffic-target-cluster-outbound"
+				expectedEnvoyFilter := &networkingv1alpha3.EnvoyFilter{
+					ObjectMeta: expectedMeta,
+					Spec: networkingv1alpha3spec.EnvoyFilter{
+						ConfigPatches: []*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{patch},
+					},
+				}
+
+				// the decorator only patches the outbound EnvoyFilter of the remote mesh
+				mockOutboundDecorator.
+					EXPECT().
+					ApplyTrafficPolicyToOutboundEnvoyFilter(
+						destination.Status.AppliedTrafficPolicies[0],
+						destination,
+						nil,
+						gomock.Any(),
+						gomock.Any(),
+					).
+					Return(nil)
+				mockOutboundDecorator.
+					EXPECT().
+					ApplyTrafficPolicyToOutboundEnvoyFilter(
+						destination.Status.AppliedTrafficPolicies[0],
+						destination,
+						remoteInstallation,
+						gomock.Any(),
+						gomock.Any(),
+					).
+					DoAndReturn(func(
+						appliedPolicy *v1.AppliedTrafficPolicy,
+						destination *discoveryv1.Destination,
+						sourceMeshInstallation *discoveryv1.MeshInstallation,
+						output *networkingv1alpha3spec.EnvoyFilter,
+						registerField decorators.RegisterField,
+					) error {
+						output.ConfigPatches = append(output.ConfigPatches, patch)
+						return nil
+					})
+
+				envoyFilters := envoyFilterTranslator.Translate(ctx, in, destination, mockReporter)
+				Expect(envoyFilters).To(Equal([]*networkingv1alpha3.EnvoyFilter{expectedEnvoyFilter}))
+			})
+		})
+	})
+
+	Context("when the Destination is an external service", func() {
+		BeforeEach(func() {
+			destination.Spec.Type = &discoveryv1.DestinationSpec_ExternalService_{
+				ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+					Name:  "external-api",
+					Hosts: []string{"api.example.com"},
+					Ports: []*discoveryv1.DestinationSpec_ExternalService_ServicePort{
+						{Number: 443, Name: "https", Protocol: "HTTPS"},
+					},
+				},
+			}
+			policyWithSpec := func(name string, policy *v1.TrafficPolicySpec_Policy) *v1.AppliedTrafficPolicy {
+				return &v1.AppliedTrafficPolicy{
+					Ref: &skv2corev1.ObjectRef{
+						Name:      name,
+						Namespace: "tp-namespace-1",
+					},
+					Spec: &v1.TrafficPolicySpec{Policy: policy},
+				}
+			}
+			destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+				policyWithSpec("retry-backoff", &v1.TrafficPolicySpec_Policy{
+					Retries: &v1.TrafficPolicySpec_Policy_RetryPolicy{
+						Attempts: 3,
+						Backoff: &v1.TrafficPolicySpec_Policy_RetryPolicy_Backoff{
+							BaseInterval: &duration.Duration{Nanos: 25000000},
+						},
+					},
+				}),
+				policyWithSpec("csrf", &v1.TrafficPolicySpec_Policy{
+					Csrf: &csrfapi.CsrfPolicy{FilterEnabled: true},
+				}),
+				policyWithSpec("rate-limit", &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimitapi.RouteRateLimit{},
+				}),
+				policyWithSpec("extauth", &v1.TrafficPolicySpec_Policy{
+					Extauth: &extauthapi.RouteExtauth{
+						Spec: &extauthapi.RouteExtauth_Disable{Disable: true},
+					},
+				}),
+			}
+			efDecorators = []decorators.Decorator{
+				retries.NewRetriesDecorator(mockClusterDomainRegistry),
+				csrf.NewCsrfDecorator(mockClusterDomainRegistry),
+				ratelimit.NewRateLimitDecorator(mockClusterDomainRegistry, in.Destinations(), in.RateLimitClientConfigs()),
+				extauth.NewExtAuthDecorator(mockClusterDomainRegistry, in.Destinations()),
+			}
+		})
+
+		It("should report each TrafficPolicy which requires an EnvoyFilter", func() {
+			expectedErrors := map[string]string{
+				"retry-backoff": "retries: retry backoff is only supported for Kubernetes service destinations",
+				"csrf":          "csrf: CSRF policies are only supported for Kubernetes service destinations",
+				"rate-limit":    "rate-limit: rate limits are only supported for Kubernetes service destinations",
+				"extauth":       "extauth: extauth is only supported for Kubernetes service destinations",
+			}
+			reportedErrors := map[string]string{}
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+					reportedErrors[trafficPolicy.GetName()] = err.Error()
+				}).
+				Times(len(expectedErrors))
+
+			envoyFilters := envoyFilterTranslator.Translate(ctx, in, destination, mockReporter)
+			Expect(envoyFilters).To(BeEmpty())
+			Expect(reportedErrors).To(Equal(expectedErrors))
+		})
+	})
+})
@@ -132,6 +132,18 @@ func (t *translator) Translate(
 			reporter,
 		)
 
+		// take a reference to any translated remote DestinationRule so that we can copy over any necessary fields for the local DestinationRule for the federated FQDN
+		// this avoids re-translating the DestinationRule
+		if remoteDestinationRule == nil {
+			remoteDestinationRule = destinationRule
+		}
+
+		// with limited trust, requests from remote meshes must originate mTLS to the ingress gateway of the Destination's Mesh,
+		// which terminates mTLS for the federated Destination
+		if destinationVirtualMesh.Spec.GetMtlsConfig().GetLimited() != nil && destinationRule != nil {
+			destinationRule = buildLimitedTrustDestinationRule(destinationRule, remoteServiceEntryTemplate)
+		}
+
 		// Append the VirtualMesh as a parent to the outputs
 		metautils.AppendParent(t.ctx, serviceEntry, destination.Status.AppliedFederation.GetVirtualMeshRef(), networkingv1.VirtualMesh{}.GVK())
 		metautils.AppendParent(t.ctx, virtualService, destination.Status.AppliedFederation.GetVirtualMeshRef(), networkingv1.VirtualMesh{}.GVK())
@@ -140,12 +152,6 @@ func (t *translator) Translate(
 		serviceEntries = append(serviceEntries, serviceEntry)
 		virtualServices = append(virtualServices, virtualService)
 		destinationRules = append(destinationRules, destinationRule)
-
-		// take a reference to any translated remote DestinationRule so that we can copy over any necessary fields for the local DestinationRule for the federated FQDN
-		// this avoids re-translating the DestinationRule
-		if remoteDestinationRule == nil {
-			remoteDestinationRule = destinationRule
-		}
 	}
 
 	// translate local resources
@@ -185,6 +191,17 @@ func (t *translator) translateRemoteServiceEntryTemplate(
 		// should never happen
 		return nil, eris.Errorf("unexpected error: failed to generate service entry ip: %v", err)
 	}
+	serviceEntryAddresses := []string{serviceEntryIP.String()}
+
+	// dual-stack and IPv6 services additionally receive an IPv6 address so that clients of either family can resolve the federated hostname
+	if hasIpv6Endpoints(kubeService) {
+		serviceEntryIPv6, err := destinationutils.ConstructUniqueIpv6ForKubeService(kubeService.GetRef())
+		if err != nil {
+			// should never happen
+			return nil, eris.Errorf("unexpected error: failed to generate service entry ipv6 address: %v", err)
+		}
+		serviceEntryAddresses = append(serviceEntryAddresses, serviceEntryIPv6.String())
+	}
 
 	var sePorts []*networkingv1alpha3spec.Port
 	workloadEntryPortMapping := make(map[string]uint32)
@@ -240,7 +257,7 @@ func (t *translator) translateRemoteServiceEntryTemplate(
 			Labels: metautils.TranslatedObjectLabels(),
 		},
 		Spec: networkingv1alpha3spec.ServiceEntry{
-			Addresses:  []string{serviceEntryIP.String()},
+			Addresses:  serviceEntryAddresses,
 			Hosts:      []string{federatedHostname},
 			Location:   networkingv1alpha3spec.ServiceEntry_MESH_INTERNAL,
 			Resolution: resolution,
@@ -370,6 +387,49 @@ func (t *translator) translateForRemoteMesh(
 	return serviceEntry, virtualService, destinationRule
 }
 
+// set the SNI of the federated Destination port on the mTLS settings for each port of the remote DestinationRule,
+// so that the ingress gateway of the Destination's Mesh can route the request after terminating mTLS
+func buildLimitedTrustDestinationRule(
+	destinationRule *networkingv1alpha3.DestinationRule,
+	serviceEntryTemplate *networkingv1alpha3.ServiceEntry,
+) *networkingv1alpha3.DestinationRule {
+	destinationRule = destinationRule.DeepCopy()
+	trafficPolicy := destinationRule.Spec.GetTrafficPolicy()
+	if trafficPolicy == nil {
+		trafficPolicy = &networkingv1alpha3spec.TrafficPolicy{}
+		destinationRule.Spec.TrafficPolicy = trafficPolicy
+	}
+	federatedHostname := serviceEntryTemplate.Spec.GetHosts()[0]
+
+	for _, port := range serviceEntryTemplate.Spec.GetPorts() {
+		tlsSettings := &networkingv1alpha3spec.ClientTLSSettings{
+			Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+			Sni:  hostutils.BuildLimitedTrustSniHost(port.GetNumber(), federatedHostname),
+		}
+
+		var portSettings *networkingv1alpha3spec.TrafficPolicy_PortTrafficPolicy
+		for _, existingPortSettings := range trafficPolicy.GetPortLevelSettings() {
+			if existingPortSettings.GetPort().GetNumber() == port.GetNumber() {
+				portSettings = existingPortSettings
+				break
+			}
+		}
+		if portSettings == nil {
+			// inherit the settings of the DestinationRule, which are overridden by port level settings
+			portSettings = &networkingv1alpha3spec.TrafficPolicy_PortTrafficPolicy{
+				Port:             &networkingv1alpha3spec.PortSelector{Number: port.GetNumber()},
+				LoadBalancer:     trafficPolicy.GetLoadBalancer(),
+				ConnectionPool:   trafficPolicy.GetConnectionPool(),
+				OutlierDetection: trafficPolicy.GetOutlierDetection(),
+			}
+			trafficPolicy.PortLevelSettings = append(trafficPolicy.PortLevelSettings, portSettings)
+		}
+		portSettings.Tls = tlsSettings
+	}
+
+	return destinationRule
+}
+
 // ConvertKubePortProtocol converts protocol of k8s Service port to application level protocol
 // exported for use in enterprise
 func ConvertKubePortProtocol(port *discoveryv1.DestinationSpec_KubeService_KubeServicePort) string {
@@ -384,6 +444,16 @@ func ConvertKubePortProtocol(port *discoveryv1.DestinationSpec_KubeService_KubeS
 	return string(convertedProtocol)
 }
 
+// return true if any of the kube service's endpoints have an IPv6 address
+func hasIpv6Endpoints(kubeService *discoveryv1.DestinationSpec_KubeService) bool {
+	for _, endpointSubset := range kubeService.GetEndpointSubsets() {
+		if endpointSubset.GetAddressFamily() == discoveryv1.DestinationSpec_KubeService_EndpointsSubset_IPV6 {
+			return true
+		}
+	}
+	return false
+}
+
 func getHostnameSuffix(hostname string) string {
 	split := strings.Split(hostname, ".")
 	return split[len(split)-1]
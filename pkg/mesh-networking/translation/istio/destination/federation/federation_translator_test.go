@@ -372,6 +372,268 @@ var _ = Describe("FederationTranslator", func() {
 		Expect(destinationRules).To(ConsistOf([]*networkingv1alpha3.DestinationRule{expectedRemoteDR, expectedRemoteDR, expectedLocalDestinationRule}))
 	})
 
+	It("sets the SNI of the federated Destination ports on remote DestinationRules for VirtualMeshes with limited trust", func() {
+		destinationMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "federated-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "namespace",
+						Cluster:   "cluster",
+					},
+				}},
+			},
+			Status: discoveryv1.MeshStatus{
+				AppliedEastWestIngressGateways: []*commonv1.AppliedIngressGateway{
+					{
+						ExternalAddresses: []string{"172.18.0.2"},
+						ExternalPort:      8181,
+					},
+				},
+			},
+		}
+		remoteMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "client-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "remote-namespace",
+						Cluster:   "remote-cluster",
+					},
+				}},
+			},
+		}
+		destinationVirtualMesh := &networkingv1.VirtualMesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virtual-mesh",
+				Namespace: "namespace",
+			},
+			Spec: networkingv1.VirtualMeshSpec{
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Limited{
+						Limited: &networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust{},
+					},
+				},
+			},
+		}
+
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "some-svc",
+							Namespace:   "some-ns",
+							ClusterName: "cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     9080,
+								Name:     "http",
+								Protocol: "TCP",
+							},
+							{
+								Port:     9443,
+								Name:     "https",
+								Protocol: "TCP",
+							},
+						},
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(destinationMesh),
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					VirtualMeshRef:    ezkube.MakeObjectRef(destinationVirtualMesh),
+					FederatedHostname: "some-svc.some-ns.svc.cluster.global",
+					FederatedToMeshes: []*skv2corev1.ObjectRef{
+						ezkube.MakeObjectRef(remoteMesh),
+					},
+				},
+			},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("ignored").
+			AddDestinations(discoveryv1.DestinationSlice{destination}).
+			AddMeshes(discoveryv1.MeshSlice{destinationMesh, remoteMesh}).
+			AddVirtualMeshes(networkingv1.VirtualMeshSlice{destinationVirtualMesh}).
+			Build()
+
+		mockVirtualServiceTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, remoteMesh.Spec.GetIstio().Installation, mockReporter).
+			Return(nil)
+
+		remoteDR := &networkingv1alpha3.DestinationRule{
+			Spec: networkingv1alpha3spec.DestinationRule{
+				Host: "some-svc.some-ns.svc.cluster.global",
+				TrafficPolicy: &networkingv1alpha3spec.TrafficPolicy{
+					Tls: &networkingv1alpha3spec.ClientTLSSettings{
+						Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+					},
+					PortLevelSettings: []*networkingv1alpha3spec.TrafficPolicy_PortTrafficPolicy{
+						{
+							Port: &networkingv1alpha3spec.PortSelector{Number: 9443},
+							ConnectionPool: &networkingv1alpha3spec.ConnectionPoolSettings{
+								Tcp: &networkingv1alpha3spec.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+							},
+						},
+					},
+				},
+			},
+		}
+		mockDestinationRuleTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, remoteMesh.Spec.GetIstio().Installation, mockReporter).
+			Return(remoteDR)
+
+		_, _, destinationRules := federationTranslator.Translate(in, destination, mockReporter)
+		Expect(destinationRules).To(HaveLen(2))
+
+		expectedRemoteDR := remoteDR.DeepCopy()
+		metautils.AppendParent(ctx, expectedRemoteDR, ezkube.MakeObjectRef(destinationVirtualMesh), networkingv1.VirtualMesh{}.GVK())
+		expectedRemoteDR.Spec.TrafficPolicy.PortLevelSettings = []*networkingv1alpha3spec.TrafficPolicy_PortTrafficPolicy{
+			{
+				Port: &networkingv1alpha3spec.PortSelector{Number: 9443},
+				ConnectionPool: &networkingv1alpha3spec.ConnectionPoolSettings{
+					Tcp: &networkingv1alpha3spec.ConnectionPoolSettings_TCPSettings{MaxConnections: 10},
+				},
+				Tls: &networkingv1alpha3spec.ClientTLSSettings{
+					Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+					Sni:  "9443.some-svc.some-ns.svc.cluster.global",
+				},
+			},
+			{
+				Port: &networkingv1alpha3spec.PortSelector{Number: 9080},
+				Tls: &networkingv1alpha3spec.ClientTLSSettings{
+					Mode: networkingv1alpha3spec.ClientTLSSettings_ISTIO_MUTUAL,
+					Sni:  "9080.some-svc.some-ns.svc.cluster.global",
+				},
+			},
+		}
+		Expect(destinationRules[0]).To(Equal(expectedRemoteDR))
+
+		// the local DestinationRule routes requests from the ingress gateway to the Destination, and is not modified
+		Expect(destinationRules[1].Spec.TrafficPolicy).To(Equal(remoteDR.Spec.TrafficPolicy))
+	})
+
+	It("assigns both IPv4 and IPv6 addresses to the remote ServiceEntry of a dual-stack Destination", func() {
+		destinationMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "federated-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "namespace",
+						Cluster:   "cluster",
+					},
+				}},
+			},
+			Status: discoveryv1.MeshStatus{
+				AppliedEastWestIngressGateways: []*commonv1.AppliedIngressGateway{
+					{
+						ExternalAddresses: []string{"172.18.0.2"},
+						ExternalPort:      8181,
+					},
+				},
+			},
+		}
+		remoteMesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "client-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "remote-namespace",
+						Cluster:   "remote-cluster",
+					},
+				}},
+			},
+		}
+		destinationVirtualMesh := &networkingv1.VirtualMesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virtual-mesh",
+				Namespace: "namespace",
+			},
+		}
+
+		destination := &discoveryv1.Destination{
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "some-svc",
+							Namespace:   "some-ns",
+							ClusterName: "cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     9080,
+								Name:     "http",
+								Protocol: "TCP",
+							},
+						},
+						EndpointSubsets: []*discoveryv1.DestinationSpec_KubeService_EndpointsSubset{
+							{
+								Endpoints: []*discoveryv1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+									{IpAddress: "10.0.0.1"},
+								},
+								AddressFamily: discoveryv1.DestinationSpec_KubeService_EndpointsSubset_IPV4,
+							},
+							{
+								Endpoints: []*discoveryv1.DestinationSpec_KubeService_EndpointsSubset_Endpoint{
+									{IpAddress: "fd00:10:244::1"},
+								},
+								AddressFamily: discoveryv1.DestinationSpec_KubeService_EndpointsSubset_IPV6,
+							},
+						},
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(destinationMesh),
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					VirtualMeshRef:    ezkube.MakeObjectRef(destinationVirtualMesh),
+					FederatedHostname: "some-svc.some-ns.svc.cluster.global",
+					FederatedToMeshes: []*skv2corev1.ObjectRef{
+						ezkube.MakeObjectRef(remoteMesh),
+					},
+				},
+			},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("ignored").
+			AddDestinations(discoveryv1.DestinationSlice{destination}).
+			AddMeshes(discoveryv1.MeshSlice{destinationMesh, remoteMesh}).
+			AddVirtualMeshes(networkingv1.VirtualMeshSlice{destinationVirtualMesh}).
+			Build()
+
+		mockVirtualServiceTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, remoteMesh.Spec.GetIstio().Installation, mockReporter).
+			Return(nil)
+		mockDestinationRuleTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, remoteMesh.Spec.GetIstio().Installation, mockReporter).
+			Return(nil)
+
+		serviceEntries, _, _ := federationTranslator.Translate(in, destination, mockReporter)
+		Expect(serviceEntries).To(HaveLen(2))
+
+		// the remote ServiceEntry is assigned a unique address of each family
+		Expect(serviceEntries[0].Spec.Addresses).To(Equal([]string{"243.21.204.125", "2001:2::e596:45a8:33b2:f373"}))
+	})
+
 	It("should set ServiceEntry resolution to STATIC if any endpoints have ipv6 address", func() {
 		workloadEntries := []*networkingv1alpha3spec.WorkloadEntry{
 			{
@@ -6,15 +6,18 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/federation"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/settingsutils"
 
-	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/authorizationpolicy"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/envoyfilter"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/requestauthentication"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
@@ -39,12 +42,15 @@ type Translator interface {
 }
 
 type translator struct {
-	ctx                   context.Context
-	userSupplied          input.RemoteSnapshot
-	destinationRules      destinationrule.Translator
-	virtualServices       virtualservice.Translator
-	authorizationPolicies authorizationpolicy.Translator
-	federation            federation.Translator
+	ctx                    context.Context
+	userSupplied           input.RemoteSnapshot
+	destinationRules       destinationrule.Translator
+	virtualServices        virtualservice.Translator
+	authorizationPolicies  authorizationpolicy.Translator
+	requestAuthentications requestauthentication.Translator
+	envoyFilters           envoyfilter.Translator
+	rateLimitConfigs       ratelimit.Translator
+	federation             federation.Translator
 }
 
 func NewTranslator(
@@ -65,11 +71,14 @@ func NewTranslator(
 	destinationRuleTranslator := destinationrule.NewTranslator(settingsutils.SettingsFromContext(ctx), existingDestinationRules, clusterDomains, decoratorFactory, destinations)
 
 	return &translator{
-		ctx:                   ctx,
-		virtualServices:       virtualServiceTranslator,
-		destinationRules:      destinationRuleTranslator,
-		authorizationPolicies: authorizationpolicy.NewTranslator(),
-		federation:            federation.NewTranslator(ctx, virtualServiceTranslator, destinationRuleTranslator),
+		ctx:                    ctx,
+		virtualServices:        virtualServiceTranslator,
+		destinationRules:       destinationRuleTranslator,
+		authorizationPolicies:  authorizationpolicy.NewTranslator(),
+		requestAuthentications: requestauthentication.NewTranslator(),
+		envoyFilters:           envoyfilter.NewTranslator(clusterDomains, decoratorFactory),
+		rateLimitConfigs:       ratelimit.NewTranslator(),
+		federation:             federation.NewTranslator(ctx, virtualServiceTranslator, destinationRuleTranslator),
 	}
 }
 
@@ -98,11 +107,37 @@ func (t *translator) Translate(
 	metautils.AppendParent(t.ctx, dr, destination, destination.GVK())
 	outputs.AddDestinationRules(dr)
 
-	// Translate AuthorizationPolicies for Destinations, can be nil if there is no service or applied traffic policies
-	ap := t.authorizationPolicies.Translate(in, destination, reporter)
-	// Append the Destination as a parent to the authorization policy
-	metautils.AppendParent(t.ctx, ap, destination, destination.GVK())
-	outputs.AddAuthorizationPolicies(ap)
+	// Translate AuthorizationPolicies for Destinations, one per AccessPolicy action, can be empty if there is no service or applied access policies
+	aps := t.authorizationPolicies.Translate(in, destination, reporter)
+	for _, ap := range aps {
+		// Append the Destination as a parent to the authorization policy
+		metautils.AppendParent(t.ctx, ap, destination, destination.GVK())
+	}
+	outputs.AddAuthorizationPolicies(aps...)
+
+	// Translate RequestAuthentication for Destinations, can be nil if there is no service or no applied traffic policies which configure JWT authentication
+	ra := t.requestAuthentications.Translate(destination, reporter)
+	// Append the Destination as a parent to the request authentication
+	metautils.AppendParent(t.ctx, ra, destination, destination.GVK())
+	outputs.AddRequestAuthentications(ra)
+
+	// Translate EnvoyFilters for Destinations, can be empty if there are no applied traffic policies which require an EnvoyFilter
+	efs := t.envoyFilters.Translate(t.ctx, in, destination, reporter)
+	for _, ef := range efs {
+		// Append the Destination as a parent to the envoy filter
+		metautils.AppendParent(t.ctx, ef, destination, destination.GVK())
+	}
+	outputs.AddEnvoyFilters(efs...)
+
+	// Translate RateLimitConfigs for the RateLimitServerConfigs selected by the Destination's applied traffic policies
+	for _, rateLimitConfig := range t.rateLimitConfigs.Translate(in, destination, reporter) {
+		// the same RateLimitServerConfig may be selected for multiple Destinations, in which case each is appended as a parent
+		if existing, err := outputs.GetRateLimitConfigs().Find(rateLimitConfig); err == nil {
+			rateLimitConfig = existing
+		}
+		metautils.AppendParent(t.ctx, rateLimitConfig, destination, destination.GVK())
+		outputs.AddRateLimitConfigs(rateLimitConfig)
+	}
 
 	// parent annotations are added inside Translate()
 	serviceEntries, virtualServices, destinationRules := t.federation.Translate(in, destination, reporter)
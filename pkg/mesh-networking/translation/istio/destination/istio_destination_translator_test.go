@@ -11,9 +11,14 @@ import (
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	mock_authorizationpolicy "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/authorizationpolicy/mocks"
 	mock_destinationrule "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/destinationrule/mocks"
+	mock_envoyfilter "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/envoyfilter/mocks"
 	mock_federation "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/federation/mocks"
+	mock_ratelimit "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/ratelimit/mocks"
+	mock_requestauthentication "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/requestauthentication/mocks"
 	mock_virtualservice "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice/mocks"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	ratelimitv1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	ratelimitv1alpha1sets "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1/sets"
 	"istio.io/client-go/pkg/apis/networking/v1alpha3"
 	"istio.io/client-go/pkg/apis/security/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +30,9 @@ var _ = Describe("IstioDestinationTranslator", func() {
 		mockDestinationRuleTranslator     *mock_destinationrule.MockTranslator
 		mockVirtualServiceTranslator      *mock_virtualservice.MockTranslator
 		mockAuthorizationPolicyTranslator *mock_authorizationpolicy.MockTranslator
+		mockRequestAuthTranslator         *mock_requestauthentication.MockTranslator
+		mockEnvoyFilterTranslator         *mock_envoyfilter.MockTranslator
+		mockRateLimitConfigTranslator     *mock_ratelimit.MockTranslator
 		mockFederationTranslator          *mock_federation.MockTranslator
 		mockOutputs                       *mock_output.MockBuilder
 		mockReporter                      *mock_reporting.MockReporter
@@ -37,15 +45,21 @@ var _ = Describe("IstioDestinationTranslator", func() {
 		mockDestinationRuleTranslator = mock_destinationrule.NewMockTranslator(ctrl)
 		mockVirtualServiceTranslator = mock_virtualservice.NewMockTranslator(ctrl)
 		mockAuthorizationPolicyTranslator = mock_authorizationpolicy.NewMockTranslator(ctrl)
+		mockRequestAuthTranslator = mock_requestauthentication.NewMockTranslator(ctrl)
+		mockEnvoyFilterTranslator = mock_envoyfilter.NewMockTranslator(ctrl)
+		mockRateLimitConfigTranslator = mock_ratelimit.NewMockTranslator(ctrl)
 		mockFederationTranslator = mock_federation.NewMockTranslator(ctrl)
 		mockOutputs = mock_output.NewMockBuilder(ctrl)
 		mockReporter = mock_reporting.NewMockReporter(ctrl)
 		istioDestinationTranslator = &translator{
-			ctx:                   ctx,
-			destinationRules:      mockDestinationRuleTranslator,
-			virtualServices:       mockVirtualServiceTranslator,
-			authorizationPolicies: mockAuthorizationPolicyTranslator,
-			federation:            mockFederationTranslator,
+			ctx:                    ctx,
+			destinationRules:       mockDestinationRuleTranslator,
+			virtualServices:        mockVirtualServiceTranslator,
+			authorizationPolicies:  mockAuthorizationPolicyTranslator,
+			requestAuthentications: mockRequestAuthTranslator,
+			envoyFilters:           mockEnvoyFilterTranslator,
+			rateLimitConfigs:       mockRateLimitConfigTranslator,
+			federation:             mockFederationTranslator,
 		}
 	})
 
@@ -81,7 +95,10 @@ var _ = Describe("IstioDestinationTranslator", func() {
 
 		vs := &v1alpha3.VirtualService{}
 		dr := &v1alpha3.DestinationRule{}
-		ap := &v1beta1.AuthorizationPolicy{}
+		aps := []*v1beta1.AuthorizationPolicy{{}, {}}
+		ra := &v1beta1.RequestAuthentication{}
+		efs := []*v1alpha3.EnvoyFilter{{}, {}}
+		rlc := &ratelimitv1alpha1.RateLimitConfig{}
 		federatedSe := []*v1alpha3.ServiceEntry{}
 		federatedVs := []*v1alpha3.VirtualService{}
 		federatedDr := []*v1alpha3.DestinationRule{}
@@ -97,7 +114,19 @@ var _ = Describe("IstioDestinationTranslator", func() {
 		mockAuthorizationPolicyTranslator.
 			EXPECT().
 			Translate(in, destination, mockReporter).
-			Return(ap)
+			Return(aps)
+		mockRequestAuthTranslator.
+			EXPECT().
+			Translate(destination, mockReporter).
+			Return(ra)
+		mockEnvoyFilterTranslator.
+			EXPECT().
+			Translate(ctx, in, destination, mockReporter).
+			Return(efs)
+		mockRateLimitConfigTranslator.
+			EXPECT().
+			Translate(in, destination, mockReporter).
+			Return([]*ratelimitv1alpha1.RateLimitConfig{rlc})
 		mockFederationTranslator.
 			EXPECT().
 			Translate(in, destination, mockReporter).
@@ -110,7 +139,20 @@ var _ = Describe("IstioDestinationTranslator", func() {
 			AddDestinationRules(dr)
 		mockOutputs.
 			EXPECT().
-			AddAuthorizationPolicies(ap)
+			AddAuthorizationPolicies(aps[0], aps[1])
+		mockOutputs.
+			EXPECT().
+			AddRequestAuthentications(ra)
+		mockOutputs.
+			EXPECT().
+			AddEnvoyFilters(efs[0], efs[1])
+		mockOutputs.
+			EXPECT().
+			GetRateLimitConfigs().
+			Return(ratelimitv1alpha1sets.NewRateLimitConfigSet())
+		mockOutputs.
+			EXPECT().
+			AddRateLimitConfigs(rlc)
 		mockOutputs.
 			EXPECT().
 			AddServiceEntries(federatedSe)
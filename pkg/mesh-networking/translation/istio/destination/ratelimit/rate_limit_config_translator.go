@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
+	solov1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//go:generate mockgen -source ./rate_limit_config_translator.go -destination mocks/rate_limit_config_translator.go
+
+const (
+	translatorName = "rate-limit-config-translator"
+)
+
+// the RateLimitConfig translator translates the RateLimitServerConfigs selected by a Destination's TrafficPolicies into RateLimitConfigs.
+type Translator interface {
+	// Translate translates a RateLimitConfig for each RateLimitServerConfig selected by a TrafficPolicy applied to the given Destination.
+	// The RateLimitConfigs are output to the Destination's cluster, where they are consumed by the rate limit server.
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		in input.LocalSnapshot,
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) []*solov1alpha1.RateLimitConfig
+}
+
+type translator struct{}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+func (t *translator) Translate(
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) []*solov1alpha1.RateLimitConfig {
+	kubeService := destination.Spec.GetKubeService()
+
+	if kubeService == nil {
+		// rate limits are only enforced by the sidecars of kube services;
+		// the EnvoyFilter translator reports rate limits applied to other Destinations
+		return nil
+	}
+
+	var rateLimitConfigs []*solov1alpha1.RateLimitConfig
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		serverConfigSelector := policy.Spec.GetPolicy().GetRateLimit().GetRatelimitServerConfigSelector()
+		if serverConfigSelector == nil {
+			continue
+		}
+
+		serverConfigs := in.RateLimitServerConfigs().List(func(serverConfig *networkingv1beta1.RateLimitServerConfig) bool {
+			return !selectorutils.SelectorMatchesObject(serverConfig, serverConfigSelector, policy.GetRef().GetNamespace())
+		})
+		if len(serverConfigs) == 0 {
+			reporter.ReportTrafficPolicyToDestination(
+				destination,
+				policy.Ref,
+				eris.Errorf("%v: no RateLimitServerConfigs match the ratelimit_server_config_selector", translatorName),
+			)
+			continue
+		}
+
+		for _, serverConfig := range serverConfigs {
+			rateLimitConfigs = append(rateLimitConfigs, translateServerConfig(serverConfig, kubeService.GetRef().GetClusterName()))
+		}
+	}
+
+	return rateLimitConfigs
+}
+
+// the RateLimitConfig shares the name and namespace of the RateLimitServerConfig from which it was translated
+func translateServerConfig(
+	serverConfig *networkingv1beta1.RateLimitServerConfig,
+	clusterName string,
+) *solov1alpha1.RateLimitConfig {
+	return &solov1alpha1.RateLimitConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        serverConfig.GetName(),
+			Namespace:   serverConfig.GetNamespace(),
+			ClusterName: clusterName,
+			Labels:      metautils.TranslatedObjectLabels(),
+		},
+		Spec: solov1alpha1.RateLimitConfigSpec{
+			ConfigType: &solov1alpha1.RateLimitConfigSpec_Raw_{
+				Raw: &solov1alpha1.RateLimitConfigSpec_Raw{
+					Descriptors:    serverConfig.Spec.GetRaw().GetDescriptors(),
+					SetDescriptors: serverConfig.Spec.GetRaw().GetSetDescriptors(),
+				},
+			},
+		},
+	}
+}
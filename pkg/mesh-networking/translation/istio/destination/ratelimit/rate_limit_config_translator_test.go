@@ -0,0 +1,187 @@
+package ratelimit_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	networkingv1beta1 "github.com/solo-io/gloo-mesh/pkg/api/networking.enterprise.mesh.gloo.solo.io/v1beta1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/ratelimit"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	ratelimittranslator "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/ratelimit"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	solov1alpha1 "github.com/solo-io/solo-apis/pkg/api/ratelimit.solo.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RateLimitConfigTranslator", func() {
+	var (
+		ctrl         *gomock.Controller
+		translator   ratelimittranslator.Translator
+		mockReporter *mock_reporting.MockReporter
+		destination  *discoveryv1.Destination
+		descriptors  = []*solov1alpha1.Descriptor{
+			{
+				Key:   "generic_key",
+				Value: "counter",
+				RateLimit: &solov1alpha1.RateLimit{
+					Unit:            solov1alpha1.RateLimit_MINUTE,
+					RequestsPerUnit: 10,
+				},
+			},
+		}
+		serverConfig = &networkingv1beta1.RateLimitServerConfig{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "server-config",
+				Namespace: "tp-namespace",
+				Labels:    map[string]string{"rate": "limited"},
+			},
+			Spec: networkingv1beta1.RateLimitServerConfigSpec{
+				ConfigType: &networkingv1beta1.RateLimitServerConfigSpec_Raw_{
+					Raw: &networkingv1beta1.RateLimitServerConfigSpec_Raw{
+						Descriptors: descriptors,
+					},
+				},
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		translator = ratelimittranslator.NewTranslator()
+		destination = &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	appliedPolicyWithSelector := func(selector *skv2corev1.ObjectSelector) *v1.AppliedTrafficPolicy {
+		return &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "tp",
+				Namespace: "tp-namespace",
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					RateLimit: &ratelimit.RouteRateLimit{
+						RatelimitServerConfigSelector: selector,
+					},
+				},
+			},
+		}
+	}
+
+	It("should translate a RateLimitConfig for each selected RateLimitServerConfig", func() {
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			appliedPolicyWithSelector(&skv2corev1.ObjectSelector{
+				Labels: map[string]string{"rate": "limited"},
+			}),
+		}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddRateLimitServerConfigs([]*networkingv1beta1.RateLimitServerConfig{
+				serverConfig,
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-server-config",
+						Namespace: "tp-namespace",
+					},
+				},
+			}).
+			Build()
+
+		expectedRateLimitConfigs := []*solov1alpha1.RateLimitConfig{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "server-config",
+					Namespace:   "tp-namespace",
+					ClusterName: "cluster",
+					Labels:      metautils.TranslatedObjectLabels(),
+				},
+				Spec: solov1alpha1.RateLimitConfigSpec{
+					ConfigType: &solov1alpha1.RateLimitConfigSpec_Raw_{
+						Raw: &solov1alpha1.RateLimitConfigSpec_Raw{
+							Descriptors: descriptors,
+						},
+					},
+				},
+			},
+		}
+
+		rateLimitConfigs := translator.Translate(in, destination, mockReporter)
+		Expect(rateLimitConfigs).To(Equal(expectedRateLimitConfigs))
+	})
+
+	It("should not translate RateLimitConfigs if no RateLimitServerConfig selector is specified", func() {
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{appliedPolicyWithSelector(nil)}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddRateLimitServerConfigs([]*networkingv1beta1.RateLimitServerConfig{serverConfig}).
+			Build()
+
+		rateLimitConfigs := translator.Translate(in, destination, mockReporter)
+		Expect(rateLimitConfigs).To(BeNil())
+	})
+
+	It("should report an error if no RateLimitServerConfigs are selected", func() {
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			appliedPolicyWithSelector(&skv2corev1.ObjectSelector{
+				Labels: map[string]string{"rate": "unlimited"},
+			}),
+		}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddRateLimitServerConfigs([]*networkingv1beta1.RateLimitServerConfig{serverConfig}).
+			Build()
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring("no RateLimitServerConfigs match the ratelimit_server_config_selector"))
+			})
+
+		rateLimitConfigs := translator.Translate(in, destination, mockReporter)
+		Expect(rateLimitConfigs).To(BeNil())
+	})
+
+	It("should not translate RateLimitConfigs for external services", func() {
+		destination.Spec.Type = &discoveryv1.DestinationSpec_ExternalService_{
+			ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+				Name:  "external-api",
+				Hosts: []string{"api.example.com"},
+			},
+		}
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			appliedPolicyWithSelector(&skv2corev1.ObjectSelector{
+				Labels: map[string]string{"rate": "limited"},
+			}),
+		}
+		in := input.NewInputLocalSnapshotManualBuilder("").
+			AddRateLimitServerConfigs([]*networkingv1beta1.RateLimitServerConfig{serverConfig}).
+			Build()
+
+		// the rate limit is reported by the EnvoyFilter translator, which translates the client side of the rate limit
+		rateLimitConfigs := translator.Translate(in, destination, mockReporter)
+		Expect(rateLimitConfigs).To(BeNil())
+	})
+})
@@ -0,0 +1,161 @@
+package requestauthentication
+
+import (
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	"github.com/solo-io/skv2/pkg/equalityutils"
+	securityv1beta1spec "istio.io/api/security/v1beta1"
+	typesv1beta1 "istio.io/api/type/v1beta1"
+	securityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+)
+
+//go:generate mockgen -source ./request_authentication_translator.go -destination mocks/request_authentication_translator.go
+
+const (
+	translatorName = "request-authentication-translator"
+)
+
+// the RequestAuthentication translator translates a Destination into a RequestAuthentication.
+type Translator interface {
+	// Translate translates a RequestAuthentication containing the JWT providers of the TrafficPolicies applied to the given Destination.
+	// The RequestAuthentication is output to the Destination's cluster, where it is enforced by the Destination's sidecars.
+	// returns nil if no RequestAuthentication is required for the Destination (i.e. if no TrafficPolicy configures JWT authentication).
+	//
+	// Errors caused by invalid user config will be reported using the Reporter.
+	Translate(
+		destination *discoveryv1.Destination,
+		reporter reporting.Reporter,
+	) *securityv1beta1.RequestAuthentication
+}
+
+type translator struct{}
+
+func NewTranslator() Translator {
+	return &translator{}
+}
+
+func (t *translator) Translate(
+	destination *discoveryv1.Destination,
+	reporter reporting.Reporter,
+) *securityv1beta1.RequestAuthentication {
+	kubeService := destination.Spec.GetKubeService()
+
+	if kubeService == nil {
+		for _, policy := range destination.Status.AppliedTrafficPolicies {
+			if policy.Spec.GetPolicy().GetJwt() == nil {
+				continue
+			}
+			reporter.ReportTrafficPolicyToDestination(
+				destination,
+				policy.Ref,
+				eris.Errorf("%v: JWT authentication is only supported for Kubernetes service destinations", translatorName),
+			)
+		}
+		return nil
+	}
+
+	var jwtRules []*securityv1beta1spec.JWTRule
+	for _, policy := range destination.Status.AppliedTrafficPolicies {
+		if policy.Spec.GetPolicy().GetJwt() == nil {
+			continue
+		}
+
+		policyRules, err := translateJwtAuthentication(policy.Spec)
+		if err == nil {
+			jwtRules, err = mergeJwtRules(jwtRules, policyRules)
+		}
+		if err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrapf(err, "%v", translatorName))
+		}
+	}
+
+	if len(jwtRules) == 0 {
+		return nil
+	}
+
+	return &securityv1beta1.RequestAuthentication{
+		ObjectMeta: metautils.TranslatedObjectMeta(
+			kubeService.GetRef(),
+			destination.Annotations,
+		),
+		Spec: securityv1beta1spec.RequestAuthentication{
+			Selector: &typesv1beta1.WorkloadSelector{
+				MatchLabels: kubeService.GetWorkloadSelectorLabels(),
+			},
+			JwtRules: jwtRules,
+		},
+	}
+}
+
+// translate the JWT providers of a TrafficPolicy into Istio JWT rules
+func translateJwtAuthentication(trafficPolicy *v1.TrafficPolicySpec) ([]*securityv1beta1spec.JWTRule, error) {
+	// JWT authentication is enforced by the Destination's sidecars, which cannot distinguish requests by source workload or route
+	if len(trafficPolicy.GetSourceSelector()) > 0 {
+		return nil, eris.New("JWT authentication is applied to all traffic to the Destination and cannot be scoped with source selectors")
+	}
+	if len(trafficPolicy.GetHttpRequestMatchers()) > 0 {
+		return nil, eris.New("JWT authentication is applied to all traffic to the Destination and cannot be scoped with http request matchers")
+	}
+
+	providers := trafficPolicy.GetPolicy().GetJwt().GetProviders()
+	if len(providers) == 0 {
+		return nil, eris.New("JWT authentication must specify at least one provider")
+	}
+
+	var jwtRules []*securityv1beta1spec.JWTRule
+	for _, provider := range providers {
+		if provider.GetIssuer() == "" {
+			return nil, eris.New("JWT providers must specify an issuer")
+		}
+
+		jwtRule := &securityv1beta1spec.JWTRule{
+			Issuer:                provider.GetIssuer(),
+			Audiences:             provider.GetAudiences(),
+			JwksUri:               provider.GetJwksUri(),
+			Jwks:                  provider.GetJwks(),
+			FromParams:            provider.GetFromParams(),
+			OutputPayloadToHeader: provider.GetOutputPayloadToHeader(),
+			ForwardOriginalToken:  provider.GetForwardOriginalToken(),
+		}
+		for _, header := range provider.GetFromHeaders() {
+			if header.GetName() == "" {
+				return nil, eris.Errorf("JWT provider %s must specify a name for each header the token is extracted from", provider.GetIssuer())
+			}
+			jwtRule.FromHeaders = append(jwtRule.FromHeaders, &securityv1beta1spec.JWTHeader{
+				Name:   header.GetName(),
+				Prefix: header.GetPrefix(),
+			})
+		}
+		jwtRules = append(jwtRules, jwtRule)
+	}
+
+	return jwtRules, nil
+}
+
+// merge the JWT rules of a TrafficPolicy into the JWT rules of previously translated TrafficPolicies.
+// An issuer can only be configured multiple times if the configuration is identical.
+func mergeJwtRules(
+	existingRules []*securityv1beta1spec.JWTRule,
+	newRules []*securityv1beta1spec.JWTRule,
+) ([]*securityv1beta1spec.JWTRule, error) {
+	mergedRules := existingRules
+	for _, newRule := range newRules {
+		var duplicate bool
+		for _, existingRule := range mergedRules {
+			if existingRule.GetIssuer() != newRule.GetIssuer() {
+				continue
+			}
+			if !equalityutils.DeepEqual(existingRule, newRule) {
+				return existingRules, eris.Errorf("JWT provider for issuer %s conflicts with another provider for the same issuer", newRule.GetIssuer())
+			}
+			duplicate = true
+		}
+		if !duplicate {
+			mergedRules = append(mergedRules, newRule)
+		}
+	}
+	return mergedRules, nil
+}
@@ -0,0 +1,232 @@
+package requestauthentication_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/requestauthentication"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
+	securityv1beta1spec "istio.io/api/security/v1beta1"
+	typesv1beta1 "istio.io/api/type/v1beta1"
+	securityv1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RequestAuthenticationTranslator", func() {
+	var (
+		ctrl         *gomock.Controller
+		translator   requestauthentication.Translator
+		mockReporter *mock_reporting.MockReporter
+		destination  *discoveryv1.Destination
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockReporter = mock_reporting.NewMockReporter(ctrl)
+		translator = requestauthentication.NewTranslator()
+		destination = &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ms",
+				Namespace: "ms-namespace",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &skv2corev1.ClusterObjectRef{
+							Name:        "kube-service",
+							Namespace:   "kube-service-namespace",
+							ClusterName: "cluster",
+						},
+						WorkloadSelectorLabels: map[string]string{"app": "reviews"},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	appliedPolicy := func(name string, providers ...*v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider) *v1.AppliedTrafficPolicy {
+		return &v1.AppliedTrafficPolicy{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      name,
+				Namespace: "tp-namespace",
+			},
+			Spec: &v1.TrafficPolicySpec{
+				Policy: &v1.TrafficPolicySpec_Policy{
+					Jwt: &v1.TrafficPolicySpec_Policy_JwtAuthentication{
+						Providers: providers,
+					},
+				},
+			},
+		}
+	}
+
+	It("should translate the JWT providers of all applied TrafficPolicies into a RequestAuthentication", func() {
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			appliedPolicy(
+				"tp-1",
+				&v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider{
+					Issuer:    "https://issuer.example.com",
+					Audiences: []string{"reviews"},
+					JwksSource: &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri{
+						JwksUri: "https://issuer.example.com/jwks.json",
+					},
+					FromHeaders: []*v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider_TokenHeader{
+						{Name: "x-jwt", Prefix: "Bearer "},
+					},
+					ForwardOriginalToken: true,
+				},
+			),
+			appliedPolicy(
+				"tp-2",
+				&v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider{
+					Issuer: "testing@secure.istio.io",
+					JwksSource: &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider_Jwks{
+						Jwks: `{"keys":[]}`,
+					},
+					FromParams:            []string{"token"},
+					OutputPayloadToHeader: "x-jwt-payload",
+				},
+			),
+			{
+				Ref: &skv2corev1.ObjectRef{
+					Name:      "no-jwt",
+					Namespace: "tp-namespace",
+				},
+				Spec: &v1.TrafficPolicySpec{},
+			},
+		}
+
+		expectedRequestAuthentication := &securityv1beta1.RequestAuthentication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "kube-service",
+				Namespace:   "kube-service-namespace",
+				ClusterName: "cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+			},
+			Spec: securityv1beta1spec.RequestAuthentication{
+				Selector: &typesv1beta1.WorkloadSelector{
+					MatchLabels: map[string]string{"app": "reviews"},
+				},
+				JwtRules: []*securityv1beta1spec.JWTRule{
+					{
+						Issuer:    "https://issuer.example.com",
+						Audiences: []string{"reviews"},
+						JwksUri:   "https://issuer.example.com/jwks.json",
+						FromHeaders: []*securityv1beta1spec.JWTHeader{
+							{Name: "x-jwt", Prefix: "Bearer "},
+						},
+						ForwardOriginalToken: true,
+					},
+					{
+						Issuer:                "testing@secure.istio.io",
+						Jwks:                  `{"keys":[]}`,
+						FromParams:            []string{"token"},
+						OutputPayloadToHeader: "x-jwt-payload",
+					},
+				},
+			},
+		}
+
+		requestAuthentication := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).To(Equal(expectedRequestAuthentication))
+	})
+
+	It("should not translate a RequestAuthentication if no TrafficPolicy configures JWT authentication", func() {
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			{
+				Ref:  &skv2corev1.ObjectRef{Name: "tp", Namespace: "tp-namespace"},
+				Spec: &v1.TrafficPolicySpec{},
+			},
+		}
+
+		requestAuthentication := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).To(BeNil())
+	})
+
+	It("should report TrafficPolicies with invalid or conflicting JWT providers", func() {
+		provider := &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider{
+			Issuer: "https://issuer.example.com",
+			JwksSource: &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri{
+				JwksUri: "https://issuer.example.com/jwks.json",
+			},
+		}
+		conflictingProvider := &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider{
+			Issuer: "https://issuer.example.com",
+			JwksSource: &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider_JwksUri{
+				JwksUri: "https://issuer.example.com/other-jwks.json",
+			},
+		}
+		scopedPolicy := appliedPolicy("scoped", provider)
+		scopedPolicy.Spec.SourceSelector = []*commonv1.WorkloadSelector{{}}
+
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			appliedPolicy("valid", provider),
+			// identical providers are merged
+			appliedPolicy("duplicate", provider),
+			appliedPolicy("conflicting", conflictingProvider),
+			appliedPolicy("missing-issuer", &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider{}),
+			scopedPolicy,
+		}
+
+		expectedErrors := map[string]string{
+			"conflicting":    "JWT provider for issuer https://issuer.example.com conflicts with another provider for the same issuer",
+			"missing-issuer": "JWT providers must specify an issuer",
+			"scoped":         "cannot be scoped with source selectors",
+		}
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring(expectedErrors[trafficPolicy.GetName()]))
+				delete(expectedErrors, trafficPolicy.GetName())
+			}).
+			Times(3)
+
+		requestAuthentication := translator.Translate(destination, mockReporter)
+		Expect(expectedErrors).To(BeEmpty())
+		Expect(requestAuthentication.Spec.JwtRules).To(Equal([]*securityv1beta1spec.JWTRule{
+			{
+				Issuer:  "https://issuer.example.com",
+				JwksUri: "https://issuer.example.com/jwks.json",
+			},
+		}))
+	})
+
+	It("should report TrafficPolicies which configure JWT authentication for external services", func() {
+		destination.Spec.Type = &discoveryv1.DestinationSpec_ExternalService_{
+			ExternalService: &discoveryv1.DestinationSpec_ExternalService{
+				Name:  "external-api",
+				Hosts: []string{"api.example.com"},
+			},
+		}
+		destination.Status.AppliedTrafficPolicies = []*v1.AppliedTrafficPolicy{
+			appliedPolicy("jwt", &v1.TrafficPolicySpec_Policy_JwtAuthentication_Provider{
+				Issuer: "https://issuer.example.com",
+			}),
+			{
+				Ref:  &skv2corev1.ObjectRef{Name: "no-jwt", Namespace: "tp-namespace"},
+				Spec: &v1.TrafficPolicySpec{},
+			},
+		}
+
+		mockReporter.
+			EXPECT().
+			ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+			DoAndReturn(func(destination *discoveryv1.Destination, trafficPolicy ezkube.ResourceId, err error) {
+				Expect(err.Error()).To(ContainSubstring("JWT authentication is only supported for Kubernetes service destinations"))
+			})
+
+		requestAuthentication := translator.Translate(destination, mockReporter)
+		Expect(requestAuthentication).To(BeNil())
+	})
+})
@@ -0,0 +1,311 @@
+package virtualservice
+
+import (
+	"github.com/rotisserie/eris"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/equalityutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+)
+
+// return true if the TrafficPolicy applies to TCP or TLS connections rather than HTTP requests
+func isConnectionLevelTrafficPolicy(trafficPolicy *v1.TrafficPolicySpec) bool {
+	return len(trafficPolicy.GetTcpRequestMatchers()) > 0 || len(trafficPolicy.GetTlsRequestMatchers()) > 0
+}
+
+// separate the TrafficPolicies which apply to HTTP requests from those which apply to TCP or TLS connections
+func partitionAppliedTpsByProtocol(
+	appliedTps []*v1.AppliedTrafficPolicy,
+) (httpTps, connectionTps []*v1.AppliedTrafficPolicy) {
+	for _, appliedTp := range appliedTps {
+		if isConnectionLevelTrafficPolicy(appliedTp.Spec) {
+			connectionTps = append(connectionTps, appliedTp)
+		} else {
+			httpTps = append(httpTps, appliedTp)
+		}
+	}
+	return httpTps, connectionTps
+}
+
+// translate the TrafficPolicies with TCP or TLS matchers into the TCP and TLS routes of the VirtualService.
+// Only traffic shifts are supported for TCP and TLS routes.
+func (t *translator) translateConnectionRoutes(
+	in input.LocalSnapshot,
+	destination *discoveryv1.Destination,
+	sourceCluster string,
+	appliedTps []*v1.AppliedTrafficPolicy,
+	virtualServiceFields fieldutils.FieldOwnershipRegistry,
+	virtualService *networkingv1alpha3.VirtualService,
+	reporter reporting.Reporter,
+) {
+	for _, policy := range appliedTps {
+		// skip TrafficPolicies whose WorkloadSelector does not select this cluster
+		if !selectorutils.WorkloadSelectorContainsCluster(policy.Spec.GetSourceSelector(), sourceCluster) {
+			continue
+		}
+
+		if err := validateConnectionLevelTrafficPolicy(policy.Spec); err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			continue
+		}
+
+		trafficShift := policy.Spec.GetPolicy().GetTrafficShift()
+		if trafficShift == nil {
+			continue
+		}
+
+		var shiftedDestinations []*networkingv1alpha3spec.RouteDestination
+		var err error
+		for _, weightedDest := range trafficShift.GetDestinations() {
+			var shiftedDestination *networkingv1alpha3spec.RouteDestination
+			shiftedDestination, err = routeutils.TranslateWeightedRouteDestination(
+				weightedDest,
+				sourceCluster,
+				in.Destinations(),
+				in.VirtualDestinations(),
+				t.clusterDomains,
+			)
+			if err != nil {
+				break
+			}
+			shiftedDestinations = append(shiftedDestinations, shiftedDestination)
+		}
+		if err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, eris.Wrap(err, "translating TCP/TLS traffic shift"))
+			continue
+		}
+
+		tcpRoutes, tlsRoutes, err := buildConnectionRoutes(destination, policy.Spec, shiftedDestinations)
+		if err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			continue
+		}
+
+		registerField := registerFieldFunc(virtualServiceFields, virtualService, policy.Ref, policy.Spec.GetPriority())
+		if err := mergeConnectionRoutes(virtualService, tcpRoutes, tlsRoutes, registerField); err != nil {
+			reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+		}
+	}
+}
+
+// TCP and TLS routes are L4, so policies which act on HTTP requests cannot be applied
+func validateConnectionLevelTrafficPolicy(trafficPolicy *v1.TrafficPolicySpec) error {
+	if len(trafficPolicy.GetHttpRequestMatchers()) > 0 {
+		return eris.New("http request matchers cannot be combined with TCP or TLS request matchers")
+	}
+	for _, matcher := range trafficPolicy.GetTlsRequestMatchers() {
+		if len(matcher.GetSniHosts()) == 0 {
+			return eris.New("TLS request matchers must specify at least one SNI host")
+		}
+	}
+
+	policy := trafficPolicy.GetPolicy()
+	for _, httpOnlyPolicy := range []struct {
+		name string
+		set  bool
+	}{
+		{name: "fault injection", set: policy.GetFaultInjection() != nil},
+		{name: "request timeout", set: policy.GetRequestTimeout() != nil},
+		{name: "retries", set: policy.GetRetries() != nil},
+		{name: "CORS policy", set: policy.GetCorsPolicy() != nil},
+		{name: "mirror", set: policy.GetMirror() != nil},
+		{name: "header manipulation", set: policy.GetHeaderManipulation() != nil},
+		{name: "CSRF policy", set: policy.GetCsrf() != nil},
+		{name: "rate limit", set: policy.GetRateLimit() != nil},
+		{name: "external auth", set: policy.GetExtauth() != nil},
+		{name: "JWT authentication", set: policy.GetJwt() != nil},
+	} {
+		if httpOnlyPolicy.set {
+			return eris.Errorf("%s is not supported for TCP and TLS connections", httpOnlyPolicy.name)
+		}
+	}
+	return nil
+}
+
+// construct a route for each matched port of the Destination,
+// required because Istio needs the destination port for every route if the service has multiple service ports defined
+func buildConnectionRoutes(
+	destination *discoveryv1.Destination,
+	trafficPolicy *v1.TrafficPolicySpec,
+	shiftedDestinations []*networkingv1alpha3spec.RouteDestination,
+) ([]*networkingv1alpha3spec.TCPRoute, []*networkingv1alpha3spec.TLSRoute, error) {
+	var tcpRoutes []*networkingv1alpha3spec.TCPRoute
+	for _, matcher := range trafficPolicy.GetTcpRequestMatchers() {
+		ports, err := matchedPorts(destination, matcher.GetPort())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, port := range ports {
+			tcpRoutes = append(tcpRoutes, &networkingv1alpha3spec.TCPRoute{
+				Match: routeutils.TranslateTcpMatcher(port, trafficPolicy.GetSourceSelector()),
+				Route: routeDestinationsForPort(shiftedDestinations, port),
+			})
+		}
+	}
+
+	var tlsRoutes []*networkingv1alpha3spec.TLSRoute
+	for _, matcher := range trafficPolicy.GetTlsRequestMatchers() {
+		ports, err := matchedPorts(destination, matcher.GetPort())
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, port := range ports {
+			tlsRoutes = append(tlsRoutes, &networkingv1alpha3spec.TLSRoute{
+				Match: routeutils.TranslateTlsMatcher(matcher, port, trafficPolicy.GetSourceSelector()),
+				Route: routeDestinationsForPort(shiftedDestinations, port),
+			})
+		}
+	}
+
+	return tcpRoutes, tlsRoutes, nil
+}
+
+// return the Destination ports selected by a TCP or TLS matcher's port, where 0 selects all ports
+func matchedPorts(
+	destination *discoveryv1.Destination,
+	port uint32,
+) ([]uint32, error) {
+	destinationPorts := destinationutils.GetPortNumbers(destination)
+	if port != 0 {
+		for _, destinationPort := range destinationPorts {
+			if destinationPort == port {
+				return []uint32{port}, nil
+			}
+		}
+		return nil, eris.Errorf("specified port %d does not exist for Destination %v", port, sets.Key(destination))
+	}
+	return destinationPorts, nil
+}
+
+// default the port of the route destinations to the matched port, without overwriting ports that were derived from traffic shift
+func routeDestinationsForPort(
+	shiftedDestinations []*networkingv1alpha3spec.RouteDestination,
+	port uint32,
+) []*networkingv1alpha3spec.RouteDestination {
+	var destinationsWithPort []*networkingv1alpha3spec.RouteDestination
+	for _, shiftedDestination := range shiftedDestinations {
+		shiftedDestination := shiftedDestination.DeepCopy()
+		if shiftedDestination.GetDestination().GetPort().GetNumber() == 0 {
+			shiftedDestination.Destination.Port = &networkingv1alpha3spec.PortSelector{
+				Number: port,
+			}
+		}
+		destinationsWithPort = append(destinationsWithPort, shiftedDestination)
+	}
+	return destinationsWithPort
+}
+
+// merge the TCP and TLS routes into the VirtualService. Routes with equivalent matchers are owned by the TrafficPolicy which configured them first.
+func mergeConnectionRoutes(
+	virtualService *networkingv1alpha3.VirtualService,
+	tcpRoutes []*networkingv1alpha3spec.TCPRoute,
+	tlsRoutes []*networkingv1alpha3spec.TLSRoute,
+	registerField decorators.RegisterField,
+) error {
+	applyTcpRoutes, err := resolveTcpRoutes(virtualService, tcpRoutes, registerField)
+	if err != nil {
+		return err
+	}
+	applyTlsRoutes, err := resolveTlsRoutes(virtualService, tlsRoutes, registerField)
+	if err != nil {
+		return err
+	}
+	applyTcpRoutes()
+	applyTlsRoutes()
+	return nil
+}
+
+// register the TCP routes, returning a function which merges them into the VirtualService
+func resolveTcpRoutes(
+	virtualService *networkingv1alpha3.VirtualService,
+	tcpRoutes []*networkingv1alpha3spec.TCPRoute,
+	registerField decorators.RegisterField,
+) (func(), error) {
+	type routeUpdate struct {
+		existingRoute *networkingv1alpha3spec.TCPRoute
+		route         []*networkingv1alpha3spec.RouteDestination
+	}
+	var updates []routeUpdate
+	var newRoutes []*networkingv1alpha3spec.TCPRoute
+	for _, tcpRoute := range tcpRoutes {
+		var existingRoute *networkingv1alpha3spec.TCPRoute
+		for _, route := range virtualService.Spec.Tcp {
+			if equalityutils.DeepEqual(route.Match, tcpRoute.Match) {
+				existingRoute = route
+				break
+			}
+		}
+		if existingRoute != nil {
+			// no-op if the existing route is equivalent, otherwise a conflict with the TrafficPolicy which owns it
+			if err := registerField(&existingRoute.Route, tcpRoute.Route); err != nil {
+				return nil, err
+			}
+			updates = append(updates, routeUpdate{existingRoute: existingRoute, route: tcpRoute.Route})
+			continue
+		}
+		newRoute := &networkingv1alpha3spec.TCPRoute{Match: tcpRoute.Match}
+		if err := registerField(&newRoute.Route, tcpRoute.Route); err != nil {
+			return nil, err
+		}
+		newRoute.Route = tcpRoute.Route
+		newRoutes = append(newRoutes, newRoute)
+	}
+	return func() {
+		for _, update := range updates {
+			update.existingRoute.Route = update.route
+		}
+		virtualService.Spec.Tcp = append(virtualService.Spec.Tcp, newRoutes...)
+	}, nil
+}
+
+// register the TLS routes, returning a function which merges them into the VirtualService
+func resolveTlsRoutes(
+	virtualService *networkingv1alpha3.VirtualService,
+	tlsRoutes []*networkingv1alpha3spec.TLSRoute,
+	registerField decorators.RegisterField,
+) (func(), error) {
+	type routeUpdate struct {
+		existingRoute *networkingv1alpha3spec.TLSRoute
+		route         []*networkingv1alpha3spec.RouteDestination
+	}
+	var updates []routeUpdate
+	var newRoutes []*networkingv1alpha3spec.TLSRoute
+	for _, tlsRoute := range tlsRoutes {
+		var existingRoute *networkingv1alpha3spec.TLSRoute
+		for _, route := range virtualService.Spec.Tls {
+			if equalityutils.DeepEqual(route.Match, tlsRoute.Match) {
+				existingRoute = route
+				break
+			}
+		}
+		if existingRoute != nil {
+			// no-op if the existing route is equivalent, otherwise a conflict with the TrafficPolicy which owns it
+			if err := registerField(&existingRoute.Route, tlsRoute.Route); err != nil {
+				return nil, err
+			}
+			updates = append(updates, routeUpdate{existingRoute: existingRoute, route: tlsRoute.Route})
+			continue
+		}
+		newRoute := &networkingv1alpha3spec.TLSRoute{Match: tlsRoute.Match}
+		if err := registerField(&newRoute.Route, tlsRoute.Route); err != nil {
+			return nil, err
+		}
+		newRoute.Route = tlsRoute.Route
+		newRoutes = append(newRoutes, newRoute)
+	}
+	return func() {
+		for _, update := range updates {
+			update.existingRoute.Route = update.route
+		}
+		virtualService.Spec.Tls = append(virtualService.Spec.Tls, newRoutes...)
+	}, nil
+}
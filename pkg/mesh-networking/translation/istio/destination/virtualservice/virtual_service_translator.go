@@ -10,10 +10,11 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/routeutils"
 
 	"github.com/golang/protobuf/proto"
-	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/utils"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/destinationutils"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/selectorutils"
 	skv2sets "github.com/solo-io/skv2/contrib/pkg/sets"
 	"github.com/solo-io/skv2/pkg/ezkube"
@@ -85,20 +86,32 @@ func (t *translator) Translate(
 	sourceMeshInstallation *discoveryv1.MeshInstallation,
 	reporter reporting.Reporter,
 ) *networkingv1alpha3.VirtualService {
-	kubeService := destination.Spec.GetKubeService()
-
-	if kubeService == nil {
-		// TODO(ilackarms): non kube services currently unsupported
+	var sourceCluster, destinationFQDN string
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *discoveryv1.DestinationSpec_KubeService_:
+		sourceCluster = destinationType.KubeService.GetRef().GetClusterName()
+		if sourceMeshInstallation != nil {
+			sourceCluster = sourceMeshInstallation.Cluster
+		}
+		destinationFQDN = t.clusterDomains.GetDestinationFQDN(sourceCluster, destinationType.KubeService.GetRef())
+	case *discoveryv1.DestinationSpec_ExternalService_:
+		// external services are configured in the cluster of the ServiceEntry from which they were discovered
+		if sourceMeshInstallation != nil {
+			return nil
+		}
+		host, err := destinationutils.GetExternalServiceHost(destinationType.ExternalService)
+		if err != nil {
+			for _, policy := range destination.Status.AppliedTrafficPolicies {
+				reporter.ReportTrafficPolicyToDestination(destination, policy.Ref, err)
+			}
+			return nil
+		}
+		sourceCluster = destinationType.ExternalService.GetRef().GetClusterName()
+		destinationFQDN = host
+	default:
 		return nil
 	}
 
-	sourceCluster := kubeService.Ref.ClusterName
-	if sourceMeshInstallation != nil {
-		sourceCluster = sourceMeshInstallation.Cluster
-	}
-
-	destinationFQDN := t.clusterDomains.GetDestinationFQDN(sourceCluster, destination.Spec.GetKubeService().Ref)
-
 	virtualService := t.initializeVirtualService(destination, sourceMeshInstallation, destinationFQDN)
 	// register the owners of the virtualservice fields
 	virtualServiceFields := fieldutils.NewOwnershipRegistry()
@@ -107,7 +120,9 @@ func (t *translator) Translate(
 		Snapshot:       in,
 	})
 
-	appliedTpsByRequestMatcher := groupAppliedTpsByRequestMatcher(destination.Status.AppliedTrafficPolicies)
+	httpTps, connectionTps := partitionAppliedTpsByProtocol(destination.Status.AppliedTrafficPolicies)
+
+	appliedTpsByRequestMatcher := groupAppliedTpsByRequestMatcher(httpTps)
 
 	for _, tpsByRequestMatcher := range appliedTpsByRequestMatcher {
 
@@ -120,7 +135,7 @@ func (t *translator) Translate(
 				continue
 			}
 
-			registerField := registerFieldFunc(virtualServiceFields, virtualService, policy.Ref)
+			registerField := registerFieldFunc(virtualServiceFields, virtualService, policy.Ref, policy.Spec.GetPriority())
 			for _, decorator := range vsDecorators {
 
 				if trafficPolicyDecorator, ok := decorator.(decorators.TrafficPolicyVirtualServiceDecorator); ok {
@@ -148,7 +163,7 @@ func (t *translator) Translate(
 
 		// construct a copy of a route for each service port
 		// required because Istio needs the destination port for every route
-		routesPerPort := duplicateRouteForEachPort(baseRoute, kubeService.Ports)
+		routesPerPort := duplicateRouteForEachPort(baseRoute, destinationutils.GetPortNumbers(destination))
 
 		// split routes with multiple HTTP matchers into one matcher per route for easier route sorting later on
 		var routesWithSingleMatcher []*networkingv1alpha3spec.HTTPRoute
@@ -162,7 +177,17 @@ func (t *translator) Translate(
 
 	sort.Sort(RoutesBySpecificity(virtualService.Spec.Http))
 
-	if len(virtualService.Spec.Http) == 0 {
+	t.translateConnectionRoutes(
+		in,
+		destination,
+		sourceCluster,
+		connectionTps,
+		virtualServiceFields,
+		virtualService,
+		reporter,
+	)
+
+	if len(virtualService.Spec.Http) == 0 && len(virtualService.Spec.Tcp) == 0 && len(virtualService.Spec.Tls) == 0 {
 		// no need to create this VirtualService as it has no effect
 		return nil
 	}
@@ -269,6 +294,7 @@ func registerFieldFunc(
 	virtualServiceFields fieldutils.FieldOwnershipRegistry,
 	virtualService *networkingv1alpha3.VirtualService,
 	policyRef ezkube.ResourceId,
+	priority int32,
 ) decorators.RegisterField {
 	return func(fieldPtr, val interface{}) error {
 		fieldVal := reflect.ValueOf(fieldPtr).Elem().Interface()
@@ -281,7 +307,7 @@ func registerFieldFunc(
 			fieldPtr,
 			[]ezkube.ResourceId{policyRef},
 			&v1.TrafficPolicy{},
-			0, // TODO(ilackarms): priority
+			priority,
 		); err != nil {
 			return err
 		}
@@ -297,13 +323,13 @@ func (t *translator) initializeVirtualService(
 	var meta metav1.ObjectMeta
 	if sourceMeshInstallation != nil {
 		meta = metautils.FederatedObjectMeta(
-			destination.Spec.GetKubeService().Ref,
+			destinationutils.GetTranslatedObjectRef(destination),
 			sourceMeshInstallation,
 			destination.Annotations,
 		)
 	} else {
 		meta = metautils.TranslatedObjectMeta(
-			destination.Spec.GetKubeService().Ref,
+			destinationutils.GetTranslatedObjectRef(destination),
 			destination.Annotations,
 		)
 	}
@@ -353,7 +379,7 @@ func (t *translator) setDefaultDestinationAndPortMatchers(
 // if the service has multiple service ports defined
 func duplicateRouteForEachPort(
 	baseRoute *networkingv1alpha3spec.HTTPRoute,
-	ports []*discoveryv1.DestinationSpec_KubeService_KubeServicePort,
+	ports []uint32,
 ) []*networkingv1alpha3spec.HTTPRoute {
 	var routesWithPort []*networkingv1alpha3spec.HTTPRoute
 	for _, port := range ports {
@@ -362,7 +388,7 @@ func duplicateRouteForEachPort(
 
 		for _, matcher := range baseRoute.Match {
 			matcher := matcher.DeepCopy()
-			matcher.Port = port.GetPort()
+			matcher.Port = port
 			matchersWithPort = append(matchersWithPort, matcher)
 		}
 
@@ -374,7 +400,7 @@ func duplicateRouteForEachPort(
 			// don't overwrite ports that were derived from traffic shift
 			if destination.GetDestination().GetPort().GetNumber() == 0 {
 				destination.Destination.Port = &networkingv1alpha3spec.PortSelector{
-					Number: port.GetPort(),
+					Number: port,
 				}
 			}
 
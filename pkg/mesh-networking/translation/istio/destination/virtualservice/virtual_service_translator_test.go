@@ -5,23 +5,27 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/rotisserie/eris"
-	v1alpha3sets "github.com/solo-io/external-apis/pkg/api/istio/networking.istio.io/v1alpha3/sets"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	v1alpha3sets "github.com/solo-io/gloo-mesh/pkg/api/external/istio/networking.istio.io/v1alpha3/sets"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
 	settingsv1 "github.com/solo-io/gloo-mesh/pkg/api/settings.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/faultinjection"
 	mock_decorators "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mocks"
 	mock_trafficpolicy "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/mocks"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators/retries"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination/virtualservice"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/fieldutils"
 	mock_hostutils "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/hostutils/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/testutils"
@@ -482,6 +486,147 @@ var _ = Describe("VirtualServiceTranslator", func() {
 		Expect(virtualService).To(Equal(expectedVirtualService))
 	})
 
+	It("should apply a combined delay and abort only to the routes matching the TrafficPolicy's headers", func() {
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "traffic-target",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ref: &v1.ClusterObjectRef{
+							Name:        "traffic-target",
+							Namespace:   "traffic-target-namespace",
+							ClusterName: "traffic-target-cluster",
+						},
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{
+								Port:     8080,
+								Name:     "http1",
+								Protocol: "http",
+							},
+						},
+					},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedTrafficPolicies: []*networkingv1.AppliedTrafficPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "chaos",
+							Namespace: "tp-namespace",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							HttpRequestMatchers: []*networkingv1.DeprecatedHttpMatcher{
+								{
+									Headers: []*networkingv1.HeaderMatcher{
+										{
+											Name:  "x-chaos",
+											Value: "true",
+										},
+									},
+								},
+							},
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								FaultInjection: &networkingv1.TrafficPolicySpec_Policy_FaultInjection{
+									FaultInjectionType: &networkingv1.TrafficPolicySpec_Policy_FaultInjection_Abort_{
+										Abort: &networkingv1.TrafficPolicySpec_Policy_FaultInjection_Abort{
+											HttpStatus: 503,
+											Percentage: &wrappers.DoubleValue{Value: 5},
+										},
+									},
+									Delay: &networkingv1.TrafficPolicySpec_Policy_FaultInjection_Delay{
+										FixedDelay: &duration.Duration{Seconds: 2},
+										Percentage: &wrappers.DoubleValue{Value: 50},
+									},
+								},
+							},
+						},
+					},
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "retries",
+							Namespace: "tp-namespace",
+						},
+						Spec: &networkingv1.TrafficPolicySpec{
+							Policy: &networkingv1.TrafficPolicySpec_Policy{
+								Retries: &networkingv1.TrafficPolicySpec_Policy_RetryPolicy{
+									Attempts: 5,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockClusterDomainRegistry.
+			EXPECT().
+			GetDestinationFQDN(destination.Spec.GetKubeService().Ref.ClusterName, destination.Spec.GetKubeService().Ref).
+			Return("local-hostname")
+
+		mockDecoratorFactory.
+			EXPECT().
+			MakeDecorators(decorators.Parameters{
+				ClusterDomains: mockClusterDomainRegistry,
+				Snapshot:       in,
+			}).
+			Return([]decorators.Decorator{
+				faultinjection.NewFaultInjectionDecorator(),
+				retries.NewRetriesDecorator(mockClusterDomainRegistry),
+			})
+
+		routeDestination := []*networkingv1alpha3spec.HTTPRouteDestination{{
+			Destination: &networkingv1alpha3spec.Destination{
+				Host: "local-hostname",
+				Port: &networkingv1alpha3spec.PortSelector{
+					Number: 8080,
+				},
+			},
+		}}
+		expectedHttpRoutes := []*networkingv1alpha3spec.HTTPRoute{
+			{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{
+					{
+						Headers: map[string]*networkingv1alpha3spec.StringMatch{
+							"x-chaos": {MatchType: &networkingv1alpha3spec.StringMatch_Exact{Exact: "true"}},
+						},
+						Port: 8080,
+					},
+				},
+				Route: routeDestination,
+				Fault: &networkingv1alpha3spec.HTTPFaultInjection{
+					Delay: &networkingv1alpha3spec.HTTPFaultInjection_Delay{
+						HttpDelayType: &networkingv1alpha3spec.HTTPFaultInjection_Delay_FixedDelay{
+							FixedDelay: &types.Duration{Seconds: 2},
+						},
+						Percentage: &networkingv1alpha3spec.Percent{Value: 50},
+					},
+					Abort: &networkingv1alpha3spec.HTTPFaultInjection_Abort{
+						ErrorType: &networkingv1alpha3spec.HTTPFaultInjection_Abort_HttpStatus{
+							HttpStatus: 503,
+						},
+						Percentage: &networkingv1alpha3spec.Percent{Value: 5},
+					},
+				},
+			},
+			{
+				Match: []*networkingv1alpha3spec.HTTPMatchRequest{
+					{
+						Port: 8080,
+					},
+				},
+				Route: routeDestination,
+				Retries: &networkingv1alpha3spec.HTTPRetry{
+					Attempts: 5,
+				},
+			},
+		}
+
+		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
+		Expect(virtualService.Spec.Http).To(Equal(expectedHttpRoutes))
+	})
+
 	It("should translate for a federated Destination", func() {
 		sourceSelectorLabels := map[string]string{"env": "dev"}
 		sourceSelectorNamespaces := []string{"n1", "n2"}
@@ -1485,4 +1630,402 @@ var _ = Describe("VirtualServiceTranslator", func() {
 		virtualService := virtualServiceTranslator.Translate(ctx, in, destination, nil, mockReporter)
 		Expect(virtualService).To(Equal(expectedVirtualService))
 	})
+
+	Context("TCP and TLS routes", func() {
+		var (
+			destination       *discoveryv1.Destination
+			canaryDestination *discoveryv1.Destination
+			meshInstallation  *discoveryv1.MeshInstallation
+		)
+
+		BeforeEach(func() {
+			meshInstallation = &discoveryv1.MeshInstallation{
+				Namespace: "istio-system",
+				Cluster:   "client-cluster",
+			}
+			destination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "mysql",
+				},
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{
+						KubeService: &discoveryv1.DestinationSpec_KubeService{
+							Ref: &v1.ClusterObjectRef{
+								Name:        "mysql",
+								Namespace:   "db",
+								ClusterName: "db-cluster",
+							},
+							Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+								{
+									Port:     3306,
+									Name:     "tcp-mysql",
+									Protocol: "TCP",
+								},
+								{
+									Port:     8443,
+									Name:     "tls-mysql",
+									Protocol: "TCP",
+								},
+							},
+						},
+					},
+				},
+			}
+			canaryDestination = &discoveryv1.Destination{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "mysql-canary",
+				},
+				Spec: discoveryv1.DestinationSpec{
+					Type: &discoveryv1.DestinationSpec_KubeService_{
+						KubeService: &discoveryv1.DestinationSpec_KubeService{
+							Ref: &v1.ClusterObjectRef{
+								Name:        "mysql-canary",
+								Namespace:   "db",
+								ClusterName: "db-cluster",
+							},
+							Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+								{
+									Port:     3306,
+									Name:     "tcp-mysql",
+									Protocol: "TCP",
+								},
+							},
+						},
+					},
+				},
+			}
+			in = input.NewInputLocalSnapshotManualBuilder("").
+				AddSettings(settingsv1.SettingsSlice{{}}).
+				AddDestinations(discoveryv1.DestinationSlice{destination, canaryDestination}).
+				Build()
+
+			mockClusterDomainRegistry.
+				EXPECT().
+				GetDestinationFQDN(meshInstallation.Cluster, gomock.Any()).
+				DoAndReturn(func(_ string, serviceRef ezkube.ClusterResourceId) string {
+					return serviceRef.GetName() + ".db.svc.db-cluster.global"
+				}).
+				AnyTimes()
+			mockDecoratorFactory.
+				EXPECT().
+				MakeDecorators(decorators.Parameters{
+					ClusterDomains: mockClusterDomainRegistry,
+					Snapshot:       in,
+				}).
+				Return([]decorators.Decorator{mockDecorator})
+		})
+
+		kubeWeightedDestination := func(ref *v1.ClusterObjectRef, port uint32, subset map[string]string, weight uint32) *networkingv1.WeightedDestination {
+			return &networkingv1.WeightedDestination{
+				Weight: weight,
+				DestinationType: &networkingv1.WeightedDestination_KubeService{
+					KubeService: &networkingv1.WeightedDestination_KubeDestination{
+						Name:        ref.Name,
+						Namespace:   ref.Namespace,
+						ClusterName: ref.ClusterName,
+						Port:        port,
+						Subset:      subset,
+					},
+				},
+			}
+		}
+
+		It("should translate TCP and TLS traffic shifts for a federated Destination", func() {
+			destination.Status.AppliedTrafficPolicies = []*networkingv1.AppliedTrafficPolicy{
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "tcp-shift",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						SourceSelector: []*commonv1.WorkloadSelector{
+							{
+								KubeWorkloadMatcher: &commonv1.WorkloadSelector_KubeWorkloadMatcher{
+									Labels:     map[string]string{"app": "client"},
+									Namespaces: []string{"n1"},
+								},
+							},
+						},
+						TcpRequestMatchers: []*networkingv1.TcpMatcher{
+							{
+								Port: 3306,
+							},
+						},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(destination.Spec.GetKubeService().Ref, 3306, nil, 80),
+									kubeWeightedDestination(canaryDestination.Spec.GetKubeService().Ref, 0, nil, 20),
+								},
+							},
+						},
+					},
+				},
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "tls-shift",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						TlsRequestMatchers: []*networkingv1.TlsMatcher{
+							{
+								Port:     8443,
+								SniHosts: []string{"mysql.example.com"},
+							},
+						},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(destination.Spec.GetKubeService().Ref, 8443, map[string]string{"version": "v2"}, 100),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			expectedVirtualService := &networkingv1alpha3.VirtualService{
+				ObjectMeta: metautils.FederatedObjectMeta(
+					destination.Spec.GetKubeService().Ref,
+					meshInstallation,
+					destination.Annotations,
+				),
+				Spec: networkingv1alpha3spec.VirtualService{
+					Hosts: []string{"mysql.db.svc.db-cluster.global"},
+					Tcp: []*networkingv1alpha3spec.TCPRoute{
+						{
+							Match: []*networkingv1alpha3spec.L4MatchAttributes{
+								{
+									Port:            3306,
+									SourceLabels:    map[string]string{"app": "client"},
+									SourceNamespace: "n1",
+								},
+							},
+							Route: []*networkingv1alpha3spec.RouteDestination{
+								{
+									Destination: &networkingv1alpha3spec.Destination{
+										Host: "mysql.db.svc.db-cluster.global",
+										Port: &networkingv1alpha3spec.PortSelector{Number: 3306},
+									},
+									Weight: 80,
+								},
+								{
+									Destination: &networkingv1alpha3spec.Destination{
+										Host: "mysql-canary.db.svc.db-cluster.global",
+										Port: &networkingv1alpha3spec.PortSelector{Number: 3306},
+									},
+									Weight: 20,
+								},
+							},
+						},
+					},
+					Tls: []*networkingv1alpha3spec.TLSRoute{
+						{
+							Match: []*networkingv1alpha3spec.TLSMatchAttributes{
+								{
+									SniHosts: []string{"mysql.example.com"},
+									Port:     8443,
+								},
+							},
+							Route: []*networkingv1alpha3spec.RouteDestination{
+								{
+									Destination: &networkingv1alpha3spec.Destination{
+										Host:   "mysql.db.svc.db-cluster.global",
+										Port:   &networkingv1alpha3spec.PortSelector{Number: 8443},
+										Subset: "version-v2",
+									},
+									Weight: 100,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, meshInstallation, mockReporter)
+			Expect(virtualService).To(Equal(expectedVirtualService))
+		})
+
+		It("should report a TCP traffic shift to a Destination with multiple ports which omits the port", func() {
+			destination.Status.AppliedTrafficPolicies = []*networkingv1.AppliedTrafficPolicy{
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "tcp-shift",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						TcpRequestMatchers: []*networkingv1.TcpMatcher{{}},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(destination.Spec.GetKubeService().Ref, 0, map[string]string{"version": "v2"}, 0),
+								},
+							},
+						},
+					},
+				},
+			}
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any())
+
+			Expect(virtualServiceTranslator.Translate(ctx, in, destination, meshInstallation, mockReporter)).To(BeNil())
+		})
+
+		It("should report HTTP-only policies on TCP and TLS TrafficPolicies", func() {
+			destination.Status.AppliedTrafficPolicies = []*networkingv1.AppliedTrafficPolicy{
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "tcp-timeout",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						TcpRequestMatchers: []*networkingv1.TcpMatcher{{Port: 3306}},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							RequestTimeout: &duration.Duration{Seconds: 5},
+						},
+					},
+				},
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "tls-no-sni",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						TlsRequestMatchers: []*networkingv1.TlsMatcher{{Port: 8443}},
+					},
+				},
+			}
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[0].Ref, gomock.Any()).
+				DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+					Expect(err).To(MatchError("request timeout is not supported for TCP and TLS connections"))
+				})
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[1].Ref, gomock.Any()).
+				DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+					Expect(err).To(MatchError("TLS request matchers must specify at least one SNI host"))
+				})
+
+			Expect(virtualServiceTranslator.Translate(ctx, in, destination, meshInstallation, mockReporter)).To(BeNil())
+		})
+
+		It("should report TCP routes shadowed by a higher priority TrafficPolicy", func() {
+			destination.Status.AppliedTrafficPolicies = []*networkingv1.AppliedTrafficPolicy{
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "high-priority",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						Priority:           1,
+						TcpRequestMatchers: []*networkingv1.TcpMatcher{{Port: 3306}},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(canaryDestination.Spec.GetKubeService().Ref, 0, nil, 0),
+								},
+							},
+						},
+					},
+				},
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "low-priority",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						TcpRequestMatchers: []*networkingv1.TcpMatcher{{Port: 3306}},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(destination.Spec.GetKubeService().Ref, 3306, nil, 0),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[1].Ref, gomock.Any()).
+				DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+					Expect(fieldutils.IsFieldShadowedError(err)).To(BeTrue())
+				})
+
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, meshInstallation, mockReporter)
+			Expect(virtualService.Spec.Tcp).To(Equal([]*networkingv1alpha3spec.TCPRoute{
+				{
+					Match: []*networkingv1alpha3spec.L4MatchAttributes{{Port: 3306}},
+					Route: []*networkingv1alpha3spec.RouteDestination{
+						{
+							Destination: &networkingv1alpha3spec.Destination{
+								Host: "mysql-canary.db.svc.db-cluster.global",
+								Port: &networkingv1alpha3spec.PortSelector{Number: 3306},
+							},
+						},
+					},
+				},
+			}))
+		})
+
+		It("should not partially apply a TrafficPolicy whose TLS routes are shadowed by a higher priority TrafficPolicy", func() {
+			destination.Status.AppliedTrafficPolicies = []*networkingv1.AppliedTrafficPolicy{
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "high-priority",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						Priority: 1,
+						TlsRequestMatchers: []*networkingv1.TlsMatcher{
+							{Port: 8443, SniHosts: []string{"mysql.db.svc.db-cluster.global"}},
+						},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(destination.Spec.GetKubeService().Ref, 8443, nil, 0),
+								},
+							},
+						},
+					},
+				},
+				{
+					Ref: &v1.ObjectRef{
+						Name:      "low-priority",
+						Namespace: "tp-namespace",
+					},
+					Spec: &networkingv1.TrafficPolicySpec{
+						TcpRequestMatchers: []*networkingv1.TcpMatcher{{Port: 3306}},
+						TlsRequestMatchers: []*networkingv1.TlsMatcher{
+							{Port: 8443, SniHosts: []string{"mysql.db.svc.db-cluster.global"}},
+						},
+						Policy: &networkingv1.TrafficPolicySpec_Policy{
+							TrafficShift: &networkingv1.TrafficPolicySpec_Policy_MultiDestination{
+								Destinations: []*networkingv1.WeightedDestination{
+									kubeWeightedDestination(canaryDestination.Spec.GetKubeService().Ref, 3306, nil, 0),
+								},
+							},
+						},
+					},
+				},
+			}
+
+			mockReporter.
+				EXPECT().
+				ReportTrafficPolicyToDestination(destination, destination.Status.AppliedTrafficPolicies[1].Ref, gomock.Any()).
+				DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+					Expect(fieldutils.IsFieldShadowedError(err)).To(BeTrue())
+				})
+
+			virtualService := virtualServiceTranslator.Translate(ctx, in, destination, meshInstallation, mockReporter)
+			Expect(virtualService.Spec.Tcp).To(BeEmpty())
+			Expect(virtualService.Spec.Tls).To(HaveLen(1))
+			Expect(virtualService.Spec.Tls[0].Route[0].Destination.Host).To(Equal("mysql.db.svc.db-cluster.global"))
+		})
+	})
 })
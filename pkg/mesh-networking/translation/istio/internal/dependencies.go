@@ -6,6 +6,7 @@ import (
 	corev1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	discoveryv1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	networkingv1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/decorators"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh"
@@ -32,6 +33,7 @@ type DependencyFactory interface {
 		ctx context.Context,
 		secrets corev1sets.SecretSet,
 		workloads discoveryv1sets.WorkloadSet,
+		virtualMeshes networkingv1sets.VirtualMeshSet,
 	) mesh.Translator
 }
 
@@ -57,9 +59,10 @@ func (d dependencyFactoryImpl) MakeMeshTranslator(
 	ctx context.Context,
 	secrets corev1sets.SecretSet,
 	workloads discoveryv1sets.WorkloadSet,
+	virtualMeshes networkingv1sets.VirtualMeshSet,
 ) mesh.Translator {
 	federationTranslator := federation.NewTranslator(ctx)
-	mtlsTranslator := mtls.NewTranslator(ctx, secrets, workloads)
+	mtlsTranslator := mtls.NewTranslator(ctx, secrets, workloads, virtualMeshes)
 	accessTranslator := access.NewTranslator(ctx)
 
 	return mesh.NewTranslator(
@@ -12,6 +12,7 @@ import (
 	v1sets "github.com/solo-io/external-apis/pkg/api/k8s/core/v1/sets"
 	v1sets0 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
 	input "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/input"
+	v1sets1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/sets"
 	destination "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/destination"
 	mesh "github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh"
 	v1alpha1sets "github.com/solo-io/skv2/pkg/api/multicluster.solo.io/v1alpha1/sets"
@@ -55,15 +56,15 @@ func (mr *MockDependencyFactoryMockRecorder) MakeDestinationTranslator(ctx, user
 }
 
 // MakeMeshTranslator mocks base method.
-func (m *MockDependencyFactory) MakeMeshTranslator(ctx context.Context, secrets v1sets.SecretSet, workloads v1sets0.WorkloadSet) mesh.Translator {
+func (m *MockDependencyFactory) MakeMeshTranslator(ctx context.Context, secrets v1sets.SecretSet, workloads v1sets0.WorkloadSet, virtualMeshes v1sets1.VirtualMeshSet) mesh.Translator {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MakeMeshTranslator", ctx, secrets, workloads)
+	ret := m.ctrl.Call(m, "MakeMeshTranslator", ctx, secrets, workloads, virtualMeshes)
 	ret0, _ := ret[0].(mesh.Translator)
 	return ret0
 }
 
 // MakeMeshTranslator indicates an expected call of MakeMeshTranslator.
-func (mr *MockDependencyFactoryMockRecorder) MakeMeshTranslator(ctx, secrets, workloads interface{}) *gomock.Call {
+func (mr *MockDependencyFactoryMockRecorder) MakeMeshTranslator(ctx, secrets, workloads, virtualMeshes interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeMeshTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeMeshTranslator), ctx, secrets, workloads)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MakeMeshTranslator", reflect.TypeOf((*MockDependencyFactory)(nil).MakeMeshTranslator), ctx, secrets, workloads, virtualMeshes)
 }
@@ -93,10 +93,15 @@ func (t *istioTranslator) Translate(
 		destinationTranslator.Translate(in, destination, istioOutputs, reporter)
 	}
 
+	// config translated for destinations applies to clients of every control plane revision
+	revisionLabels := newRevisionLabeler()
+	revisionLabels.addSharedOutputs(istioOutputs)
+
 	meshTranslator := t.dependencies.MakeMeshTranslator(
 		ctx,
 		in.Secrets(),
 		in.Workloads(),
+		in.VirtualMeshes(),
 	)
 
 	for _, mesh := range in.Meshes().List() {
@@ -107,11 +112,14 @@ func (t *istioTranslator) Translate(
 			meshTranslator,
 			reporter,
 		)
+		revisionLabels.addMeshOutputs(mesh, perMeshOutputs)
 		// merge per-mesh outputs to translator's whole outputs
 		istioOutputs.Merge(perMeshOutputs)
 		localOutputs.Merge(perMeshLocalOutputs)
 	}
 
+	revisionLabels.applyLabels(istioOutputs)
+
 	if err := t.extender.PatchOutputs(ctx, in, istioOutputs); err != nil {
 		// TODO(ilackarms): consider providing/checking user option to fail here when the extender server is unavailable.
 		// currently we just log the error and continue.
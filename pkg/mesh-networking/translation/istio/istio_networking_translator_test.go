@@ -132,7 +132,7 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 
 		mockDependencyFactory.
 			EXPECT().
-			MakeMeshTranslator(ctxWithValue, in.Secrets(), in.Workloads()).
+			MakeMeshTranslator(ctxWithValue, in.Secrets(), in.Workloads(), in.VirtualMeshes()).
 			Return(mockMeshTranslator)
 		for _, mesh := range in.Meshes().List() {
 			perMeshIstioOuputs := gomock.AssignableToTypeOf(istio.NewBuilder(nil, ""))
@@ -153,6 +153,9 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 
 		}
 
+		// outputs are visited to record and apply control plane revision labels
+		mockIstioOutputs.EXPECT().ForEachObject(gomock.Any()).Times(2)
+
 		mockIstioExtender.EXPECT().PatchOutputs(contextMatcher, in, mockIstioOutputs)
 
 		translator.Translate(ctx, in, nil, mockIstioOutputs, mockLocalOutputs, mockReporter)
@@ -189,7 +192,7 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 
 		mockDependencyFactory.
 			EXPECT().
-			MakeMeshTranslator(ctxWithValue, in.Secrets(), in.Workloads()).
+			MakeMeshTranslator(ctxWithValue, in.Secrets(), in.Workloads(), in.VirtualMeshes()).
 			Return(mockMeshTranslator)
 
 		for _, mesh := range in.Meshes().List() {
@@ -211,6 +214,9 @@ var _ = Describe("IstioNetworkingTranslator", func() {
 
 		}
 
+		// outputs are visited to record and apply control plane revision labels
+		mockIstioOutputs.EXPECT().ForEachObject(gomock.Any()).Times(2)
+
 		mockIstioExtender.EXPECT().PatchOutputs(contextMatcher, in, mockIstioOutputs)
 
 		translator.Translate(ctx, in, nil, mockIstioOutputs, mockLocalOutputs, mockReporter)
@@ -32,7 +32,9 @@ const (
 
 // the Mesh Federation translator translates a Gateway CR for enabling the Mesh to receive cross cluster traffic
 type Translator interface {
-	// Translate translates a Gateway for the given Mesh
+	// Translate translates a Gateway for the given Mesh.
+	// If the VirtualMesh uses limited trust, a VirtualService is also translated for each port of each federated Destination,
+	// which routes requests terminated by the ingress gateway to the Destination.
 	// Output resources will be added to the istio.Builder
 	// Errors caused by invalid user config will be reported using the Reporter.
 	Translate(
@@ -83,6 +85,18 @@ func (t *translator) Translate(
 		return
 	}
 
+	// with limited trust, the ingress gateway terminates mTLS for each federated Destination port
+	limitedTrust := virtualMesh.Spec.GetMtlsConfig().GetLimited() != nil
+	var limitedTrustHosts []limitedTrustHost
+	if limitedTrust {
+		limitedTrustHosts = getLimitedTrustHosts(in, mesh, virtualMesh)
+		if len(limitedTrustHosts) == 0 {
+			contextutils.LoggerFrom(t.ctx).Debugf("no federated Destinations for istio mesh %v with limited trust", sets.Key(mesh))
+			return
+		}
+	}
+
+	var gatewayNames []string
 	// translate one Gateway CR per ingress gateway Destination
 	for _, appliedIngressGateway := range mesh.Status.GetAppliedEastWestIngressGateways() {
 		destination, err := in.Destinations().Find(ezkube.MakeObjectRef(appliedIngressGateway.GetDestinationRef()))
@@ -97,24 +111,118 @@ func (t *translator) Translate(
 			continue
 		}
 
+		var servers []*networkingv1alpha3spec.Server
+		if limitedTrust {
+			servers = buildLimitedTrustServers(ingressDestinationPort, limitedTrustHosts)
+		} else {
+			servers = buildServers(ingressDestinationPort, federatedHostnameSuffix)
+		}
+
 		gateway := t.buildGateway(
 			BuildGatewayName(appliedIngressGateway),
 			istioNamespace,
 			istioCluster,
-			ingressDestinationPort,
-			federatedHostnameSuffix,
+			servers,
 			destination.Spec.GetKubeService().GetWorkloadSelectorLabels(),
 			virtualMesh.GetRef(),
 		)
 
 		outputs.AddGateways(gateway)
+		gatewayNames = append(gatewayNames, gateway.GetName())
+	}
+
+	if len(gatewayNames) == 0 {
+		return
+	}
+
+	// route requests terminated by the ingress gateways to the federated Destinations
+	for _, host := range limitedTrustHosts {
+		outputs.AddVirtualServices(t.buildLimitedTrustVirtualService(
+			istioNamespace,
+			istioCluster,
+			host,
+			gatewayNames,
+			virtualMesh.GetRef(),
+		))
 	}
 }
 
-func (t *translator) buildGateway(
-	name, namespace, cluster string,
+// a port of a federated Destination, routed through the ingress gateway with limited trust
+type limitedTrustHost struct {
+	sniHost           string
+	federatedHostname string
+	port              uint32
+}
+
+// get the SNI hosts for each port of each Destination in the Mesh which is federated by the VirtualMesh
+func getLimitedTrustHosts(
+	in input.LocalSnapshot,
+	mesh *discoveryv1.Mesh,
+	virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
+) []limitedTrustHost {
+	var hosts []limitedTrustHost
+	for _, destination := range in.Destinations().List() {
+		appliedFederation := destination.Status.GetAppliedFederation()
+		if !ezkube.RefsMatch(destination.Spec.GetMesh(), mesh) ||
+			len(appliedFederation.GetFederatedToMeshes()) == 0 ||
+			!ezkube.RefsMatch(appliedFederation.GetVirtualMeshRef(), virtualMesh.GetRef()) {
+			continue
+		}
+		for _, port := range destination.Spec.GetKubeService().GetPorts() {
+			hosts = append(hosts, limitedTrustHost{
+				sniHost:           hostutils.BuildLimitedTrustSniHost(port.GetPort(), appliedFederation.GetFederatedHostname()),
+				federatedHostname: appliedFederation.GetFederatedHostname(),
+				port:              port.GetPort(),
+			})
+		}
+	}
+	return hosts
+}
+
+// pass through mTLS for all federated hostnames to the destination workloads
+func buildServers(
 	ingressDestinationPort uint32,
 	federatedHostnameSuffix string,
+) []*networkingv1alpha3spec.Server {
+	return []*networkingv1alpha3spec.Server{{
+		Port: &networkingv1alpha3spec.Port{
+			Number:   ingressDestinationPort,
+			Protocol: defaultGatewayProtocol,
+			Name:     defaults.IstioGatewayTlsPortName,
+		},
+		Hosts: []string{"*." + federatedHostnameSuffix},
+		Tls: &networkingv1alpha3spec.ServerTLSSettings{
+			Mode: networkingv1alpha3spec.ServerTLSSettings_AUTO_PASSTHROUGH,
+		},
+	}}
+}
+
+// terminate mTLS for each federated Destination port, as the client workloads do not share a root certificate with the destination workloads
+func buildLimitedTrustServers(
+	ingressDestinationPort uint32,
+	hosts []limitedTrustHost,
+) []*networkingv1alpha3spec.Server {
+	var servers []*networkingv1alpha3spec.Server
+	for _, host := range hosts {
+		servers = append(servers, &networkingv1alpha3spec.Server{
+			Port: &networkingv1alpha3spec.Port{
+				Number:   ingressDestinationPort,
+				Protocol: defaultGatewayProtocol,
+				// istio requires the port names of a Gateway's servers to be unique
+				Name: fmt.Sprintf("%s-%s", defaults.IstioGatewayTlsPortName, kubeutils.SanitizeNameV2(host.sniHost)),
+			},
+			Hosts: []string{host.sniHost},
+			Tls: &networkingv1alpha3spec.ServerTLSSettings{
+				Mode: networkingv1alpha3spec.ServerTLSSettings_ISTIO_MUTUAL,
+			},
+		})
+	}
+	return servers
+}
+
+func (t *translator) buildGateway(
+	name, namespace, cluster string,
+	servers []*networkingv1alpha3spec.Server,
 	ingressGatewayWorkloadLabels map[string]string,
 	virtualMeshRef ezkube.ResourceId,
 ) *networkingv1alpha3.Gateway {
@@ -126,17 +234,7 @@ func (t *translator) buildGateway(
 			Labels:      metautils.TranslatedObjectLabels(),
 		},
 		Spec: networkingv1alpha3spec.Gateway{
-			Servers: []*networkingv1alpha3spec.Server{{
-				Port: &networkingv1alpha3spec.Port{
-					Number:   ingressDestinationPort,
-					Protocol: defaultGatewayProtocol,
-					Name:     defaults.IstioGatewayTlsPortName,
-				},
-				Hosts: []string{"*." + federatedHostnameSuffix},
-				Tls: &networkingv1alpha3spec.ServerTLSSettings{
-					Mode: networkingv1alpha3spec.ServerTLSSettings_AUTO_PASSTHROUGH,
-				},
-			}},
+			Servers:  servers,
 			Selector: ingressGatewayWorkloadLabels,
 		},
 	}
@@ -147,6 +245,43 @@ func (t *translator) buildGateway(
 	return gw
 }
 
+// the VirtualService routes requests for the SNI host of a federated Destination port, which are terminated by the ingress gateways,
+// to the federated hostname, which is mapped to the Destination's endpoints by a ServiceEntry in the Mesh's namespace
+func (t *translator) buildLimitedTrustVirtualService(
+	namespace, cluster string,
+	host limitedTrustHost,
+	gatewayNames []string,
+	virtualMeshRef ezkube.ResourceId,
+) *networkingv1alpha3.VirtualService {
+	vs := &networkingv1alpha3.VirtualService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        kubeutils.SanitizeNameV2(host.sniHost),
+			Namespace:   namespace,
+			ClusterName: cluster,
+			Labels:      metautils.TranslatedObjectLabels(),
+		},
+		Spec: networkingv1alpha3spec.VirtualService{
+			Hosts:    []string{host.sniHost},
+			Gateways: gatewayNames,
+			Tcp: []*networkingv1alpha3spec.TCPRoute{{
+				Route: []*networkingv1alpha3spec.RouteDestination{{
+					Destination: &networkingv1alpha3spec.Destination{
+						Host: host.federatedHostname,
+						Port: &networkingv1alpha3spec.PortSelector{
+							Number: host.port,
+						},
+					},
+				}},
+			}},
+		},
+	}
+
+	// Append the virtual mesh as a parent to each output resource
+	metautils.AppendParent(t.ctx, vs, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
+
+	return vs
+}
+
 func BuildGatewayName(appliedIngressGateway *commonv1.AppliedIngressGateway) string {
 	ingressDestinationRef := appliedIngressGateway.GetDestinationRef()
 	return kubeutils.SanitizeNameV2(
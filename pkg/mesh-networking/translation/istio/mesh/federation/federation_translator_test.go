@@ -225,4 +225,160 @@ var _ = Describe("FederationTranslator", func() {
 
 		Expect(outputs.GetGateways().List()).To(ConsistOf(expectedGateways))
 	})
+
+	It("translates federation resources for a VirtualMesh with limited trust", func() {
+		mesh := &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "federated-mesh",
+			},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{
+						Namespace: "namespace",
+						Cluster:   "cluster",
+					},
+				}},
+			},
+			Status: discoveryv1.MeshStatus{
+				AppliedEastWestIngressGateways: []*commonv1.AppliedIngressGateway{
+					{
+						DestinationRef: &skv2corev1.ObjectRef{
+							Name:      "istio-ingressgateway",
+							Namespace: "istio-system",
+						},
+						ExternalPort: 1234,
+						Port:         15443,
+					},
+				},
+			},
+		}
+		clientMeshRef := &skv2corev1.ObjectRef{
+			Name:      "client-mesh",
+			Namespace: "config-namespace",
+		}
+
+		vMesh := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-virtual-mesh",
+				Namespace: "config-namespace",
+			},
+			Spec: &v1.VirtualMeshSpec{
+				Meshes: []*skv2corev1.ObjectRef{
+					ezkube.MakeObjectRef(mesh),
+					clientMeshRef,
+				},
+				MtlsConfig: &v1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &v1.VirtualMeshSpec_MTLSConfig_Limited{
+						Limited: &v1.VirtualMeshSpec_MTLSConfig_LimitedTrust{},
+					},
+				},
+			},
+		}
+
+		ingressGateway := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "istio-system",
+				Name:      "istio-ingressgateway",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						WorkloadSelectorLabels: map[string]string{"istio": "ingressgateway"},
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(mesh),
+			},
+		}
+		federatedDestination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "config-namespace",
+				Name:      "reviews",
+			},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{
+						Ports: []*discoveryv1.DestinationSpec_KubeService_KubeServicePort{
+							{Port: 9080, Name: "http"},
+						},
+					},
+				},
+				Mesh: ezkube.MakeObjectRef(mesh),
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedFederation: &discoveryv1.DestinationStatus_AppliedFederation{
+					FederatedHostname: "reviews.default.svc.cluster.global",
+					FederatedToMeshes: []*skv2corev1.ObjectRef{clientMeshRef},
+					VirtualMeshRef:    vMesh.GetRef(),
+				},
+			},
+		}
+
+		in := input.NewInputLocalSnapshotManualBuilder("ignored").
+			AddMeshes(discoveryv1.MeshSlice{mesh}).
+			AddDestinations(discoveryv1.DestinationSlice{ingressGateway, federatedDestination}).
+			Build()
+
+		outputs := istio.NewBuilder(context.TODO(), "")
+		NewTranslator(ctx).Translate(
+			in,
+			mesh,
+			vMesh,
+			outputs,
+			nil, // no reports expected
+		)
+
+		parentAnnotations := map[string]string{
+			metautils.ParentLabelkey: `{"networking.mesh.gloo.solo.io/v1, Kind=VirtualMesh":[{"name":"my-virtual-mesh","namespace":"config-namespace"}]}`,
+		}
+		expectedGateway := &networkingv1alpha3.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "istio-ingressgateway-istio-system",
+				Namespace:   "namespace",
+				ClusterName: "cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+				Annotations: parentAnnotations,
+			},
+			Spec: networkingv1alpha3spec.Gateway{
+				Servers: []*networkingv1alpha3spec.Server{
+					{
+						Port: &networkingv1alpha3spec.Port{
+							Number:   15443,
+							Protocol: "TLS",
+							Name:     "tls-9080-reviews-default-svc-cluster-global",
+						},
+						Hosts: []string{"9080.reviews.default.svc.cluster.global"},
+						Tls: &networkingv1alpha3spec.ServerTLSSettings{
+							Mode: networkingv1alpha3spec.ServerTLSSettings_ISTIO_MUTUAL,
+						},
+					},
+				},
+				Selector: map[string]string{"istio": "ingressgateway"},
+			},
+		}
+		expectedVirtualService := &networkingv1alpha3.VirtualService{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "9080-reviews-default-svc-cluster-global",
+				Namespace:   "namespace",
+				ClusterName: "cluster",
+				Labels:      metautils.TranslatedObjectLabels(),
+				Annotations: parentAnnotations,
+			},
+			Spec: networkingv1alpha3spec.VirtualService{
+				Hosts:    []string{"9080.reviews.default.svc.cluster.global"},
+				Gateways: []string{"istio-ingressgateway-istio-system"},
+				Tcp: []*networkingv1alpha3spec.TCPRoute{{
+					Route: []*networkingv1alpha3spec.RouteDestination{{
+						Destination: &networkingv1alpha3spec.Destination{
+							Host: "reviews.default.svc.cluster.global",
+							Port: &networkingv1alpha3spec.PortSelector{Number: 9080},
+						},
+					}},
+				}},
+			},
+		}
+
+		Expect(outputs.GetGateways().List()).To(ConsistOf(expectedGateway))
+		Expect(outputs.GetVirtualServices().List()).To(ConsistOf(expectedVirtualService))
+	})
 })
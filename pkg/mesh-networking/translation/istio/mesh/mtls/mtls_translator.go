@@ -14,15 +14,17 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
 	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	networkingv1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/sets"
+	certutils "github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	"github.com/solo-io/gloo-mesh/pkg/common/defaults"
-	"github.com/solo-io/gloo-mesh/pkg/common/version"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
 	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
 	"github.com/solo-io/skv2/pkg/ezkube"
+	"istio.io/api/label"
 	"istio.io/istio/pkg/spiffe"
 	"istio.io/istio/security/pkg/pki/util"
 	corev1 "k8s.io/api/core/v1"
@@ -91,17 +93,25 @@ type translator struct {
 	ctx       context.Context
 	secrets   corev1sets.SecretSet
 	workloads discoveryv1sets.WorkloadSet
+	// used to look up the certificate rotation conditions recorded on the status of each VirtualMesh
+	virtualMeshes networkingv1sets.VirtualMeshSet
+	// root certificates generated for Meshes in a limited trust VirtualMesh during this translation,
+	// so that every Mesh in the VirtualMesh trusts the same generated root certificate of its peers
+	generatedMeshRootCas map[string]*secrets.CAData
 }
 
 func NewTranslator(
 	ctx context.Context,
-	secrets corev1sets.SecretSet,
+	secretSet corev1sets.SecretSet,
 	workloads discoveryv1sets.WorkloadSet,
+	virtualMeshes networkingv1sets.VirtualMeshSet,
 ) Translator {
 	return &translator{
-		ctx:       ctx,
-		secrets:   secrets,
-		workloads: workloads,
+		ctx:                  ctx,
+		secrets:              secretSet,
+		workloads:            workloads,
+		virtualMeshes:        virtualMeshes,
+		generatedMeshRootCas: map[string]*secrets.CAData{},
 	}
 }
 
@@ -152,7 +162,14 @@ func (t *translator) updateMtlsOutputs(
 			mtlsConfig.AutoRestartPods,
 		)
 	case *networkingv1.VirtualMeshSpec_MTLSConfig_Limited:
-		return eris.Errorf("limited trust not supported in version %v of Gloo Mesh", version.Version)
+		return t.configureLimitedTrust(
+			mesh,
+			trustModel.Limited,
+			virtualMesh,
+			istioOutputs,
+			localOutputs,
+			mtlsConfig.AutoRestartPods,
+		)
 	}
 
 	return nil
@@ -175,10 +192,15 @@ func (t *translator) configureSharedTrust(
 		return nil
 	}
 
+	if err := validateIntermediateCertOptions(sharedTrust.GetIntermediateCertOptions()); err != nil {
+		return err
+	}
+
 	// Construct the skeleton of the issuedCertificate
 	issuedCertificate, podBounceDirective := t.constructIssuedCertificate(
 		mesh,
-		sharedTrust,
+		virtualMeshRef,
+		sharedTrust.GetIntermediateCertOptions(),
 		autoRestartPods,
 	)
 
@@ -247,6 +269,136 @@ func (t *translator) configureSharedTrust(
 	return nil
 }
 
+// each Mesh in the VirtualMesh is issued a certificate signed by its own root certificate authority,
+// and trusts the root certificates of all other Meshes in the VirtualMesh
+func (t *translator) configureLimitedTrust(
+	mesh *discoveryv1.Mesh,
+	limitedTrust *networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust,
+	virtualMesh *discoveryv1.MeshStatus_AppliedVirtualMesh,
+	istioOutputs istio.Builder,
+	localOutputs local.Builder,
+	autoRestartPods bool,
+) error {
+
+	agentInfo := mesh.Spec.AgentInfo
+	if agentInfo == nil {
+		contextutils.LoggerFrom(t.ctx).Debugf("cannot configure root certificates for mesh %v which has no cert-agent", sets.Key(mesh))
+		return nil
+	}
+
+	if err := validateIntermediateCertOptions(limitedTrust.GetIntermediateCertOptions()); err != nil {
+		return err
+	}
+
+	virtualMeshRef := virtualMesh.GetRef()
+
+	rootCaSecret, _, err := t.getOrCreateMeshRootCa(mesh, limitedTrust, virtualMeshRef, localOutputs)
+	if err != nil {
+		return err
+	}
+
+	// trust the root certificates of all other Meshes in the VirtualMesh
+	var trustedRootCertificates [][]byte
+	for _, meshRef := range virtualMesh.Spec.GetMeshes() {
+		if ezkube.RefsMatch(meshRef, mesh) {
+			continue
+		}
+		_, peerRootCa, err := t.getOrCreateMeshRootCa(meshRef, limitedTrust, virtualMeshRef, localOutputs)
+		if err != nil {
+			return err
+		}
+		trustedRootCertificates = append(trustedRootCertificates, peerRootCa.RootCert)
+	}
+
+	// Construct the skeleton of the issuedCertificate
+	issuedCertificate, podBounceDirective := t.constructIssuedCertificate(
+		mesh,
+		virtualMeshRef,
+		limitedTrust.GetIntermediateCertOptions(),
+		autoRestartPods,
+	)
+	issuedCertificate.Spec.CertificateAuthority = &certificatesv1.IssuedCertificateSpec_GlooMeshCa{
+		GlooMeshCa: &certificatesv1.RootCertificateAuthority{
+			CertificateAuthority: &certificatesv1.RootCertificateAuthority_SigningCertificateSecret{
+				SigningCertificateSecret: rootCaSecret,
+			},
+		},
+	}
+	// Set deprecated field for backwards compatibility
+	issuedCertificate.Spec.SigningCertificateSecret = rootCaSecret
+	issuedCertificate.Spec.TrustedRootCertificates = trustedRootCertificates
+
+	// Append the VirtualMesh as a parent to each output resource
+	metautils.AppendParent(t.ctx, issuedCertificate, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
+	metautils.AppendParent(t.ctx, podBounceDirective, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
+
+	istioOutputs.AddIssuedCertificates(issuedCertificate)
+	istioOutputs.AddPodBounceDirectives(podBounceDirective)
+	return nil
+}
+
+// return the root certificate authority of the given Mesh in a limited trust VirtualMesh.
+// will return the user-provided secret if one is specified for the Mesh,
+// otherwise will create a self-signed root certificate authority for the Mesh
+func (t *translator) getOrCreateMeshRootCa(
+	meshRef ezkube.ResourceId,
+	limitedTrust *networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust,
+	virtualMeshRef *skv2corev1.ObjectRef,
+	localOutputs local.Builder,
+) (*skv2corev1.ObjectRef, *secrets.CAData, error) {
+
+	for _, meshCa := range limitedTrust.GetMeshCertificateAuthorities() {
+		if !ezkube.RefsMatch(meshCa.GetMesh(), meshRef) {
+			continue
+		}
+		// Pre-Validate secret to ensure it's formatted properly
+		secret, err := t.secrets.Find(meshCa.GetSecret())
+		if err != nil {
+			return nil, nil, eris.Wrapf(err, "Could not find provided ca signing secret (%s) for Mesh (%s).", sets.Key(meshCa.GetSecret()), sets.Key(meshRef))
+		}
+		caData := secrets.CADataFromSecretData(secret.Data)
+		if err := caData.Verify(); err != nil {
+			return nil, nil, eris.Wrapf(err, "Provided CA (%s) is invalid", sets.Key(secret))
+		}
+		return meshCa.GetSecret(), &caData, nil
+	}
+
+	rootCaSecret := MeshRootCASecretName(virtualMeshRef, meshRef)
+	selfSignedCertSecret, err := t.secrets.Find(rootCaSecret)
+	if err != nil {
+		selfSignedCert, ok := t.generatedMeshRootCas[sets.Key(rootCaSecret)]
+		if !ok {
+			selfSignedCert, err = generateSelfSignedCert(limitedTrust.GetGeneratedRootCertOptions())
+			if err != nil {
+				// should never happen
+				return nil, nil, err
+			}
+			t.generatedMeshRootCas[sets.Key(rootCaSecret)] = selfSignedCert
+		}
+		// the self signed cert goes to the master/local cluster
+		selfSignedCertSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: rootCaSecret.GetName(),
+				// write to the agent namespace
+				Namespace: rootCaSecret.GetNamespace(),
+				// ensure the secret is written to the maser/local cluster
+				ClusterName: "",
+				Labels:      metautils.TranslatedObjectLabels(),
+			},
+			Data: selfSignedCert.ToSecretData(),
+			Type: generatedSecretType,
+		}
+	}
+
+	// Append the VirtualMesh as a parent to the output secret
+	metautils.AppendParent(t.ctx, selfSignedCertSecret, virtualMeshRef, networkingv1.VirtualMesh{}.GVK())
+
+	localOutputs.AddSecrets(selfSignedCertSecret)
+
+	caData := secrets.CADataFromSecretData(selfSignedCertSecret.Data)
+	return rootCaSecret, &caData, nil
+}
+
 // will create the secret if it is self-signed,
 // otherwise will return the user-provided secret ref in the mtls config
 func (t *translator) getOrCreateGeneratedCaSecret(
@@ -292,7 +444,8 @@ func (t *translator) getOrCreateGeneratedCaSecret(
 
 func (t *translator) constructIssuedCertificate(
 	mesh *discoveryv1.Mesh,
-	sharedTrust *networkingv1.SharedTrust,
+	virtualMeshRef *skv2corev1.ObjectRef,
+	intermediateCertOptions *certificatesv1.CommonCertOptions,
 	autoRestartPods bool,
 ) (*certificatesv1.IssuedCertificate, *certificatesv1.PodBounceDirective) {
 	istioMesh := mesh.Spec.GetIstio()
@@ -313,7 +466,7 @@ func (t *translator) constructIssuedCertificate(
 	issuedCertificateMeta := BuildMeshResourceObjectMeta(mesh)
 
 	// get the pods that need to be bounced for this mesh
-	podsToBounce := getPodsToBounce(mesh, sharedTrust, t.workloads, autoRestartPods)
+	podsToBounce := getPodsToBounce(mesh, t.workloads, autoRestartPods)
 	var (
 		podBounceDirective *certificatesv1.PodBounceDirective
 		podBounceRef       *skv2corev1.ObjectRef
@@ -333,29 +486,71 @@ func (t *translator) constructIssuedCertificate(
 		Spec: certificatesv1.IssuedCertificateSpec{
 			Hosts: []string{buildSpiffeURI(trustDomain, istioNamespace, istiodServiceAccount)},
 			CertOptions: buildDefaultCertOptions(
-				sharedTrust.GetIntermediateCertOptions(),
+				intermediateCertOptions,
 				defaultIstioOrg,
 			),
 			// Set deprecated field for backwards compatibility
-			Org:                defaultIstioOrg,
+			Org: defaultIstioOrg,
+			// the default location of the istio CA Certs secret
+			// the certificate workflow will produce a cert with this ref
+			IssuedCertificateSecret: &skv2corev1.ObjectRef{
+				Name:      istioCaSecretName,
+				Namespace: istioNamespace,
+			},
 			PodBounceDirective: podBounceRef,
+			RotationState:      t.currentRotationStep(virtualMeshRef),
 		},
 	}
 
-	// Only set issuedCert when not using vault CA
-	if sharedTrust.GetIntermediateCertificateAuthority().GetVault() == nil {
-		// the default location of the istio CA Certs secret
-		// the certificate workflow will produce a cert with this ref
-		issuedCert.Spec.IssuedCertificateSecret = &skv2corev1.ObjectRef{
-			Name:      istioCaSecretName,
-			Namespace: istioNamespace,
+	// record the revision of the control plane, so the agent only verifies certificate rotations for the proxies of this mesh
+	if revision := istioMesh.GetRevision(); revision != "" {
+		issuedCert.Labels = map[string]string{}
+		for key, value := range issuedCertificateMeta.Labels {
+			issuedCert.Labels[key] = value
 		}
+		issuedCert.Labels[label.IoIstioRev.Name] = revision
 	}
 
 	// issue a certificate to the mesh agent
 	return issuedCert, podBounceDirective
 }
 
+// returns the certificate rotation step which the VirtualMesh is currently performing, or NOT_ROTATING.
+// The steps of a rotation are recorded as conditions on the VirtualMesh status. The networking applier records a VERIFYING
+// condition after each step which uses automatic verification, followed by its result, so these are skipped to find the step.
+func (t *translator) currentRotationStep(virtualMeshRef *skv2corev1.ObjectRef) certificatesv1.CertificateRotationState {
+	if t.virtualMeshes == nil {
+		return certificatesv1.CertificateRotationState_NOT_ROTATING
+	}
+	virtualMesh, err := t.virtualMeshes.Find(virtualMeshRef)
+	if err != nil {
+		return certificatesv1.CertificateRotationState_NOT_ROTATING
+	}
+	conditions := virtualMesh.Status.Conditions
+	for i := len(conditions) - 1; i >= 0; i-- {
+		switch state := conditions[i].GetState(); state {
+		case certificatesv1.CertificateRotationState_VERIFYING,
+			certificatesv1.CertificateRotationState_VERIFIED:
+			continue
+		case certificatesv1.CertificateRotationState_PREVIOUS_CA,
+			certificatesv1.CertificateRotationState_ADDING_NEW_ROOT,
+			certificatesv1.CertificateRotationState_PROPAGATING_NEW_INTERMEDIATE,
+			certificatesv1.CertificateRotationState_DELETING_OLD_ROOT:
+			return state
+		}
+		break
+	}
+	return certificatesv1.CertificateRotationState_NOT_ROTATING
+}
+
+// Istio only accepts RSA and ECDSA private keys for its intermediate CA
+func validateIntermediateCertOptions(options *certificatesv1.CommonCertOptions) error {
+	if options.GetKeyAlgorithm() == certificatesv1.CommonCertOptions_ED25519 {
+		return eris.Errorf("key algorithm %v is not supported by Istio for intermediate certificates", options.GetKeyAlgorithm())
+	}
+	return nil
+}
+
 func buildDefaultCertOptions(
 	options *certificatesv1.CommonCertOptions,
 	orgName string,
@@ -370,7 +565,7 @@ func buildDefaultCertOptions(
 	if result.GetTtlDays() == 0 {
 		result.TtlDays = defaultRootCertTTLDays
 	}
-	if result.GetRsaKeySizeBytes() == 0 {
+	if result.GetKeyAlgorithm() == certificatesv1.CommonCertOptions_RSA && result.GetRsaKeySizeBytes() == 0 {
 		result.RsaKeySizeBytes = defaultRootCertRsaKeySize
 	}
 	if result.GetSecretRotationGracePeriodRatio() == 0 {
@@ -383,15 +578,18 @@ func generateSelfSignedCert(
 	builtinCA *certificatesv1.CommonCertOptions,
 ) (*secrets.CAData, error) {
 	certOptions := buildDefaultCertOptions(builtinCA, defaultOrgName)
+	privateKey, err := certutils.GeneratePrivateKey(certOptions.GetKeyAlgorithm(), int(certOptions.GetRsaKeySizeBytes()))
+	if err != nil {
+		return nil, err
+	}
 	options := util.CertOptions{
-		Org:          certOptions.GetOrgName(),
-		IsCA:         true,
-		IsSelfSigned: true,
-		TTL:          time.Duration(certOptions.GetTtlDays()) * 24 * time.Hour,
-		RSAKeySize:   int(certOptions.GetRsaKeySizeBytes()),
-		PKCS8Key:     false, // currently only supporting PKCS1
-	}
-	cert, key, err := util.GenCertKeyFromOptions(options)
+		Org:           certOptions.GetOrgName(),
+		IsCA:          true,
+		IsSelfSigned:  true,
+		TTL:           time.Duration(certOptions.GetTtlDays()) * 24 * time.Hour,
+		SignerPrivPem: privateKey,
+	}
+	cert, key, err := util.GenRootCertFromExistingKey(options)
 	if err != nil {
 		return nil, err
 	}
@@ -411,7 +609,6 @@ func buildSpiffeURI(trustDomain, namespace, serviceAccount string) string {
 // get selectors for all the pods in a mesh; they need to be bounced (including the mesh control plane itself)
 func getPodsToBounce(
 	mesh *discoveryv1.Mesh,
-	sharedTrust *networkingv1.SharedTrust,
 	allWorkloads discoveryv1sets.WorkloadSet,
 	autoRestartPods bool,
 ) []*certificatesv1.PodBounceDirectiveSpec_PodSelector {
@@ -424,22 +621,20 @@ func getPodsToBounce(
 
 	// bounce the control plane pod first
 	// order matters
-	var podsToBounce []*certificatesv1.PodBounceDirectiveSpec_PodSelector
-	// If the pki-sidecar is fulfilling the issued certificate request,
-	// then the control-plane should not be bounced.
-	if sharedTrust.GetIntermediateCertificateAuthority().GetVault() == nil {
-		podsToBounce = append(podsToBounce, &certificatesv1.PodBounceDirectiveSpec_PodSelector{
+	podsToBounce := []*certificatesv1.PodBounceDirectiveSpec_PodSelector{
+		{
 			Namespace: istioInstall.Namespace,
 			Labels:    istioInstall.PodLabels,
 			// ensure at least one replica of istiod is ready before restarting the other pods
 			WaitForReplicas: 1,
-		})
+		},
 	}
 
 	// bounce all workloads controlled by the mesh
 	allWorkloads.List(func(workload *discoveryv1.Workload) bool {
+		// only pods are bounced, virtual machine workloads must be restarted out of band
 		kubeWorkload := workload.Spec.GetKubernetes()
-		workloadNamespace := kubeWorkload.Controller.GetNamespace()
+		workloadNamespace := kubeWorkload.GetController().GetNamespace()
 		if kubeWorkload != nil && ezkube.RefsMatch(workload.Spec.Mesh, mesh) {
 			podsToBounce = append(podsToBounce, &certificatesv1.PodBounceDirectiveSpec_PodSelector{
 				Namespace: workloadNamespace,
@@ -482,6 +677,13 @@ func BuildMeshResourceObjectMeta(
 	}
 }
 
+// the name of the root CA secret generated for a Mesh in a limited trust VirtualMesh
+func MeshRootCASecretName(virtualMeshRef, meshRef ezkube.ResourceId) *skv2corev1.ObjectRef {
+	rootCaSecret := RootCASecretName(virtualMeshRef)
+	rootCaSecret.Name += "." + meshRef.GetName() + "." + meshRef.GetNamespace()
+	return rootCaSecret
+}
+
 func RootCASecretName(virtualMeshRef ezkube.ResourceId) *skv2corev1.ObjectRef {
 	generatedSecretName := virtualMeshRef.GetName() + "." + virtualMeshRef.GetNamespace()
 	// write the signing secret to the gloomesh namespace
@@ -2,6 +2,7 @@ package mtls_test
 
 import (
 	"context"
+	"crypto/x509"
 	"time"
 
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
@@ -17,6 +18,7 @@ import (
 	mock_istio "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio/mocks"
 	mock_local "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/local/mocks"
 	networkingv1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	networkingv1sets "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1/sets"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	mock_reporting "github.com/solo-io/gloo-mesh/pkg/mesh-networking/reporting/mocks"
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/istio/mesh/mtls"
@@ -108,7 +110,7 @@ var _ = Describe("MtlsTranslator", func() {
 	})
 
 	It("will skip if non-istio mesh", func() {
-		translator := mtls.NewTranslator(ctx, nil, nil)
+		translator := mtls.NewTranslator(ctx, nil, nil, nil)
 		mesh := &discoveryv1.Mesh{}
 		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{}
 		translator.Translate(mesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
@@ -190,7 +192,141 @@ var _ = Describe("MtlsTranslator", func() {
 
 		mockIstioBuilder.EXPECT().AddPodBounceDirectives(nil)
 
-		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil)
+		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil, nil)
+
+		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+	})
+
+	It("generated root CA with non RSA key algorithms", func() {
+		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-vm",
+				Namespace: "gloo-mesh",
+			},
+			Spec: &networkingv1.VirtualMeshSpec{
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Shared{
+						Shared: &networkingv1.SharedTrust{
+							CertificateAuthority: &networkingv1.SharedTrust_RootCertificateAuthority{
+								RootCertificateAuthority: &networkingv1.RootCertificateAuthority{
+									CaSource: &networkingv1.RootCertificateAuthority_Generated{
+										Generated: &certificatesv1.CommonCertOptions{
+											KeyAlgorithm: certificatesv1.CommonCertOptions_ED25519,
+										},
+									},
+								},
+							},
+							IntermediateCertOptions: &certificatesv1.CommonCertOptions{
+								KeyAlgorithm: certificatesv1.CommonCertOptions_ECDSA_P256,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockLocalBuilder.EXPECT().AddSecrets(gomock.Any()).Do(func(secret *corev1.Secret) {
+			certData := secrets.CADataFromSecretData(secret.Data)
+			Expect(certData.Verify()).NotTo(HaveOccurred())
+			rootCert, err := util.ParsePemEncodedCertificate(certData.RootCert)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rootCert.PublicKeyAlgorithm).To(Equal(x509.Ed25519))
+		})
+
+		mockIstioBuilder.EXPECT().
+			AddIssuedCertificates(gomock.Any()).
+			Do(func(issuedCert *certificatesv1.IssuedCertificate) {
+				Expect(issuedCert.Spec.CertOptions).To(Equal(&certificatesv1.CommonCertOptions{
+					TtlDays:                        365,
+					OrgName:                        "Istio",
+					SecretRotationGracePeriodRatio: 0.10,
+					KeyAlgorithm:                   certificatesv1.CommonCertOptions_ECDSA_P256,
+				}))
+			})
+
+		mockIstioBuilder.EXPECT().AddPodBounceDirectives(nil)
+
+		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil, nil)
+
+		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+	})
+
+	It("reports intermediate certificates with Ed25519 keys", func() {
+		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-vm",
+				Namespace: "gloo-mesh",
+			},
+			Spec: &networkingv1.VirtualMeshSpec{
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Shared{
+						Shared: &networkingv1.SharedTrust{
+							IntermediateCertOptions: &certificatesv1.CommonCertOptions{
+								KeyAlgorithm: certificatesv1.CommonCertOptions_ED25519,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		mockReporter.EXPECT().
+			ReportVirtualMeshToMesh(istioMesh, vm.Ref, gomock.Any()).
+			Do(func(_ *discoveryv1.Mesh, _ *skv2corev1.ObjectRef, err error) {
+				Expect(err.Error()).To(ContainSubstring("key algorithm ED25519 is not supported by Istio for intermediate certificates"))
+			})
+
+		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil, nil)
+
+		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+	})
+
+	It("issues certificates for the current rotation step to the control plane revision of the mesh", func() {
+		istioMesh.Spec.GetIstio().Revision = "canary"
+		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-vm",
+				Namespace: "gloo-mesh",
+			},
+			Spec: &networkingv1.VirtualMeshSpec{
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Shared{
+						Shared: &networkingv1.SharedTrust{
+							CertificateAuthority: &networkingv1.SharedTrust_RootCertificateAuthority{
+								RootCertificateAuthority: &networkingv1.RootCertificateAuthority{
+									CaSource: &networkingv1.RootCertificateAuthority_Generated{
+										Generated: &certificatesv1.CommonCertOptions{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		virtualMesh := &networkingv1.VirtualMesh{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-vm",
+				Namespace: "gloo-mesh",
+			},
+			Status: networkingv1.VirtualMeshStatus{
+				Conditions: []*certificatesv1.CertificateRotationCondition{
+					{State: certificatesv1.CertificateRotationState_ADDING_NEW_ROOT},
+					{State: certificatesv1.CertificateRotationState_VERIFYING},
+				},
+			},
+		}
+
+		mockLocalBuilder.EXPECT().AddSecrets(gomock.Any())
+		mockIstioBuilder.EXPECT().
+			AddIssuedCertificates(gomock.Any()).
+			Do(func(issuedCert *certificatesv1.IssuedCertificate) {
+				Expect(issuedCert.Spec.RotationState).To(Equal(certificatesv1.CertificateRotationState_ADDING_NEW_ROOT))
+				Expect(issuedCert.Labels).To(HaveKeyWithValue("istio.io/rev", "canary"))
+			})
+		mockIstioBuilder.EXPECT().AddPodBounceDirectives(nil)
+
+		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(), nil, networkingv1sets.NewVirtualMeshSet(virtualMesh))
 
 		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
 	})
@@ -346,6 +482,7 @@ var _ = Describe("MtlsTranslator", func() {
 			ctx,
 			v1sets.NewSecretSet(generatedSecret),
 			discoveryv1sets.NewWorkloadSet(workload),
+			nil,
 		)
 
 		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
@@ -411,6 +548,11 @@ var _ = Describe("MtlsTranslator", func() {
 			},
 			Spec: certificatesv1.PodBounceDirectiveSpec{
 				PodsToBounce: []*certificatesv1.PodBounceDirectiveSpec_PodSelector{
+					{
+						Namespace:       istioMesh.Spec.GetIstio().Installation.GetNamespace(),
+						Labels:          istioMesh.Spec.GetIstio().Installation.GetPodLabels(),
+						WaitForReplicas: 1,
+					},
 					{
 						Namespace: kubeWorkload.Spec.GetKubernetes().GetController().GetNamespace(),
 						Labels:    kubeWorkload.Spec.GetKubernetes().GetPodLabels(),
@@ -449,6 +591,10 @@ var _ = Describe("MtlsTranslator", func() {
 						CertificateAuthority: &certificatesv1.IssuedCertificateSpec_AgentCa{
 							AgentCa: intermediateCa,
 						},
+						IssuedCertificateSecret: &skv2corev1.ObjectRef{
+							Name:      "cacerts",
+							Namespace: istioMesh.Spec.GetIstio().GetInstallation().GetNamespace(),
+						},
 						PodBounceDirective: ezkube.MakeObjectRef(pbd),
 					},
 				}
@@ -462,7 +608,117 @@ var _ = Describe("MtlsTranslator", func() {
 				Expect(pbd).To(Equal(podBounceDirective))
 			})
 
-		translator := mtls.NewTranslator(ctx, nil, discoveryv1sets.NewWorkloadSet(kubeWorkload))
+		translator := mtls.NewTranslator(ctx, nil, discoveryv1sets.NewWorkloadSet(kubeWorkload), nil)
+
+		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
+	})
+
+	It("limited trust", func() {
+		// Generate the root cert of the peer mesh
+		peerCert, peerKey, err := util.GenCertKeyFromOptions(util.CertOptions{
+			RSAKeySize:   2048,
+			IsSelfSigned: true,
+			IsCA:         true,
+			TTL:          time.Minute,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		peerRootCaData := &secrets.CAData{
+			CaPrivateKey: peerKey,
+			CaCert:       peerCert,
+			RootCert:     peerCert,
+			CertChain:    peerCert,
+		}
+		peerSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "peer-secret",
+				Namespace: "my-namespace",
+			},
+			Data: peerRootCaData.ToSecretData(),
+		}
+		peerMeshRef := &skv2corev1.ObjectRef{
+			Name:      "peer-mesh",
+			Namespace: "gloo-mesh",
+		}
+
+		vm := &discoveryv1.MeshStatus_AppliedVirtualMesh{
+			Ref: &skv2corev1.ObjectRef{
+				Name:      "my-vm",
+				Namespace: "gloo-mesh",
+			},
+			Spec: &networkingv1.VirtualMeshSpec{
+				Meshes: []*skv2corev1.ObjectRef{
+					ezkube.MakeObjectRef(istioMesh),
+					peerMeshRef,
+				},
+				MtlsConfig: &networkingv1.VirtualMeshSpec_MTLSConfig{
+					TrustModel: &networkingv1.VirtualMeshSpec_MTLSConfig_Limited{
+						Limited: &networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust{
+							GeneratedRootCertOptions: &certificatesv1.CommonCertOptions{
+								RsaKeySizeBytes: 2048,
+							},
+							MeshCertificateAuthorities: []*networkingv1.VirtualMeshSpec_MTLSConfig_LimitedTrust_MeshCertificateAuthority{
+								{
+									Mesh:   peerMeshRef,
+									Secret: ezkube.MakeObjectRef(peerSecret),
+								},
+							},
+							IntermediateCertOptions: &certificatesv1.CommonCertOptions{
+								TtlDays: 30,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		meshRootCaSecret := mtls.MeshRootCASecretName(vm.GetRef(), istioMesh)
+
+		// a distinct root cert is generated for the mesh
+		mockLocalBuilder.EXPECT().AddSecrets(gomock.Any()).Do(func(secret *corev1.Secret) {
+			Expect(secret.GetName()).To(Equal(meshRootCaSecret.GetName()))
+			certData := secrets.CADataFromSecretData(secret.Data)
+			Expect(certData.Verify()).NotTo(HaveOccurred())
+			Expect(certData.RootCert).NotTo(Equal(peerCert))
+		})
+
+		mockIstioBuilder.EXPECT().
+			AddIssuedCertificates(gomock.Any()).
+			Do(func(issuedCert *certificatesv1.IssuedCertificate) {
+				cert := &certificatesv1.IssuedCertificate{
+					ObjectMeta: *childResourceMeta,
+					Spec: certificatesv1.IssuedCertificateSpec{
+						Hosts: []string{"spiffe://cluster.not-local/ns/istio-system-2/sa/istiod-not-standard"},
+						Org:   "Istio",
+						CertOptions: &certificatesv1.CommonCertOptions{
+							TtlDays:                        30,
+							RsaKeySizeBytes:                4096,
+							OrgName:                        "Istio",
+							SecretRotationGracePeriodRatio: 0.10,
+						},
+						SigningCertificateSecret: meshRootCaSecret,
+						CertificateAuthority: &certificatesv1.IssuedCertificateSpec_GlooMeshCa{
+							GlooMeshCa: &certificatesv1.RootCertificateAuthority{
+								CertificateAuthority: &certificatesv1.RootCertificateAuthority_SigningCertificateSecret{
+									SigningCertificateSecret: meshRootCaSecret,
+								},
+							},
+						},
+						IssuedCertificateSecret: &skv2corev1.ObjectRef{
+							Name:      "cacerts",
+							Namespace: istioMesh.Spec.GetIstio().GetInstallation().GetNamespace(),
+						},
+						// the mesh trusts the root cert of its peer
+						TrustedRootCertificates: [][]byte{peerCert},
+					},
+				}
+				metautils.AppendParent(ctx, cert, vm.GetRef(), networkingv1.VirtualMesh{}.GVK())
+				Expect(cert).To(Equal(issuedCert))
+			})
+
+		mockIstioBuilder.EXPECT().AddPodBounceDirectives(nil)
+
+		translator := mtls.NewTranslator(ctx, v1sets.NewSecretSet(peerSecret), nil, nil)
 
 		translator.Translate(istioMesh, vm, mockIstioBuilder, mockLocalBuilder, mockReporter)
 	})
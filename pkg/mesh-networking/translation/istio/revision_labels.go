@@ -0,0 +1,72 @@
+package istio
+
+import (
+	"strings"
+
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	"github.com/solo-io/skv2/pkg/resource"
+	"istio.io/api/label"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// the revision recorded for outputs which are shared by all control planes
+const sharedRevision = "*"
+
+// revisionLabeler applies the `istio.io/rev` label to Istio config which is meant for a single revisioned control plane,
+// so that during canary upgrades each control plane only picks up the config translated for it.
+// Config which is produced for multiple control planes (or for Destinations, whose clients may be injected by any revision)
+// is left unlabeled so that it is processed by all control planes.
+type revisionLabeler struct {
+	// map of each output object to the revision of the control plane it is meant for
+	revisions map[string]string
+}
+
+func newRevisionLabeler() *revisionLabeler {
+	return &revisionLabeler{revisions: map[string]string{}}
+}
+
+// record outputs which are meant for all control planes
+func (r *revisionLabeler) addSharedOutputs(outputs istio.Builder) {
+	outputs.ForEachObject(func(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) {
+		r.revisions[outputKey(cluster, gvk, obj)] = sharedRevision
+	})
+}
+
+// record outputs which are meant for the control plane of the given mesh
+func (r *revisionLabeler) addMeshOutputs(mesh *discoveryv1.Mesh, outputs istio.Builder) {
+	revision := mesh.Spec.GetIstio().GetRevision()
+	outputs.ForEachObject(func(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) {
+		key := outputKey(cluster, gvk, obj)
+		if existing, ok := r.revisions[key]; ok && existing != revision {
+			// outputs produced for multiple control planes are shared
+			r.revisions[key] = sharedRevision
+			return
+		}
+		r.revisions[key] = revision
+	})
+}
+
+// label the Istio config which is meant for a single revisioned control plane
+func (r *revisionLabeler) applyLabels(outputs istio.Builder) {
+	outputs.ForEachObject(func(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) {
+		if !strings.HasSuffix(gvk.Group, "istio.io") {
+			return
+		}
+		revision := r.revisions[outputKey(cluster, gvk, obj)]
+		if revision == "" || revision == sharedRevision {
+			return
+		}
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[label.IoIstioRev.Name] = revision
+		obj.SetLabels(labels)
+	})
+}
+
+func outputKey(cluster string, gvk schema.GroupVersionKind, obj resource.TypedObject) string {
+	return cluster + "/" + gvk.String() + "/" + sets.Key(obj)
+}
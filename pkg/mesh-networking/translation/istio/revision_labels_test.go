@@ -0,0 +1,79 @@
+package istio
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/output/istio"
+	"istio.io/api/label"
+	networkingv1alpha3 "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	security_istio_io_v1beta1 "istio.io/client-go/pkg/apis/security/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("RevisionLabeler", func() {
+	revisionedMesh := func(name, revision string) *discoveryv1.Mesh {
+		return &discoveryv1.Mesh{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: discoveryv1.MeshSpec{
+				Type: &discoveryv1.MeshSpec_Istio_{Istio: &discoveryv1.MeshSpec_Istio{
+					Installation: &discoveryv1.MeshInstallation{Cluster: "cluster"},
+					Revision:     revision,
+				}},
+			},
+		}
+	}
+
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: "istio-system", ClusterName: "cluster"}
+	}
+
+	It("labels config translated for a single revisioned control plane", func() {
+		destinationOutputs := istio.NewBuilder(nil, "")
+		destinationOutputs.AddAuthorizationPolicies(&security_istio_io_v1beta1.AuthorizationPolicy{ObjectMeta: objectMeta("destination-policy")})
+
+		canaryOutputs := istio.NewBuilder(nil, "")
+		canaryOutputs.AddGateways(&networkingv1alpha3.Gateway{ObjectMeta: objectMeta("canary-gateway")})
+		canaryOutputs.AddEnvoyFilters(&networkingv1alpha3.EnvoyFilter{ObjectMeta: objectMeta("shared-filter")})
+		canaryOutputs.AddAuthorizationPolicies(&security_istio_io_v1beta1.AuthorizationPolicy{ObjectMeta: objectMeta("destination-policy")})
+
+		stableOutputs := istio.NewBuilder(nil, "")
+		stableOutputs.AddGateways(&networkingv1alpha3.Gateway{ObjectMeta: objectMeta("stable-gateway")})
+		stableOutputs.AddEnvoyFilters(&networkingv1alpha3.EnvoyFilter{ObjectMeta: objectMeta("shared-filter")})
+
+		defaultOutputs := istio.NewBuilder(nil, "")
+		defaultOutputs.AddGateways(&networkingv1alpha3.Gateway{ObjectMeta: objectMeta("default-gateway")})
+
+		labeler := newRevisionLabeler()
+		labeler.addSharedOutputs(destinationOutputs)
+		labeler.addMeshOutputs(revisionedMesh("canary", "1-11"), canaryOutputs)
+		labeler.addMeshOutputs(revisionedMesh("stable", "1-10"), stableOutputs)
+		labeler.addMeshOutputs(revisionedMesh("default", ""), defaultOutputs)
+
+		outputs := istio.NewBuilder(nil, "")
+		outputs.Merge(destinationOutputs)
+		outputs.Merge(canaryOutputs)
+		outputs.Merge(stableOutputs)
+		outputs.Merge(defaultOutputs)
+		labeler.applyLabels(outputs)
+
+		gateways := outputs.GetGateways()
+		Expect(gateways.List()).To(HaveLen(3))
+		for _, gateway := range gateways.List() {
+			switch gateway.Name {
+			case "canary-gateway":
+				Expect(gateway.Labels).To(HaveKeyWithValue(label.IoIstioRev.Name, "1-11"))
+			case "stable-gateway":
+				Expect(gateway.Labels).To(HaveKeyWithValue(label.IoIstioRev.Name, "1-10"))
+			default:
+				Expect(gateway.Labels).NotTo(HaveKey(label.IoIstioRev.Name))
+			}
+		}
+		for _, filter := range outputs.GetEnvoyFilters().List() {
+			Expect(filter.Labels).NotTo(HaveKey(label.IoIstioRev.Name))
+		}
+		for _, policy := range outputs.GetAuthorizationPolicies().List() {
+			Expect(policy.Labels).NotTo(HaveKey(label.IoIstioRev.Name))
+		}
+	})
+})
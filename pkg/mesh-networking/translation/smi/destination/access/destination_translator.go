@@ -47,6 +47,14 @@ var (
 		return eris.Errorf("Could not determine ServiceAccount target for Destination as workloads belong to "+
 			"%d service accounts", total)
 	}
+
+	UnsupportedActionError = func(action v1.AccessPolicySpec_Action) error {
+		return eris.Errorf("SMI only supports ALLOW AccessPolicies, found %v", action)
+	}
+
+	UnsupportedNegativeMatchError = eris.New("SMI does not support not_paths, not_methods, or not_ports for AccessPolicies")
+
+	UnsupportedConditionsError = eris.New("SMI does not support conditions for AccessPolicies")
 )
 
 func NewTranslator() Translator {
@@ -75,6 +83,33 @@ func (t *translator) Translate(
 	backingWorkloads := workloadutils.FindBackingWorkloads(destination.Spec.GetKubeService(), in.Workloads())
 	for _, ap := range destination.Status.GetAppliedAccessPolicies() {
 
+		// SMI TrafficTargets can only grant access, so translating the AccessPolicy while ignoring
+		// unsupported fields would grant more access than intended
+		if action := ap.GetSpec().GetAction(); action != v1.AccessPolicySpec_ALLOW {
+			reporter.ReportAccessPolicyToDestination(
+				destination,
+				ap.GetRef(),
+				UnsupportedActionError(action),
+			)
+			continue
+		}
+		if len(ap.GetSpec().GetNotPaths()) > 0 || len(ap.GetSpec().GetNotMethods()) > 0 || len(ap.GetSpec().GetNotPorts()) > 0 {
+			reporter.ReportAccessPolicyToDestination(
+				destination,
+				ap.GetRef(),
+				UnsupportedNegativeMatchError,
+			)
+			continue
+		}
+		if ap.GetSpec().GetConditions() != nil {
+			reporter.ReportAccessPolicyToDestination(
+				destination,
+				ap.GetRef(),
+				UnsupportedConditionsError,
+			)
+			continue
+		}
+
 		if len(backingWorkloads) == 0 {
 			reporter.ReportAccessPolicyToDestination(
 				destination,
@@ -87,11 +122,7 @@ func (t *translator) Translate(
 		// Check workloads for all possible service accounts, if they belong to more than 1, report an error
 		serviceAccounts := sets.NewString()
 		for _, workload := range backingWorkloads {
-			kubeWorkload := workload.Spec.GetKubernetes()
-			if kubeWorkload == nil {
-				continue
-			}
-			serviceAccounts.Insert(kubeWorkload.GetServiceAccountName())
+			serviceAccounts.Insert(workloadutils.GetWorkloadServiceAccountName(workload))
 		}
 
 		if serviceAccounts.Len() > 1 {
@@ -106,6 +137,7 @@ func (t *translator) Translate(
 		var trafficTargetsByAp []*smiaccessv1alpha2.TrafficTarget
 
 		backingWorkload := backingWorkloads[0]
+		backingWorkloadRef, _ := workloadutils.GetWorkloadRefAndLabels(backingWorkload)
 		trafficTarget := &smiaccessv1alpha2.TrafficTarget{
 			ObjectMeta: metautils.TranslatedObjectMeta(
 				destination.Spec.GetKubeService().Ref,
@@ -114,8 +146,8 @@ func (t *translator) Translate(
 			Spec: smiaccessv1alpha2.TrafficTargetSpec{
 				Destination: smiaccessv1alpha2.IdentityBindingSubject{
 					Kind:      "ServiceAccount",
-					Namespace: backingWorkload.Spec.GetKubernetes().GetController().GetNamespace(),
-					Name:      backingWorkload.Spec.GetKubernetes().GetServiceAccountName(),
+					Namespace: backingWorkloadRef.GetNamespace(),
+					Name:      workloadutils.GetWorkloadServiceAccountName(backingWorkload),
 				},
 			},
 		}
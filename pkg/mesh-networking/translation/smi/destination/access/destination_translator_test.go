@@ -19,6 +19,7 @@ import (
 	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/metautils"
 	"github.com/solo-io/gloo-mesh/test/matchers"
 	v1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+	"github.com/solo-io/skv2/pkg/ezkube"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -74,6 +75,81 @@ var _ = Describe("DestinationTranslator", func() {
 
 	})
 
+	It("will report an error for AccessPolicy features SMI cannot express", func() {
+		in := input.NewInputLocalSnapshotManualBuilder("").Build()
+
+		destination := &discoveryv1.Destination{
+			ObjectMeta: metav1.ObjectMeta{},
+			Spec: discoveryv1.DestinationSpec{
+				Type: &discoveryv1.DestinationSpec_KubeService_{
+					KubeService: &discoveryv1.DestinationSpec_KubeService{},
+				},
+			},
+			Status: discoveryv1.DestinationStatus{
+				AppliedAccessPolicies: []*discoveryv1.DestinationStatus_AppliedAccessPolicy{
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "deny",
+							Namespace: "world",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							Action: networkingv1.AccessPolicySpec_DENY,
+						},
+					},
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "not-paths",
+							Namespace: "world",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							NotPaths: []string{"/admin"},
+						},
+					},
+					{
+						Ref: &v1.ObjectRef{
+							Name:      "conditions",
+							Namespace: "world",
+						},
+						Spec: &networkingv1.AccessPolicySpec{
+							Conditions: &networkingv1.AccessPolicySpec_Conditions{
+								IpBlocks: []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		reporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(
+				destination,
+				destination.Status.AppliedAccessPolicies[0].Ref,
+				gomock.Any(),
+			).
+			DoAndReturn(func(_ *discoveryv1.Destination, _ ezkube.ResourceId, err error) {
+				Expect(err).To(MatchError(UnsupportedActionError(networkingv1.AccessPolicySpec_DENY).Error()))
+			})
+		reporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(
+				destination,
+				destination.Status.AppliedAccessPolicies[1].Ref,
+				UnsupportedNegativeMatchError,
+			)
+		reporter.
+			EXPECT().
+			ReportAccessPolicyToDestination(
+				destination,
+				destination.Status.AppliedAccessPolicies[2].Ref,
+				UnsupportedConditionsError,
+			)
+
+		tt, hrg := NewTranslator().Translate(ctx, in, destination, reporter)
+		Expect(tt).To(HaveLen(0))
+		Expect(hrg).To(HaveLen(0))
+	})
+
 	It("will report an error if backing workloads belong to multiple service accounts", func() {
 		ns := "default"
 		podLabels := map[string]string{"we": "match"}
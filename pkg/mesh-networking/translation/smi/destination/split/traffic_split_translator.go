@@ -135,6 +135,13 @@ func validate(
 	destination *discoveryv1.Destination,
 	reporter reporting.Reporter,
 ) {
+	if tp.GetSpec().GetPolicy().GetConnectionPool() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"ConnectionPool",
+			"SMI does not support connection pool settings",
+		))
+	}
 	if tp.GetSpec().GetPolicy().GetCorsPolicy() != nil {
 		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
 			tp.GetRef(),
@@ -156,6 +163,13 @@ func validate(
 			"SMI does not support header manipulation",
 		))
 	}
+	if tp.GetSpec().GetPolicy().GetJwt() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"Jwt",
+			"SMI does not support JWT authentication",
+		))
+	}
 	if tp.GetSpec().GetPolicy().GetMirror() != nil {
 		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
 			tp.GetRef(),
@@ -184,6 +198,20 @@ func validate(
 			"SMI does not support source selectors for traffic policies",
 		))
 	}
+	if tp.GetSpec().GetTcpRequestMatchers() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"TcpRequestMatchers",
+			"SMI does not support TCP request matchers for traffic policies",
+		))
+	}
+	if tp.GetSpec().GetTlsRequestMatchers() != nil {
+		reporter.ReportTrafficPolicyToDestination(destination, tp.GetRef(), NewUnsupportedFeatureError(
+			tp.GetRef(),
+			"TlsRequestMatchers",
+			"SMI does not support TLS request matchers for traffic policies",
+		))
+	}
 }
 
 func buildBackends(
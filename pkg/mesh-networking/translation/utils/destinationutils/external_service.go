@@ -0,0 +1,69 @@
+package destinationutils
+
+import (
+	"github.com/rotisserie/eris"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/skv2/contrib/pkg/sets"
+	skv2corev1 "github.com/solo-io/skv2/pkg/api/core.skv2.solo.io/v1"
+)
+
+// suffix appended to the names of resources translated for external services,
+// to avoid collisions with resources translated for Kubernetes Services of the same name
+const externalServiceNameSuffix = "-serviceentry"
+
+// GetTranslatedObjectRef returns the ref of the object from which the metadata of resources translated for the Destination is derived,
+// i.e. the Kubernetes Service, or the Istio ServiceEntry of an external service.
+// Returns nil if the Destination was not discovered from either.
+func GetTranslatedObjectRef(destination *v1.Destination) *skv2corev1.ClusterObjectRef {
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *v1.DestinationSpec_KubeService_:
+		return destinationType.KubeService.GetRef()
+	case *v1.DestinationSpec_ExternalService_:
+		ref := destinationType.ExternalService.GetRef()
+		if ref == nil {
+			return nil
+		}
+		return &skv2corev1.ClusterObjectRef{
+			Name:        ref.GetName() + externalServiceNameSuffix,
+			Namespace:   ref.GetNamespace(),
+			ClusterName: ref.GetClusterName(),
+		}
+	}
+	return nil
+}
+
+// GetPortNumbers returns the port numbers exposed by the Destination.
+func GetPortNumbers(destination *v1.Destination) []uint32 {
+	var ports []uint32
+	switch destinationType := destination.Spec.GetType().(type) {
+	case *v1.DestinationSpec_KubeService_:
+		for _, port := range destinationType.KubeService.GetPorts() {
+			ports = append(ports, port.GetPort())
+		}
+	case *v1.DestinationSpec_ExternalService_:
+		for _, port := range destinationType.ExternalService.GetPorts() {
+			ports = append(ports, port.GetNumber())
+		}
+	}
+	return ports
+}
+
+// GetExternalServiceHost returns the host which Istio routes and traffic policies are translated for.
+// Istio configures routes and traffic policies per host, so only external services with a single host are supported.
+func GetExternalServiceHost(externalService *v1.DestinationSpec_ExternalService) (string, error) {
+	if hosts := externalService.GetHosts(); len(hosts) == 1 {
+		return hosts[0], nil
+	}
+	return "", eris.Errorf(
+		"external service %v must declare exactly one host to be configured, found %d",
+		sets.Key(externalService.GetRef()),
+		len(externalService.GetHosts()),
+	)
+}
+
+// IsMeshExternalService returns true if the Destination is an external service located outside of the mesh,
+// i.e. discovered from a ServiceEntry which does not select any mesh workloads.
+func IsMeshExternalService(destination *v1.Destination) bool {
+	externalService := destination.Spec.GetExternalService()
+	return externalService != nil && len(externalService.GetWorkloadSelectorLabels()) == 0
+}
@@ -1,6 +1,8 @@
 package destinationutils
 
 import (
+	"encoding/binary"
+	"hash"
 	"hash/fnv"
 	"net"
 
@@ -16,6 +18,10 @@ import (
 // istio supports creating service entries without assigning IPs.
 var ipAssignableSubnet = "240.0.0.0/4"
 
+// IPv6 addresses for Service Entries of dual-stack and IPv6 services are generated from the IPv6 benchmarking range,
+// which, like the IPv4 subnet above, will not collide with routable addresses.
+var ipv6AssignableSubnet = "2001:2::/48"
+
 const (
 	kubeService   = "kube-service"
 	localResource = "local-resource"
@@ -25,6 +31,32 @@ func ConstructUniqueIpForKubeService(kubeServiceRef ezkube.ClusterResourceId) (n
 	return constructUniqueIp(kubeServiceRef, kubeService)
 }
 
+// construct a unique IPv6 address for a kube service, for use alongside the IPv4 address for dual-stack services
+func ConstructUniqueIpv6ForKubeService(kubeServiceRef ezkube.ClusterResourceId) (net.IP, error) {
+	ip, cidr, err := net.ParseCIDR(ipv6AssignableSubnet)
+	if err != nil {
+		return nil, err
+	}
+	ip = ip.Mask(cidr.Mask)
+	if len(ip) != net.IPv6len {
+		return nil, eris.Errorf("unexpected length for cidr IP: %v", len(ip))
+	}
+
+	h := fnv.New64()
+	if err := writeClusterObjectRef(h, kubeServiceRef, kubeService); err != nil {
+		return nil, err
+	}
+	// the hash fills the lower 64 bits, which lie entirely outside of the subnet prefix
+	hashedIP := make(net.IP, net.IPv6len)
+	binary.BigEndian.PutUint64(hashedIP[8:], h.Sum64())
+
+	for i := range hashedIP {
+		hashedIP[i] = hashedIP[i] | ip[i]
+	}
+
+	return hashedIP, nil
+}
+
 func ConstructUniqueIpForLocalResource(resourceRef ezkube.ResourceId) (net.IP, error) {
 	return constructUniqueIp(&skv2corev1.ClusterObjectRef{
 		Name:        resourceRef.GetName(),
@@ -44,16 +76,7 @@ func constructUniqueIp(clusterObjectRef ezkube.ClusterResourceId, scope string)
 	}
 
 	h := fnv.New32()
-	if _, err := h.Write([]byte(clusterObjectRef.GetName())); err != nil {
-		return nil, err
-	}
-	if _, err := h.Write([]byte(clusterObjectRef.GetNamespace())); err != nil {
-		return nil, err
-	}
-	if _, err := h.Write([]byte(clusterObjectRef.GetClusterName())); err != nil {
-		return nil, err
-	}
-	if _, err := h.Write([]byte(scope)); err != nil {
+	if err := writeClusterObjectRef(h, clusterObjectRef, scope); err != nil {
 		return nil, err
 	}
 	hash := h.Sum32()
@@ -71,3 +94,19 @@ func constructUniqueIp(clusterObjectRef ezkube.ClusterResourceId, scope string)
 
 	return hashedIP, nil
 }
+
+func writeClusterObjectRef(h hash.Hash, clusterObjectRef ezkube.ClusterResourceId, scope string) error {
+	if _, err := h.Write([]byte(clusterObjectRef.GetName())); err != nil {
+		return err
+	}
+	if _, err := h.Write([]byte(clusterObjectRef.GetNamespace())); err != nil {
+		return err
+	}
+	if _, err := h.Write([]byte(clusterObjectRef.GetClusterName())); err != nil {
+		return err
+	}
+	if _, err := h.Write([]byte(scope)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package envoyfilterutils
+
+import (
+	envoy_extensions_filters_network_http_connection_manager_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/gogo/protobuf/types"
+	golangproto "github.com/golang/protobuf/proto"
+	"github.com/rotisserie/eris"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-networking/translation/utils/protoutils"
+	"github.com/solo-io/skv2/pkg/equalityutils"
+	networkingv1alpha3spec "istio.io/api/networking/v1alpha3"
+)
+
+// construct a patch which inserts the given HTTP filter before the router filter of every inbound HTTP listener.
+// typedConfig must be the filter's v3 Envoy config message.
+func InboundHttpFilterPatch(
+	filterName string,
+	typedConfig golangproto.Message,
+) (*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch, error) {
+	return httpFilterPatch(networkingv1alpha3spec.EnvoyFilter_SIDECAR_INBOUND, filterName, typedConfig)
+}
+
+// construct a patch which inserts the given HTTP filter before the router filter of every outbound HTTP listener.
+// typedConfig must be the filter's v3 Envoy config message.
+func OutboundHttpFilterPatch(
+	filterName string,
+	typedConfig golangproto.Message,
+) (*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch, error) {
+	return httpFilterPatch(networkingv1alpha3spec.EnvoyFilter_SIDECAR_OUTBOUND, filterName, typedConfig)
+}
+
+func httpFilterPatch(
+	patchContext networkingv1alpha3spec.EnvoyFilter_PatchContext,
+	filterName string,
+	typedConfig golangproto.Message,
+) (*networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch, error) {
+	typedConfigAny, err := protoutils.MessageToAnyWithError(typedConfig)
+	if err != nil {
+		return nil, err
+	}
+	filterStruct, err := protoutils.GolangMessageToGogoStruct(&envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter{
+		Name: filterName,
+		ConfigType: &envoy_extensions_filters_network_http_connection_manager_v3.HttpFilter_TypedConfig{
+			TypedConfig: typedConfigAny,
+		},
+	})
+	if err != nil {
+		return nil, eris.Wrapf(err, "converting filter %s to struct", filterName)
+	}
+
+	return &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+		ApplyTo: networkingv1alpha3spec.EnvoyFilter_HTTP_FILTER,
+		Match: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch{
+			Context: patchContext,
+			ObjectTypes: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch_Listener{
+				Listener: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch{
+					FilterChain: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch_FilterChainMatch{
+						Filter: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch_FilterMatch{
+							Name: wellknown.HTTPConnectionManager,
+							SubFilter: &networkingv1alpha3spec.EnvoyFilter_ListenerMatch_SubFilterMatch{
+								Name: wellknown.Router,
+							},
+						},
+					},
+				},
+			},
+		},
+		Patch: &networkingv1alpha3spec.EnvoyFilter_Patch{
+			Operation: networkingv1alpha3spec.EnvoyFilter_Patch_INSERT_BEFORE,
+			Value:     filterStruct,
+		},
+	}, nil
+}
+
+// construct a patch which merges the given value into every inbound virtual host.
+func InboundVirtualHostMergePatch(value *types.Struct) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	return virtualHostMergePatch(networkingv1alpha3spec.EnvoyFilter_SIDECAR_INBOUND, value)
+}
+
+// construct a patch which merges the given value into every outbound virtual host.
+func OutboundVirtualHostMergePatch(value *types.Struct) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	return virtualHostMergePatch(networkingv1alpha3spec.EnvoyFilter_SIDECAR_OUTBOUND, value)
+}
+
+func virtualHostMergePatch(
+	patchContext networkingv1alpha3spec.EnvoyFilter_PatchContext,
+	value *types.Struct,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	return &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+		ApplyTo: networkingv1alpha3spec.EnvoyFilter_VIRTUAL_HOST,
+		Match: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch{
+			Context: patchContext,
+			ObjectTypes: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch_RouteConfiguration{
+				RouteConfiguration: &networkingv1alpha3spec.EnvoyFilter_RouteConfigurationMatch{},
+			},
+		},
+		Patch: &networkingv1alpha3spec.EnvoyFilter_Patch{
+			Operation: networkingv1alpha3spec.EnvoyFilter_Patch_MERGE,
+			Value:     value,
+		},
+	}
+}
+
+// append the patch to the EnvoyFilter unless an identical patch is already present.
+// used for patches, such as HTTP filter insertion, which may be required by multiple policies but must only be applied once.
+func AppendPatchIfMissing(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	patch *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch,
+) {
+	for _, existingPatch := range envoyFilter.ConfigPatches {
+		if equalityutils.DeepEqual(existingPatch, patch) {
+			return
+		}
+	}
+	envoyFilter.ConfigPatches = append(envoyFilter.ConfigPatches, patch)
+}
+
+// find the inbound virtual host merge patch whose value contains the given (nested) field, or nil if no such patch exists.
+func FindInboundVirtualHostMergePatch(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	fieldPath ...string,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	for _, patch := range envoyFilter.ConfigPatches {
+		if patch.GetApplyTo() != networkingv1alpha3spec.EnvoyFilter_VIRTUAL_HOST ||
+			patch.GetMatch().GetContext() != networkingv1alpha3spec.EnvoyFilter_SIDECAR_INBOUND ||
+			patch.GetPatch().GetOperation() != networkingv1alpha3spec.EnvoyFilter_Patch_MERGE {
+			continue
+		}
+		if structContainsField(patch.GetPatch().GetValue(), fieldPath) {
+			return patch
+		}
+	}
+	return nil
+}
+
+// find the inbound HTTP filter patch which inserts the filter with the given name, or nil if no such patch exists.
+func FindInboundHttpFilterPatch(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	filterName string,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	return findHttpFilterPatch(envoyFilter, networkingv1alpha3spec.EnvoyFilter_SIDECAR_INBOUND, filterName)
+}
+
+// find the outbound HTTP filter patch which inserts the filter with the given name, or nil if no such patch exists.
+func FindOutboundHttpFilterPatch(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	filterName string,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	return findHttpFilterPatch(envoyFilter, networkingv1alpha3spec.EnvoyFilter_SIDECAR_OUTBOUND, filterName)
+}
+
+func findHttpFilterPatch(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	patchContext networkingv1alpha3spec.EnvoyFilter_PatchContext,
+	filterName string,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	for _, patch := range envoyFilter.ConfigPatches {
+		if patch.GetApplyTo() != networkingv1alpha3spec.EnvoyFilter_HTTP_FILTER ||
+			patch.GetMatch().GetContext() != patchContext {
+			continue
+		}
+		if patch.GetPatch().GetValue().GetFields()["name"].GetStringValue() == filterName {
+			return patch
+		}
+	}
+	return nil
+}
+
+func structContainsField(value *types.Struct, fieldPath []string) bool {
+	for i, field := range fieldPath {
+		fieldValue, ok := value.GetFields()[field]
+		if !ok {
+			return false
+		}
+		if i == len(fieldPath)-1 {
+			return true
+		}
+		value = fieldValue.GetStructValue()
+	}
+	return false
+}
+
+// construct a patch which merges the given value into the routes of the outbound virtual host with the given name.
+// Istio names outbound virtual hosts `<hostname>:<port>`, and routes after the name of the VirtualService HTTPRoute.
+// If routeName is empty, the value is merged into every route of the virtual host.
+func OutboundRouteMergePatch(
+	vhostName string,
+	routeName string,
+	value *types.Struct,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	var routeMatch *networkingv1alpha3spec.EnvoyFilter_RouteConfigurationMatch_RouteMatch
+	if routeName != "" {
+		routeMatch = &networkingv1alpha3spec.EnvoyFilter_RouteConfigurationMatch_RouteMatch{
+			Name: routeName,
+		}
+	}
+	return &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch{
+		ApplyTo: networkingv1alpha3spec.EnvoyFilter_HTTP_ROUTE,
+		Match: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch{
+			Context: networkingv1alpha3spec.EnvoyFilter_SIDECAR_OUTBOUND,
+			ObjectTypes: &networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectMatch_RouteConfiguration{
+				RouteConfiguration: &networkingv1alpha3spec.EnvoyFilter_RouteConfigurationMatch{
+					Vhost: &networkingv1alpha3spec.EnvoyFilter_RouteConfigurationMatch_VirtualHostMatch{
+						Name:  vhostName,
+						Route: routeMatch,
+					},
+				},
+			},
+		},
+		Patch: &networkingv1alpha3spec.EnvoyFilter_Patch{
+			Operation: networkingv1alpha3spec.EnvoyFilter_Patch_MERGE,
+			Value:     value,
+		},
+	}
+}
+
+// find the outbound route merge patch for the virtual host and route with the given names whose value contains the given (nested) field,
+// or nil if no such patch exists.
+func FindOutboundRouteMergePatch(
+	envoyFilter *networkingv1alpha3spec.EnvoyFilter,
+	vhostName string,
+	routeName string,
+	fieldPath ...string,
+) *networkingv1alpha3spec.EnvoyFilter_EnvoyConfigObjectPatch {
+	for _, patch := range envoyFilter.ConfigPatches {
+		if patch.GetApplyTo() != networkingv1alpha3spec.EnvoyFilter_HTTP_ROUTE ||
+			patch.GetMatch().GetContext() != networkingv1alpha3spec.EnvoyFilter_SIDECAR_OUTBOUND ||
+			patch.GetMatch().GetRouteConfiguration().GetVhost().GetName() != vhostName ||
+			patch.GetMatch().GetRouteConfiguration().GetVhost().GetRoute().GetName() != routeName ||
+			patch.GetPatch().GetOperation() != networkingv1alpha3spec.EnvoyFilter_Patch_MERGE {
+			continue
+		}
+		if structContainsField(patch.GetPatch().GetValue(), fieldPath) {
+			return patch
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package fieldutils
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -22,6 +23,19 @@ func (e FieldConflictError) Error() string {
 	return fmt.Sprintf("field %s is already owned by %T %s (priority %v)", fieldName, e.OwnerType, resourceidutils.ResourceIdsToString(e.Owners), e.Priority)
 }
 
+// A FieldShadowedError indicates that a field is owned by an owner with a strictly higher priority.
+// Unlike a FieldConflictError, a shadowed owner is not considered to be in conflict:
+// the field retains the value of the higher priority owner.
+type FieldShadowedError struct {
+	FieldConflictError
+}
+
+func (e FieldShadowedError) Error() string {
+	// remove ** from field name
+	fieldName := strings.ReplaceAll(fmt.Sprintf("%T", e.Field), "*", "")
+	return fmt.Sprintf("field %s is shadowed by %T %s (priority %v)", fieldName, e.OwnerType, resourceidutils.ResourceIdsToString(e.Owners), e.Priority)
+}
+
 // an FieldOwnershipRegistry tracks the ownership of individual object fields.
 // this is used to track e.g. which TrafficPolicy
 // Note that the current implementation of the ownership registry
@@ -30,8 +44,10 @@ func (e FieldConflictError) Error() string {
 type FieldOwnershipRegistry interface {
 	// Registers ownership with a given priority for the given field.
 	//
-	// If an ownership with a higher or equal priority exists for the field,
-	// a ConflictError containing the previous owner and its priority are returned.
+	// If an ownership with an equal priority exists for the field,
+	// a FieldConflictError containing the previous owner and its priority is returned.
+	// If an ownership with a higher priority exists for the field,
+	// a FieldShadowedError containing the previous owner and its priority is returned.
 	//
 	// field must be a pointer to the field.
 	RegisterFieldOwnership(obj ezkube.Object, field interface{}, owners []ezkube.ResourceId, ownerType ezkube.Object, priority int32) error
@@ -61,12 +77,16 @@ type FieldOwnership struct {
 func (o *ownershipRegistry) RegisterFieldOwnership(obj ezkube.Object, field interface{}, owners []ezkube.ResourceId, ownerType ezkube.Object, priority int32) error {
 	previousOwner, exists := o.fieldOwners[field]
 	if exists && previousOwner.priority >= priority {
-		return FieldConflictError{
+		conflictErr := FieldConflictError{
 			Owners:    previousOwner.owners,
 			OwnerType: previousOwner.ownerType,
 			Priority:  previousOwner.priority,
 			Field:     field,
 		}
+		if previousOwner.priority > priority {
+			return FieldShadowedError{FieldConflictError: conflictErr}
+		}
+		return conflictErr
 	}
 	newOwner := FieldOwnership{
 		owners:    owners,
@@ -104,3 +124,9 @@ func typedOwnerSet(fieldOwner FieldOwnership) sets.String {
 	}
 	return set
 }
+
+// returns true if the error, or any error it wraps, is a FieldShadowedError
+func IsFieldShadowedError(err error) bool {
+	var shadowedErr FieldShadowedError
+	return errors.As(err, &shadowedErr)
+}
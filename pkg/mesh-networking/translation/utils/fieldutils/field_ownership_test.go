@@ -31,12 +31,51 @@ var _ = Describe("FieldOwnership", func() {
 
 		err = fieldRegistry.RegisterFieldOwnership(vs, corsPolicyField, owner2, &v1.TrafficPolicy{}, 0)
 		Expect(err).To(HaveOccurred())
+		Expect(err).To(Equal(FieldShadowedError{
+			FieldConflictError: FieldConflictError{
+				Field:     corsPolicyField,
+				OwnerType: &v1.TrafficPolicy{},
+				Owners:    owner1,
+				Priority:  1,
+			},
+		}))
+		Expect(IsFieldShadowedError(err)).To(BeTrue())
+
+		err = fieldRegistry.RegisterFieldOwnership(vs, corsPolicyField, owner2, &v1.TrafficPolicy{}, 1)
+		Expect(err).To(HaveOccurred())
 		Expect(err).To(Equal(FieldConflictError{
 			Field:     corsPolicyField,
 			OwnerType: &v1.TrafficPolicy{},
 			Owners:    owner1,
 			Priority:  1,
 		}))
+		Expect(IsFieldShadowedError(err)).To(BeFalse())
+
+	})
 
+	It("replaces the owner of a field when an owner with higher priority registers", func() {
+		fieldRegistry := NewOwnershipRegistry()
+		vs := &networkingv1alpha3.VirtualService{}
+		istioRoute := &networkingv1alpha3spec.HTTPRoute{}
+
+		owner1 := []ezkube.ResourceId{&skv2corev1.ObjectRef{Name: "1"}}
+		owner2 := []ezkube.ResourceId{&skv2corev1.ObjectRef{Name: "2"}}
+
+		corsPolicyField := &istioRoute.CorsPolicy
+		err := fieldRegistry.RegisterFieldOwnership(vs, corsPolicyField, owner1, &v1.TrafficPolicy{}, 0)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fieldRegistry.RegisterFieldOwnership(vs, corsPolicyField, owner2, &v1.TrafficPolicy{}, 1)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = fieldRegistry.RegisterFieldOwnership(vs, corsPolicyField, owner1, &v1.TrafficPolicy{}, 0)
+		Expect(err).To(Equal(FieldShadowedError{
+			FieldConflictError: FieldConflictError{
+				Field:     corsPolicyField,
+				OwnerType: &v1.TrafficPolicy{},
+				Owners:    owner2,
+				Priority:  1,
+			},
+		}))
 	})
 })
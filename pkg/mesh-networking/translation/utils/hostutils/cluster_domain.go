@@ -119,3 +119,9 @@ func GetFederatedHostnameSuffix(virtualMeshSpec *v1.VirtualMeshSpec) string {
 	}
 	return federatedHostnameSuffix
 }
+
+// Construct the SNI hostname used to route requests for a port of a federated Destination through the ingress gateway
+// of the Destination's Mesh, which terminates mTLS for VirtualMeshes with limited trust.
+func BuildLimitedTrustSniHost(port uint32, federatedHostname string) string {
+	return fmt.Sprintf("%d.%s", port, federatedHostname)
+}
@@ -0,0 +1,57 @@
+package routeutils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
+	v1 "github.com/solo-io/gloo-mesh/pkg/api/networking.mesh.gloo.solo.io/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// RouteName returns the name of the VirtualService HTTPRoute translated for TrafficPolicies with the given request matchers
+// and source selectors. TrafficPolicies with semantically equivalent matchers are translated into a single route,
+// so they share a name, which EnvoyFilters use to patch only the routes selected by a TrafficPolicy.
+func RouteName(
+	requestMatchers []*v1.HttpMatcher,
+	sourceSelectors []*commonv1.WorkloadSelector,
+) (string, error) {
+	h := fnv.New64()
+	for _, matcher := range requestMatchers {
+		matcherBytes, err := proto.MarshalOptions{Deterministic: true}.Marshal(matcher)
+		if err != nil {
+			return "", err
+		}
+		if _, err := fmt.Fprintf(h, "%d:%s;", len(matcherBytes), matcherBytes); err != nil {
+			return "", err
+		}
+	}
+
+	// source selectors are equivalent regardless of their order and clusters
+	var selectorKeys []string
+	for _, sourceSelector := range sourceSelectors {
+		selectorKeys = append(selectorKeys, workloadSelectorKey(sourceSelector))
+	}
+	sort.Strings(selectorKeys)
+	for _, selectorKey := range selectorKeys {
+		if _, err := fmt.Fprintf(h, "%d:%s;", len(selectorKey), selectorKey); err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("gloo-mesh-%x", h.Sum64()), nil
+}
+
+func workloadSelectorKey(workloadSelector *commonv1.WorkloadSelector) string {
+	kubeWorkloadMatcher := workloadSelector.GetKubeWorkloadMatcher()
+	var labels []string
+	for key, value := range kubeWorkloadMatcher.GetLabels() {
+		labels = append(labels, key+"="+value)
+	}
+	sort.Strings(labels)
+	namespaces := append([]string{}, kubeWorkloadMatcher.GetNamespaces()...)
+	sort.Strings(namespaces)
+	return strings.Join(labels, ",") + "/" + strings.Join(namespaces, ",")
+}
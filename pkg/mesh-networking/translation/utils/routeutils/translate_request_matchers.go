@@ -11,29 +11,7 @@ func TranslateRequestMatchers(
 	requestMatchers []*v1.HttpMatcher,
 	sourceSelectors []*commonv1.WorkloadSelector, // should be nil for gateway
 ) []*networkingv1alpha3spec.HTTPMatchRequest {
-	// Generate HttpMatchRequests for SourceSelector, one per namespace.
-	var sourceMatchers []*networkingv1alpha3spec.HTTPMatchRequest
-	// Set SourceNamespace and SourceLabels.
-	for _, sourceSelector := range sourceSelectors {
-
-		sourceWorkloadMatcher := sourceSelector.GetKubeWorkloadMatcher()
-		if len(sourceWorkloadMatcher.GetLabels()) > 0 ||
-			len(sourceWorkloadMatcher.GetNamespaces()) > 0 {
-			if len(sourceWorkloadMatcher.GetNamespaces()) > 0 {
-				for _, namespace := range sourceWorkloadMatcher.GetNamespaces() {
-					matchRequest := &networkingv1alpha3spec.HTTPMatchRequest{
-						SourceNamespace: namespace,
-						SourceLabels:    sourceWorkloadMatcher.GetLabels(),
-					}
-					sourceMatchers = append(sourceMatchers, matchRequest)
-				}
-			} else {
-				sourceMatchers = append(sourceMatchers, &networkingv1alpha3spec.HTTPMatchRequest{
-					SourceLabels: sourceWorkloadMatcher.GetLabels(),
-				})
-			}
-		}
-	}
+	sourceMatchers := translateSourceSelectors(sourceSelectors)
 	if requestMatchers == nil {
 		return sourceMatchers
 	}
@@ -70,6 +48,76 @@ func TranslateRequestMatchers(
 	return translatedRequestMatchers
 }
 
+// TranslateTcpMatcher translates a TCP matcher on the given Destination port to Istio, with one L4MatchAttributes per source namespace.
+func TranslateTcpMatcher(
+	port uint32,
+	sourceSelectors []*commonv1.WorkloadSelector,
+) []*networkingv1alpha3spec.L4MatchAttributes {
+	sourceMatchers := translateSourceSelectors(sourceSelectors)
+	if len(sourceMatchers) == 0 {
+		sourceMatchers = append(sourceMatchers, &networkingv1alpha3spec.HTTPMatchRequest{})
+	}
+
+	var translatedMatchers []*networkingv1alpha3spec.L4MatchAttributes
+	for _, sourceMatcher := range sourceMatchers {
+		translatedMatchers = append(translatedMatchers, &networkingv1alpha3spec.L4MatchAttributes{
+			Port:            port,
+			SourceNamespace: sourceMatcher.GetSourceNamespace(),
+			SourceLabels:    sourceMatcher.GetSourceLabels(),
+		})
+	}
+	return translatedMatchers
+}
+
+// TranslateTlsMatcher translates a TLS matcher on the given Destination port to Istio, with one TLSMatchAttributes per source namespace.
+func TranslateTlsMatcher(
+	matcher *v1.TlsMatcher,
+	port uint32,
+	sourceSelectors []*commonv1.WorkloadSelector,
+) []*networkingv1alpha3spec.TLSMatchAttributes {
+	sourceMatchers := translateSourceSelectors(sourceSelectors)
+	if len(sourceMatchers) == 0 {
+		sourceMatchers = append(sourceMatchers, &networkingv1alpha3spec.HTTPMatchRequest{})
+	}
+
+	var translatedMatchers []*networkingv1alpha3spec.TLSMatchAttributes
+	for _, sourceMatcher := range sourceMatchers {
+		translatedMatchers = append(translatedMatchers, &networkingv1alpha3spec.TLSMatchAttributes{
+			SniHosts:        matcher.GetSniHosts(),
+			Port:            port,
+			SourceNamespace: sourceMatcher.GetSourceNamespace(),
+			SourceLabels:    sourceMatcher.GetSourceLabels(),
+		})
+	}
+	return translatedMatchers
+}
+
+// Generate HttpMatchRequests for SourceSelector, one per namespace, with only SourceNamespace and SourceLabels set.
+func translateSourceSelectors(sourceSelectors []*commonv1.WorkloadSelector) []*networkingv1alpha3spec.HTTPMatchRequest {
+	var sourceMatchers []*networkingv1alpha3spec.HTTPMatchRequest
+	for _, sourceSelector := range sourceSelectors {
+
+		sourceWorkloadMatcher := sourceSelector.GetKubeWorkloadMatcher()
+		if len(sourceWorkloadMatcher.GetLabels()) > 0 ||
+			len(sourceWorkloadMatcher.GetNamespaces()) > 0 {
+			if len(sourceWorkloadMatcher.GetNamespaces()) > 0 {
+				for _, namespace := range sourceWorkloadMatcher.GetNamespaces() {
+					matchRequest := &networkingv1alpha3spec.HTTPMatchRequest{
+						SourceNamespace: namespace,
+						SourceLabels:    sourceWorkloadMatcher.GetLabels(),
+					}
+					sourceMatchers = append(sourceMatchers, matchRequest)
+				}
+			} else {
+				sourceMatchers = append(sourceMatchers, &networkingv1alpha3spec.HTTPMatchRequest{
+					SourceLabels: sourceWorkloadMatcher.GetLabels(),
+				})
+			}
+		}
+	}
+	return sourceMatchers
+}
+
 func translateRequestMatcherHeaders(matchers []*v1.HeaderMatcher) (
 	map[string]*networkingv1alpha3spec.StringMatch, map[string]*networkingv1alpha3spec.StringMatch,
 ) {
@@ -56,6 +56,43 @@ func TranslateWeightedDestination(
 	}, nil
 }
 
+// TranslateWeightedRouteDestination translates a WeightedDestination into a destination for a TCP or TLS route,
+// which unlike HTTP routes do not support header manipulation.
+func TranslateWeightedRouteDestination(
+	weightedDest *networkingv1.WeightedDestination,
+	sourceCluster string,
+	destinations discoveryv1sets.DestinationSet,
+	virtualDestinations v1beta1sets.VirtualDestinationSet,
+	clusterDomains hostutils.ClusterDomainRegistry,
+) (*networkingv1alpha3spec.RouteDestination, error) {
+	if weightedDest.DestinationType == nil {
+		return nil, eris.Errorf("must set a destination type on weighted destination")
+	}
+	if weightedDest.GetOptions().GetHeaderManipulation() != nil {
+		return nil, eris.Errorf("header manipulation is not supported for TCP and TLS destinations")
+	}
+
+	destinationHost, destinationPort, subsetName, err := resolveHostPortSubset(
+		weightedDest,
+		destinations,
+		virtualDestinations,
+		sourceCluster,
+		clusterDomains,
+	)
+	if err != nil {
+		return nil, eris.Wrap(err, "resolving host")
+	}
+
+	return &networkingv1alpha3spec.RouteDestination{
+		Destination: &networkingv1alpha3spec.Destination{
+			Host:   destinationHost,
+			Port:   destinationPort,
+			Subset: subsetName,
+		},
+		Weight: int32(weightedDest.Weight),
+	}, nil
+}
+
 func resolveHostPortSubset(
 	weightedDest *networkingv1.WeightedDestination,
 	destinations discoveryv1sets.DestinationSet,
@@ -6,6 +6,7 @@ import (
 	"github.com/rotisserie/eris"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
 	"github.com/solo-io/go-utils/contextutils"
 	"github.com/solo-io/go-utils/stringutils"
 	"github.com/solo-io/skv2/contrib/pkg/sets"
@@ -43,9 +44,10 @@ func SelectorMatchesWorkload(ctx context.Context, selectors []*commonv1.Workload
 		return true
 	}
 
-	kubeWorkload := workload.Spec.GetKubernetes()
-	if kubeWorkload == nil {
-		contextutils.LoggerFrom(ctx).DPanicf("Missing Kubernetes workload data for Workload %s", sets.Key(workload))
+	// Kubernetes and virtual machine workloads are both selected by the namespace and cluster of the resource defining them
+	workloadRef, workloadLabels := workloadutils.GetWorkloadRefAndLabels(workload)
+	if workloadRef == nil {
+		contextutils.LoggerFrom(ctx).DPanicf("Missing Kubernetes or virtual machine workload data for Workload %s", sets.Key(workload))
 		return false
 	}
 
@@ -53,7 +55,7 @@ func SelectorMatchesWorkload(ctx context.Context, selectors []*commonv1.Workload
 
 		// matcher-based selection
 		matcher := selector.GetKubeWorkloadMatcher()
-		if kubeWorkloadMatches(matcher.GetLabels(), matcher.GetNamespaces(), matcher.GetClusters(), kubeWorkload) {
+		if workloadMatches(matcher.GetLabels(), matcher.GetNamespaces(), matcher.GetClusters(), workloadRef, workloadLabels) {
 			return true
 		}
 	}
@@ -66,30 +68,32 @@ func IdentityMatchesWorkload(selectors []*commonv1.IdentitySelector, workload *d
 		return true
 	}
 
+	workloadRef, _ := workloadutils.GetWorkloadRefAndLabels(workload)
+	if workloadRef == nil {
+		return false
+	}
+
 	for _, selector := range selectors {
-		kubeWorkload := workload.Spec.GetKubernetes()
-		if kubeWorkload != nil {
-			if kubeWorkloadIdentityMatcher := selector.GetKubeIdentityMatcher(); kubeWorkloadIdentityMatcher != nil {
-				namespaces := kubeWorkloadIdentityMatcher.GetNamespaces()
-				clusters := kubeWorkloadIdentityMatcher.GetClusters()
-				if len(namespaces) > 0 && !stringutils.ContainsString(kubeWorkload.GetController().GetNamespace(), namespaces) {
-					return false
-				}
-				if len(clusters) > 0 && !stringutils.ContainsString(kubeWorkload.GetController().GetClusterName(), clusters) {
-					return false
-				}
-				return true
+		if kubeWorkloadIdentityMatcher := selector.GetKubeIdentityMatcher(); kubeWorkloadIdentityMatcher != nil {
+			namespaces := kubeWorkloadIdentityMatcher.GetNamespaces()
+			clusters := kubeWorkloadIdentityMatcher.GetClusters()
+			if len(namespaces) > 0 && !stringutils.ContainsString(workloadRef.GetNamespace(), namespaces) {
+				return false
 			}
-			if kubeWorkloadRefs := selector.GetKubeServiceAccountRefs(); kubeWorkloadRefs != nil {
-				for _, ref := range kubeWorkloadRefs.GetServiceAccounts() {
-					if ref.GetName() == kubeWorkload.GetServiceAccountName() &&
-						ref.GetNamespace() == kubeWorkload.GetController().GetNamespace() &&
-						ref.GetClusterName() == kubeWorkload.GetController().GetClusterName() {
-						return true
-					}
-				}
+			if len(clusters) > 0 && !stringutils.ContainsString(workloadRef.GetClusterName(), clusters) {
 				return false
 			}
+			return true
+		}
+		if kubeWorkloadRefs := selector.GetKubeServiceAccountRefs(); kubeWorkloadRefs != nil {
+			for _, ref := range kubeWorkloadRefs.GetServiceAccounts() {
+				if ref.GetName() == workloadutils.GetWorkloadServiceAccountName(workload) &&
+					ref.GetNamespace() == workloadRef.GetNamespace() &&
+					ref.GetClusterName() == workloadRef.GetClusterName() {
+					return true
+				}
+			}
+			return false
 		}
 	}
 
@@ -123,6 +127,19 @@ func SelectorMatchesDestination(selectors []*commonv1.DestinationSelector, desti
 				}
 			}
 		}
+		externalService := destination.Spec.GetExternalService()
+		if externalService != nil {
+			if externalServiceMatcher := selector.ExternalServiceMatcher; externalServiceMatcher != nil {
+				if externalServiceMatches(
+					externalServiceMatcher.Hosts,
+					externalServiceMatcher.Namespaces,
+					externalServiceMatcher.Clusters,
+					externalService,
+				) {
+					return true
+				}
+			}
+		}
 	}
 
 	return false
@@ -145,32 +162,37 @@ func WorkloadSelectorContainsCluster(selectors []*commonv1.WorkloadSelector, clu
 	return false
 }
 
-/* For a k8s Workload to match:
-1) If labels is specified, all labels must exist on the k8s Workload
-2) If namespaces is specified, the k8s workload must be in one of those namespaces
+/*
+	For a Workload to match:
+
+1) If labels is specified, all labels must exist on the Workload's pods or virtual machine instances
+2) If namespaces is specified, the Workload must be in one of those namespaces
 */
-func kubeWorkloadMatches(
+func workloadMatches(
 	labels map[string]string,
 	namespaces []string,
 	clusters []string,
-	kubeWorkload *discoveryv1.WorkloadSpec_KubernetesWorkload,
+	workloadRef *v1.ClusterObjectRef,
+	workloadLabels map[string]string,
 ) bool {
-	if len(namespaces) > 0 && !stringutils.ContainsString(kubeWorkload.GetController().GetNamespace(), namespaces) {
+	if len(namespaces) > 0 && !stringutils.ContainsString(workloadRef.GetNamespace(), namespaces) {
 		return false
 	}
-	if len(clusters) > 0 && !stringutils.ContainsString(kubeWorkload.GetController().GetClusterName(), clusters) {
+	if len(clusters) > 0 && !stringutils.ContainsString(workloadRef.GetClusterName(), clusters) {
 		return false
 	}
 	for k, v := range labels {
-		serviceLabelValue, ok := kubeWorkload.GetPodLabels()[k]
-		if !ok || serviceLabelValue != v {
+		workloadLabelValue, ok := workloadLabels[k]
+		if !ok || workloadLabelValue != v {
 			return false
 		}
 	}
 	return true
 }
 
-/* For a k8s Service to match:
+/*
+	For a k8s Service to match:
+
 1) If labels is specified, all labels must exist on the k8s Service
 2) If namespaces is specified, the k8s must be in one of those namespaces
 3) The k8s Service must exist in the specified cluster. If cluster is empty, select across all clusters.
@@ -196,6 +218,39 @@ func kubeServiceMatches(
 	return true
 }
 
+/*
+	Match an external service discovered from an Istio ServiceEntry if:
+	1) It declares at least one of the specified hosts. If hosts is empty, select any host.
+	2) The ServiceEntry exists in the specified namespace. If namespaces is empty, select across all namespaces.
+	3) The ServiceEntry exists in the specified cluster. If clusters is empty, select across all clusters.
+*/
+func externalServiceMatches(
+	hosts []string,
+	namespaces []string,
+	clusters []string,
+	externalService *discoveryv1.DestinationSpec_ExternalService,
+) bool {
+	if len(hosts) > 0 && !containsAnyString(externalService.GetHosts(), hosts) {
+		return false
+	}
+	if len(namespaces) > 0 && !stringutils.ContainsString(externalService.GetRef().GetNamespace(), namespaces) {
+		return false
+	}
+	if len(clusters) > 0 && !stringutils.ContainsString(externalService.GetRef().GetClusterName(), clusters) {
+		return false
+	}
+	return true
+}
+
+func containsAnyString(candidates []string, values []string) bool {
+	for _, candidate := range candidates {
+		if stringutils.ContainsString(candidate, values) {
+			return true
+		}
+	}
+	return false
+}
+
 func refsContain(refs []*v1.ClusterObjectRef, targetRef *v1.ClusterObjectRef) bool {
 	for _, ref := range refs {
 		if ezkube.ClusterRefsMatch(targetRef, ref) {
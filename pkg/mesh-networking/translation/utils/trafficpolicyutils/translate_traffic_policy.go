@@ -1,8 +1,12 @@
 package trafficpolicyutils
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/rotisserie/eris"
 	commonv1 "github.com/solo-io/gloo-mesh/pkg/api/common.mesh.gloo.solo.io/v1"
 	v1sets "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1/sets"
@@ -42,51 +46,135 @@ func TranslateTimeout(
 	return gogoutils.DurationProtoToGogo(timeout)
 }
 
-func TranslateRetries(
+// translate the retry policy into the VirtualService route retries.
+// returns an error if a retry condition or retriable status code is invalid.
+func TranslateRetryPolicy(
 	retries *v1.TrafficPolicySpec_Policy_RetryPolicy,
-) *istiov1alpha3.HTTPRetry {
+) (*istiov1alpha3.HTTPRetry, error) {
 	if retries == nil {
-		return nil
+		return nil, nil
+	}
+	retryOn, err := translateRetryOn(retries)
+	if err != nil {
+		return nil, err
 	}
 	return &networkingv1alpha3spec.HTTPRetry{
-		Attempts:      retries.GetAttempts(),
-		PerTryTimeout: gogoutils.DurationProtoToGogo(retries.GetPerTryTimeout()),
+		Attempts:              retries.GetAttempts(),
+		PerTryTimeout:         gogoutils.DurationProtoToGogo(retries.GetPerTryTimeout()),
+		RetryOn:               retryOn,
+		RetryRemoteLocalities: gogoutils.BoolProtoToGogo(retries.GetRetryRemoteLocalities()),
+	}, nil
+}
+
+// Istio accepts both retry conditions and retriable status codes as a single comma separated list
+func translateRetryOn(
+	retries *v1.TrafficPolicySpec_Policy_RetryPolicy,
+) (string, error) {
+	var retryOn []string
+	for _, condition := range retries.GetRetryOn() {
+		if condition == "" || strings.ContainsAny(condition, ", ") {
+			return "", eris.Errorf("invalid retry condition %q", condition)
+		}
+		retryOn = append(retryOn, condition)
 	}
+	for _, statusCode := range retries.GetRetriableStatusCodes() {
+		if statusCode < 100 || statusCode > 599 {
+			return "", eris.Errorf("invalid retriable status code %d", statusCode)
+		}
+		retryOn = append(retryOn, strconv.Itoa(int(statusCode)))
+	}
+	return strings.Join(retryOn, ","), nil
 }
 
 func TranslateFault(faultInjection *v1.TrafficPolicySpec_Policy_FaultInjection) (*networkingv1alpha3spec.HTTPFaultInjection, error) {
 	if faultInjection == nil {
 		return nil, nil
 	}
-	if faultInjection.GetFaultInjectionType() == nil {
+	if faultInjection.GetFaultInjectionType() == nil && faultInjection.GetDelay() == nil {
 		return nil, eris.New("FaultInjection type must be specified.")
 	}
-	var translatedFaultInjection *networkingv1alpha3spec.HTTPFaultInjection
+	translatedFaultInjection := &networkingv1alpha3spec.HTTPFaultInjection{}
 	switch injectionType := faultInjection.GetFaultInjectionType().(type) {
+	case nil:
 	case *v1.TrafficPolicySpec_Policy_FaultInjection_Abort_:
-		translatedFaultInjection = &networkingv1alpha3spec.HTTPFaultInjection{
-			Abort: &networkingv1alpha3spec.HTTPFaultInjection_Abort{
-				ErrorType: &networkingv1alpha3spec.HTTPFaultInjection_Abort_HttpStatus{
-					HttpStatus: faultInjection.GetAbort().GetHttpStatus(),
-				},
-				Percentage: &networkingv1alpha3spec.Percent{Value: faultInjection.GetPercentage()},
-			},
+		abort, err := translateAbort(faultInjection.GetAbort(), faultInjection.GetPercentage())
+		if err != nil {
+			return nil, err
 		}
+		translatedFaultInjection.Abort = abort
 	case *v1.TrafficPolicySpec_Policy_FaultInjection_FixedDelay:
-		translatedFaultInjection = &networkingv1alpha3spec.HTTPFaultInjection{
-			Delay: &networkingv1alpha3spec.HTTPFaultInjection_Delay{
-				HttpDelayType: &networkingv1alpha3spec.HTTPFaultInjection_Delay_FixedDelay{
-					FixedDelay: gogoutils.DurationProtoToGogo(faultInjection.GetFixedDelay()),
-				},
-				Percentage: &networkingv1alpha3spec.Percent{Value: faultInjection.GetPercentage()},
+		if faultInjection.GetDelay() != nil {
+			return nil, eris.New("FaultInjection.FixedDelay and FaultInjection.Delay are mutually exclusive.")
+		}
+		translatedFaultInjection.Delay = &networkingv1alpha3spec.HTTPFaultInjection_Delay{
+			HttpDelayType: &networkingv1alpha3spec.HTTPFaultInjection_Delay_FixedDelay{
+				FixedDelay: gogoutils.DurationProtoToGogo(faultInjection.GetFixedDelay()),
 			},
+			Percentage: &networkingv1alpha3spec.Percent{Value: faultInjection.GetPercentage()},
 		}
 	default:
 		return nil, eris.Errorf("FaultInjection.FaultInjectionType has unexpected type %T", injectionType)
 	}
+	if delay := faultInjection.GetDelay(); delay != nil {
+		if delay.GetFixedDelay() == nil {
+			return nil, eris.New("FaultInjection.Delay.FixedDelay must be specified.")
+		}
+		percentage, err := translateFaultPercentage(delay.GetPercentage(), faultInjection.GetPercentage())
+		if err != nil {
+			return nil, eris.Wrap(err, "FaultInjection.Delay")
+		}
+		translatedFaultInjection.Delay = &networkingv1alpha3spec.HTTPFaultInjection_Delay{
+			HttpDelayType: &networkingv1alpha3spec.HTTPFaultInjection_Delay_FixedDelay{
+				FixedDelay: gogoutils.DurationProtoToGogo(delay.GetFixedDelay()),
+			},
+			Percentage: percentage,
+		}
+	}
 	return translatedFaultInjection, nil
 }
 
+func translateAbort(
+	abort *v1.TrafficPolicySpec_Policy_FaultInjection_Abort,
+	defaultPercentage float64,
+) (*networkingv1alpha3spec.HTTPFaultInjection_Abort, error) {
+	percentage, err := translateFaultPercentage(abort.GetPercentage(), defaultPercentage)
+	if err != nil {
+		return nil, eris.Wrap(err, "FaultInjection.Abort")
+	}
+	translatedAbort := &networkingv1alpha3spec.HTTPFaultInjection_Abort{
+		Percentage: percentage,
+	}
+	switch {
+	case abort.GetHttpStatus() != 0 && abort.GetGrpcStatus() != "":
+		return nil, eris.New("FaultInjection.Abort.HttpStatus and FaultInjection.Abort.GrpcStatus are mutually exclusive.")
+	case abort.GetHttpStatus() == 0 && abort.GetGrpcStatus() == "":
+		return nil, eris.New("FaultInjection.Abort must specify either HttpStatus or GrpcStatus.")
+	case abort.GetGrpcStatus() != "":
+		translatedAbort.ErrorType = &networkingv1alpha3spec.HTTPFaultInjection_Abort_GrpcStatus{
+			GrpcStatus: abort.GetGrpcStatus(),
+		}
+	default:
+		translatedAbort.ErrorType = &networkingv1alpha3spec.HTTPFaultInjection_Abort_HttpStatus{
+			HttpStatus: abort.GetHttpStatus(),
+		}
+	}
+	return translatedAbort, nil
+}
+
+// the percentage of a delay or abort overrides the percentage of the FaultInjection
+func translateFaultPercentage(
+	percentage *wrappers.DoubleValue,
+	defaultPercentage float64,
+) (*networkingv1alpha3spec.Percent, error) {
+	if percentage == nil {
+		return &networkingv1alpha3spec.Percent{Value: defaultPercentage}, nil
+	}
+	if percentage.GetValue() < 0 || percentage.GetValue() > 100 {
+		return nil, eris.Errorf("Percentage must be between 0 and 100, found %v", percentage.GetValue())
+	}
+	return &networkingv1alpha3spec.Percent{Value: percentage.GetValue()}, nil
+}
+
 func TranslateCorsPolicy(
 	corsPolicy *v1.TrafficPolicySpec_Policy_CorsPolicy,
 ) (*istiov1alpha3.CorsPolicy, error) {
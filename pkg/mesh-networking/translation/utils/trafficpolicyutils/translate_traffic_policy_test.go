@@ -91,6 +91,82 @@ var _ = Describe("FaultInjection", func() {
 		Expect(faultResult).To(Equal(expectedFaultInjection))
 	})
 
+	It("should set both a delay and an abort with independent percentages", func() {
+		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
+			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort_{
+				Abort: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort{
+					HttpStatus: 503,
+					Percentage: &wrappers.DoubleValue{Value: 5},
+				},
+			},
+			Delay: &v1.TrafficPolicySpec_Policy_FaultInjection_Delay{
+				FixedDelay: &duration.Duration{Seconds: 2},
+				Percentage: &wrappers.DoubleValue{Value: 50},
+			},
+		}
+		expectedFaultInjection := &v1alpha3.HTTPFaultInjection{
+			Delay: &v1alpha3.HTTPFaultInjection_Delay{
+				HttpDelayType: &v1alpha3.HTTPFaultInjection_Delay_FixedDelay{FixedDelay: &types.Duration{Seconds: 2}},
+				Percentage:    &v1alpha3.Percent{Value: 50},
+			},
+			Abort: &v1alpha3.HTTPFaultInjection_Abort{
+				ErrorType:  &v1alpha3.HTTPFaultInjection_Abort_HttpStatus{HttpStatus: 503},
+				Percentage: &v1alpha3.Percent{Value: 5},
+			},
+		}
+		faultResult, err := TranslateFault(faultPolicy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(faultResult).To(Equal(expectedFaultInjection))
+	})
+
+	It("should set fault injection of type abort with a gRPC status", func() {
+		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
+			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort_{
+				Abort: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort{
+					GrpcStatus: "UNAVAILABLE",
+				},
+			},
+			Percentage: 10,
+		}
+		expectedFaultInjection := &v1alpha3.HTTPFaultInjection{
+			Abort: &v1alpha3.HTTPFaultInjection_Abort{
+				ErrorType:  &v1alpha3.HTTPFaultInjection_Abort_GrpcStatus{GrpcStatus: "UNAVAILABLE"},
+				Percentage: &v1alpha3.Percent{Value: 10},
+			},
+		}
+		faultResult, err := TranslateFault(faultPolicy)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(faultResult).To(Equal(expectedFaultInjection))
+	})
+
+	It("should return error if both fixed delay and delay are specified", func() {
+		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
+			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_FixedDelay{
+				FixedDelay: &duration.Duration{Seconds: 2},
+			},
+			Delay: &v1.TrafficPolicySpec_Policy_FaultInjection_Delay{
+				FixedDelay: &duration.Duration{Seconds: 2},
+			},
+		}
+		faultResult, err := TranslateFault(faultPolicy)
+		Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		Expect(faultResult).To(BeNil())
+	})
+
+	It("should return error if an abort specifies both an HTTP and a gRPC status", func() {
+		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
+			FaultInjectionType: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort_{
+				Abort: &v1.TrafficPolicySpec_Policy_FaultInjection_Abort{
+					HttpStatus: 503,
+					GrpcStatus: "UNAVAILABLE",
+				},
+			},
+		}
+		faultResult, err := TranslateFault(faultPolicy)
+		Expect(err.Error()).To(ContainSubstring("mutually exclusive"))
+		Expect(faultResult).To(BeNil())
+	})
+
 	It("should return error if fault injection type not specified", func() {
 		faultPolicy := &v1.TrafficPolicySpec_Policy_FaultInjection{
 			Percentage: 50,
@@ -350,10 +426,40 @@ var _ = Describe("Mirror", func() {
 				Attempts:      5,
 				PerTryTimeout: &types.Duration{Seconds: 2},
 			}
-			retriesResult := TranslateRetries(retriesPolicy)
+			retriesResult, err := TranslateRetryPolicy(retriesPolicy)
+			Expect(err).ToNot(HaveOccurred())
 			Expect(retriesResult).To(Equal(expectedRetries))
 		})
 
+		It("should set retry conditions, retriable status codes and retry remote localities", func() {
+			retriesPolicy := &v1.TrafficPolicySpec_Policy_RetryPolicy{
+				Attempts:              3,
+				RetryOn:               []string{"connect-failure", "unavailable", "resource-exhausted"},
+				RetriableStatusCodes:  []uint32{503, 504},
+				RetryRemoteLocalities: &wrappers.BoolValue{Value: true},
+			}
+			expectedRetries := &v1alpha3.HTTPRetry{
+				Attempts:              3,
+				RetryOn:               "connect-failure,unavailable,resource-exhausted,503,504",
+				RetryRemoteLocalities: &types.BoolValue{Value: true},
+			}
+			retriesResult, err := TranslateRetryPolicy(retriesPolicy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(retriesResult).To(Equal(expectedRetries))
+		})
+
+		It("should return an error for invalid retry conditions or status codes", func() {
+			_, err := TranslateRetryPolicy(&v1.TrafficPolicySpec_Policy_RetryPolicy{
+				RetryOn: []string{"5xx,gateway-error"},
+			})
+			Expect(err).To(HaveOccurred())
+
+			_, err = TranslateRetryPolicy(&v1.TrafficPolicySpec_Policy_RetryPolicy{
+				RetriableStatusCodes: []uint32{42},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
 	})
 
 	var _ = Describe("Timeout", func() {
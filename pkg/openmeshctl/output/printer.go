@@ -333,6 +333,12 @@ func (p *printer) printDestinationSelector(w io.Writer, spaces int, sel *commonv
 	p.printMatcher(w, spaces+1, sel.GetKubeServiceMatcher())
 	p.printIndented(w, spaces, "Refs:")
 	p.printClusterRefList(w, spaces+1, sel.GetKubeServiceRefs().Services)
+	if externalServiceMatcher := sel.GetExternalServiceMatcher(); externalServiceMatcher != nil {
+		p.printIndented(w, spaces, "External Service Matcher:")
+		p.printMatcher(w, spaces+1, externalServiceMatcher)
+		p.printIndented(w, spaces+1, "Hosts: ")
+		p.printShortListValue(w, externalServiceMatcher.GetHosts())
+	}
 }
 
 func (p *printer) printDestinationSelectors(w io.Writer, spaces int, sels []*commonv1.DestinationSelector) {
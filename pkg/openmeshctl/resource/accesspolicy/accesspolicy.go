@@ -46,6 +46,7 @@ type Formatter struct{}
 func (f Formatter) ToSummary(obj runtime.Object) *output.Summary {
 	ap := obj.(*networkingv1.AccessPolicy)
 	fieldSet := output.FieldSet{}
+	fieldSet.AddField("Action", ap.Spec.GetAction().String())
 	fieldSet.AddField("Allowed Methods", strings.Join(ap.Spec.GetAllowedPaths(), ","))
 	fieldSet.AddField("Allowed Paths", ap.Spec.GetAllowedPaths())
 	ports := make([]string, len(ap.Spec.GetAllowedPorts()))
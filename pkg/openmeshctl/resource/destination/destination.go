@@ -88,6 +88,7 @@ func (f Formatter) makeKubeServiceFieldSet(svc *discoveryv1.DestinationSpec_Kube
 func (f Formatter) makeExtServiceFieldSet(svc *discoveryv1.DestinationSpec_ExternalService) *output.FieldSet {
 	fieldSet := output.FieldSet{}
 	fieldSet.AddField("Name", svc.GetName())
+	fieldSet.AddField("Service Entry", svc.GetRef())
 	fieldSet.AddField("Hosts", svc.GetHosts())
 	fieldSet.AddField("Addresses", svc.GetAddresses())
 	ports := make([]string, len(svc.GetPorts()))
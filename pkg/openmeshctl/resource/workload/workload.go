@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	discoveryv1 "github.com/solo-io/gloo-mesh/pkg/api/discovery.mesh.gloo.solo.io/v1"
+	"github.com/solo-io/gloo-mesh/pkg/mesh-discovery/utils/workloadutils"
 	"github.com/solo-io/gloo-mesh/pkg/openmeshctl/output"
 	"github.com/solo-io/gloo-mesh/pkg/openmeshctl/resource"
 	"github.com/solo-io/gloo-mesh/pkg/openmeshctl/resource/apply"
@@ -47,15 +48,36 @@ type Formatter struct{}
 func (f Formatter) ToSummary(obj runtime.Object) *output.Summary {
 	workload := obj.(*discoveryv1.Workload)
 	fieldSet := output.FieldSet{}
-	fieldSet.AddField("Kubernetes Workload", f.buildKubernetesWorkloadFieldSet(workload))
+	if vmWorkload := workload.Spec.GetVirtualMachine(); vmWorkload != nil {
+		fieldSet.AddField("Virtual Machine Workload", f.buildVirtualMachineWorkloadFieldSet(workload))
+	} else {
+		fieldSet.AddField("Kubernetes Workload", f.buildKubernetesWorkloadFieldSet(workload))
+	}
 	return &output.Summary{Meta: workload, Fields: fieldSet}
 }
 
 func (f Formatter) buildKubernetesWorkloadFieldSet(workload *discoveryv1.Workload) output.FieldSet {
 	fieldSet := output.FieldSet{}
-	fieldSet.AddField("Controller", workload.Spec.GetKubernetes().Controller)
-	fieldSet.AddField("Pod Labels", workload.Spec.GetKubernetes().PodLabels)
-	fieldSet.AddField("Service Account", workload.Spec.GetKubernetes().ServiceAccountName)
+	fieldSet.AddField("Controller", workload.Spec.GetKubernetes().GetController())
+	fieldSet.AddField("Pod Labels", workload.Spec.GetKubernetes().GetPodLabels())
+	fieldSet.AddField("Service Account", workload.Spec.GetKubernetes().GetServiceAccountName())
+	fieldSet.AddField("Service Dependencies", f.buildServideDepsValue(workload))
+	fieldSet.AddField("Destination Hostnames", workload.Status.GetServiceDependencies().GetDestinationHostnames())
+	return fieldSet
+}
+
+func (f Formatter) buildVirtualMachineWorkloadFieldSet(workload *discoveryv1.Workload) output.FieldSet {
+	vmWorkload := workload.Spec.GetVirtualMachine()
+	addresses := make([]string, len(vmWorkload.GetInstances()))
+	for i, instance := range vmWorkload.GetInstances() {
+		addresses[i] = instance.GetAddress()
+	}
+	fieldSet := output.FieldSet{}
+	fieldSet.AddField(vmWorkload.GetKind(), vmWorkload.GetRef())
+	fieldSet.AddField("Labels", vmWorkload.GetLabels())
+	fieldSet.AddField("Service Account", vmWorkload.GetServiceAccountName())
+	fieldSet.AddField("Network", vmWorkload.GetNetwork())
+	fieldSet.AddField("Addresses", addresses)
 	fieldSet.AddField("Service Dependencies", f.buildServideDepsValue(workload))
 	fieldSet.AddField("Destination Hostnames", workload.Status.GetServiceDependencies().GetDestinationHostnames())
 	return fieldSet
@@ -105,18 +127,18 @@ func (f Formatter) makeNextTableRowFunc(objs []runtime.Object, includeNS, wide b
 		if includeNS {
 			data = append(data, workload.GetNamespace())
 		}
-		kubesWorkload := workload.Spec.GetKubernetes()
+		workloadRef, _ := workloadutils.GetWorkloadRefAndLabels(workload)
 		data = append(
 			data,
 			workload.GetName(),
-			output.RefToString(kubesWorkload.GetController()),
+			output.RefToString(workloadRef),
 			f.makePodLablesCell(workload),
 			output.FormatAge(workload.GetCreationTimestamp()),
 		)
 		if wide {
 			data = append(
 				data,
-				kubesWorkload.GetServiceAccountName(),
+				workloadutils.GetWorkloadServiceAccountName(workload),
 				strings.Join(workload.Status.GetServiceDependencies().GetDestinationHostnames(), ","),
 			)
 		}
@@ -127,7 +149,8 @@ func (f Formatter) makeNextTableRowFunc(objs []runtime.Object, includeNS, wide b
 
 func (f Formatter) makePodLablesCell(workload *discoveryv1.Workload) string {
 	var labels sort.StringSlice
-	for label, value := range workload.Spec.GetKubernetes().GetPodLabels() {
+	_, workloadLabels := workloadutils.GetWorkloadRefAndLabels(workload)
+	for label, value := range workloadLabels {
 		labels = append(labels, fmt.Sprintf("%s=%s", label, value))
 	}
 	labels.Sort()
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	certificatesv1 "github.com/solo-io/gloo-mesh/pkg/api/certificates.mesh.gloo.solo.io/v1"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/agent/utils"
 	"github.com/solo-io/gloo-mesh/pkg/certificates/common/secrets"
 	utils2 "github.com/solo-io/gloo-mesh/pkg/certificates/issuer/utils"
@@ -27,7 +28,7 @@ func BuildRootCa(ctx context.Context, secretMeta ezkube.ResourceId) (*corev1.Sec
 		return nil, err
 	}
 
-	intermediateKey, err := utils.GeneratePrivateKey(2048)
+	intermediateKey, err := utils.GeneratePrivateKey(certificatesv1.CommonCertOptions_RSA, 2048)
 	if err != nil {
 		panic(err)
 	}